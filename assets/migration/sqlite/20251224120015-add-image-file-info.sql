@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE image ADD COLUMN filesize_bytes INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE image ADD COLUMN mime TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE image DROP COLUMN mime;
+ALTER TABLE image DROP COLUMN filesize_bytes;
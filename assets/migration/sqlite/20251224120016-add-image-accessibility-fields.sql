@@ -0,0 +1,17 @@
+-- +migrate Up
+ALTER TABLE image ADD COLUMN alt_lang TEXT NOT NULL DEFAULT '';
+ALTER TABLE image ADD COLUMN long_description TEXT NOT NULL DEFAULT '';
+ALTER TABLE image ADD COLUMN caption TEXT NOT NULL DEFAULT '';
+ALTER TABLE image ADD COLUMN decorative INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE image ADD COLUMN credit TEXT NOT NULL DEFAULT '';
+ALTER TABLE image ADD COLUMN license TEXT NOT NULL DEFAULT '';
+ALTER TABLE image ADD COLUMN source_url TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE image DROP COLUMN source_url;
+ALTER TABLE image DROP COLUMN license;
+ALTER TABLE image DROP COLUMN credit;
+ALTER TABLE image DROP COLUMN decorative;
+ALTER TABLE image DROP COLUMN caption;
+ALTER TABLE image DROP COLUMN long_description;
+ALTER TABLE image DROP COLUMN alt_lang;
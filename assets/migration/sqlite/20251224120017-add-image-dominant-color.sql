@@ -0,0 +1,5 @@
+-- +migrate Up
+ALTER TABLE image ADD COLUMN dominant_color TEXT NOT NULL DEFAULT '';
+
+-- +migrate Down
+ALTER TABLE image DROP COLUMN dominant_color;
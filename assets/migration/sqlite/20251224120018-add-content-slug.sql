@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE content ADD COLUMN slug TEXT NOT NULL DEFAULT '';
+CREATE UNIQUE INDEX idx_content_site_slug ON content(site_id, slug) WHERE slug != '';
+
+-- +migrate Down
+DROP INDEX idx_content_site_slug;
+ALTER TABLE content DROP COLUMN slug;
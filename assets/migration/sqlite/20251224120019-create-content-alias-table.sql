@@ -0,0 +1,16 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS content_alias (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	content_id TEXT NOT NULL,
+	path TEXT NOT NULL,
+	manual INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMP,
+	FOREIGN KEY (content_id) REFERENCES content(id) ON DELETE CASCADE,
+	UNIQUE(site_id, path)
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_alias_content_id ON content_alias(content_id);
+
+-- +migrate Down
+DROP TABLE IF EXISTS content_alias;
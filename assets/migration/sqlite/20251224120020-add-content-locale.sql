@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE content ADD COLUMN locale TEXT NOT NULL DEFAULT '';
+ALTER TABLE content ADD COLUMN translation_group TEXT NOT NULL DEFAULT '';
+CREATE INDEX idx_content_translation_group ON content(translation_group) WHERE translation_group != '';
+
+-- +migrate Down
+DROP INDEX idx_content_translation_group;
+ALTER TABLE content DROP COLUMN translation_group;
+ALTER TABLE content DROP COLUMN locale;
@@ -0,0 +1,43 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS series (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	section_id TEXT,
+	name TEXT NOT NULL,
+	slug TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	cover_image TEXT NOT NULL DEFAULT '',
+	status TEXT NOT NULL DEFAULT 'ongoing',
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	UNIQUE(site_id, slug)
+);
+
+CREATE INDEX IF NOT EXISTS idx_series_site_id ON series(site_id);
+
+ALTER TABLE content ADD COLUMN series_id TEXT NOT NULL DEFAULT '';
+CREATE INDEX idx_content_series_id ON content(series_id);
+
+-- Series only known by the name stored in their parts become entities.
+INSERT INTO series (id, site_id, short_id, section_id, name, slug, status, created_at, updated_at)
+SELECT
+	lower(hex(randomblob(4)) || '-' || hex(randomblob(2)) || '-4' || substr(hex(randomblob(2)), 2) || '-' ||
+		substr('89ab', 1 + (abs(random()) % 4), 1) || substr(hex(randomblob(2)), 2) || '-' || hex(randomblob(6))),
+	site_id, '', MIN(section_id), series, series, 'ongoing', CURRENT_TIMESTAMP, CURRENT_TIMESTAMP
+FROM content
+WHERE series IS NOT NULL AND series != ''
+GROUP BY site_id, series;
+
+UPDATE content SET series_id = (
+	SELECT s.id FROM series s WHERE s.site_id = content.site_id AND s.slug = content.series
+)
+WHERE series IS NOT NULL AND series != '';
+
+-- +migrate Down
+DROP INDEX idx_content_series_id;
+ALTER TABLE content DROP COLUMN series_id;
+DROP TABLE IF EXISTS series;
@@ -0,0 +1,40 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS menu (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	name TEXT NOT NULL,
+	location TEXT NOT NULL,
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	UNIQUE(site_id, location)
+);
+
+CREATE TABLE IF NOT EXISTS menu_item (
+	id TEXT PRIMARY KEY,
+	menu_id TEXT NOT NULL,
+	short_id TEXT,
+	parent_id TEXT NOT NULL DEFAULT '',
+	label TEXT NOT NULL DEFAULT '',
+	target_type TEXT NOT NULL,
+	target_id TEXT NOT NULL DEFAULT '',
+	url TEXT NOT NULL DEFAULT '',
+	position INTEGER NOT NULL DEFAULT 0,
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (menu_id) REFERENCES menu(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_menu_site_id ON menu(site_id);
+CREATE INDEX IF NOT EXISTS idx_menu_item_menu_id ON menu_item(menu_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_menu_item_menu_id;
+DROP INDEX IF EXISTS idx_menu_site_id;
+DROP TABLE IF EXISTS menu_item;
+DROP TABLE IF EXISTS menu;
@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE section ADD COLUMN parent_id TEXT NOT NULL DEFAULT '';
+ALTER TABLE section ADD COLUMN roll_up INTEGER NOT NULL DEFAULT 0;
+CREATE INDEX idx_section_parent_id ON section(parent_id);
+
+-- +migrate Down
+DROP INDEX idx_section_parent_id;
+ALTER TABLE section DROP COLUMN roll_up;
+ALTER TABLE section DROP COLUMN parent_id;
@@ -0,0 +1,36 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS author (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	user_id TEXT NOT NULL DEFAULT '',
+	name TEXT NOT NULL,
+	slug TEXT NOT NULL,
+	bio TEXT NOT NULL DEFAULT '',
+	avatar TEXT NOT NULL DEFAULT '',
+	links TEXT NOT NULL DEFAULT '',
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	UNIQUE(site_id, slug)
+);
+
+CREATE TABLE IF NOT EXISTS content_author (
+	content_id TEXT NOT NULL,
+	author_id TEXT NOT NULL,
+	position INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (content_id, author_id),
+	FOREIGN KEY (content_id) REFERENCES content(id) ON DELETE CASCADE,
+	FOREIGN KEY (author_id) REFERENCES author(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_author_site_id ON author(site_id);
+CREATE INDEX IF NOT EXISTS idx_content_author_author_id ON content_author(author_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_content_author_author_id;
+DROP INDEX IF EXISTS idx_author_site_id;
+DROP TABLE IF EXISTS content_author;
+DROP TABLE IF EXISTS author;
@@ -0,0 +1,51 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS content_type (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	name TEXT NOT NULL,
+	label TEXT NOT NULL DEFAULT '',
+	layout_id TEXT NOT NULL DEFAULT '',
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	UNIQUE(site_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS content_type_field (
+	content_type_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	label TEXT NOT NULL DEFAULT '',
+	type TEXT NOT NULL,
+	required INTEGER NOT NULL DEFAULT 0,
+	position INTEGER NOT NULL DEFAULT 0,
+	PRIMARY KEY (content_type_id, name),
+	FOREIGN KEY (content_type_id) REFERENCES content_type(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS content_type_section (
+	content_type_id TEXT NOT NULL,
+	section_id TEXT NOT NULL,
+	PRIMARY KEY (content_type_id, section_id),
+	FOREIGN KEY (content_type_id) REFERENCES content_type(id) ON DELETE CASCADE,
+	FOREIGN KEY (section_id) REFERENCES section(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS content_field (
+	content_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (content_id, name),
+	FOREIGN KEY (content_id) REFERENCES content(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_type_site_id ON content_type(site_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_content_type_site_id;
+DROP TABLE IF EXISTS content_field;
+DROP TABLE IF EXISTS content_type_section;
+DROP TABLE IF EXISTS content_type_field;
+DROP TABLE IF EXISTS content_type;
@@ -0,0 +1,39 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS archetype (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	kind TEXT NOT NULL,
+	section_id TEXT NOT NULL DEFAULT '',
+	body TEXT NOT NULL DEFAULT '',
+	tags TEXT NOT NULL DEFAULT '',
+	robots TEXT NOT NULL DEFAULT '',
+	keywords TEXT NOT NULL DEFAULT '',
+	sitemap TEXT NOT NULL DEFAULT '',
+	table_of_contents INTEGER NOT NULL DEFAULT 0,
+	share INTEGER NOT NULL DEFAULT 0,
+	comments INTEGER NOT NULL DEFAULT 0,
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	UNIQUE(site_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS archetype_field (
+	archetype_id TEXT NOT NULL,
+	name TEXT NOT NULL,
+	value TEXT NOT NULL DEFAULT '',
+	PRIMARY KEY (archetype_id, name),
+	FOREIGN KEY (archetype_id) REFERENCES archetype(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_archetype_site_id ON archetype(site_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_archetype_site_id;
+DROP TABLE IF EXISTS archetype_field;
+DROP TABLE IF EXISTS archetype;
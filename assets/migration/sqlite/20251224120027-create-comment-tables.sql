@@ -0,0 +1,34 @@
+-- +migrate Up
+CREATE TABLE IF NOT EXISTS comment (
+	id TEXT PRIMARY KEY,
+	site_id TEXT NOT NULL,
+	short_id TEXT,
+	content_id TEXT NOT NULL,
+	author_name TEXT NOT NULL,
+	author_email TEXT NOT NULL DEFAULT '',
+	body TEXT NOT NULL,
+	status TEXT NOT NULL DEFAULT 'pending',
+	created_by TEXT,
+	updated_by TEXT,
+	created_at TIMESTAMP,
+	updated_at TIMESTAMP,
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	FOREIGN KEY (content_id) REFERENCES content(id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS comment_rebuild (
+	site_id TEXT NOT NULL,
+	content_id TEXT NOT NULL,
+	PRIMARY KEY (site_id, content_id),
+	FOREIGN KEY (site_id) REFERENCES site(id) ON DELETE CASCADE,
+	FOREIGN KEY (content_id) REFERENCES content(id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_comment_site_status ON comment(site_id, status);
+CREATE INDEX IF NOT EXISTS idx_comment_content_id ON comment(content_id);
+
+-- +migrate Down
+DROP INDEX IF EXISTS idx_comment_content_id;
+DROP INDEX IF EXISTS idx_comment_site_status;
+DROP TABLE IF EXISTS comment_rebuild;
+DROP TABLE IF EXISTS comment;
@@ -0,0 +1,73 @@
+-- Res: Archetype
+-- Table: archetype
+
+-- Create
+INSERT INTO archetype (
+    id, site_id, short_id, name, description, kind, section_id, body, tags,
+    robots, keywords, sitemap, table_of_contents, share, comments,
+    created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :name, :description, :kind, :section_id, :body, :tags,
+    :robots, :keywords, :sitemap, :table_of_contents, :share, :comments,
+    :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    a.id, a.site_id, COALESCE(a.short_id, '') AS short_id, a.name, a.description, a.kind,
+    a.section_id, COALESCE(s.name, '') AS section_name, a.body, a.tags,
+    a.robots, a.keywords, a.sitemap, a.table_of_contents, a.share, a.comments,
+    COALESCE(a.created_by, '') AS created_by, COALESCE(a.updated_by, '') AS updated_by, a.created_at, a.updated_at
+FROM archetype a
+LEFT JOIN section s ON s.id = a.section_id
+WHERE a.id = ?;
+
+-- GetAll
+SELECT
+    a.id, a.site_id, COALESCE(a.short_id, '') AS short_id, a.name, a.description, a.kind,
+    a.section_id, COALESCE(s.name, '') AS section_name, a.body, a.tags,
+    a.robots, a.keywords, a.sitemap, a.table_of_contents, a.share, a.comments,
+    COALESCE(a.created_by, '') AS created_by, COALESCE(a.updated_by, '') AS updated_by, a.created_at, a.updated_at
+FROM archetype a
+LEFT JOIN section s ON s.id = a.section_id
+WHERE a.site_id = ?
+ORDER BY a.kind, a.name;
+
+-- Update
+UPDATE archetype SET
+    name = :name,
+    description = :description,
+    kind = :kind,
+    section_id = :section_id,
+    body = :body,
+    tags = :tags,
+    robots = :robots,
+    keywords = :keywords,
+    sitemap = :sitemap,
+    table_of_contents = :table_of_contents,
+    share = :share,
+    comments = :comments,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM archetype WHERE id = ?;
+
+-- Res: ArchetypeField
+-- Table: archetype_field
+
+-- GetFields
+SELECT archetype_id, name, value
+FROM archetype_field
+WHERE archetype_id IN (?);
+
+-- AddField
+INSERT INTO archetype_field (
+    archetype_id, name, value
+) VALUES (
+    ?, ?, ?
+);
+
+-- ClearFields
+DELETE FROM archetype_field WHERE archetype_id = ?;
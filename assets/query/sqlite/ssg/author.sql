@@ -0,0 +1,71 @@
+-- Res: Author
+-- Table: author
+
+-- Create
+INSERT INTO author (
+    id, site_id, short_id, user_id, name, slug, bio, avatar, links, created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :user_id, :name, :slug, :bio, :avatar, :links, :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    id, site_id, COALESCE(short_id, '') AS short_id, user_id, name, slug, bio, avatar, links,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM author
+WHERE id = ?;
+
+-- GetAll
+SELECT
+    id, site_id, COALESCE(short_id, '') AS short_id, user_id, name, slug, bio, avatar, links,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM author
+WHERE site_id = ?
+ORDER BY name;
+
+-- Update
+UPDATE author SET
+    user_id = :user_id,
+    name = :name,
+    slug = :slug,
+    bio = :bio,
+    avatar = :avatar,
+    links = :links,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM author WHERE id = ?;
+
+-- Res: ContentAuthor
+-- Table: content_author
+
+-- GetAuthorsForContent
+SELECT
+    a.id, a.site_id, COALESCE(a.short_id, '') AS short_id, a.user_id, a.name, a.slug, a.bio, a.avatar, a.links,
+    COALESCE(a.created_by, '') AS created_by, COALESCE(a.updated_by, '') AS updated_by, a.created_at, a.updated_at
+FROM author a
+JOIN content_author ca ON ca.author_id = a.id
+WHERE ca.content_id = ?
+ORDER BY ca.position;
+
+-- GetContentAuthors
+SELECT ca.content_id, ca.author_id, ca.position
+FROM content_author ca
+JOIN author a ON a.id = ca.author_id
+WHERE a.site_id = ?
+ORDER BY ca.content_id, ca.position;
+
+-- AddAuthorToContent
+INSERT INTO content_author (
+    content_id, author_id, position
+) VALUES (
+    ?, ?, ?
+);
+
+-- ClearContentAuthors
+DELETE FROM content_author WHERE content_id = ?;
+
+-- DeleteAuthorAttributions
+DELETE FROM content_author WHERE author_id = ?;
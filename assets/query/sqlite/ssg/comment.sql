@@ -0,0 +1,69 @@
+-- Res: Comment
+-- Table: comment
+
+-- Create
+INSERT INTO comment (
+    id, site_id, short_id, content_id, author_name, author_email, body, status,
+    created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :content_id, :author_name, :author_email, :body, :status,
+    :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    c.id, c.site_id, COALESCE(c.short_id, '') AS short_id, c.content_id,
+    c.author_name, c.author_email, c.body, c.status, COALESCE(ct.heading, '') AS content_heading,
+    COALESCE(c.created_by, '') AS created_by, COALESCE(c.updated_by, '') AS updated_by, c.created_at, c.updated_at
+FROM comment c
+LEFT JOIN content ct ON ct.id = c.content_id
+WHERE c.id = ?;
+
+-- GetAll
+SELECT
+    c.id, c.site_id, COALESCE(c.short_id, '') AS short_id, c.content_id,
+    c.author_name, c.author_email, c.body, c.status, COALESCE(ct.heading, '') AS content_heading,
+    COALESCE(c.created_by, '') AS created_by, COALESCE(c.updated_by, '') AS updated_by, c.created_at, c.updated_at
+FROM comment c
+LEFT JOIN content ct ON ct.id = c.content_id
+WHERE c.site_id = ? AND (? = '' OR c.status = ?)
+ORDER BY c.created_at DESC;
+
+-- GetAllOldestFirst
+SELECT
+    c.id, c.site_id, COALESCE(c.short_id, '') AS short_id, c.content_id,
+    c.author_name, c.author_email, c.body, c.status, COALESCE(ct.heading, '') AS content_heading,
+    COALESCE(c.created_by, '') AS created_by, COALESCE(c.updated_by, '') AS updated_by, c.created_at, c.updated_at
+FROM comment c
+LEFT JOIN content ct ON ct.id = c.content_id
+WHERE c.site_id = ? AND (? = '' OR c.status = ?)
+ORDER BY c.created_at ASC;
+
+-- Update
+UPDATE comment SET
+    status = :status,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM comment WHERE id = ?;
+
+-- Res: CommentRebuild
+-- Table: comment_rebuild
+
+-- AddRebuild
+INSERT OR IGNORE INTO comment_rebuild (
+    site_id, content_id
+) VALUES (
+    ?, ?
+);
+
+-- GetRebuilds
+SELECT content_id FROM comment_rebuild WHERE site_id = ? ORDER BY content_id;
+
+-- ClearRebuild
+DELETE FROM comment_rebuild WHERE site_id = ? AND content_id = ?;
+
+-- ClearRebuilds
+DELETE FROM comment_rebuild WHERE site_id = ?;
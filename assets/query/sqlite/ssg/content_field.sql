@@ -0,0 +1,22 @@
+-- Res: ContentField
+-- Table: content_field
+
+-- GetFieldsForContent
+SELECT content_id, name, value FROM content_field WHERE content_id = ? ORDER BY name;
+
+-- GetAll
+SELECT cf.content_id, cf.name, cf.value
+FROM content_field cf
+JOIN content c ON c.id = cf.content_id
+WHERE c.site_id = ?
+ORDER BY cf.content_id, cf.name;
+
+-- Create
+INSERT INTO content_field (
+    content_id, name, value
+) VALUES (
+    ?, ?, ?
+);
+
+-- DeleteForContent
+DELETE FROM content_field WHERE content_id = ?;
@@ -0,0 +1,77 @@
+-- Res: ContentType
+-- Table: content_type
+
+-- Create
+INSERT INTO content_type (
+    id, site_id, short_id, name, label, layout_id, created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :name, :label, :layout_id, :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    ct.id, ct.site_id, COALESCE(ct.short_id, '') AS short_id, ct.name, ct.label, ct.layout_id,
+    COALESCE(l.name, '') AS layout_name,
+    COALESCE(ct.created_by, '') AS created_by, COALESCE(ct.updated_by, '') AS updated_by, ct.created_at, ct.updated_at
+FROM content_type ct
+LEFT JOIN layout l ON l.id = ct.layout_id
+WHERE ct.id = ?;
+
+-- GetAll
+SELECT
+    ct.id, ct.site_id, COALESCE(ct.short_id, '') AS short_id, ct.name, ct.label, ct.layout_id,
+    COALESCE(l.name, '') AS layout_name,
+    COALESCE(ct.created_by, '') AS created_by, COALESCE(ct.updated_by, '') AS updated_by, ct.created_at, ct.updated_at
+FROM content_type ct
+LEFT JOIN layout l ON l.id = ct.layout_id
+WHERE ct.site_id = ?
+ORDER BY ct.name;
+
+-- Update
+UPDATE content_type SET
+    name = :name,
+    label = :label,
+    layout_id = :layout_id,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM content_type WHERE id = ?;
+
+-- Res: ContentTypeField
+-- Table: content_type_field
+
+-- GetFields
+SELECT content_type_id, name, label, type, required, position
+FROM content_type_field
+WHERE content_type_id IN (?)
+ORDER BY position;
+
+-- AddField
+INSERT INTO content_type_field (
+    content_type_id, name, label, type, required, position
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+);
+
+-- ClearFields
+DELETE FROM content_type_field WHERE content_type_id = ?;
+
+-- Res: ContentTypeSection
+-- Table: content_type_section
+
+-- GetSections
+SELECT content_type_id, section_id
+FROM content_type_section
+WHERE content_type_id IN (?);
+
+-- AddSection
+INSERT INTO content_type_section (
+    content_type_id, section_id
+) VALUES (
+    ?, ?
+);
+
+-- ClearSections
+DELETE FROM content_type_section WHERE content_type_id = ?;
@@ -1,27 +1,27 @@
 -- Res: ssg
 -- Table: image
 -- Create
-INSERT INTO image (id, site_id, short_id, file_name, file_path, alt_text, title, width, height, created_by, updated_by, created_at, updated_at)
-VALUES (:id, :site_id, :short_id, :file_name, :file_path, :alt_text, :title, :width, :height, :created_by, :updated_by, :created_at, :updated_at);
+INSERT INTO image (id, site_id, short_id, file_name, file_path, alt_text, alt_lang, long_description, caption, decorative, credit, license, source_url, title, width, height, filesize_bytes, mime, dominant_color, created_by, updated_by, created_at, updated_at)
+VALUES (:id, :site_id, :short_id, :file_name, :file_path, :alt_text, :alt_lang, :long_description, :caption, :decorative, :credit, :license, :source_url, :title, :width, :height, :filesize_bytes, :mime, :dominant_color, :created_by, :updated_by, :created_at, :updated_at);
 
 -- Res: ssg
 -- Table: image
 -- Get
-SELECT id, site_id, short_id, file_name, file_path, alt_text, title, width, height, created_by, updated_by, created_at, updated_at
+SELECT id, site_id, short_id, file_name, file_path, alt_text, alt_lang, long_description, caption, decorative, credit, license, source_url, title, width, height, filesize_bytes, mime, dominant_color, created_by, updated_by, created_at, updated_at
 FROM image
 WHERE id = ?;
 
 -- Res: ssg
 -- Table: image
 -- GetImageByShortID
-SELECT id, site_id, short_id, file_name, file_path, alt_text, title, width, height, created_by, updated_by, created_at, updated_at
+SELECT id, site_id, short_id, file_name, file_path, alt_text, alt_lang, long_description, caption, decorative, credit, license, source_url, title, width, height, filesize_bytes, mime, dominant_color, created_by, updated_by, created_at, updated_at
 FROM image
 WHERE short_id = ?;
 
 -- Res: ssg
 -- Table: image
 -- GetImageByContentHash
-SELECT id, site_id, short_id, file_name, file_path, alt_text, title, width, height, created_by, updated_by, created_at, updated_at
+SELECT id, site_id, short_id, file_name, file_path, alt_text, alt_lang, long_description, caption, decorative, credit, license, source_url, title, width, height, filesize_bytes, mime, dominant_color, created_by, updated_by, created_at, updated_at
 FROM image
 WHERE file_path = ?;
 
@@ -29,7 +29,7 @@ WHERE file_path = ?;
 -- Table: image
 -- Update
 UPDATE image
-SET file_name = :file_name, file_path = :file_path, alt_text = :alt_text, title = :title, width = :width, height = :height, updated_by = :updated_by, updated_at = :updated_at
+SET file_name = :file_name, file_path = :file_path, alt_text = :alt_text, alt_lang = :alt_lang, long_description = :long_description, caption = :caption, decorative = :decorative, credit = :credit, license = :license, source_url = :source_url, title = :title, width = :width, height = :height, filesize_bytes = :filesize_bytes, mime = :mime, dominant_color = :dominant_color, updated_by = :updated_by, updated_at = :updated_at
 WHERE id = :id;
 
 -- Res: ssg
@@ -41,5 +41,5 @@ WHERE id = ?;
 -- Res: ssg
 -- Table: image
 -- List
-SELECT id, site_id, short_id, file_name, file_path, alt_text, title, width, height, created_by, updated_by, created_at, updated_at
+SELECT id, site_id, short_id, file_name, file_path, alt_text, alt_lang, long_description, caption, decorative, credit, license, source_url, title, width, height, filesize_bytes, mime, dominant_color, created_by, updated_by, created_at, updated_at
 FROM image;
@@ -1,61 +1,72 @@
 -- Res: ImageVariant
--- Table: image_variants
+-- Table: image_variant
 -- GetImageVariantByID
 SELECT
   id,
+  short_id,
   image_id,
-  name,
+  kind,
+  blob_ref,
   width,
   height,
-  url,
+  filesize_bytes,
+  mime,
   created_by,
   updated_by,
   created_at,
   updated_at
-FROM image_variants
+FROM image_variant
 WHERE id = ?;
 
 -- GetImageVariantsByImageID
 SELECT
   id,
+  short_id,
   image_id,
-  name,
+  kind,
+  blob_ref,
   width,
   height,
-  url,
+  filesize_bytes,
+  mime,
   created_by,
   updated_by,
   created_at,
   updated_at
-FROM image_variants
+FROM image_variant
 WHERE image_id = ?;
 
 -- CreateImageVariant
-INSERT INTO image_variants (
+INSERT INTO image_variant (
   id,
+  short_id,
   image_id,
-  name,
+  kind,
+  blob_ref,
   width,
   height,
-  url,
+  filesize_bytes,
+  mime,
   created_by,
   updated_by,
   created_at,
   updated_at
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+) VALUES (:id, :short_id, :image_id, :kind, :blob_ref, :width, :height, :filesize_bytes, :mime, :created_by, :updated_by, :created_at, :updated_at);
 
 -- UpdateImageVariant
-UPDATE image_variants
+UPDATE image_variant
 SET
-  image_id = ?,
-  name = ?,
-  width = ?,
-  height = ?,
-  url = ?,
-  updated_by = ?,
-  updated_at = ?
-WHERE id = ?;
+  image_id = :image_id,
+  kind = :kind,
+  blob_ref = :blob_ref,
+  width = :width,
+  height = :height,
+  filesize_bytes = :filesize_bytes,
+  mime = :mime,
+  updated_by = :updated_by,
+  updated_at = :updated_at
+WHERE id = :id;
 
 -- DeleteImageVariant
-DELETE FROM image_variants
+DELETE FROM image_variant
 WHERE id = ?;
@@ -0,0 +1,42 @@
+-- Res: MenuItem
+-- Table: menu_item
+
+-- Create
+INSERT INTO menu_item (
+    id, menu_id, short_id, parent_id, label, target_type, target_id, url, position, created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :menu_id, :short_id, :parent_id, :label, :target_type, :target_id, :url, :position, :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    id, menu_id, COALESCE(short_id, '') AS short_id, parent_id, label, target_type, target_id, url, position,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM menu_item
+WHERE id = ?;
+
+-- GetByMenu
+SELECT
+    id, menu_id, COALESCE(short_id, '') AS short_id, parent_id, label, target_type, target_id, url, position,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM menu_item
+WHERE menu_id = ?
+ORDER BY position;
+
+-- Update
+UPDATE menu_item SET
+    parent_id = :parent_id,
+    label = :label,
+    target_type = :target_type,
+    target_id = :target_id,
+    url = :url,
+    position = :position,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM menu_item WHERE id = ? OR parent_id = ?;
+
+-- DeleteByMenu
+DELETE FROM menu_item WHERE menu_id = ?;
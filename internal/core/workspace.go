@@ -43,11 +43,13 @@ func (w *Workspace) setupDirs() error {
 		configDir := filepath.Join(base, "config")
 		dbBase := filepath.Join(base, "db")
 		sitesBase := filepath.Join(base, "sites")
+		themesBase := ssg.GetThemesPath(base)
 
 		dirs = []string{
 			configDir,
 			dbBase,
 			sitesBase,
+			themesBase,
 		}
 
 		// Set single DB path (all data)
@@ -71,11 +73,13 @@ func (w *Workspace) setupDirs() error {
 		dataDir := filepath.Join(homeDir, ".clio")
 		configDir := filepath.Join(homeDir, ".config", "clio")
 		sitesBase := filepath.Join(homeDir, "Documents", "Clio", "sites")
+		themesBase := ssg.GetThemesPath(filepath.Join(homeDir, "Documents", "Clio"))
 
 		dirs = []string{
 			dataDir,
 			configDir,
 			sitesBase,
+			themesBase,
 		}
 
 		// Set single DB path (all data)
@@ -2,6 +2,7 @@ package fake
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -15,7 +16,9 @@ type SsgRepo struct {
 
 	CreateContentFn                      func(ctx context.Context, content *ssg.Content) error
 	GetContentFn                         func(ctx context.Context, id uuid.UUID) (ssg.Content, error)
+	GetContentBySlugFn                   func(ctx context.Context, slug string) (ssg.Content, error)
 	UpdateContentFn                      func(ctx context.Context, content *ssg.Content) error
+	MoveContentFn                        func(ctx context.Context, move ssg.ContentMove) error
 	DeleteContentFn                      func(ctx context.Context, id uuid.UUID) error
 	GetAllContentWithMetaFn              func(ctx context.Context) ([]ssg.Content, error)
 	GetContentWithPaginationAndSearchFn  func(ctx context.Context, offset, limit int, searchQuery string) ([]ssg.Content, int, error)
@@ -23,6 +26,7 @@ type SsgRepo struct {
 	GetSectionFn                         func(ctx context.Context, id uuid.UUID) (ssg.Section, error)
 	GetSectionsFn                        func(ctx context.Context) ([]ssg.Section, error)
 	UpdateSectionFn                      func(ctx context.Context, section ssg.Section) error
+	MoveSectionsFn                       func(ctx context.Context, move ssg.SectionMove) error
 	DeleteSectionFn                      func(ctx context.Context, id uuid.UUID) error
 	CreateLayoutFn                       func(ctx context.Context, layout ssg.Layout) error
 	GetLayoutFn                          func(ctx context.Context, id uuid.UUID) (ssg.Layout, error)
@@ -35,6 +39,47 @@ type SsgRepo struct {
 	GetAllTagsFn                         func(ctx context.Context) ([]ssg.Tag, error)
 	UpdateTagFn                          func(ctx context.Context, tag ssg.Tag) error
 	DeleteTagFn                          func(ctx context.Context, id uuid.UUID) error
+	CreateSeriesFn                       func(ctx context.Context, series ssg.Series) error
+	GetSeriesFn                          func(ctx context.Context, id uuid.UUID) (ssg.Series, error)
+	GetAllSeriesFn                       func(ctx context.Context) ([]ssg.Series, error)
+	UpdateSeriesFn                       func(ctx context.Context, series ssg.Series) error
+	DeleteSeriesFn                       func(ctx context.Context, id uuid.UUID) error
+	UpdateSeriesOrderFn                  func(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error
+	CreateAuthorFn                       func(ctx context.Context, author ssg.Author) error
+	GetAuthorFn                          func(ctx context.Context, id uuid.UUID) (ssg.Author, error)
+	GetAllAuthorsFn                      func(ctx context.Context) ([]ssg.Author, error)
+	UpdateAuthorFn                       func(ctx context.Context, author ssg.Author) error
+	DeleteAuthorFn                       func(ctx context.Context, id uuid.UUID) error
+	SetContentAuthorsFn                  func(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error
+	CreateContentTypeFn                  func(ctx context.Context, contentType ssg.ContentType) error
+	GetContentTypeFn                     func(ctx context.Context, id uuid.UUID) (ssg.ContentType, error)
+	GetContentTypesFn                    func(ctx context.Context) ([]ssg.ContentType, error)
+	UpdateContentTypeFn                  func(ctx context.Context, contentType ssg.ContentType) error
+	DeleteContentTypeFn                  func(ctx context.Context, id uuid.UUID) error
+	SetContentFieldsFn                   func(ctx context.Context, contentID uuid.UUID, fields []ssg.ContentField) error
+	CreateArchetypeFn                    func(ctx context.Context, archetype ssg.Archetype) error
+	GetArchetypeFn                       func(ctx context.Context, id uuid.UUID) (ssg.Archetype, error)
+	GetArchetypesFn                      func(ctx context.Context) ([]ssg.Archetype, error)
+	UpdateArchetypeFn                    func(ctx context.Context, archetype ssg.Archetype) error
+	DeleteArchetypeFn                    func(ctx context.Context, id uuid.UUID) error
+	CreateCommentFn                     func(ctx context.Context, comment ssg.Comment) error
+	GetCommentFn                        func(ctx context.Context, id uuid.UUID) (ssg.Comment, error)
+	GetCommentsFn                       func(ctx context.Context, status string) ([]ssg.Comment, error)
+	UpdateCommentFn                     func(ctx context.Context, comment ssg.Comment) error
+	DeleteCommentFn                     func(ctx context.Context, id uuid.UUID) error
+	AddCommentRebuildFn                 func(ctx context.Context, contentID uuid.UUID) error
+	GetCommentRebuildsFn                func(ctx context.Context) ([]uuid.UUID, error)
+	ClearCommentRebuildsFn              func(ctx context.Context, contentIDs []uuid.UUID) error
+	CreateMenuFn                         func(ctx context.Context, menu ssg.Menu) error
+	GetMenuFn                            func(ctx context.Context, id uuid.UUID) (ssg.Menu, error)
+	GetMenusFn                           func(ctx context.Context) ([]ssg.Menu, error)
+	UpdateMenuFn                         func(ctx context.Context, menu ssg.Menu) error
+	DeleteMenuFn                         func(ctx context.Context, id uuid.UUID) error
+	CreateMenuItemFn                     func(ctx context.Context, item ssg.MenuItem) error
+	GetMenuItemFn                        func(ctx context.Context, id uuid.UUID) (ssg.MenuItem, error)
+	GetMenuItemsFn                       func(ctx context.Context, menuID uuid.UUID) ([]ssg.MenuItem, error)
+	UpdateMenuItemFn                     func(ctx context.Context, item ssg.MenuItem) error
+	DeleteMenuItemFn                     func(ctx context.Context, id uuid.UUID) error
 	CreateParamFn                        func(ctx context.Context, param *ssg.Param) error
 	GetParamFn                           func(ctx context.Context, id uuid.UUID) (ssg.Param, error)
 	GetParamByNameFn                     func(ctx context.Context, name string) (ssg.Param, error)
@@ -57,6 +102,10 @@ type SsgRepo struct {
 	CreateContentImageFn                 func(ctx context.Context, contentImage *ssg.ContentImage) error
 	DeleteContentImageFn                 func(ctx context.Context, id uuid.UUID) error
 	GetContentImagesByContentIDFn        func(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentImage, error)
+	CreateContentAliasFn                 func(ctx context.Context, alias *ssg.ContentAlias) error
+	GetContentAliasesFn                  func(ctx context.Context) ([]ssg.ContentAlias, error)
+	GetContentAliasesByContentIDFn       func(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentAlias, error)
+	DeleteContentAliasFn                 func(ctx context.Context, id uuid.UUID) error
 	CreateSectionImageFn                 func(ctx context.Context, sectionImage *ssg.SectionImage) error
 	DeleteSectionImageFn                 func(ctx context.Context, id uuid.UUID) error
 	GetSectionImagesBySectionIDFn        func(ctx context.Context, sectionID uuid.UUID) ([]ssg.SectionImage, error)
@@ -72,6 +121,16 @@ type SsgRepo struct {
 	layouts        map[uuid.UUID]ssg.Layout
 	tags           map[uuid.UUID]ssg.Tag
 	tagsByName     map[string]ssg.Tag
+	series         map[uuid.UUID]ssg.Series
+	authors        map[uuid.UUID]ssg.Author
+	contentAuthors map[uuid.UUID][]uuid.UUID
+	contentTypes   map[uuid.UUID]ssg.ContentType
+	contentFields  map[uuid.UUID][]ssg.ContentField
+	archetypes     map[uuid.UUID]ssg.Archetype
+	comments       map[uuid.UUID]ssg.Comment
+	rebuilds       map[uuid.UUID]bool
+	menus          map[uuid.UUID]ssg.Menu
+	menuItems      map[uuid.UUID]ssg.MenuItem
 	params         map[uuid.UUID]ssg.Param
 	paramsByName   map[string]ssg.Param
 	paramsByRefKey map[string]ssg.Param
@@ -81,6 +140,7 @@ type SsgRepo struct {
 	imageVariants  map[uuid.UUID]ssg.ImageVariant
 	contentImages  map[uuid.UUID][]ssg.ContentImage
 	sectionImages  map[uuid.UUID][]ssg.SectionImage
+	contentAliases map[uuid.UUID]ssg.ContentAlias
 	contentTags    map[uuid.UUID][]uuid.UUID
 	users          map[string]auth.User
 	sites          map[string]ssg.Site
@@ -95,6 +155,16 @@ func NewSsgRepo() *SsgRepo {
 		layouts:        make(map[uuid.UUID]ssg.Layout),
 		tags:           make(map[uuid.UUID]ssg.Tag),
 		tagsByName:     make(map[string]ssg.Tag),
+		series:         make(map[uuid.UUID]ssg.Series),
+		authors:        make(map[uuid.UUID]ssg.Author),
+		contentAuthors: make(map[uuid.UUID][]uuid.UUID),
+		contentTypes:   make(map[uuid.UUID]ssg.ContentType),
+		contentFields:  make(map[uuid.UUID][]ssg.ContentField),
+		archetypes:     make(map[uuid.UUID]ssg.Archetype),
+		comments:       make(map[uuid.UUID]ssg.Comment),
+		rebuilds:       make(map[uuid.UUID]bool),
+		menus:          make(map[uuid.UUID]ssg.Menu),
+		menuItems:      make(map[uuid.UUID]ssg.MenuItem),
 		params:         make(map[uuid.UUID]ssg.Param),
 		paramsByName:   make(map[string]ssg.Param),
 		paramsByRefKey: make(map[string]ssg.Param),
@@ -104,6 +174,7 @@ func NewSsgRepo() *SsgRepo {
 		imageVariants:  make(map[uuid.UUID]ssg.ImageVariant),
 		contentImages:  make(map[uuid.UUID][]ssg.ContentImage),
 		sectionImages:  make(map[uuid.UUID][]ssg.SectionImage),
+		contentAliases: make(map[uuid.UUID]ssg.ContentAlias),
 		contentTags:    make(map[uuid.UUID][]uuid.UUID),
 		users:          make(map[string]auth.User),
 		sites:          make(map[string]ssg.Site),
@@ -136,6 +207,18 @@ func (f *SsgRepo) GetContent(ctx context.Context, id uuid.UUID) (ssg.Content, er
 	return ssg.Content{}, fmt.Errorf("content not found")
 }
 
+func (f *SsgRepo) GetContentBySlug(ctx context.Context, slug string) (ssg.Content, error) {
+	if f.GetContentBySlugFn != nil {
+		return f.GetContentBySlugFn(ctx, slug)
+	}
+	for _, c := range f.contents {
+		if c.SlugField == slug {
+			return c, nil
+		}
+	}
+	return ssg.Content{}, sql.ErrNoRows
+}
+
 func (f *SsgRepo) UpdateContent(ctx context.Context, content *ssg.Content) error {
 	if f.UpdateContentFn != nil {
 		return f.UpdateContentFn(ctx, content)
@@ -144,6 +227,22 @@ func (f *SsgRepo) UpdateContent(ctx context.Context, content *ssg.Content) error
 	return nil
 }
 
+func (f *SsgRepo) MoveContent(ctx context.Context, move ssg.ContentMove) error {
+	if f.MoveContentFn != nil {
+		return f.MoveContentFn(ctx, move)
+	}
+	f.contents[move.Content.ID] = *move.Content
+	for _, alias := range move.Aliases {
+		if err := f.CreateContentAlias(ctx, alias); err != nil {
+			return err
+		}
+	}
+	for _, id := range move.ClearedAliases {
+		delete(f.contentAliases, id)
+	}
+	return nil
+}
+
 func (f *SsgRepo) DeleteContent(ctx context.Context, id uuid.UUID) error {
 	if f.DeleteContentFn != nil {
 		return f.DeleteContentFn(ctx, id)
@@ -211,6 +310,24 @@ func (f *SsgRepo) UpdateSection(ctx context.Context, section ssg.Section) error
 	return nil
 }
 
+func (f *SsgRepo) MoveSections(ctx context.Context, move ssg.SectionMove) error {
+	if f.MoveSectionsFn != nil {
+		return f.MoveSectionsFn(ctx, move)
+	}
+	for _, section := range move.Sections {
+		f.sections[section.ID] = section
+	}
+	for _, alias := range move.Aliases {
+		if err := f.CreateContentAlias(ctx, alias); err != nil {
+			return err
+		}
+	}
+	for _, id := range move.ClearedAliases {
+		delete(f.contentAliases, id)
+	}
+	return nil
+}
+
 func (f *SsgRepo) DeleteSection(ctx context.Context, id uuid.UUID) error {
 	if f.DeleteSectionFn != nil {
 		return f.DeleteSectionFn(ctx, id)
@@ -324,6 +441,445 @@ func (f *SsgRepo) DeleteTag(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+func (f *SsgRepo) CreateSeries(ctx context.Context, series ssg.Series) error {
+	if f.CreateSeriesFn != nil {
+		return f.CreateSeriesFn(ctx, series)
+	}
+	f.series[series.ID] = series
+	return nil
+}
+
+func (f *SsgRepo) GetSeries(ctx context.Context, id uuid.UUID) (ssg.Series, error) {
+	if f.GetSeriesFn != nil {
+		return f.GetSeriesFn(ctx, id)
+	}
+	if s, ok := f.series[id]; ok {
+		return s, nil
+	}
+	return ssg.Series{}, fmt.Errorf("series not found")
+}
+
+func (f *SsgRepo) GetAllSeries(ctx context.Context) ([]ssg.Series, error) {
+	if f.GetAllSeriesFn != nil {
+		return f.GetAllSeriesFn(ctx)
+	}
+	var series []ssg.Series
+	for _, s := range f.series {
+		series = append(series, s)
+	}
+	return series, nil
+}
+
+func (f *SsgRepo) UpdateSeries(ctx context.Context, series ssg.Series) error {
+	if f.UpdateSeriesFn != nil {
+		return f.UpdateSeriesFn(ctx, series)
+	}
+	f.series[series.ID] = series
+	return nil
+}
+
+func (f *SsgRepo) DeleteSeries(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteSeriesFn != nil {
+		return f.DeleteSeriesFn(ctx, id)
+	}
+	for cid, c := range f.contents {
+		if c.SeriesID == id {
+			c.SeriesID = uuid.Nil
+			c.Series = ""
+			c.SeriesOrder = 0
+			f.contents[cid] = c
+		}
+	}
+	delete(f.series, id)
+	return nil
+}
+
+func (f *SsgRepo) UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error {
+	if f.UpdateSeriesOrderFn != nil {
+		return f.UpdateSeriesOrderFn(ctx, seriesID, contentIDs)
+	}
+	for i, id := range contentIDs {
+		if c, ok := f.contents[id]; ok && c.SeriesID == seriesID {
+			c.SeriesOrder = i + 1
+			f.contents[id] = c
+		}
+	}
+	return nil
+}
+
+func (f *SsgRepo) CreateAuthor(ctx context.Context, author ssg.Author) error {
+	if f.CreateAuthorFn != nil {
+		return f.CreateAuthorFn(ctx, author)
+	}
+	f.authors[author.ID] = author
+	return nil
+}
+
+func (f *SsgRepo) GetAuthor(ctx context.Context, id uuid.UUID) (ssg.Author, error) {
+	if f.GetAuthorFn != nil {
+		return f.GetAuthorFn(ctx, id)
+	}
+	if a, ok := f.authors[id]; ok {
+		return a, nil
+	}
+	return ssg.Author{}, fmt.Errorf("author not found")
+}
+
+func (f *SsgRepo) GetAllAuthors(ctx context.Context) ([]ssg.Author, error) {
+	if f.GetAllAuthorsFn != nil {
+		return f.GetAllAuthorsFn(ctx)
+	}
+	var authors []ssg.Author
+	for _, a := range f.authors {
+		authors = append(authors, a)
+	}
+	return authors, nil
+}
+
+func (f *SsgRepo) UpdateAuthor(ctx context.Context, author ssg.Author) error {
+	if f.UpdateAuthorFn != nil {
+		return f.UpdateAuthorFn(ctx, author)
+	}
+	f.authors[author.ID] = author
+	return nil
+}
+
+func (f *SsgRepo) DeleteAuthor(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteAuthorFn != nil {
+		return f.DeleteAuthorFn(ctx, id)
+	}
+	for cid, ids := range f.contentAuthors {
+		var kept []uuid.UUID
+		for _, aid := range ids {
+			if aid != id {
+				kept = append(kept, aid)
+			}
+		}
+		f.contentAuthors[cid] = kept
+	}
+	delete(f.authors, id)
+	return nil
+}
+
+func (f *SsgRepo) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]ssg.Author, error) {
+	var authors []ssg.Author
+	for _, id := range f.contentAuthors[contentID] {
+		if a, ok := f.authors[id]; ok {
+			authors = append(authors, a)
+		}
+	}
+	return authors, nil
+}
+
+func (f *SsgRepo) GetContentAuthors(ctx context.Context) ([]ssg.ContentAuthor, error) {
+	var links []ssg.ContentAuthor
+	for cid, ids := range f.contentAuthors {
+		for i, id := range ids {
+			links = append(links, ssg.ContentAuthor{ContentID: cid, AuthorID: id, Position: i + 1})
+		}
+	}
+	return links, nil
+}
+
+func (f *SsgRepo) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error {
+	if f.SetContentAuthorsFn != nil {
+		return f.SetContentAuthorsFn(ctx, contentID, authorIDs)
+	}
+	f.contentAuthors[contentID] = append([]uuid.UUID(nil), authorIDs...)
+	return nil
+}
+
+func (f *SsgRepo) CreateContentType(ctx context.Context, contentType ssg.ContentType) error {
+	if f.CreateContentTypeFn != nil {
+		return f.CreateContentTypeFn(ctx, contentType)
+	}
+	f.contentTypes[contentType.ID] = contentType
+	return nil
+}
+
+func (f *SsgRepo) GetContentType(ctx context.Context, id uuid.UUID) (ssg.ContentType, error) {
+	if f.GetContentTypeFn != nil {
+		return f.GetContentTypeFn(ctx, id)
+	}
+	if ct, ok := f.contentTypes[id]; ok {
+		return ct, nil
+	}
+	return ssg.ContentType{}, fmt.Errorf("content type not found")
+}
+
+func (f *SsgRepo) GetContentTypes(ctx context.Context) ([]ssg.ContentType, error) {
+	if f.GetContentTypesFn != nil {
+		return f.GetContentTypesFn(ctx)
+	}
+	var types []ssg.ContentType
+	for _, ct := range f.contentTypes {
+		types = append(types, ct)
+	}
+	return types, nil
+}
+
+func (f *SsgRepo) UpdateContentType(ctx context.Context, contentType ssg.ContentType) error {
+	if f.UpdateContentTypeFn != nil {
+		return f.UpdateContentTypeFn(ctx, contentType)
+	}
+	f.contentTypes[contentType.ID] = contentType
+	return nil
+}
+
+func (f *SsgRepo) DeleteContentType(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteContentTypeFn != nil {
+		return f.DeleteContentTypeFn(ctx, id)
+	}
+	delete(f.contentTypes, id)
+	return nil
+}
+
+func (f *SsgRepo) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentField, error) {
+	return f.contentFields[contentID], nil
+}
+
+func (f *SsgRepo) GetContentFields(ctx context.Context) ([]ssg.ContentField, error) {
+	var fields []ssg.ContentField
+	for _, list := range f.contentFields {
+		fields = append(fields, list...)
+	}
+	return fields, nil
+}
+
+func (f *SsgRepo) SetContentFields(ctx context.Context, contentID uuid.UUID, fields []ssg.ContentField) error {
+	if f.SetContentFieldsFn != nil {
+		return f.SetContentFieldsFn(ctx, contentID, fields)
+	}
+	f.contentFields[contentID] = append([]ssg.ContentField(nil), fields...)
+	return nil
+}
+
+func (f *SsgRepo) CreateArchetype(ctx context.Context, archetype ssg.Archetype) error {
+	if f.CreateArchetypeFn != nil {
+		return f.CreateArchetypeFn(ctx, archetype)
+	}
+	f.archetypes[archetype.ID] = archetype
+	return nil
+}
+
+func (f *SsgRepo) GetArchetype(ctx context.Context, id uuid.UUID) (ssg.Archetype, error) {
+	if f.GetArchetypeFn != nil {
+		return f.GetArchetypeFn(ctx, id)
+	}
+	if a, ok := f.archetypes[id]; ok {
+		return a, nil
+	}
+	return ssg.Archetype{}, fmt.Errorf("archetype not found")
+}
+
+func (f *SsgRepo) GetArchetypes(ctx context.Context) ([]ssg.Archetype, error) {
+	if f.GetArchetypesFn != nil {
+		return f.GetArchetypesFn(ctx)
+	}
+	var archetypes []ssg.Archetype
+	for _, a := range f.archetypes {
+		archetypes = append(archetypes, a)
+	}
+	return archetypes, nil
+}
+
+func (f *SsgRepo) UpdateArchetype(ctx context.Context, archetype ssg.Archetype) error {
+	if f.UpdateArchetypeFn != nil {
+		return f.UpdateArchetypeFn(ctx, archetype)
+	}
+	f.archetypes[archetype.ID] = archetype
+	return nil
+}
+
+func (f *SsgRepo) DeleteArchetype(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteArchetypeFn != nil {
+		return f.DeleteArchetypeFn(ctx, id)
+	}
+	delete(f.archetypes, id)
+	return nil
+}
+
+func (f *SsgRepo) CreateComment(ctx context.Context, comment ssg.Comment) error {
+	if f.CreateCommentFn != nil {
+		return f.CreateCommentFn(ctx, comment)
+	}
+	f.comments[comment.ID] = comment
+	return nil
+}
+
+func (f *SsgRepo) GetComment(ctx context.Context, id uuid.UUID) (ssg.Comment, error) {
+	if f.GetCommentFn != nil {
+		return f.GetCommentFn(ctx, id)
+	}
+	if c, ok := f.comments[id]; ok {
+		return c, nil
+	}
+	return ssg.Comment{}, fmt.Errorf("comment not found")
+}
+
+func (f *SsgRepo) GetComments(ctx context.Context, status string) ([]ssg.Comment, error) {
+	if f.GetCommentsFn != nil {
+		return f.GetCommentsFn(ctx, status)
+	}
+	var comments []ssg.Comment
+	for _, c := range f.comments {
+		if status == "" || c.Status == status {
+			comments = append(comments, c)
+		}
+	}
+	return comments, nil
+}
+
+func (f *SsgRepo) UpdateComment(ctx context.Context, comment ssg.Comment) error {
+	if f.UpdateCommentFn != nil {
+		return f.UpdateCommentFn(ctx, comment)
+	}
+	f.comments[comment.ID] = comment
+	return nil
+}
+
+func (f *SsgRepo) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteCommentFn != nil {
+		return f.DeleteCommentFn(ctx, id)
+	}
+	delete(f.comments, id)
+	return nil
+}
+
+func (f *SsgRepo) AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error {
+	if f.AddCommentRebuildFn != nil {
+		return f.AddCommentRebuildFn(ctx, contentID)
+	}
+	f.rebuilds[contentID] = true
+	return nil
+}
+
+func (f *SsgRepo) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	if f.GetCommentRebuildsFn != nil {
+		return f.GetCommentRebuildsFn(ctx)
+	}
+	var ids []uuid.UUID
+	for id := range f.rebuilds {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (f *SsgRepo) ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error {
+	if f.ClearCommentRebuildsFn != nil {
+		return f.ClearCommentRebuildsFn(ctx, contentIDs)
+	}
+	if contentIDs == nil {
+		f.rebuilds = make(map[uuid.UUID]bool)
+	}
+	for _, id := range contentIDs {
+		delete(f.rebuilds, id)
+	}
+	return nil
+}
+
+func (f *SsgRepo) CreateMenu(ctx context.Context, menu ssg.Menu) error {
+	if f.CreateMenuFn != nil {
+		return f.CreateMenuFn(ctx, menu)
+	}
+	f.menus[menu.ID] = menu
+	return nil
+}
+
+func (f *SsgRepo) GetMenu(ctx context.Context, id uuid.UUID) (ssg.Menu, error) {
+	if f.GetMenuFn != nil {
+		return f.GetMenuFn(ctx, id)
+	}
+	if m, ok := f.menus[id]; ok {
+		return m, nil
+	}
+	return ssg.Menu{}, fmt.Errorf("menu not found")
+}
+
+func (f *SsgRepo) GetMenus(ctx context.Context) ([]ssg.Menu, error) {
+	if f.GetMenusFn != nil {
+		return f.GetMenusFn(ctx)
+	}
+	var menus []ssg.Menu
+	for _, m := range f.menus {
+		menus = append(menus, m)
+	}
+	return menus, nil
+}
+
+func (f *SsgRepo) UpdateMenu(ctx context.Context, menu ssg.Menu) error {
+	if f.UpdateMenuFn != nil {
+		return f.UpdateMenuFn(ctx, menu)
+	}
+	f.menus[menu.ID] = menu
+	return nil
+}
+
+func (f *SsgRepo) DeleteMenu(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteMenuFn != nil {
+		return f.DeleteMenuFn(ctx, id)
+	}
+	for iid, item := range f.menuItems {
+		if item.MenuID == id {
+			delete(f.menuItems, iid)
+		}
+	}
+	delete(f.menus, id)
+	return nil
+}
+
+func (f *SsgRepo) CreateMenuItem(ctx context.Context, item ssg.MenuItem) error {
+	if f.CreateMenuItemFn != nil {
+		return f.CreateMenuItemFn(ctx, item)
+	}
+	f.menuItems[item.ID] = item
+	return nil
+}
+
+func (f *SsgRepo) GetMenuItem(ctx context.Context, id uuid.UUID) (ssg.MenuItem, error) {
+	if f.GetMenuItemFn != nil {
+		return f.GetMenuItemFn(ctx, id)
+	}
+	if item, ok := f.menuItems[id]; ok {
+		return item, nil
+	}
+	return ssg.MenuItem{}, fmt.Errorf("menu item not found")
+}
+
+func (f *SsgRepo) GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]ssg.MenuItem, error) {
+	if f.GetMenuItemsFn != nil {
+		return f.GetMenuItemsFn(ctx, menuID)
+	}
+	var items []ssg.MenuItem
+	for _, item := range f.menuItems {
+		if item.MenuID == menuID {
+			items = append(items, item)
+		}
+	}
+	return ssg.SortMenuItems(items), nil
+}
+
+func (f *SsgRepo) UpdateMenuItem(ctx context.Context, item ssg.MenuItem) error {
+	if f.UpdateMenuItemFn != nil {
+		return f.UpdateMenuItemFn(ctx, item)
+	}
+	f.menuItems[item.ID] = item
+	return nil
+}
+
+func (f *SsgRepo) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteMenuItemFn != nil {
+		return f.DeleteMenuItemFn(ctx, id)
+	}
+	for iid, item := range f.menuItems {
+		if item.ParentID == id {
+			delete(f.menuItems, iid)
+		}
+	}
+	delete(f.menuItems, id)
+	return nil
+}
+
 func (f *SsgRepo) CreateParam(ctx context.Context, param *ssg.Param) error {
 	if f.CreateParamFn != nil {
 		return f.CreateParamFn(ctx, param)
@@ -547,6 +1103,51 @@ func (f *SsgRepo) GetContentImagesByContentID(ctx context.Context, contentID uui
 	return f.contentImages[contentID], nil
 }
 
+func (f *SsgRepo) CreateContentAlias(ctx context.Context, alias *ssg.ContentAlias) error {
+	if f.CreateContentAliasFn != nil {
+		return f.CreateContentAliasFn(ctx, alias)
+	}
+	for id, a := range f.contentAliases {
+		if a.SiteID == alias.SiteID && a.Path == alias.Path {
+			delete(f.contentAliases, id)
+		}
+	}
+	f.contentAliases[alias.ID] = *alias
+	return nil
+}
+
+func (f *SsgRepo) GetContentAliases(ctx context.Context) ([]ssg.ContentAlias, error) {
+	if f.GetContentAliasesFn != nil {
+		return f.GetContentAliasesFn(ctx)
+	}
+	var aliases []ssg.ContentAlias
+	for _, a := range f.contentAliases {
+		aliases = append(aliases, a)
+	}
+	return aliases, nil
+}
+
+func (f *SsgRepo) GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentAlias, error) {
+	if f.GetContentAliasesByContentIDFn != nil {
+		return f.GetContentAliasesByContentIDFn(ctx, contentID)
+	}
+	var aliases []ssg.ContentAlias
+	for _, a := range f.contentAliases {
+		if a.ContentID == contentID {
+			aliases = append(aliases, a)
+		}
+	}
+	return aliases, nil
+}
+
+func (f *SsgRepo) DeleteContentAlias(ctx context.Context, id uuid.UUID) error {
+	if f.DeleteContentAliasFn != nil {
+		return f.DeleteContentAliasFn(ctx, id)
+	}
+	delete(f.contentAliases, id)
+	return nil
+}
+
 func (f *SsgRepo) CreateSectionImage(ctx context.Context, sectionImage *ssg.SectionImage) error {
 	if f.CreateSectionImageFn != nil {
 		return f.CreateSectionImageFn(ctx, sectionImage)
@@ -14,6 +14,15 @@ const (
 	resSectionName      = "section"
 	resLayoutName       = "layout"
 	resTagName          = "tag"
+	resSeriesName       = "series"
+	resAuthorName       = "author"
+	resContentTypeName  = "content type"
+	resArchetypeName    = "archetype"
+	resCommentName      = "comment"
+	resThemeName        = "theme"
+	resDataFileName     = "data file"
+	resMenuName         = "menu"
+	resMenuItemName     = "menu item"
 	resParamName        = "param"
 	resImageName        = "image"
 	resImageVariantName = "image variant"
@@ -23,6 +32,7 @@ type APIHandler struct {
 	*hm.APIHandler
 	svc         Service
 	siteManager *SiteManager
+	comments    *CommentLimiter
 }
 
 func NewAPIHandler(name string, service Service, siteManager *SiteManager, params hm.XParams) *APIHandler {
@@ -30,6 +40,7 @@ func NewAPIHandler(name string, service Service, siteManager *SiteManager, param
 		APIHandler:  hm.NewAPIHandler(name, params),
 		svc:         service,
 		siteManager: siteManager,
+		comments:    newCommentLimiter(params.Cfg),
 	}
 }
 
@@ -56,6 +67,24 @@ func (h *APIHandler) wrapData(data interface{}) interface{} {
 		return map[string]interface{}{"content": v}
 	case Tag:
 		return map[string]interface{}{"tag": v}
+	case Series:
+		return map[string]interface{}{"series": v}
+	case Author:
+		return map[string]interface{}{"author": v}
+	case ContentType:
+		return map[string]interface{}{"content_type": v}
+	case Archetype:
+		return map[string]interface{}{"archetype": v}
+	case Comment:
+		return map[string]interface{}{"comment": v}
+	case ThemeManifest:
+		return map[string]interface{}{"theme": v}
+	case DataFile:
+		return map[string]interface{}{"data_file": v}
+	case Menu:
+		return map[string]interface{}{"menu": v}
+	case MenuItem:
+		return map[string]interface{}{"menu_item": v}
 	case Param:
 		return map[string]interface{}{"param": v}
 	case Image:
@@ -74,6 +103,22 @@ func (h *APIHandler) wrapData(data interface{}) interface{} {
 		return map[string]interface{}{"contents": v}
 	case []Tag:
 		return map[string]interface{}{"tags": v}
+	case []Series:
+		return map[string]interface{}{"series": v}
+	case []Author:
+		return map[string]interface{}{"authors": v}
+	case []ContentType:
+		return map[string]interface{}{"content_types": v}
+	case []Archetype:
+		return map[string]interface{}{"archetypes": v}
+	case []Comment:
+		return map[string]interface{}{"comments": v}
+	case []ThemeManifest:
+		return map[string]interface{}{"themes": v}
+	case []DataFile:
+		return map[string]interface{}{"data_files": v}
+	case []Menu:
+		return map[string]interface{}{"menus": v}
 	case []Param:
 		return map[string]interface{}{"params": v}
 	case []Image:
@@ -0,0 +1,175 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+func (h *APIHandler) CreateArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateArchetype", h.Name())
+
+	var archetype Archetype
+	var err error
+	err = json.NewDecoder(r.Body).Decode(&archetype)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newArchetype := archetypeFromBody(archetype)
+	newArchetype.GenCreateValues()
+
+	siteID, err := RequireSiteID(r.Context())
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "No site selected", err)
+		return
+	}
+	newArchetype.SiteID = siteID
+
+	err = h.svc.CreateArchetype(r.Context(), newArchetype)
+	if !h.checkArchetypeErr(w, err, hm.ErrCannotCreateResource) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resArchetypeName))
+	h.Created(w, msg, newArchetype)
+}
+
+func (h *APIHandler) GetArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetArchetype", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resArchetypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var archetype Archetype
+	archetype, err = h.svc.GetArchetype(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resArchetypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resArchetypeName))
+	h.OK(w, msg, archetype)
+}
+
+func (h *APIHandler) GetAllArchetypes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllArchetypes", h.Name())
+
+	var archetypes []Archetype
+	var err error
+	archetypes, err = h.svc.GetArchetypes(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resArchetypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resArchetypeName))
+	h.OK(w, msg, archetypes)
+}
+
+func (h *APIHandler) UpdateArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateArchetype", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resArchetypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var archetype Archetype
+	err = json.NewDecoder(r.Body).Decode(&archetype)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedArchetype := archetypeFromBody(archetype)
+	updatedArchetype.SetID(id, true)
+	updatedArchetype.GenUpdateValues()
+
+	err = h.svc.UpdateArchetype(r.Context(), updatedArchetype)
+	if !h.checkArchetypeErr(w, err, hm.ErrCannotUpdateResource) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resArchetypeName))
+	h.OK(w, msg, updatedArchetype)
+}
+
+func (h *APIHandler) DeleteArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteArchetype", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resArchetypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteArchetype(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resArchetypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resArchetypeName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// archetypeFromBody returns a new archetype with the values of a decoded
+// request body.
+func archetypeFromBody(body Archetype) Archetype {
+	archetype := NewArchetype(body.Name, body.Kind)
+	archetype.Description = body.Description
+	archetype.SectionID = body.SectionID
+	archetype.Body = body.Body
+	archetype.Tags = body.Tags
+	archetype.Robots = body.Robots
+	archetype.Keywords = body.Keywords
+	archetype.Sitemap = body.Sitemap
+	archetype.TableOfContents = body.TableOfContents
+	archetype.Share = body.Share
+	archetype.Comments = body.Comments
+	archetype.Fields = body.Fields
+	return archetype
+}
+
+// checkArchetypeErr writes the error response for err from saving an
+// archetype, formatting format for unexpected errors. It returns false when
+// there was an error.
+func (h *APIHandler) checkArchetypeErr(w http.ResponseWriter, err error, format string) bool {
+	if errors.Is(err, ErrArchetypeNameTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return false
+	}
+	if errors.Is(err, ErrInvalidArchetype) || isContentKindErr(err) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return false
+	}
+	if err != nil {
+		msg := fmt.Sprintf(format, resArchetypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return false
+	}
+	return true
+}
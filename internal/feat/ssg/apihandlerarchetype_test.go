@@ -0,0 +1,205 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerCreateArchetype(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name: "creates archetype successfully",
+			requestBody: map[string]interface{}{
+				"name":   "quick",
+				"kind":   "recipe",
+				"body":   "## Steps",
+				"fields": map[string]string{"servings": "2"},
+			},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid JSON",
+			requestBody:    "invalid json",
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without site",
+			requestBody:    map[string]string{"name": "quick", "kind": "recipe"},
+			ctx:            context.Background(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with unknown kind",
+			requestBody:    map[string]string{"name": "episode", "kind": "podcast"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with invalid field value",
+			requestBody: map[string]interface{}{
+				"name":   "quick",
+				"kind":   "recipe",
+				"fields": map[string]string{"servings": "two"},
+			},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with taken name",
+			requestBody:    map[string]string{"name": "Review", "kind": "article"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			typeID := uuid.New()
+			repo.contentTypes[typeID] = ContentType{
+				ID:     typeID,
+				Name:   "recipe",
+				Fields: []FieldDef{{Name: "servings", Type: FieldTypeNumber, Required: true}},
+			}
+			existingID := uuid.New()
+			repo.archetypes[existingID] = Archetype{ID: existingID, Name: "review", Kind: "article"}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/archetypes", bytes.NewReader(body))
+			req = req.WithContext(tt.ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateArchetype(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateArchetype() status = %d, want %d: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIHandlerCreateContentFromArchetype(t *testing.T) {
+	sectionID := uuid.New()
+
+	tests := []struct {
+		name           string
+		template       string
+		requestBody    map[string]interface{}
+		wantStatusCode int
+		wantKind       string
+		wantBody       string
+	}{
+		{
+			name:           "fills content from archetype",
+			template:       "quick",
+			requestBody:    map[string]interface{}{"heading": "Pasta"},
+			wantStatusCode: http.StatusCreated,
+			wantKind:       "recipe",
+			wantBody:       "## Steps",
+		},
+		{
+			name:           "keeps explicit values",
+			template:       "quick",
+			requestBody:    map[string]interface{}{"heading": "Pasta", "body": "Boil water"},
+			wantStatusCode: http.StatusCreated,
+			wantKind:       "recipe",
+			wantBody:       "Boil water",
+		},
+		{
+			name:           "fails with unknown archetype",
+			template:       "slow",
+			requestBody:    map[string]interface{}{"heading": "Pasta"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with archetype of another kind",
+			template:       "quick",
+			requestBody:    map[string]interface{}{"heading": "Pasta", "kind": "article"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			typeID := uuid.New()
+			repo.contentTypes[typeID] = ContentType{
+				ID:     typeID,
+				Name:   "recipe",
+				Fields: []FieldDef{{Name: "servings", Type: FieldTypeNumber}},
+			}
+			archetypeID := uuid.New()
+			repo.archetypes[archetypeID] = Archetype{
+				ID:        archetypeID,
+				Name:      "quick",
+				Kind:      "recipe",
+				SectionID: sectionID,
+				Body:      "## Steps",
+				Fields:    map[string]string{"servings": "2"},
+			}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			body, err := json.Marshal(tt.requestBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/contents?template="+tt.template, bytes.NewReader(body))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateContent(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("CreateContent() status = %d, want %d: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			if tt.wantStatusCode != http.StatusCreated {
+				return
+			}
+			if len(repo.contents) != 1 {
+				t.Fatalf("stored contents = %d, want 1", len(repo.contents))
+			}
+			for _, c := range repo.contents {
+				if c.Kind != tt.wantKind || c.Body != tt.wantBody || c.SectionID != sectionID {
+					t.Errorf("stored content kind, body, section = %q, %q, %s", c.Kind, c.Body, c.SectionID)
+				}
+			}
+			for _, fields := range repo.contentFields {
+				if len(fields) != 1 || fields[0].Value != "2" {
+					t.Errorf("stored fields = %+v, want servings 2", fields)
+				}
+			}
+		})
+	}
+}
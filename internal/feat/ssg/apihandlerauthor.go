@@ -0,0 +1,239 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+// ContentAuthorsRequest lists the authors of a content in byline order.
+type ContentAuthorsRequest struct {
+	AuthorIDs []uuid.UUID `json:"author_ids"`
+}
+
+func (h *APIHandler) CreateAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateAuthor", h.Name())
+
+	var author Author
+	var err error
+	err = json.NewDecoder(r.Body).Decode(&author)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newAuthor := NewAuthor(author.Name, author.UserID)
+	newAuthor.SlugField = author.SlugField
+	newAuthor.Bio = author.Bio
+	newAuthor.Avatar = author.Avatar
+	newAuthor.Links = author.Links
+	newAuthor.GenCreateValues()
+
+	siteID, err := RequireSiteID(r.Context())
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "No site selected", err)
+		return
+	}
+	newAuthor.SiteID = siteID
+
+	err = h.svc.CreateAuthor(r.Context(), newAuthor)
+	if errors.Is(err, ErrSlugTaken) || errors.Is(err, ErrAuthorUserTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resAuthorName))
+	h.Created(w, msg, newAuthor)
+}
+
+func (h *APIHandler) GetAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAuthor", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resAuthorName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var author Author
+	author, err = h.svc.GetAuthor(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resAuthorName))
+	h.OK(w, msg, author)
+}
+
+func (h *APIHandler) GetAllAuthors(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllAuthors", h.Name())
+
+	var authors []Author
+	var err error
+	authors, err = h.svc.GetAllAuthors(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resAuthorName))
+	h.OK(w, msg, authors)
+}
+
+func (h *APIHandler) UpdateAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateAuthor", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resAuthorName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var author Author
+	err = json.NewDecoder(r.Body).Decode(&author)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedAuthor := NewAuthor(author.Name, author.UserID)
+	updatedAuthor.SlugField = author.SlugField
+	updatedAuthor.Bio = author.Bio
+	updatedAuthor.Avatar = author.Avatar
+	updatedAuthor.Links = author.Links
+	updatedAuthor.SetID(id, true)
+	updatedAuthor.GenUpdateValues()
+
+	err = h.svc.UpdateAuthor(r.Context(), updatedAuthor)
+	if errors.Is(err, ErrSlugTaken) || errors.Is(err, ErrAuthorUserTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resAuthorName))
+	h.OK(w, msg, updatedAuthor)
+}
+
+func (h *APIHandler) DeleteAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteAuthor", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resAuthorName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteAuthor(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resAuthorName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// GetContentAuthors returns the authors of a content in byline order.
+func (h *APIHandler) GetContentAuthors(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetContentAuthors", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	authors, err := h.svc.GetAuthorsForContent(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resAuthorName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resAuthorName))
+	h.OK(w, msg, authors)
+}
+
+// SetContentAuthors replaces the authors of a content.
+func (h *APIHandler) SetContentAuthors(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling SetContentAuthors", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var req ContentAuthorsRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	if !h.setContentAuthors(w, r, id, req.AuthorIDs) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resContentName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// setContentAuthors attributes the content of id to the authors of
+// authorIDs, writing the error response when it fails.
+func (h *APIHandler) setContentAuthors(w http.ResponseWriter, r *http.Request, id uuid.UUID, authorIDs []uuid.UUID) bool {
+	err := h.svc.SetContentAuthors(r.Context(), id, authorIDs)
+	if errors.Is(err, ErrUnknownAuthor) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return false
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Cannot set authors of content %s", id)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return false
+	}
+	return true
+}
+
+// authorIDs returns the IDs of authors, in order.
+func authorIDs(authors []Author) []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(authors))
+	for _, a := range authors {
+		ids = append(ids, a.ID)
+	}
+	return ids
+}
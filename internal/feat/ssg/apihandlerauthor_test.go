@@ -0,0 +1,148 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerCreateAuthor(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name:           "creates author successfully",
+			requestBody:    map[string]string{"name": "Jane Doe", "bio": "Writes about Go"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid JSON",
+			requestBody:    "invalid json",
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without site",
+			requestBody:    map[string]string{"name": "Jane Doe"},
+			ctx:            context.Background(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with taken slug",
+			requestBody:    map[string]string{"name": "Taken"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "fails with user with a profile",
+			requestBody:    map[string]string{"name": "Jane Doe", "user_id": userID.String()},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.authors[existingID] = Author{ID: existingID, Name: "Taken", UserID: userID}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/authors", bytes.NewReader(body))
+			req = req.WithContext(tt.ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateAuthor(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateAuthor() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerSetContentAuthors(t *testing.T) {
+	contentID := uuid.New()
+	authorID := uuid.New()
+
+	tests := []struct {
+		name           string
+		contentID      string
+		requestBody    interface{}
+		wantStatusCode int
+	}{
+		{
+			name:           "sets authors successfully",
+			contentID:      contentID.String(),
+			requestBody:    ContentAuthorsRequest{AuthorIDs: []uuid.UUID{authorID}},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fails with invalid UUID",
+			contentID:      "invalid-uuid",
+			requestBody:    ContentAuthorsRequest{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with unknown author",
+			contentID:      contentID.String(),
+			requestBody:    ContentAuthorsRequest{AuthorIDs: []uuid.UUID{uuid.New()}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.authors[authorID] = Author{ID: authorID, Name: "Jane"}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			body, err := json.Marshal(tt.requestBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/ssg/contents/"+tt.contentID+"/authors", bytes.NewReader(body))
+			req.SetPathValue("id", tt.contentID)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.SetContentAuthors(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("SetContentAuthors() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantStatusCode == http.StatusOK && len(repo.contentAuthors[contentID]) != 1 {
+				t.Errorf("content authors = %v, want one", repo.contentAuthors[contentID])
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultCommentRateLimit  = 5
+	defaultCommentRateWindow = 10 * time.Minute
+)
+
+// ModerateCommentForm represents the data for changing the status of a
+// comment.
+type ModerateCommentForm struct {
+	Status string `json:"status"`
+}
+
+// SubmitComment stores a comment posted by a reader from a generated page.
+// It takes a form post, so pages need no JavaScript, and redirects back to
+// the page the comment was posted from. Posts filling in the honeypot field
+// are answered as if stored and dropped.
+func (h *APIHandler) SubmitComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling SubmitComment", h.Name())
+
+	var err error
+	err = r.ParseForm()
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	if r.PostForm.Get(CommentHoneypotField) != "" {
+		h.Log().Info("Dropping comment caught by honeypot")
+		h.commentSubmitted(w, r)
+		return
+	}
+
+	siteSlug, _ := GetSiteSlugFromContext(r.Context())
+	if !h.comments.Allow(siteSlug + "|" + clientIP(r)) {
+		h.Err(w, http.StatusTooManyRequests, "Too many comments, try again later", ErrCommentRateLimited)
+		return
+	}
+
+	var contentID uuid.UUID
+	contentID, err = uuid.Parse(r.URL.Query().Get("content"))
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	comment := NewComment(contentID, r.PostForm.Get("name"), r.PostForm.Get("email"), r.PostForm.Get("comment"))
+	err = h.svc.SubmitComment(r.Context(), &comment)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrInvalidComment):
+			h.Err(w, http.StatusBadRequest, err.Error(), err)
+		case errors.Is(err, ErrCommentsClosed):
+			h.Err(w, http.StatusForbidden, err.Error(), err)
+		default:
+			msg := fmt.Sprintf(hm.ErrCannotCreateResource, resCommentName)
+			h.Err(w, http.StatusInternalServerError, msg, err)
+		}
+		return
+	}
+
+	h.commentSubmitted(w, r)
+}
+
+func (h *APIHandler) GetComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetComment", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resCommentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var comment Comment
+	comment, err = h.svc.GetComment(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resCommentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resCommentName))
+	h.OK(w, msg, comment)
+}
+
+// GetAllComments returns the comments of the site, filtered by the status
+// query parameter when set.
+func (h *APIHandler) GetAllComments(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllComments", h.Name())
+
+	status := r.URL.Query().Get("status")
+	if status != "" && !ValidCommentStatus(status) {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidParam, fmt.Errorf("unknown comment status %q", status))
+		return
+	}
+
+	var comments []Comment
+	var err error
+	comments, err = h.svc.GetComments(r.Context(), status)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resCommentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resCommentName))
+	h.OK(w, msg, comments)
+}
+
+// ModerateComment approves a comment, flags it as spam or sends it back to
+// the queue.
+func (h *APIHandler) ModerateComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling ModerateComment", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resCommentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var form ModerateCommentForm
+	err = json.NewDecoder(r.Body).Decode(&form)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	err = h.svc.ModerateComment(r.Context(), id, form.Status)
+	if err != nil {
+		if errors.Is(err, ErrInvalidComment) {
+			h.Err(w, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resCommentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resCommentName))
+	h.OK(w, msg, nil)
+}
+
+func (h *APIHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteComment", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resCommentName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteComment(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resCommentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resCommentName))
+	h.OK(w, msg, nil)
+}
+
+// GetCommentRebuilds returns the IDs of the content whose pages show
+// outdated comments.
+func (h *APIHandler) GetCommentRebuilds(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetCommentRebuilds", h.Name())
+
+	ids, err := h.svc.GetCommentRebuilds(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, "comment rebuilds")
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	h.OK(w, "Comment rebuilds retrieved successfully", map[string]interface{}{"content_ids": ids})
+}
+
+// GenerateCommentPages regenerates the pages whose comments changed since
+// the last build.
+func (h *APIHandler) GenerateCommentPages(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GenerateCommentPages", h.Name())
+
+	pages, err := h.svc.GenerateCommentPages(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf("Cannot generate comment pages: %v", err)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := "Comment pages generated successfully"
+	h.OK(w, msg, map[string]interface{}{"pages": pages})
+}
+
+// commentSubmitted answers a comment post. Browsers are sent back to the
+// page the comment was posted from; other clients get a JSON response.
+func (h *APIHandler) commentSubmitted(w http.ResponseWriter, r *http.Request) {
+	if back, err := url.Parse(r.Referer()); err == nil && (back.Scheme == "http" || back.Scheme == "https") {
+		back.Fragment = "comment-submitted"
+		http.Redirect(w, r, back.String(), http.StatusSeeOther)
+		return
+	}
+	h.Created(w, "Comment received and waiting for moderation", nil)
+}
+
+// clientIP returns the address of the client of r. Forwarding headers are
+// not trusted, as any client can set them.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// newCommentLimiter returns the comment rate limiter set up in cfg.
+func newCommentLimiter(cfg *hm.Config) *CommentLimiter {
+	limit := defaultCommentRateLimit
+	window := defaultCommentRateWindow
+	if cfg != nil {
+		limit = int(cfg.IntVal(SSGKey.CommentsRateLimit, defaultCommentRateLimit))
+		if d, err := time.ParseDuration(cfg.StrValOrDef(SSGKey.CommentsRateWindow, "")); err == nil && d > 0 {
+			window = d
+		}
+	}
+	return NewCommentLimiter(limit, window)
+}
@@ -0,0 +1,236 @@
+package ssg
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerSubmitComment(t *testing.T) {
+	openID, closedID := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		contentID      uuid.UUID
+		form           url.Values
+		referer        string
+		wantStatusCode int
+		wantLocation   string
+		wantStored     int
+	}{
+		{
+			name:           "stores a pending comment",
+			contentID:      openID,
+			form:           url.Values{"name": {"Ana"}, "comment": {"Nice post"}},
+			wantStatusCode: http.StatusCreated,
+			wantStored:     1,
+		},
+		{
+			name:           "redirects browsers back to the page",
+			contentID:      openID,
+			form:           url.Values{"name": {"Ana"}, "comment": {"Nice post"}},
+			referer:        "https://example.com/blog/post/#comments",
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "https://example.com/blog/post/#comment-submitted",
+			wantStored:     1,
+		},
+		{
+			name:           "drops comments filling in the honeypot",
+			contentID:      openID,
+			form:           url.Values{"name": {"Bot"}, "comment": {"Buy now"}, CommentHoneypotField: {"http://spam.example"}},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails without name",
+			contentID:      openID,
+			form:           url.Values{"comment": {"Nice post"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails for content without comments",
+			contentID:      closedID,
+			form:           url.Values{"name": {"Ana"}, "comment": {"Nice post"}},
+			wantStatusCode: http.StatusForbidden,
+		},
+		{
+			name:           "fails with invalid content ID",
+			form:           url.Values{"name": {"Ana"}, "comment": {"Nice post"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.contents[openID] = Content{ID: openID, Heading: "Open", Meta: Meta{Comments: true}}
+			repo.contents[closedID] = Content{ID: closedID, Heading: "Closed"}
+			svc := newTestService(repo)
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			target := "/?site=test-site"
+			if tt.contentID != uuid.Nil {
+				target += "&content=" + tt.contentID.String()
+			}
+			req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(tt.form.Encode()))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if tt.referer != "" {
+				req.Header.Set("Referer", tt.referer)
+			}
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			w := httptest.NewRecorder()
+
+			handler.SubmitComment(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("SubmitComment() status = %v, want %v (body: %s)", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			if got := w.Header().Get("Location"); got != tt.wantLocation {
+				t.Errorf("SubmitComment() location = %q, want %q", got, tt.wantLocation)
+			}
+			if len(repo.comments) != tt.wantStored {
+				t.Errorf("stored comments = %d, want %d", len(repo.comments), tt.wantStored)
+			}
+			for _, c := range repo.comments {
+				if c.Status != CommentPending {
+					t.Errorf("stored comment status = %q, want pending", c.Status)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIHandlerSubmitCommentRateLimit(t *testing.T) {
+	contentID := uuid.New()
+	repo := newMockServiceRepo()
+	repo.contents[contentID] = Content{ID: contentID, Meta: Meta{Comments: true}}
+	svc := newTestService(repo)
+	cfg := hm.NewConfig()
+	cfg.Set(SSGKey.CommentsRateLimit, "2")
+	handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: cfg})
+
+	submit := func(remoteAddr string) int {
+		form := url.Values{"name": {"Ana"}, "comment": {"Nice post"}}
+		req := httptest.NewRequest(http.MethodPost, "/?site=test-site&content="+contentID.String(), strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		req.RemoteAddr = remoteAddr
+		req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+		w := httptest.NewRecorder()
+		handler.SubmitComment(w, req)
+		return w.Code
+	}
+
+	for i := 0; i < 2; i++ {
+		if code := submit("192.0.2.1:1234"); code != http.StatusCreated {
+			t.Fatalf("submission %d status = %v, want %v", i+1, code, http.StatusCreated)
+		}
+	}
+	if code := submit("192.0.2.1:5678"); code != http.StatusTooManyRequests {
+		t.Errorf("submission over the limit status = %v, want %v", code, http.StatusTooManyRequests)
+	}
+	if code := submit("192.0.2.2:1234"); code != http.StatusCreated {
+		t.Errorf("submission from another client status = %v, want %v", code, http.StatusCreated)
+	}
+}
+
+func TestAPIHandlerModerateComment(t *testing.T) {
+	contentID := uuid.New()
+
+	tests := []struct {
+		name           string
+		status         string
+		body           string
+		wantStatusCode int
+		wantRebuild    bool
+	}{
+		{
+			name:           "approving queues the page",
+			status:         CommentPending,
+			body:           `{"status":"approved"}`,
+			wantStatusCode: http.StatusOK,
+			wantRebuild:    true,
+		},
+		{
+			name:           "flagging a pending comment as spam leaves the page",
+			status:         CommentPending,
+			body:           `{"status":"spam"}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "flagging an approved comment as spam queues the page",
+			status:         CommentApproved,
+			body:           `{"status":"spam"}`,
+			wantStatusCode: http.StatusOK,
+			wantRebuild:    true,
+		},
+		{
+			name:           "fails with unknown status",
+			status:         CommentPending,
+			body:           `{"status":"published"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with invalid JSON",
+			status:         CommentPending,
+			body:           `invalid json`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			id := uuid.New()
+			repo.comments[id] = Comment{ID: id, ContentID: contentID, Status: tt.status}
+			svc := newTestService(repo)
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			req := httptest.NewRequest(http.MethodPut, "/comments/"+id.String()+"/status", bytes.NewBufferString(tt.body))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.SetPathValue("id", id.String())
+			w := httptest.NewRecorder()
+
+			handler.ModerateComment(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("ModerateComment() status = %v, want %v (body: %s)", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			if repo.rebuilds[contentID] != tt.wantRebuild {
+				t.Errorf("page queued = %v, want %v", repo.rebuilds[contentID], tt.wantRebuild)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerDeleteComment(t *testing.T) {
+	contentID := uuid.New()
+	repo := newMockServiceRepo()
+	pending, approved := uuid.New(), uuid.New()
+	repo.comments[pending] = Comment{ID: pending, ContentID: uuid.New(), Status: CommentPending}
+	repo.comments[approved] = Comment{ID: approved, ContentID: contentID, Status: CommentApproved}
+	svc := newTestService(repo)
+	handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+	for _, id := range []uuid.UUID{pending, approved} {
+		req := httptest.NewRequest(http.MethodDelete, "/comments/"+id.String(), nil)
+		req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+		req.SetPathValue("id", id.String())
+		w := httptest.NewRecorder()
+		handler.DeleteComment(w, req)
+		if w.Code != http.StatusOK {
+			t.Errorf("DeleteComment() status = %v, want %v", w.Code, http.StatusOK)
+		}
+	}
+
+	if len(repo.comments) != 0 {
+		t.Errorf("comments = %d, want none", len(repo.comments))
+	}
+	if len(repo.rebuilds) != 1 || !repo.rebuilds[contentID] {
+		t.Errorf("rebuilds = %v, want only the page of the approved comment", repo.rebuilds)
+	}
+}
@@ -2,6 +2,7 @@ package ssg
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -59,6 +60,20 @@ func (h *APIHandler) GetContent(w http.ResponseWriter, r *http.Request) {
 		content.Tags = tags
 	}
 
+	content.Authors, err = h.svc.GetAuthorsForContent(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf("Cannot get authors for content %s", id)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	content.Fields, err = h.svc.GetFieldsForContent(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf("Cannot get fields for content %s", id)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
 	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resContentName))
 	h.OK(w, msg, content)
 }
@@ -89,6 +104,20 @@ func (h *APIHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
 	content.SiteID = siteID
 	h.Log().Infof("API: Set SiteID from context: %s", siteID)
 
+	// Fill the values left unset from the archetype asked for, if any
+	if name := r.URL.Query().Get("template"); name != "" {
+		err = h.svc.ApplyArchetype(r.Context(), name, &content)
+		if errors.Is(err, ErrUnknownArchetype) || errors.Is(err, ErrInvalidArchetype) {
+			h.Err(w, http.StatusBadRequest, err.Error(), err)
+			return
+		}
+		if err != nil {
+			msg := fmt.Sprintf(hm.ErrCannotGetResource, resArchetypeName)
+			h.Err(w, http.StatusInternalServerError, msg, err)
+			return
+		}
+	}
+
 	// Set default kind if not provided
 	if content.Kind == "" {
 		content.Kind = "article"
@@ -96,6 +125,14 @@ func (h *APIHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.svc.CreateContent(r.Context(), &content)
+	if errors.Is(err, ErrSlugTaken) || errors.Is(err, ErrTranslationTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidLocale) || isContentKindErr(err) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resContentName)
 		h.Err(w, http.StatusInternalServerError, msg, err)
@@ -111,6 +148,16 @@ func (h *APIHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Authors are only set when listed, even if empty.
+	if content.Authors != nil && !h.setContentAuthors(w, r, content.ID, authorIDs(content.Authors)) {
+		return
+	}
+
+	// Custom fields are only set when listed, even if empty.
+	if content.Fields != nil && !h.setContentFields(w, r, content.ID, content.Fields) {
+		return
+	}
+
 	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resContentName))
 	h.Created(w, msg, content)
 }
@@ -146,6 +193,14 @@ func (h *APIHandler) UpdateContent(w http.ResponseWriter, r *http.Request) {
 	content.SiteID = siteID
 
 	err = h.svc.UpdateContent(r.Context(), &content)
+	if errors.Is(err, ErrSlugTaken) || errors.Is(err, ErrTranslationTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidLocale) || isContentKindErr(err) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resContentName)
 		h.Err(w, http.StatusInternalServerError, msg, err)
@@ -177,6 +232,16 @@ func (h *APIHandler) UpdateContent(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	// Authors are only replaced when listed, even if empty.
+	if content.Authors != nil && !h.setContentAuthors(w, r, id, authorIDs(content.Authors)) {
+		return
+	}
+
+	// Custom fields are only replaced when listed, even if empty.
+	if content.Fields != nil && !h.setContentFields(w, r, id, content.Fields) {
+		return
+	}
+
 	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resContentName))
 	h.OK(w, msg, content)
 }
@@ -410,3 +475,135 @@ func (h *APIHandler) DeleteContentImage(w http.ResponseWriter, r *http.Request)
 	msg := fmt.Sprintf("Content image deleted successfully")
 	h.OK(w, msg, nil)
 }
+
+// AddContentAliasRequest represents the request body for adding a content alias
+type AddContentAliasRequest struct {
+	Path string `json:"path"`
+}
+
+// GetContentAliases returns the aliases redirecting to a content
+func (h *APIHandler) GetContentAliases(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetContentAliases", h.Name())
+
+	contentID, err := h.contentIDParam(w, r)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid content ID", err)
+		return
+	}
+
+	aliases, err := h.svc.GetContentAliases(r.Context(), contentID)
+	if err != nil {
+		h.Err(w, http.StatusInternalServerError, "Failed to get content aliases", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Retrieved %d aliases for content", len(aliases))
+	h.OK(w, msg, map[string]interface{}{
+		"aliases": aliases,
+	})
+}
+
+// AddContentAlias adds a manual alias to a content
+func (h *APIHandler) AddContentAlias(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling AddContentAlias", h.Name())
+
+	contentID, err := h.contentIDParam(w, r)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid content ID", err)
+		return
+	}
+
+	var req AddContentAliasRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	alias, err := h.svc.AddContentAlias(r.Context(), contentID, req.Path)
+	if errors.Is(err, ErrInvalidAliasPath) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrAliasTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if err != nil {
+		h.Err(w, http.StatusInternalServerError, "Failed to add content alias", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Alias %s added to content %s", alias.Path, contentID)
+	h.Created(w, msg, alias)
+}
+
+// DeleteContentAlias removes an alias from a content
+func (h *APIHandler) DeleteContentAlias(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteContentAlias", h.Name())
+
+	contentID, err := h.contentIDParam(w, r)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid content ID", err)
+		return
+	}
+
+	idStr, err := h.Param(w, r, "id")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid alias ID", err)
+		return
+	}
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid alias ID format", err)
+		return
+	}
+
+	err = h.svc.DeleteContentAlias(r.Context(), contentID, id)
+	if err != nil {
+		h.Err(w, http.StatusInternalServerError, "Failed to delete content alias", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Alias %s removed from content %s", id, contentID)
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// GetContentBacklinks returns the reference to use for linking a content and
+// the contents linking to it
+func (h *APIHandler) GetContentBacklinks(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetContentBacklinks", h.Name())
+
+	contentID, err := h.contentIDParam(w, r)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid content ID", err)
+		return
+	}
+
+	content, err := h.svc.GetContent(r.Context(), contentID)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resContentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	backlinks, err := h.svc.GetContentBacklinks(r.Context(), contentID)
+	if err != nil {
+		h.Err(w, http.StatusInternalServerError, "Failed to get content backlinks", err)
+		return
+	}
+
+	msg := fmt.Sprintf("Retrieved %d backlinks for content", len(backlinks))
+	h.OK(w, msg, map[string]interface{}{
+		"reference": Reference(content),
+		"backlinks": backlinks,
+	})
+}
+
+func (h *APIHandler) contentIDParam(w http.ResponseWriter, r *http.Request) (uuid.UUID, error) {
+	contentIDStr, err := h.Param(w, r, "content_id")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return uuid.Parse(contentIDStr)
+}
@@ -0,0 +1,187 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+func (h *APIHandler) CreateContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateContentType", h.Name())
+
+	var contentType ContentType
+	var err error
+	err = json.NewDecoder(r.Body).Decode(&contentType)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newContentType := NewContentType(contentType.Name, contentType.Label)
+	newContentType.LayoutID = contentType.LayoutID
+	newContentType.SectionIDs = contentType.SectionIDs
+	newContentType.Fields = contentType.Fields
+	newContentType.GenCreateValues()
+
+	siteID, err := RequireSiteID(r.Context())
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "No site selected", err)
+		return
+	}
+	newContentType.SiteID = siteID
+
+	err = h.svc.CreateContentType(r.Context(), newContentType)
+	if !h.checkContentTypeErr(w, err, hm.ErrCannotCreateResource) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resContentTypeName))
+	h.Created(w, msg, newContentType)
+}
+
+func (h *APIHandler) GetContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetContentType", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentTypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var contentType ContentType
+	contentType, err = h.svc.GetContentType(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resContentTypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resContentTypeName))
+	h.OK(w, msg, contentType)
+}
+
+func (h *APIHandler) GetAllContentTypes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllContentTypes", h.Name())
+
+	var contentTypes []ContentType
+	var err error
+	contentTypes, err = h.svc.GetContentTypes(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resContentTypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resContentTypeName))
+	h.OK(w, msg, contentTypes)
+}
+
+func (h *APIHandler) UpdateContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateContentType", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentTypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var contentType ContentType
+	err = json.NewDecoder(r.Body).Decode(&contentType)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedContentType := NewContentType(contentType.Name, contentType.Label)
+	updatedContentType.LayoutID = contentType.LayoutID
+	updatedContentType.SectionIDs = contentType.SectionIDs
+	updatedContentType.Fields = contentType.Fields
+	updatedContentType.SetID(id, true)
+	updatedContentType.GenUpdateValues()
+
+	err = h.svc.UpdateContentType(r.Context(), updatedContentType)
+	if !h.checkContentTypeErr(w, err, hm.ErrCannotUpdateResource) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resContentTypeName))
+	h.OK(w, msg, updatedContentType)
+}
+
+func (h *APIHandler) DeleteContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteContentType", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resContentTypeName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteContentType(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resContentTypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resContentTypeName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// checkContentTypeErr writes the error response for err from saving a
+// content type, formatting format for unexpected errors. It returns false
+// when there was an error.
+func (h *APIHandler) checkContentTypeErr(w http.ResponseWriter, err error, format string) bool {
+	if errors.Is(err, ErrContentKindTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return false
+	}
+	if errors.Is(err, ErrInvalidContentType) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return false
+	}
+	if err != nil {
+		msg := fmt.Sprintf(format, resContentTypeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return false
+	}
+	return true
+}
+
+// setContentFields replaces the custom field values of the content of id,
+// writing the error response when it fails.
+func (h *APIHandler) setContentFields(w http.ResponseWriter, r *http.Request, id uuid.UUID, fields map[string]string) bool {
+	err := h.svc.SetContentFields(r.Context(), id, fields)
+	if isContentKindErr(err) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return false
+	}
+	if err != nil {
+		msg := fmt.Sprintf("Cannot set fields of content %s", id)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return false
+	}
+	return true
+}
+
+// isContentKindErr reports whether err rejects content for its kind: an
+// unknown kind, a section the kind does not allow or invalid custom fields.
+func isContentKindErr(err error) bool {
+	return errors.Is(err, ErrUnknownContentKind) ||
+		errors.Is(err, ErrSectionNotAllowed) ||
+		errors.Is(err, ErrInvalidContentField)
+}
@@ -0,0 +1,179 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerCreateContentType(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name: "creates content type successfully",
+			requestBody: map[string]interface{}{
+				"name":   "recipe",
+				"fields": []map[string]interface{}{{"name": "servings", "type": "number"}},
+			},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid JSON",
+			requestBody:    "invalid json",
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without site",
+			requestBody:    map[string]string{"name": "recipe"},
+			ctx:            context.Background(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with unknown field type",
+			requestBody: map[string]interface{}{
+				"name":   "recipe",
+				"fields": []map[string]interface{}{{"name": "servings", "type": "money"}},
+			},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with taken name",
+			requestBody:    map[string]string{"name": "Event"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.contentTypes[existingID] = ContentType{ID: existingID, Name: "event"}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/content-types", bytes.NewReader(body))
+			req = req.WithContext(tt.ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateContentType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateContentType() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerCreateContentWithFields(t *testing.T) {
+	allowedSection := uuid.New()
+
+	tests := []struct {
+		name           string
+		requestBody    map[string]interface{}
+		wantStatusCode int
+		wantFields     int
+	}{
+		{
+			name: "stores custom fields",
+			requestBody: map[string]interface{}{
+				"heading": "Pasta",
+				"kind":    "recipe",
+				"fields":  map[string]string{"servings": "4"},
+			},
+			wantStatusCode: http.StatusCreated,
+			wantFields:     1,
+		},
+		{
+			name: "fails with unknown kind",
+			requestBody: map[string]interface{}{
+				"heading": "Pasta",
+				"kind":    "podcast",
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with section not allowed",
+			requestBody: map[string]interface{}{
+				"heading":    "Pasta",
+				"kind":       "recipe",
+				"section_id": uuid.New().String(),
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with invalid field value",
+			requestBody: map[string]interface{}{
+				"heading": "Pasta",
+				"kind":    "recipe",
+				"fields":  map[string]string{"servings": "four"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			typeID := uuid.New()
+			repo.contentTypes[typeID] = ContentType{
+				ID:         typeID,
+				Name:       "recipe",
+				SectionIDs: []uuid.UUID{allowedSection},
+				Fields:     []FieldDef{{Name: "servings", Type: FieldTypeNumber}},
+			}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			body, err := json.Marshal(tt.requestBody)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/contents", bytes.NewReader(body))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateContent(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("CreateContent() status = %d, want %d: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			stored := 0
+			for _, fields := range repo.contentFields {
+				stored += len(fields)
+			}
+			if stored != tt.wantFields {
+				t.Errorf("stored fields = %d, want %d", stored, tt.wantFields)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+)
+
+func (h *APIHandler) GetAllDataFiles(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllDataFiles", h.Name())
+
+	files, err := h.svc.ListDataFiles(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resDataFileName))
+	h.OK(w, msg, files)
+}
+
+func (h *APIHandler) GetDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetDataFile", h.Name())
+
+	name, err := h.Param(w, r, "name")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid data file name", err)
+		return
+	}
+
+	file, err := h.svc.GetDataFile(r.Context(), name)
+	if errors.Is(err, ErrDataFileNotFound) {
+		h.Err(w, http.StatusNotFound, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resDataFileName))
+	h.OK(w, msg, file)
+}
+
+// CreateDataFile creates a data file. Invalid content is rejected with the
+// file and line of the problem.
+func (h *APIHandler) CreateDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateDataFile", h.Name())
+
+	var file DataFile
+	err := json.NewDecoder(r.Body).Decode(&file)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	_, err = h.svc.GetDataFile(r.Context(), file.Name)
+	if err == nil {
+		err = fmt.Errorf("%w: %s", ErrDataFileExists, file.Name)
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+
+	if !h.saveDataFile(w, r, file) {
+		return
+	}
+
+	saved, err := h.svc.GetDataFile(r.Context(), file.Name)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resDataFileName))
+	h.Created(w, msg, saved)
+}
+
+// UpdateDataFile replaces the content of a data file.
+func (h *APIHandler) UpdateDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateDataFile", h.Name())
+
+	name, err := h.Param(w, r, "name")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid data file name", err)
+		return
+	}
+
+	var file DataFile
+	err = json.NewDecoder(r.Body).Decode(&file)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+	file.Name = name
+
+	_, err = h.svc.GetDataFile(r.Context(), name)
+	if errors.Is(err, ErrDataFileNotFound) {
+		h.Err(w, http.StatusNotFound, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	if !h.saveDataFile(w, r, file) {
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resDataFileName))
+	h.OK(w, msg, file)
+}
+
+func (h *APIHandler) DeleteDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteDataFile", h.Name())
+
+	name, err := h.Param(w, r, "name")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid data file name", err)
+		return
+	}
+
+	err = h.svc.DeleteDataFile(r.Context(), name)
+	if errors.Is(err, ErrDataFileNotFound) {
+		h.Err(w, http.StatusNotFound, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resDataFileName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// saveDataFile saves file, writing the error response and returning false
+// when it fails.
+func (h *APIHandler) saveDataFile(w http.ResponseWriter, r *http.Request, file DataFile) bool {
+	err := h.svc.SaveDataFile(r.Context(), file)
+	if errors.Is(err, ErrInvalidDataFile) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return false
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resDataFileName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return false
+	}
+	return true
+}
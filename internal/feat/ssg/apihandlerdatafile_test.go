@@ -0,0 +1,217 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func newDataFileTestHandler(t *testing.T) (*APIHandler, string) {
+	t.Helper()
+	sitesBasePath := t.TempDir()
+	dataPath := GetSiteDataPath(sitesBasePath, "test-site")
+	if err := os.MkdirAll(dataPath, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dataPath, "team.yaml"), []byte("- name: Ada\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := hm.NewConfig()
+	cfg.Set(SSGKey.SitesBasePath, sitesBasePath)
+	params := hm.XParams{Cfg: cfg}
+	repo := newMockServiceRepo()
+	svc := NewService(nil, repo, nil, &mockPublisher{}, NewParamManager(repo, params), nil, params)
+
+	return NewAPIHandler("test-api", svc, nil, params), dataPath
+}
+
+func TestAPIHandlerGetAllDataFiles(t *testing.T) {
+	handler, _ := newDataFileTestHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/ssg/data-files", nil)
+	req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+	w := httptest.NewRecorder()
+
+	handler.GetAllDataFiles(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetAllDataFiles() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var resp struct {
+		Data struct {
+			DataFiles []DataFile `json:"data_files"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data.DataFiles) != 1 || resp.Data.DataFiles[0].Name != "team.yaml" {
+		t.Errorf("GetAllDataFiles() files = %+v", resp.Data.DataFiles)
+	}
+}
+
+func TestAPIHandlerGetDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		file           string
+		wantStatusCode int
+	}{
+		{name: "gets data file successfully", file: "team.yaml", wantStatusCode: http.StatusOK},
+		{name: "fails with unknown file", file: "missing.yaml", wantStatusCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := newDataFileTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodGet, "/ssg/data-files/"+tt.file, nil)
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.SetPathValue("name", tt.file)
+			w := httptest.NewRecorder()
+
+			handler.GetDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("GetDataFile() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIHandlerCreateDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "creates data file successfully",
+			requestBody:    `{"name":"links.json","content":"{\"docs\": \"/docs/\"}"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with existing file",
+			requestBody:    `{"name":"team.yaml","content":"[]"}`,
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "fails with invalid content",
+			requestBody:    `{"name":"links.json","content":"{\n\"docs\" \"/docs/\"\n}"}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantBody:       "links.json: line 2:",
+		},
+		{
+			name:           "fails with invalid body",
+			requestBody:    `{`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := newDataFileTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/data-files", bytes.NewReader([]byte(tt.requestBody)))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateDataFile() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			if tt.wantBody != "" && !strings.Contains(w.Body.String(), tt.wantBody) {
+				t.Errorf("CreateDataFile() body = %s, want it to contain %q", w.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerUpdateDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		file           string
+		requestBody    string
+		wantStatusCode int
+	}{
+		{
+			name:           "updates data file successfully",
+			file:           "team.yaml",
+			requestBody:    `{"content":"- name: Grace\n"}`,
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fails with unknown file",
+			file:           "missing.yaml",
+			requestBody:    `{"content":"[]"}`,
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "fails with invalid content",
+			file:           "team.yaml",
+			requestBody:    `{"content":"\tbad"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, dataPath := newDataFileTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodPut, "/ssg/data-files/"+tt.file, bytes.NewReader([]byte(tt.requestBody)))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("name", tt.file)
+			w := httptest.NewRecorder()
+
+			handler.UpdateDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("UpdateDataFile() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			content, _ := os.ReadFile(filepath.Join(dataPath, "team.yaml"))
+			if updated := string(content) == "- name: Grace\n"; updated != (w.Code == http.StatusOK) {
+				t.Errorf("team.yaml content = %q", content)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerDeleteDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		file           string
+		wantStatusCode int
+	}{
+		{name: "deletes data file successfully", file: "team.yaml", wantStatusCode: http.StatusOK},
+		{name: "fails with unknown file", file: "missing.yaml", wantStatusCode: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := newDataFileTestHandler(t)
+
+			req := httptest.NewRequest(http.MethodDelete, "/ssg/data-files/"+tt.file, nil)
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.SetPathValue("name", tt.file)
+			w := httptest.NewRecorder()
+
+			handler.DeleteDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteDataFile() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+		})
+	}
+}
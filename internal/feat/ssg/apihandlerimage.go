@@ -28,8 +28,18 @@ func (h *APIHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 	newImage.FilePath = image.FilePath
 	newImage.Width = image.Width
 	newImage.Height = image.Height
+	newImage.FilesizeByte = image.FilesizeByte
+	newImage.Mime = image.Mime
+	newImage.DominantColor = image.DominantColor
 	newImage.Title = image.Title
 	newImage.AltText = image.AltText
+	newImage.AltLang = image.AltLang
+	newImage.LongDescription = image.LongDescription
+	newImage.Caption = image.Caption
+	newImage.Decorative = image.Decorative
+	newImage.Credit = image.Credit
+	newImage.License = image.License
+	newImage.SourceURL = image.SourceURL
 
 	newImage.GenCreateValues()
 
@@ -136,8 +146,18 @@ func (h *APIHandler) UpdateImage(w http.ResponseWriter, r *http.Request) {
 	updatedImage.FilePath = image.FilePath
 	updatedImage.Width = image.Width
 	updatedImage.Height = image.Height
+	updatedImage.FilesizeByte = image.FilesizeByte
+	updatedImage.Mime = image.Mime
+	updatedImage.DominantColor = image.DominantColor
 	updatedImage.Title = image.Title
 	updatedImage.AltText = image.AltText
+	updatedImage.AltLang = image.AltLang
+	updatedImage.LongDescription = image.LongDescription
+	updatedImage.Caption = image.Caption
+	updatedImage.Decorative = image.Decorative
+	updatedImage.Credit = image.Credit
+	updatedImage.License = image.License
+	updatedImage.SourceURL = image.SourceURL
 
 	updatedImage.GenUpdateValues()
 
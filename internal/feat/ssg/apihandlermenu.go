@@ -0,0 +1,329 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+// MoveMenuItemRequest moves a menu item one position "up" or "down" among
+// its siblings.
+type MoveMenuItemRequest struct {
+	Direction string `json:"direction"`
+}
+
+func (h *APIHandler) CreateMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateMenu", h.Name())
+
+	var menu Menu
+	var err error
+	err = json.NewDecoder(r.Body).Decode(&menu)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newMenu := NewMenu(menu.Name, menu.Location)
+	newMenu.GenCreateValues()
+
+	siteID, err := RequireSiteID(r.Context())
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "No site selected", err)
+		return
+	}
+	newMenu.SiteID = siteID
+
+	err = h.svc.CreateMenu(r.Context(), newMenu)
+	if errors.Is(err, ErrMenuLocationTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidMenuLocation) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resMenuName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resMenuName))
+	h.Created(w, msg, newMenu)
+}
+
+func (h *APIHandler) GetMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetMenu", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var menu Menu
+	menu, err = h.svc.GetMenu(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resMenuName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resMenuName))
+	h.OK(w, msg, menu)
+}
+
+func (h *APIHandler) GetMenus(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetMenus", h.Name())
+
+	var menus []Menu
+	var err error
+	menus, err = h.svc.GetMenus(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resMenuName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resMenuName))
+	h.OK(w, msg, menus)
+}
+
+func (h *APIHandler) UpdateMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateMenu", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var menu Menu
+	err = json.NewDecoder(r.Body).Decode(&menu)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedMenu := NewMenu(menu.Name, menu.Location)
+	updatedMenu.SetID(id, true)
+	updatedMenu.GenUpdateValues()
+
+	err = h.svc.UpdateMenu(r.Context(), updatedMenu)
+	if errors.Is(err, ErrMenuLocationTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidMenuLocation) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resMenuName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resMenuName))
+	h.OK(w, msg, updatedMenu)
+}
+
+func (h *APIHandler) DeleteMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteMenu", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteMenu(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resMenuName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resMenuName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// CreateMenuItem adds an item to the menu identified in the path.
+func (h *APIHandler) CreateMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateMenuItem", h.Name())
+
+	var err error
+	var menuID uuid.UUID
+	menuID, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var item MenuItem
+	err = json.NewDecoder(r.Body).Decode(&item)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newItem := NewMenuItem(menuID, item.Label, item.TargetType)
+	newItem.ParentID = item.ParentID
+	newItem.TargetID = item.TargetID
+	newItem.URL = item.URL
+	newItem.Position = item.Position
+	newItem.GenCreateValues()
+
+	err = h.svc.CreateMenuItem(r.Context(), newItem)
+	if errors.Is(err, ErrInvalidMenuItem) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resMenuItemName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resMenuItemName))
+	h.Created(w, msg, newItem)
+}
+
+func (h *APIHandler) GetMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetMenuItem", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuItemName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var item MenuItem
+	item, err = h.svc.GetMenuItem(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resMenuItemName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resMenuItemName))
+	h.OK(w, msg, item)
+}
+
+func (h *APIHandler) UpdateMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateMenuItem", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuItemName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var item MenuItem
+	err = json.NewDecoder(r.Body).Decode(&item)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedItem := NewMenuItem(item.MenuID, item.Label, item.TargetType)
+	updatedItem.ParentID = item.ParentID
+	updatedItem.TargetID = item.TargetID
+	updatedItem.URL = item.URL
+	updatedItem.Position = item.Position
+	updatedItem.SetID(id, true)
+	updatedItem.GenUpdateValues()
+
+	err = h.svc.UpdateMenuItem(r.Context(), updatedItem)
+	if errors.Is(err, ErrInvalidMenuItem) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resMenuItemName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resMenuItemName))
+	h.OK(w, msg, updatedItem)
+}
+
+// MoveMenuItem moves a menu item one position among its siblings.
+func (h *APIHandler) MoveMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling MoveMenuItem", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuItemName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var req MoveMenuItemRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	err = h.svc.MoveMenuItem(r.Context(), id, req.Direction)
+	if errors.Is(err, ErrInvalidMenuItem) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resMenuItemName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resMenuItemName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+func (h *APIHandler) DeleteMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteMenuItem", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resMenuItemName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteMenuItem(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resMenuItemName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resMenuItemName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
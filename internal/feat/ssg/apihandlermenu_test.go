@@ -0,0 +1,211 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerCreateMenu(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name:           "creates menu successfully",
+			requestBody:    map[string]string{"name": "Footer", "location": "footer"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid JSON",
+			requestBody:    "invalid json",
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without site",
+			requestBody:    map[string]string{"name": "Footer", "location": "footer"},
+			ctx:            context.Background(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with unknown location",
+			requestBody:    map[string]string{"name": "Sidebar", "location": "sidebar"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with taken location",
+			requestBody:    map[string]string{"name": "Other", "location": "main"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.menus[existingID] = Menu{ID: existingID, Name: "Main", Location: MenuLocationMain}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/menus", bytes.NewReader(body))
+			req = req.WithContext(tt.ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateMenu(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateMenu() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerGetMenu(t *testing.T) {
+	repo := newMockServiceRepo()
+	menuID, itemID := uuid.New(), uuid.New()
+	repo.menus[menuID] = Menu{ID: menuID, Name: "Main", Location: MenuLocationMain}
+	repo.menuItems[itemID] = MenuItem{ID: itemID, MenuID: menuID, Label: "Docs", TargetType: MenuTargetURL, URL: "/docs/"}
+	svc := newTestService(repo)
+
+	handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+	req := httptest.NewRequest(http.MethodGet, "/ssg/menus/"+menuID.String(), nil)
+	req.SetPathValue("id", menuID.String())
+	w := httptest.NewRecorder()
+
+	handler.GetMenu(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetMenu() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Data struct {
+			Menu Menu `json:"menu"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data.Menu.Items) != 1 || resp.Data.Menu.Items[0].ID != itemID {
+		t.Errorf("GetMenu() items = %+v, want the menu item", resp.Data.Menu.Items)
+	}
+}
+
+func TestAPIHandlerCreateMenuItem(t *testing.T) {
+	menuID := uuid.New()
+
+	tests := []struct {
+		name           string
+		menuID         string
+		requestBody    interface{}
+		wantStatusCode int
+	}{
+		{
+			name:           "creates item successfully",
+			menuID:         menuID.String(),
+			requestBody:    map[string]string{"label": "Code", "target_type": "url", "url": "https://example.com"},
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid UUID",
+			menuID:         "invalid-uuid",
+			requestBody:    map[string]string{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with invalid JSON",
+			menuID:         menuID.String(),
+			requestBody:    "invalid json",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without target",
+			menuID:         menuID.String(),
+			requestBody:    map[string]string{"label": "Docs", "target_type": "section"},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.menus[menuID] = Menu{ID: menuID, Name: "Main", Location: MenuLocationMain}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/menus/"+tt.menuID+"/items", bytes.NewReader(body))
+			req.SetPathValue("id", tt.menuID)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateMenuItem(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateMenuItem() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerMoveMenuItem(t *testing.T) {
+	menuID, first, second := uuid.New(), uuid.New(), uuid.New()
+	repo := newMockServiceRepo()
+	repo.menus[menuID] = Menu{ID: menuID, Location: MenuLocationMain}
+	repo.menuItems[first] = MenuItem{ID: first, MenuID: menuID, TargetType: MenuTargetURL, URL: "/a/", Position: 1}
+	repo.menuItems[second] = MenuItem{ID: second, MenuID: menuID, TargetType: MenuTargetURL, URL: "/b/", Position: 2}
+	svc := newTestService(repo)
+
+	handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+	body, _ := json.Marshal(MoveMenuItemRequest{Direction: "up"})
+	req := httptest.NewRequest(http.MethodPut, "/ssg/menu-items/"+second.String()+"/move", bytes.NewReader(body))
+	req.SetPathValue("id", second.String())
+	w := httptest.NewRecorder()
+
+	handler.MoveMenuItem(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("MoveMenuItem() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	if repo.menuItems[second].Position != 1 || repo.menuItems[first].Position != 2 {
+		t.Errorf("positions = %d, %d, want 1, 2", repo.menuItems[second].Position, repo.menuItems[first].Position)
+	}
+}
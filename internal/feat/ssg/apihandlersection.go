@@ -2,6 +2,7 @@ package ssg
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -62,9 +63,15 @@ func (h *APIHandler) CreateSection(w http.ResponseWriter, r *http.Request) {
 	}
 
 	newSection := NewSection(section.Name, section.Description, section.Path, section.LayoutID)
+	newSection.ParentID = section.ParentID
+	newSection.RollUp = section.RollUp
 	newSection.GenCreateValues()
 
 	err = h.svc.CreateSection(r.Context(), newSection)
+	if errors.Is(err, ErrInvalidSectionParent) || errors.Is(err, ErrSectionCycle) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resSectionName)
 		h.Err(w, http.StatusInternalServerError, msg, err)
@@ -96,9 +103,15 @@ func (h *APIHandler) UpdateSection(w http.ResponseWriter, r *http.Request) {
 
 	updatedSection := NewSection(section.Name, section.Description, section.Path, section.LayoutID)
 	updatedSection.SetID(id, true)
+	updatedSection.ParentID = section.ParentID
+	updatedSection.RollUp = section.RollUp
 	updatedSection.GenUpdateValues()
 
 	err = h.svc.UpdateSection(r.Context(), updatedSection)
+	if errors.Is(err, ErrInvalidSectionParent) || errors.Is(err, ErrSectionCycle) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resSectionName)
 		h.Err(w, http.StatusInternalServerError, msg, err)
@@ -122,6 +135,10 @@ func (h *APIHandler) DeleteSection(w http.ResponseWriter, r *http.Request) {
 	}
 
 	err = h.svc.DeleteSection(r.Context(), id)
+	if errors.Is(err, ErrSectionHasChildren) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
 	if err != nil {
 		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resSectionName)
 		h.Err(w, http.StatusInternalServerError, msg, err)
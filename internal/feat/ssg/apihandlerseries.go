@@ -0,0 +1,227 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+
+	"github.com/google/uuid"
+)
+
+// ReorderSeriesRequest lists the parts of a series in their new order.
+type ReorderSeriesRequest struct {
+	ContentIDs []uuid.UUID `json:"content_ids"`
+}
+
+func (h *APIHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling CreateSeries", h.Name())
+
+	var series Series
+	var err error
+	err = json.NewDecoder(r.Body).Decode(&series)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	newSeries := NewSeries(series.Name, series.Description, series.SectionID)
+	newSeries.SlugField = series.SlugField
+	newSeries.CoverImage = series.CoverImage
+	newSeries.Status = series.Status
+	newSeries.GenCreateValues()
+
+	siteID, err := RequireSiteID(r.Context())
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "No site selected", err)
+		return
+	}
+	newSeries.SiteID = siteID
+
+	err = h.svc.CreateSeries(r.Context(), newSeries)
+	if errors.Is(err, ErrSlugTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidSeriesStatus) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resSeriesName))
+	h.Created(w, msg, newSeries)
+}
+
+func (h *APIHandler) GetSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetSeries", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resSeriesName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var series Series
+	series, err = h.svc.GetSeries(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResource, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetItem, hm.Cap(resSeriesName))
+	h.OK(w, msg, series)
+}
+
+func (h *APIHandler) GetAllSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllSeries", h.Name())
+
+	var series []Series
+	var err error
+	series, err = h.svc.GetAllSeries(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resSeriesName))
+	h.OK(w, msg, series)
+}
+
+func (h *APIHandler) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling UpdateSeries", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resSeriesName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var series Series
+	err = json.NewDecoder(r.Body).Decode(&series)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	updatedSeries := NewSeries(series.Name, series.Description, series.SectionID)
+	updatedSeries.SlugField = series.SlugField
+	updatedSeries.CoverImage = series.CoverImage
+	updatedSeries.Status = series.Status
+	updatedSeries.SetID(id, true)
+	updatedSeries.GenUpdateValues()
+
+	err = h.svc.UpdateSeries(r.Context(), updatedSeries)
+	if errors.Is(err, ErrSlugTaken) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrInvalidSeriesStatus) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resSeriesName))
+	h.OK(w, msg, updatedSeries)
+}
+
+func (h *APIHandler) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteSeries", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resSeriesName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	err = h.svc.DeleteSeries(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resSeriesName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+// GetSeriesContents returns the parts of a series in reading order.
+func (h *APIHandler) GetSeriesContents(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetSeriesContents", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resSeriesName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	contents, err := h.svc.GetSeriesContents(r.Context(), id)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resContentName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resContentName))
+	h.OK(w, msg, contents)
+}
+
+// ReorderSeries sets the reading order of the parts of a series.
+func (h *APIHandler) ReorderSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling ReorderSeries", h.Name())
+
+	var err error
+	var id uuid.UUID
+	id, err = h.ID(w, r)
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrInvalidID, hm.Cap(resSeriesName))
+		h.Err(w, http.StatusBadRequest, msg, err)
+		return
+	}
+
+	var req ReorderSeriesRequest
+	err = json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	err = h.svc.ReorderSeries(r.Context(), id, req.ContentIDs)
+	if errors.Is(err, ErrInvalidSeriesOrder) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resSeriesName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resSeriesName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
@@ -0,0 +1,195 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestAPIHandlerCreateSeries(t *testing.T) {
+	tests := []struct {
+		name           string
+		requestBody    interface{}
+		ctx            context.Context
+		wantStatusCode int
+	}{
+		{
+			name:           "creates series successfully",
+			requestBody:    map[string]string{"name": "Learning Go", "status": "complete"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails with invalid JSON",
+			requestBody:    "invalid json",
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails without site",
+			requestBody:    map[string]string{"name": "Learning Go"},
+			ctx:            context.Background(),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with unknown status",
+			requestBody:    map[string]string{"name": "Learning Go", "status": "paused"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with taken slug",
+			requestBody:    map[string]string{"name": "Taken"},
+			ctx:            NewContextWithSite("test-site", uuid.New()),
+			wantStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.series[existingID] = Series{ID: existingID, Name: "Taken"}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/series", bytes.NewReader(body))
+			req = req.WithContext(tt.ctx)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.CreateSeries(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateSeries() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerGetSeriesContents(t *testing.T) {
+	repo := newMockServiceRepo()
+	seriesID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	repo.series[seriesID] = Series{ID: seriesID, Name: "Learning Go"}
+	repo.contents[second] = Content{ID: second, Heading: "Second", SeriesID: seriesID, SeriesOrder: 2}
+	repo.contents[first] = Content{ID: first, Heading: "First", SeriesID: seriesID, SeriesOrder: 1}
+	repo.contents[uuid.New()] = Content{Heading: "Standalone"}
+	svc := newTestService(repo)
+
+	handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+	req := httptest.NewRequest(http.MethodGet, "/ssg/series/"+seriesID.String()+"/contents", nil)
+	req.SetPathValue("id", seriesID.String())
+	w := httptest.NewRecorder()
+
+	handler.GetSeriesContents(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetSeriesContents() status = %d, want %d", w.Code, http.StatusOK)
+	}
+
+	var resp struct {
+		Data struct {
+			Contents []Content `json:"contents"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data.Contents) != 2 || resp.Data.Contents[0].ID != first || resp.Data.Contents[1].ID != second {
+		t.Errorf("GetSeriesContents() = %+v, want parts in order", resp.Data.Contents)
+	}
+}
+
+func TestAPIHandlerReorderSeries(t *testing.T) {
+	seriesID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name           string
+		seriesID       string
+		requestBody    interface{}
+		wantStatusCode int
+	}{
+		{
+			name:           "reorders parts successfully",
+			seriesID:       seriesID.String(),
+			requestBody:    ReorderSeriesRequest{ContentIDs: []uuid.UUID{second, first}},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fails with invalid UUID",
+			seriesID:       "invalid-uuid",
+			requestBody:    ReorderSeriesRequest{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with invalid JSON",
+			seriesID:       seriesID.String(),
+			requestBody:    "invalid json",
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with incomplete order",
+			seriesID:       seriesID.String(),
+			requestBody:    ReorderSeriesRequest{ContentIDs: []uuid.UUID{second}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.series[seriesID] = Series{ID: seriesID, Name: "Learning Go"}
+			repo.contents[first] = Content{ID: first, SeriesID: seriesID, SeriesOrder: 1}
+			repo.contents[second] = Content{ID: second, SeriesID: seriesID, SeriesOrder: 2}
+			svc := newTestService(repo)
+
+			handler := NewAPIHandler("test-api", svc, nil, hm.XParams{Cfg: hm.NewConfig()})
+
+			var body []byte
+			if str, ok := tt.requestBody.(string); ok {
+				body = []byte(str)
+			} else {
+				var err error
+				body, err = json.Marshal(tt.requestBody)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			req := httptest.NewRequest(http.MethodPut, "/ssg/series/"+tt.seriesID+"/order", bytes.NewReader(body))
+			req.SetPathValue("id", tt.seriesID)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.ReorderSeries(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("ReorderSeries() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantStatusCode == http.StatusOK && repo.contents[second].SeriesOrder != 1 {
+				t.Errorf("second part order = %d, want 1", repo.contents[second].SeriesOrder)
+			}
+		})
+	}
+}
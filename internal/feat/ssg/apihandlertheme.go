@@ -0,0 +1,108 @@
+package ssg
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/hm"
+)
+
+// InstallThemeRequest points to a theme on the server: a directory or a zip
+// file.
+type InstallThemeRequest struct {
+	Source string `json:"source"`
+}
+
+func (h *APIHandler) GetAllThemes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling GetAllThemes", h.Name())
+
+	themes, err := h.svc.ListThemes(r.Context())
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotGetResources, resThemeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgGetAllItems, hm.Cap(resThemeName))
+	h.OK(w, msg, themes)
+}
+
+func (h *APIHandler) InstallTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling InstallTheme", h.Name())
+
+	var req InstallThemeRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil || req.Source == "" {
+		h.Err(w, http.StatusBadRequest, hm.ErrInvalidBody, err)
+		return
+	}
+
+	theme, err := h.svc.InstallTheme(r.Context(), req.Source)
+	if errors.Is(err, ErrInvalidTheme) {
+		h.Err(w, http.StatusBadRequest, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotCreateResource, resThemeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgCreateItem, hm.Cap(resThemeName))
+	h.Created(w, msg, theme)
+}
+
+// SelectTheme makes a theme the theme of the site.
+func (h *APIHandler) SelectTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling SelectTheme", h.Name())
+
+	name, err := h.Param(w, r, "name")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid theme name", err)
+		return
+	}
+
+	err = h.svc.SelectTheme(r.Context(), name)
+	if errors.Is(err, ErrThemeNotFound) {
+		h.Err(w, http.StatusNotFound, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotUpdateResource, resThemeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgUpdateItem, hm.Cap(resThemeName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
+
+func (h *APIHandler) DeleteTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Debugf("%s: Handling DeleteTheme", h.Name())
+
+	name, err := h.Param(w, r, "name")
+	if err != nil {
+		h.Err(w, http.StatusBadRequest, "Invalid theme name", err)
+		return
+	}
+
+	err = h.svc.RemoveTheme(r.Context(), name)
+	if errors.Is(err, ErrThemeNotFound) {
+		h.Err(w, http.StatusNotFound, err.Error(), err)
+		return
+	}
+	if errors.Is(err, ErrThemeInUse) {
+		h.Err(w, http.StatusConflict, err.Error(), err)
+		return
+	}
+	if err != nil {
+		msg := fmt.Sprintf(hm.ErrCannotDeleteResource, resThemeName)
+		h.Err(w, http.StatusInternalServerError, msg, err)
+		return
+	}
+
+	msg := fmt.Sprintf(hm.MsgDeleteItem, hm.Cap(resThemeName))
+	h.OK(w, msg, json.RawMessage("null"))
+}
@@ -0,0 +1,215 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func newThemeTestHandler(t *testing.T, repo *mockServiceRepo) (*APIHandler, string) {
+	t.Helper()
+	themesPath := t.TempDir()
+	dark := filepath.Join(themesPath, "dark")
+	if err := os.MkdirAll(dark, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dark, ThemeManifestFile), []byte(`{"name":"dark"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := hm.NewConfig()
+	cfg.Set(SSGKey.ThemesPath, themesPath)
+	params := hm.XParams{Cfg: cfg}
+	assetsFS := fstest.MapFS{
+		"assets/ssg/theme.json": {Data: []byte(`{"name":"default"}`)},
+	}
+	svc := NewService(assetsFS, repo, nil, &mockPublisher{}, NewParamManager(repo, params), nil, params)
+
+	return NewAPIHandler("test-api", svc, nil, params), themesPath
+}
+
+func TestAPIHandlerGetAllThemes(t *testing.T) {
+	handler, _ := newThemeTestHandler(t, newMockServiceRepo())
+
+	req := httptest.NewRequest(http.MethodGet, "/ssg/themes", nil)
+	req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+	w := httptest.NewRecorder()
+
+	handler.GetAllThemes(w, req)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetAllThemes() status = %d, want %d", w.Code, http.StatusOK)
+	}
+	var resp struct {
+		Data struct {
+			Themes []ThemeManifest `json:"themes"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Data.Themes) != 2 || resp.Data.Themes[1].Name != "dark" {
+		t.Errorf("GetAllThemes() themes = %+v", resp.Data.Themes)
+	}
+}
+
+func TestAPIHandlerInstallTheme(t *testing.T) {
+	src := t.TempDir()
+	if err := os.WriteFile(filepath.Join(src, ThemeManifestFile), []byte(`{"name":"light"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		wantStatusCode int
+	}{
+		{
+			name:           "installs theme successfully",
+			requestBody:    `{"source":"` + src + `"}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "fails without source",
+			requestBody:    `{}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with invalid theme",
+			requestBody:    `{"source":"` + t.TempDir() + `"}`,
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, _ := newThemeTestHandler(t, newMockServiceRepo())
+
+			req := httptest.NewRequest(http.MethodPost, "/ssg/themes", bytes.NewReader([]byte(tt.requestBody)))
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+
+			handler.InstallTheme(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("InstallTheme() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestAPIHandlerSelectTheme(t *testing.T) {
+	tests := []struct {
+		name           string
+		theme          string
+		setup          func(*mockServiceRepo)
+		wantStatusCode int
+	}{
+		{
+			name:           "creates the theme param",
+			theme:          "dark",
+			setup:          func(m *mockServiceRepo) {},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:  "updates the theme param",
+			theme: "dark",
+			setup: func(m *mockServiceRepo) {
+				p := Param{ID: uuid.New(), Name: "SSG Theme Name", RefKey: SSGKey.ThemeName, Value: DefaultThemeName}
+				m.params[p.ID] = p
+				m.paramsByRef[p.RefKey] = p
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fails with unknown theme",
+			theme:          "missing",
+			setup:          func(m *mockServiceRepo) {},
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			tt.setup(repo)
+			handler, _ := newThemeTestHandler(t, repo)
+
+			req := httptest.NewRequest(http.MethodPut, "/ssg/themes/"+tt.theme+"/select", nil)
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.SetPathValue("name", tt.theme)
+			w := httptest.NewRecorder()
+
+			handler.SelectTheme(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("SelectTheme() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			if w.Code == http.StatusOK && repo.paramsByRef[SSGKey.ThemeName].Value != tt.theme {
+				t.Errorf("theme param = %q, want %q", repo.paramsByRef[SSGKey.ThemeName].Value, tt.theme)
+			}
+		})
+	}
+}
+
+func TestAPIHandlerDeleteTheme(t *testing.T) {
+	tests := []struct {
+		name           string
+		theme          string
+		active         string
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes theme successfully",
+			theme:          "dark",
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name:           "fails with the active theme",
+			theme:          "dark",
+			active:         "dark",
+			wantStatusCode: http.StatusConflict,
+		},
+		{
+			name:           "fails with unknown theme",
+			theme:          "missing",
+			wantStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			if tt.active != "" {
+				p := Param{ID: uuid.New(), RefKey: SSGKey.ThemeName, Value: tt.active}
+				repo.params[p.ID] = p
+				repo.paramsByRef[p.RefKey] = p
+			}
+			handler, themesPath := newThemeTestHandler(t, repo)
+
+			req := httptest.NewRequest(http.MethodDelete, "/ssg/themes/"+tt.theme, nil)
+			req = req.WithContext(NewContextWithSite("test-site", uuid.New()))
+			req.SetPathValue("name", tt.theme)
+			w := httptest.NewRecorder()
+
+			handler.DeleteTheme(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Fatalf("DeleteTheme() status = %d, want %d, body: %s", w.Code, tt.wantStatusCode, w.Body.String())
+			}
+			_, err := os.Stat(filepath.Join(themesPath, "dark"))
+			if removed := os.IsNotExist(err); removed != (w.Code == http.StatusOK) {
+				t.Errorf("theme removed = %v", removed)
+			}
+		})
+	}
+}
@@ -15,6 +15,7 @@ func NewAPIRouter(handler *APIHandler, mw []hm.Middleware, params hm.XParams) *h
 	// SSG API routes
 	core.Post("/generate-markdown", handler.GenerateMarkdown)
 	core.Post("/generate-html", handler.GenerateHTML)
+	core.Post("/generate-html/comments", handler.GenerateCommentPages)
 
 	// Publish API routes
 	core.Post("/publish", handler.Publish)
@@ -50,6 +51,12 @@ func NewAPIRouter(handler *APIHandler, mw []hm.Middleware, params hm.XParams) *h
 	core.Get("/contents/{content_id}/images", handler.GetContentImages)
 	core.Delete("/contents/{content_id}/images/delete", handler.DeleteContentImage)
 
+	// Content Alias API routes
+	core.Get("/contents/{content_id}/aliases", handler.GetContentAliases)
+	core.Post("/contents/{content_id}/aliases", handler.AddContentAlias)
+	core.Delete("/contents/{content_id}/aliases/{id}", handler.DeleteContentAlias)
+	core.Get("/contents/{content_id}/backlinks", handler.GetContentBacklinks)
+
 	// Section Image Upload API routes
 	core.Post("/sections/{section_id}/images", handler.UploadSectionImage)
 	core.Delete("/sections/{section_id}/images/{image_type}", handler.DeleteSectionImage)
@@ -62,6 +69,70 @@ func NewAPIRouter(handler *APIHandler, mw []hm.Middleware, params hm.XParams) *h
 	core.Put("/tags/{id}", handler.UpdateTag)
 	core.Delete("/tags/{id}", handler.DeleteTag)
 
+	// Series API routes
+	core.Get("/series", handler.GetAllSeries)
+	core.Get("/series/{id}", handler.GetSeries)
+	core.Get("/series/{id}/contents", handler.GetSeriesContents)
+	core.Post("/series", handler.CreateSeries)
+	core.Put("/series/{id}", handler.UpdateSeries)
+	core.Put("/series/{id}/order", handler.ReorderSeries)
+	core.Delete("/series/{id}", handler.DeleteSeries)
+
+	// Author API routes
+	core.Get("/authors", handler.GetAllAuthors)
+	core.Get("/authors/{id}", handler.GetAuthor)
+	core.Post("/authors", handler.CreateAuthor)
+	core.Put("/authors/{id}", handler.UpdateAuthor)
+	core.Delete("/authors/{id}", handler.DeleteAuthor)
+	core.Get("/contents/{id}/authors", handler.GetContentAuthors)
+	core.Put("/contents/{id}/authors", handler.SetContentAuthors)
+
+	// Content type API routes
+	core.Get("/content-types", handler.GetAllContentTypes)
+	core.Get("/content-types/{id}", handler.GetContentType)
+	core.Post("/content-types", handler.CreateContentType)
+	core.Put("/content-types/{id}", handler.UpdateContentType)
+	core.Delete("/content-types/{id}", handler.DeleteContentType)
+
+	// Archetype API routes
+	core.Get("/archetypes", handler.GetAllArchetypes)
+	core.Get("/archetypes/{id}", handler.GetArchetype)
+	core.Post("/archetypes", handler.CreateArchetype)
+	core.Put("/archetypes/{id}", handler.UpdateArchetype)
+	core.Delete("/archetypes/{id}", handler.DeleteArchetype)
+
+	// Comment moderation API routes
+	core.Get("/comments", handler.GetAllComments)
+	core.Get("/comments/rebuilds", handler.GetCommentRebuilds)
+	core.Get("/comments/{id}", handler.GetComment)
+	core.Put("/comments/{id}/status", handler.ModerateComment)
+	core.Delete("/comments/{id}", handler.DeleteComment)
+
+	// Theme API routes
+	core.Get("/themes", handler.GetAllThemes)
+	core.Post("/themes", handler.InstallTheme)
+	core.Put("/themes/{name}/select", handler.SelectTheme)
+	core.Delete("/themes/{name}", handler.DeleteTheme)
+
+	// Data file API routes
+	core.Get("/data-files", handler.GetAllDataFiles)
+	core.Get("/data-files/{name}", handler.GetDataFile)
+	core.Post("/data-files", handler.CreateDataFile)
+	core.Put("/data-files/{name}", handler.UpdateDataFile)
+	core.Delete("/data-files/{name}", handler.DeleteDataFile)
+
+	// Menu API routes
+	core.Get("/menus", handler.GetMenus)
+	core.Get("/menus/{id}", handler.GetMenu)
+	core.Post("/menus", handler.CreateMenu)
+	core.Put("/menus/{id}", handler.UpdateMenu)
+	core.Delete("/menus/{id}", handler.DeleteMenu)
+	core.Post("/menus/{id}/items", handler.CreateMenuItem)
+	core.Get("/menu-items/{id}", handler.GetMenuItem)
+	core.Put("/menu-items/{id}", handler.UpdateMenuItem)
+	core.Put("/menu-items/{id}/move", handler.MoveMenuItem)
+	core.Delete("/menu-items/{id}", handler.DeleteMenuItem)
+
 	// Param API routes
 	core.Get("/params", handler.ListParams)
 	core.Get("/params/{id}", handler.GetParam)
@@ -88,3 +159,15 @@ func NewAPIRouter(handler *APIHandler, mw []hm.Middleware, params hm.XParams) *h
 
 	return core
 }
+
+// NewCommentRouter returns the router of the public comment submission
+// endpoint generated pages post to. It is kept apart from the API router as
+// readers are not signed in and their forms cannot send site headers.
+func NewCommentRouter(handler *APIHandler, mw []hm.Middleware, params hm.XParams) *hm.Router {
+	core := hm.NewAPIRouter("comment-router", params)
+	core.SetMiddlewares(mw)
+
+	core.Post("/", handler.SubmitComment)
+
+	return core
+}
@@ -0,0 +1,314 @@
+package ssg
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+// ErrInvalidArchetype is returned when an archetype is not valid.
+var ErrInvalidArchetype = errors.New("invalid archetype")
+
+// ErrArchetypeNameTaken is returned when an archetype uses the name of
+// another archetype of the site.
+var ErrArchetypeNameTaken = errors.New("archetype name already used")
+
+// ErrUnknownArchetype is returned when new content asks for an archetype
+// the site does not have.
+var ErrUnknownArchetype = errors.New("unknown archetype")
+
+// Archetype holds the values new content of a kind starts with: a body
+// skeleton, default tags and section, meta defaults and default custom
+// field values.
+type Archetype struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Site relationship
+	SiteID uuid.UUID `json:"site_id" db:"site_id"`
+
+	// Archetype specific fields
+	// Name identifies the archetype when creating content, e.g. "recipe".
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	Kind        string    `json:"kind" db:"kind"`
+	SectionID   uuid.UUID `json:"section_id" db:"section_id"`
+	SectionName string    `json:"section_name" db:"section_name"`
+	Body        string    `json:"body" db:"body"`
+	// Tags are the comma separated names of the default tags.
+	Tags string `json:"tags" db:"tags"`
+
+	// Meta defaults
+	Robots          string `json:"robots" db:"robots"`
+	Keywords        string `json:"keywords" db:"keywords"`
+	Sitemap         string `json:"sitemap" db:"sitemap"`
+	TableOfContents bool   `json:"table_of_contents" db:"table_of_contents"`
+	Share           bool   `json:"share" db:"share"`
+	Comments        bool   `json:"comments" db:"comments"`
+
+	// Fields holds the default custom field values by field name.
+	Fields map[string]string `json:"fields" db:"-"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// ArchetypeField is the stored default value of a custom field of an
+// archetype.
+type ArchetypeField struct {
+	ArchetypeID uuid.UUID `json:"archetype_id" db:"archetype_id"`
+	Name        string    `json:"name" db:"name"`
+	Value       string    `json:"value" db:"value"`
+}
+
+// NewArchetype creates a new Archetype.
+func NewArchetype(name, kind string) Archetype {
+	a := Archetype{
+		Name: name,
+		Kind: kind,
+	}
+
+	return a
+}
+
+// Type returns the type of the entity.
+func (a *Archetype) Type() string {
+	return "archetype"
+}
+
+// GetID returns the unique identifier of the entity.
+func (a *Archetype) GetID() uuid.UUID {
+	return a.ID
+}
+
+// GenID delegates to the functional helper.
+func (a *Archetype) GenID() {
+	hm.GenID(a)
+}
+
+// SetID sets the unique identifier of the entity.
+func (a *Archetype) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		a.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (a *Archetype) GetShortID() string {
+	return a.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (a *Archetype) GenShortID() {
+	hm.GenShortID(a)
+}
+
+// SetShortID sets the short ID of the entity.
+func (a *Archetype) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ShortID == "" || shouldForce {
+		a.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (a *Archetype) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(a, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (a *Archetype) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(a, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (a *Archetype) GetCreatedBy() uuid.UUID {
+	return a.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (a *Archetype) GetUpdatedBy() uuid.UUID {
+	return a.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (a *Archetype) GetCreatedAt() time.Time {
+	return a.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (a *Archetype) GetUpdatedAt() time.Time {
+	return a.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (a *Archetype) SetCreatedAt(createdAt time.Time) {
+	a.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (a *Archetype) SetUpdatedAt(updatedAt time.Time) {
+	a.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (a *Archetype) SetCreatedBy(createdBy uuid.UUID) {
+	a.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (a *Archetype) SetUpdatedBy(updatedBy uuid.UUID) {
+	a.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the Archetype is uninitialized.
+func (a *Archetype) IsZero() bool {
+	return a.ID == uuid.Nil
+}
+
+// Slug returns the name of the archetype.
+func (a *Archetype) Slug() string {
+	return a.Name
+}
+
+func (a *Archetype) OptValue() string {
+	return a.Name
+}
+
+func (a *Archetype) OptLabel() string {
+	return humanize(a.Name)
+}
+
+func (a *Archetype) Ref() string {
+	return a.ref
+}
+
+func (a *Archetype) SetRef(ref string) {
+	a.ref = ref
+}
+
+// TagNames returns the names of the default tags, trimmed and without
+// empty or repeated names.
+func (a *Archetype) TagNames() []string {
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(a.Tags, ",") {
+		name = strings.TrimSpace(name)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// Normalize lowercases the name and kind of the archetype and tidies its
+// tag list.
+func (a *Archetype) Normalize() {
+	a.Name = NormalizeSlug(a.Name)
+	a.Kind = strings.ToLower(strings.TrimSpace(a.Kind))
+	a.Tags = strings.Join(a.TagNames(), ", ")
+}
+
+// Validate checks the name and kind of the archetype and, given the content
+// types of the site, that its kind exists and its default field values match
+// the schema of the type of the kind. Required fields may be left for the
+// author to fill in.
+func (a *Archetype) Validate(types []ContentType) error {
+	if a.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidArchetype)
+	}
+	if a.Kind == "" {
+		return fmt.Errorf("%w: kind is required", ErrInvalidArchetype)
+	}
+
+	ct, ok := FindContentType(types, a.Kind)
+	if !ok && !IsBuiltinKind(a.Kind) {
+		return fmt.Errorf("%w: %s", ErrUnknownContentKind, a.Kind)
+	}
+	if a.SectionID != uuid.Nil && !ct.AllowsSection(a.SectionID) {
+		return fmt.Errorf("%w: %s", ErrSectionNotAllowed, a.Kind)
+	}
+
+	return ct.CheckFieldValues(a.Fields)
+}
+
+// Apply fills the values content leaves unset with the defaults of the
+// archetype. Values already set in content are kept, so explicit values
+// win over the archetype.
+func (a *Archetype) Apply(c *Content) {
+	if c.Kind == "" {
+		c.Kind = a.Kind
+	}
+	if c.SectionID == uuid.Nil {
+		c.SectionID = a.SectionID
+	}
+	if strings.TrimSpace(c.Body) == "" {
+		c.Body = a.Body
+	}
+	if len(c.Tags) == 0 {
+		for _, name := range a.TagNames() {
+			c.Tags = append(c.Tags, NewTag(name))
+		}
+	}
+
+	if c.Meta.Robots == "" {
+		c.Meta.Robots = a.Robots
+	}
+	if c.Meta.Keywords == "" {
+		c.Meta.Keywords = a.Keywords
+	}
+	if c.Meta.Sitemap == "" {
+		c.Meta.Sitemap = a.Sitemap
+	}
+	c.Meta.TableOfContents = c.Meta.TableOfContents || a.TableOfContents
+	c.Meta.Share = c.Meta.Share || a.Share
+	c.Meta.Comments = c.Meta.Comments || a.Comments
+
+	if len(a.Fields) > 0 && c.Fields == nil {
+		c.Fields = make(map[string]string, len(a.Fields))
+	}
+	for name, value := range a.Fields {
+		if strings.TrimSpace(c.Fields[name]) == "" {
+			c.Fields[name] = value
+		}
+	}
+}
+
+// FindArchetype returns the archetype named name among archetypes.
+func FindArchetype(archetypes []Archetype, name string) (Archetype, bool) {
+	for _, a := range archetypes {
+		if strings.EqualFold(a.Name, name) {
+			return a, true
+		}
+	}
+	return Archetype{}, false
+}
+
+// ArchetypeFieldList returns values as stored default fields of
+// archetypeID, sorted by name. Empty values are left out.
+func ArchetypeFieldList(archetypeID uuid.UUID, values map[string]string) []ArchetypeField {
+	list := make([]ArchetypeField, 0, len(values))
+	for name, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		list = append(list, ArchetypeField{ArchetypeID: archetypeID, Name: name, Value: value})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
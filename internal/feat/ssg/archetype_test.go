@@ -0,0 +1,146 @@
+package ssg
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestArchetypeValidate(t *testing.T) {
+	allowedSection := uuid.New()
+	types := []ContentType{{
+		Name:       "recipe",
+		SectionIDs: []uuid.UUID{allowedSection},
+		Fields: []FieldDef{
+			{Name: "servings", Type: FieldTypeNumber, Required: true},
+			{Name: "vegan", Type: FieldTypeBool},
+		},
+	}}
+
+	tests := []struct {
+		name    string
+		a       Archetype
+		wantErr error
+	}{
+		{
+			name: "valid archetype",
+			a:    Archetype{Name: "Quick Recipe", Kind: "Recipe", SectionID: allowedSection, Fields: map[string]string{"vegan": "true"}},
+		},
+		{
+			name: "builtin kind",
+			a:    Archetype{Name: "review", Kind: "article"},
+		},
+		{
+			name:    "missing name",
+			a:       Archetype{Kind: "article"},
+			wantErr: ErrInvalidArchetype,
+		},
+		{
+			name:    "missing kind",
+			a:       Archetype{Name: "review"},
+			wantErr: ErrInvalidArchetype,
+		},
+		{
+			name:    "unknown kind",
+			a:       Archetype{Name: "episode", Kind: "podcast"},
+			wantErr: ErrUnknownContentKind,
+		},
+		{
+			name:    "section not allowed",
+			a:       Archetype{Name: "quick", Kind: "recipe", SectionID: uuid.New()},
+			wantErr: ErrSectionNotAllowed,
+		},
+		{
+			name:    "invalid field value",
+			a:       Archetype{Name: "quick", Kind: "recipe", Fields: map[string]string{"servings": "four"}},
+			wantErr: ErrInvalidContentField,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.a.Normalize()
+			err := tt.a.Validate(types)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("Validate() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Validate() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestArchetypeNormalize(t *testing.T) {
+	a := Archetype{Name: "Quick Recipe", Kind: " Recipe ", Tags: "food, ,Pasta, food"}
+
+	a.Normalize()
+
+	if a.Name != "quick-recipe" {
+		t.Errorf("Name = %q, want %q", a.Name, "quick-recipe")
+	}
+	if a.Kind != "recipe" {
+		t.Errorf("Kind = %q, want %q", a.Kind, "recipe")
+	}
+	if a.Tags != "food, Pasta" {
+		t.Errorf("Tags = %q, want %q", a.Tags, "food, Pasta")
+	}
+}
+
+func TestArchetypeApply(t *testing.T) {
+	sectionID := uuid.New()
+	a := Archetype{
+		Name:            "quick",
+		Kind:            "recipe",
+		SectionID:       sectionID,
+		Body:            "## Ingredients\n\n## Steps\n",
+		Tags:            "food, quick",
+		Robots:          "noindex",
+		TableOfContents: true,
+		Fields:          map[string]string{"servings": "2", "vegan": "false"},
+	}
+
+	t.Run("fills empty content", func(t *testing.T) {
+		c := NewContent("Pasta", "")
+
+		a.Apply(&c)
+
+		if c.Kind != "recipe" || c.SectionID != sectionID || c.Body != a.Body {
+			t.Errorf("Apply() kind, section, body = %q, %s, %q", c.Kind, c.SectionID, c.Body)
+		}
+		if len(c.Tags) != 2 || c.Tags[0].Name != "food" || c.Tags[1].Name != "quick" {
+			t.Errorf("Tags = %+v, want food and quick", c.Tags)
+		}
+		if c.Meta.Robots != "noindex" || !c.Meta.TableOfContents {
+			t.Errorf("Meta = %+v, want robots and table of contents set", c.Meta)
+		}
+		if c.Fields["servings"] != "2" || c.Fields["vegan"] != "false" {
+			t.Errorf("Fields = %v, want archetype defaults", c.Fields)
+		}
+	})
+
+	t.Run("keeps set values", func(t *testing.T) {
+		otherSection := uuid.New()
+		c := NewContent("Pasta", "My own body")
+		c.SectionID = otherSection
+		c.Tags = []Tag{NewTag("dinner")}
+		c.Meta.Robots = "index"
+		c.Fields = map[string]string{"servings": "6"}
+
+		a.Apply(&c)
+
+		if c.SectionID != otherSection || c.Body != "My own body" {
+			t.Errorf("Apply() section, body = %s, %q, want set values", c.SectionID, c.Body)
+		}
+		if len(c.Tags) != 1 || c.Tags[0].Name != "dinner" {
+			t.Errorf("Tags = %+v, want dinner", c.Tags)
+		}
+		if c.Meta.Robots != "index" {
+			t.Errorf("Robots = %q, want %q", c.Meta.Robots, "index")
+		}
+		if c.Fields["servings"] != "6" || c.Fields["vegan"] != "false" {
+			t.Errorf("Fields = %v, want set servings and default vegan", c.Fields)
+		}
+	})
+}
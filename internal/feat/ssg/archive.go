@@ -0,0 +1,176 @@
+package ssg
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ArchivePath is the path of the index listing all dated content.
+const ArchivePath = "/archive/"
+
+// ArchiveYear summarizes the content published in a year.
+type ArchiveYear struct {
+	Year   int
+	Count  int
+	Path   string
+	Months []ArchiveMonth // Newest first.
+}
+
+// ArchiveMonth summarizes the content published in a month.
+type ArchiveMonth struct {
+	Year  int
+	Month time.Month
+	Count int
+	Path  string
+}
+
+// ArchiveYearPath returns the path of the archive of year, e.g. /2024/.
+func ArchiveYearPath(year int) string {
+	return fmt.Sprintf("/%04d/", year)
+}
+
+// ArchiveMonthPath returns the path of the archive of a month, e.g. /2024/03/.
+func ArchiveMonthPath(year int, month time.Month) string {
+	return fmt.Sprintf("/%04d/%02d/", year, int(month))
+}
+
+// archived reports whether content belongs to the date archives: published,
+// dated content that is listed on the home index of the site mode.
+func archived(content Content, mode string) bool {
+	if content.Draft || content.PublishedAt == nil {
+		return false
+	}
+
+	kind := strings.ToLower(content.Kind)
+	if mode == "blog" {
+		return kind == "blog" && content.SectionPath == "/"
+	}
+	return kind == "article" || kind == "blog" || kind == "series"
+}
+
+// BuildArchiveIndexes returns the date archive indexes of allContent: the
+// whole archive plus one index per year and per month with content. Their
+// content is ordered newest first.
+func BuildArchiveIndexes(allContent []Content, mode string) []*Index {
+	indexes := make(map[string]*Index)
+	add := func(path string, content Content) {
+		if _, ok := indexes[path]; !ok {
+			indexes[path] = &Index{Path: path, Type: "archive", Content: []Content{}}
+		}
+		indexes[path].Content = append(indexes[path].Content, content)
+	}
+
+	for _, content := range allContent {
+		if !archived(content, mode) {
+			continue
+		}
+		published := *content.PublishedAt
+		add(ArchivePath, content)
+		add(ArchiveYearPath(published.Year()), content)
+		add(ArchiveMonthPath(published.Year(), published.Month()), content)
+	}
+
+	result := make([]*Index, 0, len(indexes))
+	for _, index := range indexes {
+		sort.SliceStable(index.Content, func(i, j int) bool {
+			return index.Content[i].PublishedAt.After(*index.Content[j].PublishedAt)
+		})
+		result = append(result, index)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// BuildLocaleArchiveIndexes builds the date archive indexes of every locale
+// in allContent, prefixed like the ones of BuildLocaleIndexes.
+func BuildLocaleArchiveIndexes(allContent []Content, mode, defaultLocale string) []*Index {
+	return buildLocaleIndexes(allContent, defaultLocale, func(contents []Content) []*Index {
+		return BuildArchiveIndexes(contents, mode)
+	})
+}
+
+// BuildArchiveSummary counts the archived content of allContent per year and
+// month, newest first, for archive widgets. Paths are localized to locale.
+func BuildArchiveSummary(allContent []Content, mode, locale, defaultLocale string) []ArchiveYear {
+	years := make(map[int]*ArchiveYear)
+	months := make(map[string]*ArchiveMonth)
+
+	for _, content := range allContent {
+		if !archived(content, mode) || localeOrDefault(content.Locale, defaultLocale) != locale {
+			continue
+		}
+		published := *content.PublishedAt
+		year, month := published.Year(), published.Month()
+
+		y, ok := years[year]
+		if !ok {
+			y = &ArchiveYear{Year: year, Path: LocalizePath(ArchiveYearPath(year), locale, defaultLocale)}
+			years[year] = y
+		}
+		y.Count++
+
+		monthPath := ArchiveMonthPath(year, month)
+		m, ok := months[monthPath]
+		if !ok {
+			m = &ArchiveMonth{Year: year, Month: month, Path: LocalizePath(monthPath, locale, defaultLocale)}
+			months[monthPath] = m
+		}
+		m.Count++
+	}
+
+	for _, m := range months {
+		years[m.Year].Months = append(years[m.Year].Months, *m)
+	}
+
+	summary := make([]ArchiveYear, 0, len(years))
+	for _, y := range years {
+		sort.Slice(y.Months, func(i, j int) bool {
+			return y.Months[i].Month > y.Months[j].Month
+		})
+		summary = append(summary, *y)
+	}
+	sort.Slice(summary, func(i, j int) bool {
+		return summary[i].Year > summary[j].Year
+	})
+
+	return summary
+}
+
+// archiveTitle returns the title of an archive index in locale: "Archive",
+// the year or the month and year.
+func archiveTitle(index *Index, locale string) string {
+	parts := strings.Split(strings.Trim(index.BasePath(), "/"), "/")
+	switch len(parts) {
+	case 2:
+		var year, month int
+		if _, err := fmt.Sscanf(parts[0]+" "+parts[1], "%d %d", &year, &month); err == nil && month >= 1 && month <= 12 {
+			return FormatDate("January 2006", locale, time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC))
+		}
+	case 1:
+		if parts[0] != "archive" {
+			return parts[0]
+		}
+	}
+	return Translate(locale, "Archive")
+}
+
+// archiveBreadcrumbs returns the crumbs from the whole archive down to an
+// archive index, e.g. Archive > 2024 > March 2024.
+func archiveBreadcrumbs(index *Index, title string) []Breadcrumb {
+	crumbs := []Breadcrumb{{Name: "Archive", URL: ArchivePath, Label: true}}
+	parts := strings.Split(strings.Trim(index.Path, "/"), "/")
+	if len(parts) == 2 {
+		crumbs = append(crumbs, Breadcrumb{Name: parts[0], URL: "/" + parts[0] + "/"})
+	}
+	if index.Path != ArchivePath {
+		crumbs = append(crumbs, Breadcrumb{Name: title, URL: index.Path})
+	}
+	crumbs[len(crumbs)-1].Current = true
+	return crumbs
+}
@@ -0,0 +1,151 @@
+package ssg
+
+import (
+	"testing"
+	"time"
+)
+
+func archiveTestContent() []Content {
+	date := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	return []Content{
+		{Heading: "Old", Kind: "blog", SectionPath: "/", PublishedAt: date(2023, time.December, 5)},
+		{Heading: "First", Kind: "blog", SectionPath: "/", PublishedAt: date(2024, time.March, 1)},
+		{Heading: "Second", Kind: "article", SectionPath: "/tech/", PublishedAt: date(2024, time.March, 20)},
+		{Heading: "Third", Kind: "blog", SectionPath: "/", PublishedAt: date(2024, time.May, 2)},
+		{Heading: "About", Kind: "page", SectionPath: "/", PublishedAt: date(2024, time.May, 3)},
+		{Heading: "Draft", Kind: "blog", SectionPath: "/", Draft: true, PublishedAt: date(2024, time.May, 4)},
+		{Heading: "Undated", Kind: "blog", SectionPath: "/"},
+	}
+}
+
+func TestBuildArchiveIndexes(t *testing.T) {
+	tests := []struct {
+		name string
+		mode string
+		want map[string][]string
+	}{
+		{
+			name: "structured mode",
+			mode: "structured",
+			want: map[string][]string{
+				"/archive/": {"Third", "Second", "First", "Old"},
+				"/2023/":    {"Old"},
+				"/2023/12/": {"Old"},
+				"/2024/":    {"Third", "Second", "First"},
+				"/2024/03/": {"Second", "First"},
+				"/2024/05/": {"Third"},
+			},
+		},
+		{
+			name: "blog mode lists root blog posts only",
+			mode: "blog",
+			want: map[string][]string{
+				"/archive/": {"Third", "First", "Old"},
+				"/2023/":    {"Old"},
+				"/2023/12/": {"Old"},
+				"/2024/":    {"Third", "First"},
+				"/2024/03/": {"First"},
+				"/2024/05/": {"Third"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexes := BuildArchiveIndexes(archiveTestContent(), tt.mode)
+
+			if len(indexes) != len(tt.want) {
+				t.Fatalf("got %d indexes, want %d", len(indexes), len(tt.want))
+			}
+			for _, idx := range indexes {
+				want, ok := tt.want[idx.Path]
+				if !ok {
+					t.Errorf("unexpected index %s", idx.Path)
+					continue
+				}
+				if idx.Type != "archive" {
+					t.Errorf("index %s type = %q, want archive", idx.Path, idx.Type)
+				}
+				if len(idx.Content) != len(want) {
+					t.Errorf("index %s has %d items, want %d", idx.Path, len(idx.Content), len(want))
+					continue
+				}
+				for i, heading := range want {
+					if idx.Content[i].Heading != heading {
+						t.Errorf("index %s item %d = %q, want %q", idx.Path, i, idx.Content[i].Heading, heading)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBuildLocaleArchiveIndexes(t *testing.T) {
+	content := archiveTestContent()
+	for i := range content {
+		content[i].Locale = "en"
+	}
+	content[0].Locale = "es"
+
+	got := make(map[string]*Index)
+	for _, idx := range BuildLocaleArchiveIndexes(content, "structured", "en") {
+		got[idx.Path] = idx
+	}
+
+	for _, path := range []string{"/archive/", "/2024/03/", "/es/archive/", "/es/2023/12/"} {
+		if _, ok := got[path]; !ok {
+			t.Errorf("missing index %s", path)
+		}
+	}
+	if _, ok := got["/2023/"]; ok {
+		t.Error("unexpected /2023/ index for the default locale")
+	}
+	if base := got["/es/2023/12/"].BasePath(); base != "/2023/12/" {
+		t.Errorf("BasePath() = %q, want /2023/12/", base)
+	}
+}
+
+func TestBuildArchiveSummary(t *testing.T) {
+	content := archiveTestContent()
+	for i := range content {
+		content[i].Locale = "en"
+	}
+
+	got := BuildArchiveSummary(content, "structured", "en", "en")
+
+	want := []ArchiveYear{
+		{Year: 2024, Count: 3, Path: "/2024/", Months: []ArchiveMonth{
+			{Year: 2024, Month: time.May, Count: 1, Path: "/2024/05/"},
+			{Year: 2024, Month: time.March, Count: 2, Path: "/2024/03/"},
+		}},
+		{Year: 2023, Count: 1, Path: "/2023/", Months: []ArchiveMonth{
+			{Year: 2023, Month: time.December, Count: 1, Path: "/2023/12/"},
+		}},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("got %d years %+v, want %d", len(got), got, len(want))
+	}
+	for i := range want {
+		if got[i].Year != want[i].Year || got[i].Count != want[i].Count || got[i].Path != want[i].Path {
+			t.Errorf("year %d = %+v, want %+v", i, got[i], want[i])
+		}
+		if len(got[i].Months) != len(want[i].Months) {
+			t.Errorf("year %d months = %+v, want %+v", i, got[i].Months, want[i].Months)
+			continue
+		}
+		for j := range want[i].Months {
+			if got[i].Months[j] != want[i].Months[j] {
+				t.Errorf("year %d month %d = %+v, want %+v", i, j, got[i].Months[j], want[i].Months[j])
+			}
+		}
+	}
+
+	if es := BuildArchiveSummary(content, "structured", "es", "en"); len(es) != 0 {
+		t.Errorf("es summary = %+v, want empty", es)
+	}
+}
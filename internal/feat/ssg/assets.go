@@ -1,7 +1,6 @@
 package ssg
 
 import (
-	"embed"
 	"fmt"
 	"io"
 	"io/fs"
@@ -9,14 +8,15 @@ import (
 	"path/filepath"
 )
 
-func CopyStaticAssets(assetsFS embed.FS, targetDir string) error {
-
-	return fs.WalkDir(assetsFS, "assets/ssg/static", func(path string, d fs.DirEntry, err error) error {
+// CopyStaticAssets copies the static assets of a theme, the files below its
+// ThemeStaticDir, to the static directory of targetDir.
+func CopyStaticAssets(themeFS fs.FS, targetDir string) error {
+	return fs.WalkDir(themeFS, ThemeStaticDir, func(path string, d fs.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("error walking directory: %w", err)
 		}
 
-		destPath := filepath.Join(targetDir, path[len("assets/ssg"):])
+		destPath := filepath.Join(targetDir, filepath.FromSlash(path))
 
 		if d.IsDir() {
 			if err := os.MkdirAll(destPath, 0755); err != nil {
@@ -25,7 +25,7 @@ func CopyStaticAssets(assetsFS embed.FS, targetDir string) error {
 			return nil
 		}
 
-		return copyFile(assetsFS, path, destPath)
+		return copyFile(themeFS, path, destPath)
 	})
 }
 
@@ -65,8 +65,8 @@ func CopyDynamicImages(sourceDir, targetDir string) error {
 	})
 }
 
-func copyFile(assetsFS embed.FS, srcPath, dstPath string) error {
-	srcFile, err := assetsFS.Open(srcPath)
+func copyFile(fsys fs.FS, srcPath, dstPath string) error {
+	srcFile, err := fsys.Open(srcPath)
 	if err != nil {
 		return fmt.Errorf("cannot open source file: %w", err)
 	}
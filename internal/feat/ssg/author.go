@@ -0,0 +1,345 @@
+package ssg
+
+import (
+	"errors"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+// ErrAuthorUserTaken is returned when a user already has an author profile
+// in the site.
+var ErrAuthorUserTaken = errors.New("user already has an author profile")
+
+// ErrUnknownAuthor is returned when content is attributed to an author that
+// does not exist in the site.
+var ErrUnknownAuthor = errors.New("unknown author")
+
+// Author is the public profile content is attributed to. It is usually tied
+// to the user writing the content, though guest authors may have no user.
+type Author struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Site relationship
+	SiteID uuid.UUID `json:"site_id" db:"site_id"`
+
+	// Author specific fields
+	UserID    uuid.UUID `json:"user_id" db:"user_id"`
+	Name      string    `json:"name" db:"name"`
+	SlugField string    `json:"slug" db:"slug"`
+	Bio       string    `json:"bio" db:"bio"`
+	Avatar    string    `json:"avatar" db:"avatar"`
+	// Links holds one link per line: a URL, optionally preceded by a label,
+	// e.g. "Mastodon https://example.social/@jane".
+	Links string `json:"links" db:"links"`
+
+	// URL is the path of the author page in the locale of the content the
+	// author is resolved for. See ResolveAuthors.
+	URL string `json:"-" db:"-"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// AuthorLink is one of the links of an author profile.
+type AuthorLink struct {
+	Label string
+	URL   string
+}
+
+// ContentAuthor attributes content to an author. Position orders the authors
+// of a content, starting at 1.
+type ContentAuthor struct {
+	ContentID uuid.UUID `json:"content_id" db:"content_id"`
+	AuthorID  uuid.UUID `json:"author_id" db:"author_id"`
+	Position  int       `json:"position" db:"position"`
+}
+
+// NewAuthor creates a new Author.
+func NewAuthor(name string, userID uuid.UUID) Author {
+	a := Author{
+		Name:   name,
+		UserID: userID,
+	}
+
+	return a
+}
+
+// Type returns the type of the entity.
+func (a *Author) Type() string {
+	return "author"
+}
+
+// GetID returns the unique identifier of the entity.
+func (a *Author) GetID() uuid.UUID {
+	return a.ID
+}
+
+// GenID delegates to the functional helper.
+func (a *Author) GenID() {
+	hm.GenID(a)
+}
+
+// SetID sets the unique identifier of the entity.
+func (a *Author) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		a.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (a *Author) GetShortID() string {
+	return a.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (a *Author) GenShortID() {
+	hm.GenShortID(a)
+}
+
+// SetShortID sets the short ID of the entity.
+func (a *Author) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ShortID == "" || shouldForce {
+		a.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (a *Author) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(a, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (a *Author) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(a, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (a *Author) GetCreatedBy() uuid.UUID {
+	return a.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (a *Author) GetUpdatedBy() uuid.UUID {
+	return a.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (a *Author) GetCreatedAt() time.Time {
+	return a.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (a *Author) GetUpdatedAt() time.Time {
+	return a.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (a *Author) SetCreatedAt(createdAt time.Time) {
+	a.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (a *Author) SetUpdatedAt(updatedAt time.Time) {
+	a.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (a *Author) SetCreatedBy(createdBy uuid.UUID) {
+	a.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (a *Author) SetUpdatedBy(updatedBy uuid.UUID) {
+	a.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the Author is uninitialized.
+func (a *Author) IsZero() bool {
+	return a.ID == uuid.Nil
+}
+
+// Slug returns the path segment of the author page.
+func (a *Author) Slug() string {
+	if a.SlugField != "" {
+		return a.SlugField
+	}
+	return NormalizeSlug(a.Name)
+}
+
+// Path returns the path of the author page, e.g. /authors/jane-doe/.
+func (a *Author) Path() string {
+	return AuthorIndexPath(a.Slug())
+}
+
+// LinkList returns the links of the profile. Lines without a URL are
+// skipped and links without a label are labeled with their host.
+func (a *Author) LinkList() []AuthorLink {
+	var links []AuthorLink
+	for _, line := range strings.Split(a.Links, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+
+		link := AuthorLink{
+			URL:   fields[len(fields)-1],
+			Label: strings.Join(fields[:len(fields)-1], " "),
+		}
+		u, err := url.Parse(link.URL)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			continue
+		}
+		if link.Label == "" {
+			link.Label = strings.TrimPrefix(u.Host, "www.")
+		}
+		links = append(links, link)
+	}
+	return links
+}
+
+func (a *Author) OptValue() string {
+	return a.GetID().String()
+}
+
+func (a *Author) OptLabel() string {
+	return a.Name
+}
+
+func (a *Author) Ref() string {
+	return a.ref
+}
+
+func (a *Author) SetRef(ref string) {
+	a.ref = ref
+}
+
+// AuthorIndexPath returns the path of the index listing the content of the
+// author of slug, e.g. /authors/jane-doe/.
+func AuthorIndexPath(slug string) string {
+	return path.Join("/authors", slug) + "/"
+}
+
+// ResolveAuthors sets the authors of contents: the ones attributed through
+// links, in their position order, or else the profile of the user who wrote
+// the content, if any. Their URL is localized to the content locale.
+func ResolveAuthors(contents []Content, authors []Author, links []ContentAuthor, defaultLocale string) {
+	byID := make(map[uuid.UUID]Author, len(authors))
+	byUser := make(map[uuid.UUID]Author, len(authors))
+	for _, a := range authors {
+		byID[a.ID] = a
+		if a.UserID != uuid.Nil {
+			byUser[a.UserID] = a
+		}
+	}
+
+	links = append([]ContentAuthor(nil), links...)
+	sort.SliceStable(links, func(i, j int) bool {
+		return links[i].Position < links[j].Position
+	})
+	byContent := make(map[uuid.UUID][]Author)
+	for _, l := range links {
+		if a, ok := byID[l.AuthorID]; ok {
+			byContent[l.ContentID] = append(byContent[l.ContentID], a)
+		}
+	}
+
+	for i := range contents {
+		resolved, ok := byContent[contents[i].ID]
+		if !ok {
+			a, ok := byUser[contents[i].UserID]
+			if !ok || contents[i].UserID == uuid.Nil {
+				continue
+			}
+			resolved = []Author{a}
+		}
+
+		contents[i].Authors = make([]Author, len(resolved))
+		for j, a := range resolved {
+			a.URL = LocalizePath(a.Path(), contents[i].Locale, defaultLocale)
+			contents[i].Authors[j] = a
+		}
+	}
+}
+
+// BuildAuthorIndexes returns one index per author of the published content
+// of allContent that is listed on other indexes, newest first.
+func BuildAuthorIndexes(allContent []Content) []*Index {
+	indexes := make(map[string]*Index)
+
+	for _, content := range allContent {
+		if content.Draft || !listedKind(content.Kind) {
+			continue
+		}
+		for _, author := range content.Authors {
+			authorPath := author.Path()
+			if _, ok := indexes[authorPath]; !ok {
+				indexes[authorPath] = &Index{Path: authorPath, Type: "author", Content: []Content{}}
+			}
+			indexes[authorPath].Content = append(indexes[authorPath].Content, content)
+		}
+	}
+
+	result := make([]*Index, 0, len(indexes))
+	for _, index := range indexes {
+		sort.SliceStable(index.Content, func(i, j int) bool {
+			a, b := index.Content[i].PublishedAt, index.Content[j].PublishedAt
+			if a == nil || b == nil {
+				return a != nil
+			}
+			return a.After(*b)
+		})
+		result = append(result, index)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// BuildLocaleAuthorIndexes builds the author indexes of every locale in
+// allContent, prefixed like the ones of BuildLocaleIndexes.
+func BuildLocaleAuthorIndexes(allContent []Content, defaultLocale string) []*Index {
+	return buildLocaleIndexes(allContent, defaultLocale, BuildAuthorIndexes)
+}
+
+// IndexAuthor returns the author listed by an author index, nil for other
+// indexes.
+func IndexAuthor(index *Index) *Author {
+	if index.Type != "author" {
+		return nil
+	}
+	slug := path.Base(strings.TrimSuffix(index.Path, "/"))
+	for _, c := range index.Content {
+		for i := range c.Authors {
+			if c.Authors[i].Slug() == slug {
+				return &c.Authors[i]
+			}
+		}
+	}
+	return nil
+}
+
+// authorTitle returns the name of the author listed by an author index.
+func authorTitle(index *Index) string {
+	if a := IndexAuthor(index); a != nil {
+		return a.Name
+	}
+	return humanize(path.Base(strings.TrimSuffix(index.Path, "/")))
+}
@@ -0,0 +1,95 @@
+package ssg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestAuthorLinkList(t *testing.T) {
+	author := Author{Links: "Mastodon https://example.social/@jane\n\nhttps://www.github.com/jane\nnot a link\nMy site https://jane.dev"}
+
+	links := author.LinkList()
+
+	want := []AuthorLink{
+		{Label: "Mastodon", URL: "https://example.social/@jane"},
+		{Label: "github.com", URL: "https://www.github.com/jane"},
+		{Label: "My site", URL: "https://jane.dev"},
+	}
+	if len(links) != len(want) {
+		t.Fatalf("LinkList() = %+v, want %+v", links, want)
+	}
+	for i := range want {
+		if links[i] != want[i] {
+			t.Errorf("LinkList()[%d] = %+v, want %+v", i, links[i], want[i])
+		}
+	}
+}
+
+func TestResolveAuthors(t *testing.T) {
+	userID := uuid.New()
+	jane := Author{ID: uuid.New(), Name: "Jane Doe", UserID: userID}
+	bob := Author{ID: uuid.New(), Name: "Bob", SlugField: "bobby"}
+
+	coauthored := Content{ID: uuid.New(), UserID: userID, Locale: "es"}
+	owned := Content{ID: uuid.New(), UserID: userID}
+	orphan := Content{ID: uuid.New(), UserID: uuid.New()}
+	contents := []Content{coauthored, owned, orphan}
+
+	links := []ContentAuthor{
+		{ContentID: coauthored.ID, AuthorID: jane.ID, Position: 2},
+		{ContentID: coauthored.ID, AuthorID: bob.ID, Position: 1},
+	}
+
+	ResolveAuthors(contents, []Author{jane, bob}, links, "en")
+
+	got := contents[0].Authors
+	if len(got) != 2 || got[0].ID != bob.ID || got[1].ID != jane.ID {
+		t.Fatalf("linked authors = %+v, want Bob, Jane", got)
+	}
+	if got[0].URL != "/es/authors/bobby/" {
+		t.Errorf("URL = %q, want /es/authors/bobby/", got[0].URL)
+	}
+	if len(contents[1].Authors) != 1 || contents[1].Authors[0].URL != "/authors/jane-doe/" {
+		t.Errorf("user author = %+v, want Jane", contents[1].Authors)
+	}
+	if contents[2].Authors != nil {
+		t.Errorf("orphan authors = %+v, want none", contents[2].Authors)
+	}
+}
+
+func TestBuildAuthorIndexes(t *testing.T) {
+	jane := Author{ID: uuid.New(), Name: "Jane"}
+	bob := Author{ID: uuid.New(), Name: "Bob"}
+	date := func(day int) *time.Time {
+		t := time.Date(2024, 3, day, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	contents := []Content{
+		{Heading: "Old", Kind: "article", PublishedAt: date(1), Authors: []Author{jane}},
+		{Heading: "New", Kind: "blog", PublishedAt: date(9), Authors: []Author{jane, bob}},
+		{Heading: "Draft", Kind: "article", Draft: true, Authors: []Author{bob}},
+		{Heading: "About", Kind: "page", Authors: []Author{bob}},
+	}
+
+	indexes := BuildAuthorIndexes(contents)
+
+	if len(indexes) != 2 {
+		t.Fatalf("got %d indexes, want 2", len(indexes))
+	}
+	if indexes[0].Path != "/authors/bob/" || len(indexes[0].Content) != 1 {
+		t.Errorf("bob index = %s with %d items", indexes[0].Path, len(indexes[0].Content))
+	}
+	janeIndex := indexes[1]
+	if janeIndex.Type != "author" || len(janeIndex.Content) != 2 || janeIndex.Content[0].Heading != "New" {
+		t.Errorf("jane index = %+v, want New then Old", janeIndex)
+	}
+	if a := IndexAuthor(janeIndex); a == nil || a.ID != jane.ID {
+		t.Errorf("IndexAuthor() = %v, want Jane", a)
+	}
+	if IndexAuthor(&Index{Path: "/tags/go/", Type: "tag"}) != nil {
+		t.Error("IndexAuthor() of a tag index should be nil")
+	}
+}
@@ -0,0 +1,325 @@
+package ssg
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+const (
+	// CommentPending is the status of comments waiting for moderation.
+	CommentPending = "pending"
+	// CommentApproved is the status of comments rendered into their page.
+	CommentApproved = "approved"
+	// CommentSpam is the status of comments flagged as spam. They are kept
+	// out of pages and of the moderation queue.
+	CommentSpam = "spam"
+)
+
+const (
+	// CommentHoneypotField is the comment form field people leave empty.
+	// Submissions that fill it in are dropped as spam.
+	CommentHoneypotField = "website"
+
+	commentNameMaxLen  = 100
+	commentEmailMaxLen = 254
+	commentBodyMaxLen  = 5000
+)
+
+// ErrInvalidComment is returned when a submitted comment is not valid.
+var ErrInvalidComment = errors.New("invalid comment")
+
+// ErrCommentsClosed is returned when a comment is submitted for content that
+// does not take comments.
+var ErrCommentsClosed = errors.New("comments are closed")
+
+// ErrCommentRateLimited is returned when a client submits too many comments
+// in a short time.
+var ErrCommentRateLimited = errors.New("too many comments")
+
+// Comment is a comment left by a reader on a content page. Comments are
+// moderated before being rendered into the page on the next build.
+type Comment struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Relationships
+	SiteID    uuid.UUID `json:"site_id" db:"site_id"`
+	ContentID uuid.UUID `json:"content_id" db:"content_id"`
+
+	// Comment specific fields
+	AuthorName  string `json:"author_name" db:"author_name"`
+	AuthorEmail string `json:"author_email" db:"author_email"`
+	Body        string `json:"body" db:"body"`
+	Status      string `json:"status" db:"status"`
+
+	// ContentHeading is the heading of the commented content, for the
+	// moderation queue.
+	ContentHeading string `json:"content_heading" db:"content_heading"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// NewComment creates a new pending Comment on the content.
+func NewComment(contentID uuid.UUID, authorName, authorEmail, body string) Comment {
+	c := Comment{
+		ContentID:   contentID,
+		AuthorName:  authorName,
+		AuthorEmail: authorEmail,
+		Body:        body,
+		Status:      CommentPending,
+	}
+
+	return c
+}
+
+// Type returns the type of the entity.
+func (c *Comment) Type() string {
+	return "comment"
+}
+
+// GetID returns the unique identifier of the entity.
+func (c *Comment) GetID() uuid.UUID {
+	return c.ID
+}
+
+// GenID delegates to the functional helper.
+func (c *Comment) GenID() {
+	hm.GenID(c)
+}
+
+// SetID sets the unique identifier of the entity.
+func (c *Comment) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if c.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		c.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (c *Comment) GetShortID() string {
+	return c.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (c *Comment) GenShortID() {
+	hm.GenShortID(c)
+}
+
+// SetShortID sets the short ID of the entity.
+func (c *Comment) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if c.ShortID == "" || shouldForce {
+		c.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (c *Comment) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(c, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (c *Comment) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(c, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (c *Comment) GetCreatedBy() uuid.UUID {
+	return c.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (c *Comment) GetUpdatedBy() uuid.UUID {
+	return c.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (c *Comment) GetCreatedAt() time.Time {
+	return c.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (c *Comment) GetUpdatedAt() time.Time {
+	return c.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (c *Comment) SetCreatedAt(createdAt time.Time) {
+	c.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (c *Comment) SetUpdatedAt(updatedAt time.Time) {
+	c.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (c *Comment) SetCreatedBy(createdBy uuid.UUID) {
+	c.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (c *Comment) SetUpdatedBy(updatedBy uuid.UUID) {
+	c.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the Comment is uninitialized.
+func (c *Comment) IsZero() bool {
+	return c.ID == uuid.Nil
+}
+
+// Slug returns the short ID of the comment.
+func (c *Comment) Slug() string {
+	return c.ShortID
+}
+
+func (c *Comment) Ref() string {
+	return c.ref
+}
+
+func (c *Comment) SetRef(ref string) {
+	c.ref = ref
+}
+
+// Normalize trims the values of the comment and lowercases its email.
+func (c *Comment) Normalize() {
+	c.AuthorName = strings.TrimSpace(c.AuthorName)
+	c.AuthorEmail = strings.ToLower(strings.TrimSpace(c.AuthorEmail))
+	c.Body = strings.TrimSpace(strings.ReplaceAll(c.Body, "\r\n", "\n"))
+}
+
+// Validate checks the values of a submitted comment. The email is optional.
+func (c *Comment) Validate() error {
+	if c.ContentID == uuid.Nil {
+		return fmt.Errorf("%w: content is required", ErrInvalidComment)
+	}
+	if c.AuthorName == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidComment)
+	}
+	if utf8.RuneCountInString(c.AuthorName) > commentNameMaxLen {
+		return fmt.Errorf("%w: name is longer than %d characters", ErrInvalidComment, commentNameMaxLen)
+	}
+	if c.AuthorEmail != "" {
+		if len(c.AuthorEmail) > commentEmailMaxLen {
+			return fmt.Errorf("%w: email is too long", ErrInvalidComment)
+		}
+		if addr, err := mail.ParseAddress(c.AuthorEmail); err != nil || addr.Address != c.AuthorEmail {
+			return fmt.Errorf("%w: email is not valid", ErrInvalidComment)
+		}
+	}
+	if c.Body == "" {
+		return fmt.Errorf("%w: comment is required", ErrInvalidComment)
+	}
+	if utf8.RuneCountInString(c.Body) > commentBodyMaxLen {
+		return fmt.Errorf("%w: comment is longer than %d characters", ErrInvalidComment, commentBodyMaxLen)
+	}
+	return nil
+}
+
+// IsApproved reports whether the comment is rendered into its page.
+func (c *Comment) IsApproved() bool {
+	return c.Status == CommentApproved
+}
+
+// Paragraphs returns the body of the comment split in paragraphs, for
+// templates to render as plain text.
+func (c Comment) Paragraphs() []string {
+	var paragraphs []string
+	for _, p := range strings.Split(c.Body, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return paragraphs
+}
+
+// ValidCommentStatus reports whether status is a status moderators can set.
+func ValidCommentStatus(status string) bool {
+	switch status {
+	case CommentPending, CommentApproved, CommentSpam:
+		return true
+	}
+	return false
+}
+
+// CommentsByContent returns the approved comments by content ID, oldest
+// first as listed.
+func CommentsByContent(comments []Comment) map[uuid.UUID][]Comment {
+	byContent := make(map[uuid.UUID][]Comment)
+	for _, c := range comments {
+		if c.IsApproved() {
+			byContent[c.ContentID] = append(byContent[c.ContentID], c)
+		}
+	}
+	return byContent
+}
+
+// CommentLimiter limits how many comments a client can submit in a window of
+// time. Hits are kept in memory, so limits reset when the server restarts.
+type CommentLimiter struct {
+	mu     sync.Mutex
+	limit  int
+	window time.Duration
+	hits   map[string][]time.Time
+	now    func() time.Time
+}
+
+// NewCommentLimiter creates a limiter allowing limit comments per window. A
+// limit of zero or less disables it.
+func NewCommentLimiter(limit int, window time.Duration) *CommentLimiter {
+	return &CommentLimiter{
+		limit:  limit,
+		window: window,
+		hits:   make(map[string][]time.Time),
+		now:    time.Now,
+	}
+}
+
+// Allow records a submission by key and reports whether it is within the
+// limit. Rejected submissions are not recorded.
+func (l *CommentLimiter) Allow(key string) bool {
+	if l.limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	since := now.Add(-l.window)
+	for k, hits := range l.hits {
+		l.hits[k] = recentHits(hits, since)
+		if len(l.hits[k]) == 0 {
+			delete(l.hits, k)
+		}
+	}
+
+	if len(l.hits[key]) >= l.limit {
+		return false
+	}
+	l.hits[key] = append(l.hits[key], now)
+	return true
+}
+
+func recentHits(hits []time.Time, since time.Time) []time.Time {
+	i := 0
+	for i < len(hits) && !hits[i].After(since) {
+		i++
+	}
+	return hits[i:]
+}
@@ -0,0 +1,249 @@
+package ssg
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"html/template"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/hm"
+)
+
+func TestCommentValidate(t *testing.T) {
+	contentID := uuid.New()
+	tests := []struct {
+		name    string
+		comment Comment
+		wantErr bool
+	}{
+		{
+			name:    "valid without email",
+			comment: NewComment(contentID, "Ana", "", "Nice post"),
+		},
+		{
+			name:    "valid with email",
+			comment: NewComment(contentID, "Ana", "ana@example.com", "Nice post"),
+		},
+		{
+			name:    "missing content",
+			comment: NewComment(uuid.Nil, "Ana", "", "Nice post"),
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			comment: NewComment(contentID, "  ", "", "Nice post"),
+			wantErr: true,
+		},
+		{
+			name:    "missing body",
+			comment: NewComment(contentID, "Ana", "", "\n"),
+			wantErr: true,
+		},
+		{
+			name:    "invalid email",
+			comment: NewComment(contentID, "Ana", "not an email", "Nice post"),
+			wantErr: true,
+		},
+		{
+			name:    "body too long",
+			comment: NewComment(contentID, "Ana", "", strings.Repeat("a", commentBodyMaxLen+1)),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.comment.Normalize()
+			err := tt.comment.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidComment) {
+				t.Errorf("Validate() error = %v, want ErrInvalidComment", err)
+			}
+		})
+	}
+}
+
+func TestCommentParagraphs(t *testing.T) {
+	c := Comment{Body: "First line\nsame paragraph\n\n\n\nSecond"}
+	got := c.Paragraphs()
+	if len(got) != 2 || got[0] != "First line\nsame paragraph" || got[1] != "Second" {
+		t.Errorf("Paragraphs() = %q", got)
+	}
+}
+
+func TestCommentsByContent(t *testing.T) {
+	a, b := uuid.New(), uuid.New()
+	comments := []Comment{
+		{ContentID: a, Status: CommentApproved, Body: "one"},
+		{ContentID: a, Status: CommentPending, Body: "pending"},
+		{ContentID: b, Status: CommentSpam, Body: "spam"},
+		{ContentID: a, Status: CommentApproved, Body: "two"},
+	}
+
+	got := CommentsByContent(comments)
+	if len(got[a]) != 2 || got[a][0].Body != "one" || got[a][1].Body != "two" {
+		t.Errorf("CommentsByContent()[a] = %+v, want the approved ones in order", got[a])
+	}
+	if len(got[b]) != 0 {
+		t.Errorf("CommentsByContent()[b] = %+v, want none", got[b])
+	}
+}
+
+func TestCommentLimiter(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	l := NewCommentLimiter(2, time.Minute)
+	l.now = func() time.Time { return now }
+
+	if !l.Allow("a") || !l.Allow("a") {
+		t.Fatal("Allow() rejected submissions within the limit")
+	}
+	if l.Allow("a") {
+		t.Error("Allow() accepted a submission over the limit")
+	}
+	if !l.Allow("b") {
+		t.Error("Allow() limited another client")
+	}
+
+	now = now.Add(time.Minute + time.Second)
+	if !l.Allow("a") {
+		t.Error("Allow() rejected a submission after the window")
+	}
+
+	if off := NewCommentLimiter(0, time.Minute); !off.Allow("a") || !off.Allow("a") {
+		t.Error("Allow() limited with the limiter disabled")
+	}
+}
+
+func TestEmbeddedThemeRendersComments(t *testing.T) {
+	f := &TemplateFuncs{}
+	tmpl, err := template.New("layout.html").Funcs(f.FuncMap()).ParseFS(os.DirFS("../../../assets/ssg"), ThemeLayoutFile, ThemePartialsPattern)
+	if err != nil {
+		t.Fatalf("ParseFS() error = %v", err)
+	}
+
+	data := PageData{
+		Locale: "en",
+		Content: PageContent{
+			CommentsOpen: true,
+			CommentURL:   "http://localhost:8081/api/v1/comments?content=1&site=blog",
+			Comments: []Comment{{
+				ShortID:     "abc123",
+				AuthorName:  "Ana",
+				AuthorEmail: "ana@example.com",
+				Body:        "Great <b>post</b>",
+				CreatedAt:   time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			}},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&buf, "comments", data); err != nil {
+		t.Fatalf("ExecuteTemplate() error = %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		`id="comment-abc123"`,
+		"Ana",
+		"Great &lt;b&gt;post&lt;/b&gt;",
+		"March 1, 2025",
+		`action="http://localhost:8081/api/v1/comments?content=1&amp;site=blog"`,
+		`name="` + CommentHoneypotField + `"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("comments output missing %q", want)
+		}
+	}
+	if strings.Contains(out, "ana@example.com") {
+		t.Error("comments output shows the email of the author")
+	}
+
+	buf.Reset()
+	data.Content.CommentURL = ""
+	if err := tmpl.ExecuteTemplate(&buf, "comments", data); err != nil {
+		t.Fatalf("ExecuteTemplate() error = %v", err)
+	}
+	if out := buf.String(); strings.Contains(out, "<form") || !strings.Contains(out, `id="comment-abc123"`) {
+		t.Errorf("comments output without URL = %q, want approved comments and no form", out)
+	}
+
+	buf.Reset()
+	data.Content.Comments = nil
+	if err := tmpl.ExecuteTemplate(&buf, "comments", data); err != nil {
+		t.Fatalf("ExecuteTemplate() error = %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "" {
+		t.Errorf("comments output = %q, want empty without comments nor URL", buf.String())
+	}
+
+	buf.Reset()
+	data.Content.CommentsOpen = false
+	if err := tmpl.ExecuteTemplate(&buf, "comments", data); err != nil {
+		t.Fatalf("ExecuteTemplate() error = %v", err)
+	}
+	if strings.TrimSpace(buf.String()) != "" {
+		t.Errorf("comments output = %q, want empty with comments closed", buf.String())
+	}
+}
+
+func TestServiceGenerateCommentPages(t *testing.T) {
+	repo := newMockServiceRepo()
+	svc := newTestService(repo)
+	ctx := NewContextWithSite("test-site", uuid.New())
+
+	pages, err := svc.GenerateCommentPages(ctx)
+	if err != nil || pages != 0 {
+		t.Errorf("GenerateCommentPages() = %d, %v, want 0 pages with nothing queued", pages, err)
+	}
+
+	contentID := uuid.New()
+	repo.rebuilds[contentID] = true
+	repo.getContentErr = errors.New("db error")
+	if _, err := svc.GenerateCommentPages(ctx); err == nil {
+		t.Fatal("GenerateCommentPages() error = nil, want generation error")
+	}
+	if !repo.rebuilds[contentID] {
+		t.Error("GenerateCommentPages() cleared the queue after failing")
+	}
+}
+
+// moderatingRepo queues a comment rebuild while the site is generated, as a
+// comment moderated during generation does.
+type moderatingRepo struct {
+	*mockServiceRepo
+	contentID uuid.UUID
+}
+
+func (r *moderatingRepo) GetAllContentWithMeta(ctx context.Context) ([]Content, error) {
+	r.rebuilds[r.contentID] = true
+	return r.mockServiceRepo.GetAllContentWithMeta(ctx)
+}
+
+func TestServiceGenerateCommentPagesKeepsRequeued(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	post := Content{ID: uuid.New(), ShortID: "abc123", Heading: "First post", Kind: "blog", SectionPath: "/", Body: "Hello.", PublishedAt: &published}
+	repo := &moderatingRepo{mockServiceRepo: newMockServiceRepo(), contentID: post.ID}
+	repo.contents[post.ID] = post
+	repo.rebuilds[post.ID] = true
+
+	cfg := hm.NewConfig()
+	cfg.Set(SSGKey.SitesBasePath, t.TempDir())
+	params := hm.XParams{Cfg: cfg}
+	svc := NewService(os.DirFS("../../.."), repo, nil, &mockPublisher{}, NewParamManager(repo, params), nil, params)
+	ctx := NewContextWithSite("test-site", uuid.New())
+
+	pages, err := svc.GenerateCommentPages(ctx)
+	if err != nil || pages != 1 {
+		t.Fatalf("GenerateCommentPages() = %d, %v, want 1 page", pages, err)
+	}
+	if !repo.rebuilds[post.ID] {
+		t.Error("GenerateCommentPages() cleared a rebuild queued during generation")
+	}
+}
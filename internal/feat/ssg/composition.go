@@ -0,0 +1,229 @@
+package ssg
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// Blocks an index page can be composed of.
+const (
+	CompositionHero     = "hero"
+	CompositionFeatured = "featured"
+	CompositionSections = "sections"
+	CompositionPinned   = "pinned"
+	CompositionLatest   = "latest"
+)
+
+const (
+	defaultCompositionFeaturedItems = 6
+	defaultCompositionSectionItems  = 3
+)
+
+// Composition defines how the home page, and optionally the section indexes,
+// are composed. An empty definition keeps the plain content list.
+type Composition struct {
+	Blocks         []string // Blocks in render order, see the Composition constants.
+	FeaturedItems  int      // Maximum items of the featured grid.
+	SectionItems   int      // Maximum items of each section row.
+	Pinned         []string // Slugs of the pinned pages, in order.
+	SectionIndexes bool     // Whether section indexes are composed too.
+}
+
+// ParseComposition builds a composition from its site params: blocks and
+// pinned are comma separated lists. Unknown and repeated blocks are ignored
+// and the latest content list is appended when not placed explicitly, so
+// that paginated indexes keep listing their content.
+func ParseComposition(blocks, pinned string, featuredItems, sectionItems int, sectionIndexes bool) Composition {
+	c := Composition{
+		FeaturedItems:  featuredItems,
+		SectionItems:   sectionItems,
+		Pinned:         splitList(pinned),
+		SectionIndexes: sectionIndexes,
+	}
+	if c.FeaturedItems <= 0 {
+		c.FeaturedItems = defaultCompositionFeaturedItems
+	}
+	if c.SectionItems <= 0 {
+		c.SectionItems = defaultCompositionSectionItems
+	}
+
+	seen := make(map[string]bool)
+	for _, b := range splitList(strings.ToLower(blocks)) {
+		switch b {
+		case CompositionHero, CompositionFeatured, CompositionSections, CompositionPinned, CompositionLatest:
+			if !seen[b] {
+				seen[b] = true
+				c.Blocks = append(c.Blocks, b)
+			}
+		}
+	}
+	if len(c.Blocks) > 0 && !seen[CompositionLatest] {
+		c.Blocks = append(c.Blocks, CompositionLatest)
+	}
+
+	return c
+}
+
+// Enabled reports whether the composition defines any block.
+func (c Composition) Enabled() bool {
+	return len(c.Blocks) > 0
+}
+
+// Applies reports whether index is composed: the root index and, when
+// enabled, the section indexes.
+func (c Composition) Applies(index *Index) bool {
+	if !c.Enabled() || index.Type != "section" {
+		return false
+	}
+	return index.BasePath() == "/" || c.SectionIndexes
+}
+
+func (c Composition) has(block string) bool {
+	for _, b := range c.Blocks {
+		if b == block {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexComposition holds the content of the blocks of a composed index page.
+type IndexComposition struct {
+	Blocks   []string
+	Hero     *Content
+	Featured []Content
+	Sections []SectionRow
+	Pinned   []Content
+}
+
+// SectionRow lists the latest content of a section and the sections nested
+// in it.
+type SectionRow struct {
+	Name    string
+	Path    string // Localized path of the section index.
+	Content []Content
+}
+
+// BuildComposition returns the blocks of index composed as c defines. The
+// hero is the newest featured item of the index and the featured grid the
+// following ones. Section rows are built for the top level sections on the
+// root index and for the child sections on a section index. Pinned pages are
+// looked up by slug in allContent, in the index locale. Drafts are left out.
+func BuildComposition(c Composition, index *Index, allContent []Content, sections []Section, mode, defaultLocale string) *IndexComposition {
+	comp := &IndexComposition{Blocks: c.Blocks}
+	locale := localeOrDefault(index.Locale, defaultLocale)
+
+	var featured []Content
+	for _, content := range index.Content {
+		if content.Featured && !content.Draft {
+			featured = append(featured, content)
+		}
+	}
+
+	if c.has(CompositionHero) && len(featured) > 0 {
+		comp.Hero = &featured[0]
+		featured = featured[1:]
+	}
+	if c.has(CompositionFeatured) {
+		comp.Featured = limit(featured, c.FeaturedItems)
+	}
+	if c.has(CompositionSections) && mode == "structured" {
+		comp.Sections = buildSectionRows(c, index, allContent, sections, locale, defaultLocale)
+	}
+	if c.has(CompositionPinned) {
+		comp.Pinned = findPinned(c.Pinned, allContent, locale, defaultLocale)
+	}
+
+	return comp
+}
+
+// buildSectionRows returns a row per section below index with its newest
+// listed content, skipping sections with none.
+func buildSectionRows(c Composition, index *Index, allContent []Content, sections []Section, locale, defaultLocale string) []SectionRow {
+	var parents []Section
+	if index.BasePath() == "/" {
+		parents = TopLevelSections(sections)
+	} else if section := index.Section(sections); section != nil {
+		parents = SectionChildren(section.ID, sections)
+	}
+
+	var rows []SectionRow
+	for _, parent := range parents {
+		subtree := map[uuid.UUID]bool{parent.ID: true}
+		for _, s := range SectionDescendants(parent.ID, sections) {
+			subtree[s.ID] = true
+		}
+
+		var content []Content
+		for _, item := range allContent {
+			if !subtree[item.SectionID] || item.Draft || !listedKind(item.Kind) {
+				continue
+			}
+			if localeOrDefault(item.Locale, defaultLocale) != locale {
+				continue
+			}
+			content = append(content, item)
+		}
+		if len(content) == 0 {
+			continue
+		}
+		sortNewestFirst(content)
+
+		rows = append(rows, SectionRow{
+			Name:    parent.Name,
+			Path:    LocalizePath(sectionIndexPath(parent.Path), locale, defaultLocale),
+			Content: limit(content, c.SectionItems),
+		})
+	}
+
+	return rows
+}
+
+// findPinned returns the content with the given slugs in locale, in the
+// order of slugs. Unknown slugs are skipped.
+func findPinned(slugs []string, allContent []Content, locale, defaultLocale string) []Content {
+	var pinned []Content
+	for _, slug := range slugs {
+		for _, item := range allContent {
+			if item.Draft || item.Slug() != slug {
+				continue
+			}
+			if localeOrDefault(item.Locale, defaultLocale) == locale {
+				pinned = append(pinned, item)
+				break
+			}
+		}
+	}
+	return pinned
+}
+
+// listedKind reports whether content of kind is listed in indexes.
+func listedKind(kind string) bool {
+	kind = strings.ToLower(kind)
+	return kind == "article" || kind == "blog" || kind == "series"
+}
+
+// sortNewestFirst orders content by publication date, newest first. Undated
+// content keeps its position relative to its neighbours.
+func sortNewestFirst(content []Content) {
+	sort.SliceStable(content, func(i, j int) bool {
+		if content[i].PublishedAt == nil || content[j].PublishedAt == nil {
+			return false
+		}
+		return content[i].PublishedAt.After(*content[j].PublishedAt)
+	})
+}
+
+// splitList splits a comma separated list, trimming its items and dropping
+// empty ones.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,147 @@
+package ssg_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestParseComposition(t *testing.T) {
+	tests := []struct {
+		name       string
+		blocks     string
+		wantBlocks []string
+	}{
+		{name: "empty", blocks: ""},
+		{name: "latest placed", blocks: "hero, latest ,featured", wantBlocks: []string{"hero", "latest", "featured"}},
+		{name: "latest appended", blocks: "Hero,featured", wantBlocks: []string{"hero", "featured", "latest"}},
+		{name: "unknown and repeated", blocks: "hero,carousel,hero,pinned", wantBlocks: []string{"hero", "pinned", "latest"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := ssg.ParseComposition(tt.blocks, "about, contact", 0, 0, false)
+			if !reflect.DeepEqual(c.Blocks, tt.wantBlocks) {
+				t.Errorf("Blocks = %v, want %v", c.Blocks, tt.wantBlocks)
+			}
+			if c.Enabled() != (len(tt.wantBlocks) > 0) {
+				t.Errorf("Enabled() = %v", c.Enabled())
+			}
+			if c.FeaturedItems != 6 || c.SectionItems != 3 {
+				t.Errorf("items = %d, %d, want defaults 6, 3", c.FeaturedItems, c.SectionItems)
+			}
+			if !reflect.DeepEqual(c.Pinned, []string{"about", "contact"}) {
+				t.Errorf("Pinned = %v", c.Pinned)
+			}
+		})
+	}
+}
+
+func TestCompositionApplies(t *testing.T) {
+	root := &ssg.Index{Path: "/", Type: "section"}
+	section := &ssg.Index{Path: "/guides", Type: "section"}
+	blog := &ssg.Index{Path: "/blog/", Type: "blog"}
+
+	c := ssg.ParseComposition("hero", "", 0, 0, false)
+	if !c.Applies(root) || c.Applies(section) || c.Applies(blog) {
+		t.Errorf("Applies() without section indexes = %v, %v, %v", c.Applies(root), c.Applies(section), c.Applies(blog))
+	}
+
+	c.SectionIndexes = true
+	if !c.Applies(section) || c.Applies(blog) {
+		t.Errorf("Applies() with section indexes = %v, %v", c.Applies(section), c.Applies(blog))
+	}
+
+	if ssg.ParseComposition("", "", 0, 0, true).Applies(root) {
+		t.Error("Applies() of an empty composition = true")
+	}
+}
+
+func TestBuildComposition(t *testing.T) {
+	root := ssg.Section{ID: uuid.New(), Name: "root", Path: "/"}
+	guides := ssg.Section{ID: uuid.New(), Name: "Guides", Path: "/guides", ParentID: root.ID}
+	golang := ssg.Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	news := ssg.Section{ID: uuid.New(), Name: "News", Path: "/news", ParentID: root.ID}
+	empty := ssg.Section{ID: uuid.New(), Name: "Empty", Path: "/empty", ParentID: root.ID}
+	sections := []ssg.Section{root, guides, golang, news, empty}
+
+	day := func(d int) *time.Time {
+		t := time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	item := func(heading string, section ssg.Section, published int, featured bool) ssg.Content {
+		return ssg.Content{
+			ID: uuid.New(), Kind: "Article", Heading: heading, SectionID: section.ID,
+			SectionPath: section.Path, PublishedAt: day(published), Featured: featured, Locale: "en",
+		}
+	}
+
+	content := []ssg.Content{
+		item("Go modules", golang, 5, true),
+		item("Release", news, 4, true),
+		item("Go basics", golang, 3, false),
+		item("Guides intro", guides, 2, true),
+		item("Old news", news, 1, false),
+		{ID: uuid.New(), Kind: "Page", Heading: "About", SlugField: "about", SectionID: root.ID, SectionPath: "/", Locale: "en"},
+		{ID: uuid.New(), Kind: "Page", Heading: "Acerca", SlugField: "about", SectionID: root.ID, SectionPath: "/", Locale: "es"},
+	}
+	draft := item("Draft", news, 6, true)
+	draft.Draft = true
+	content = append(content, draft)
+
+	headings := func(content []ssg.Content) []string {
+		var got []string
+		for _, c := range content {
+			got = append(got, c.Heading)
+		}
+		return got
+	}
+	index := func(path string) *ssg.Index {
+		for _, idx := range ssg.BuildIndexes(content, sections, "structured") {
+			if idx.Path == path {
+				return idx
+			}
+		}
+		t.Fatalf("index %s not found", path)
+		return nil
+	}
+
+	c := ssg.ParseComposition("hero,featured,sections,pinned", "about,missing", 1, 2, true)
+	comp := ssg.BuildComposition(c, index("/"), content, sections, "structured", "en")
+
+	if comp.Hero == nil || comp.Hero.Heading != "Go modules" {
+		t.Fatalf("Hero = %v, want Go modules", comp.Hero)
+	}
+	if got := headings(comp.Featured); !reflect.DeepEqual(got, []string{"Release"}) {
+		t.Errorf("Featured = %v, want [Release]", got)
+	}
+	if got := headings(comp.Pinned); !reflect.DeepEqual(got, []string{"About"}) {
+		t.Errorf("Pinned = %v, want [About]", got)
+	}
+
+	wantRows := []ssg.SectionRow{
+		{Name: "Guides", Path: "/guides/", Content: []ssg.Content{content[0], content[2]}},
+		{Name: "News", Path: "/news/", Content: []ssg.Content{content[1], content[4]}},
+	}
+	if !reflect.DeepEqual(comp.Sections, wantRows) {
+		t.Errorf("Sections = %+v, want %+v", comp.Sections, wantRows)
+	}
+
+	comp = ssg.BuildComposition(c, index("/guides"), content, sections, "structured", "en")
+	if len(comp.Sections) != 1 || comp.Sections[0].Name != "Go" {
+		t.Errorf("section index Sections = %+v, want a Go row", comp.Sections)
+	}
+
+	comp = ssg.BuildComposition(c, index("/"), content, sections, "blog", "en")
+	if comp.Sections != nil {
+		t.Errorf("blog mode Sections = %+v, want none", comp.Sections)
+	}
+
+	comp = ssg.BuildComposition(ssg.ParseComposition("featured", "", 0, 0, false), index("/"), content, sections, "structured", "en")
+	if comp.Hero != nil || len(comp.Featured) != 3 {
+		t.Errorf("without hero: Hero = %v, Featured = %v", comp.Hero, headings(comp.Featured))
+	}
+}
@@ -2,6 +2,8 @@ package ssg
 
 import (
 	"fmt"
+	"html/template"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,21 +21,60 @@ type Content struct {
 	SectionID   uuid.UUID  `json:"section_id" db:"section_id"`
 	Kind        string     `json:"kind" db:"kind"`
 	Heading     string     `json:"heading" db:"heading"`
+	SlugField   string     `json:"slug" db:"slug"`
 	Summary     string     `json:"summary" db:"summary"`
 	Body        string     `json:"body" db:"body"`
 	Draft       bool       `json:"draft" db:"draft"`
 	Featured    bool       `json:"featured" db:"featured"`
+	SeriesID    uuid.UUID  `json:"series_id" db:"series_id"`
 	Series      string     `json:"series,omitempty" db:"series"`
 	SeriesOrder int        `json:"series_order,omitempty" db:"series_order"`
 	PublishedAt *time.Time `json:"published_at" db:"published_at"`
 	Tags        []Tag      `json:"tags"`
+	Authors     []Author   `json:"authors"`
 	Meta        Meta       `json:"meta"`
 
+	// Fields holds the values of the custom fields of the content type of
+	// its kind by field name. See ContentType.
+	Fields map[string]string `json:"fields" db:"-"`
+	// FieldValues are the Fields typed by their schema and Layout is the
+	// default layout of the content type, both set at build time. See
+	// ResolveContentTypes.
+	FieldValues map[string]any `json:"-" db:"-"`
+	Layout      string         `json:"-" db:"-"`
+
+	// Locale is the language of the content, empty for the site default.
+	// TranslationGroup is shared by all the translations of a content.
+	Locale           string `json:"locale" db:"locale"`
+	TranslationGroup string `json:"translation_group" db:"translation_group"`
+
 	ThumbnailURL       string `json:"thumbnail_url,omitempty" db:"-"`
 	HeaderImageURL     string `json:"header_image_url,omitempty" db:"-"`
 	HeaderImageAlt     string `json:"header_image_alt,omitempty" db:"-"`
 	HeaderImageCaption string `json:"header_image_caption,omitempty" db:"-"`
 
+	HeaderImageColor       string `json:"header_image_color,omitempty" db:"-"`
+	HeaderImagePlaceholder string `json:"header_image_placeholder,omitempty" db:"-"`
+
+	SocialImageURL string `json:"social_image_url,omitempty" db:"-"`
+
+	// Permalink is the URL path resolved from the site permalink pattern
+	// at build time. Empty means the default layout for the site mode.
+	Permalink string `json:"permalink,omitempty" db:"-"`
+
+	// WordCount, ReadingTime in minutes and Excerpt are computed at build
+	// time from the body. See SetReadingStats.
+	WordCount   int    `json:"word_count,omitempty" db:"-"`
+	ReadingTime int    `json:"reading_time,omitempty" db:"-"`
+	Excerpt     string `json:"excerpt,omitempty" db:"-"`
+
+	// SeriesStatus is the translated status of the series an overview item
+	// represents. See BuildSeriesOverviews.
+	SeriesStatus string `json:"-" db:"-"`
+	// SeriesName is the name of the series the content is a part of. See
+	// ResolveSeries.
+	SeriesName string `json:"-" db:"-"`
+
 	SectionPath string `json:"section_path,omitempty" db:"section_path"`
 	SectionName string `json:"section_name,omitempty" db:"section_name"`
 
@@ -54,6 +95,15 @@ func NewContent(heading, body string) Content {
 	return c
 }
 
+// HeaderImagePlaceholderURL returns the inline placeholder of the header image
+// typed so templates accept it as an image source.
+func (c Content) HeaderImagePlaceholderURL() template.URL {
+	if !strings.HasPrefix(c.HeaderImagePlaceholder, "data:image/") {
+		return ""
+	}
+	return template.URL(c.HeaderImagePlaceholder)
+}
+
 // Type returns the type of the entity.
 func (c *Content) Type() string {
 	return "content"
@@ -150,8 +200,12 @@ func (c *Content) IsZero() bool {
 	return c.ID == uuid.Nil
 }
 
-// Slug returns the slug for the content.
+// Slug returns the slug for the content. A custom slug is kept stable
+// across heading changes, otherwise it is derived from heading and short ID.
 func (c *Content) Slug() string {
+	if c.SlugField != "" {
+		return c.SlugField
+	}
 	return hm.Normalize(c.Heading) + "-" + c.GetShortID()
 }
 
@@ -451,6 +451,7 @@ func TestContentSlug(t *testing.T) {
 		name    string
 		heading string
 		shortID string
+		slug    string
 		want    string
 	}{
 		{
@@ -471,13 +472,21 @@ func TestContentSlug(t *testing.T) {
 			shortID: "def456",
 			want:    "multiple---spaces-def456",
 		},
+		{
+			name:    "uses custom slug when set",
+			heading: "My First Post",
+			shortID: "abc123",
+			slug:    "first-post",
+			want:    "first-post",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			c := Content{
-				Heading: tt.heading,
-				ShortID: tt.shortID,
+				Heading:   tt.heading,
+				ShortID:   tt.shortID,
+				SlugField: tt.slug,
 			}
 			got := c.Slug()
 
@@ -0,0 +1,64 @@
+package ssg
+
+import (
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrAliasTaken is returned when an alias path already belongs to another
+// content or is the live URL of a content.
+var ErrAliasTaken = errors.New("alias path already in use")
+
+// ErrInvalidAliasPath is returned when an alias path resolves to the site root.
+var ErrInvalidAliasPath = errors.New("invalid alias path")
+
+// ContentAlias is a public path that redirects to a content. Previous paths
+// are recorded automatically when content moves, editors can add manual ones.
+type ContentAlias struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	SiteID    uuid.UUID `json:"site_id" db:"site_id"`
+	ContentID uuid.UUID `json:"content_id" db:"content_id"`
+	Path      string    `json:"path" db:"path"`
+	Manual    bool      `json:"manual" db:"manual"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// ContentMove is a content update stored at once with the aliases keeping
+// its previous path and the aliases dropped because it moved back to them.
+type ContentMove struct {
+	Content        *Content
+	Aliases        []*ContentAlias
+	ClearedAliases []uuid.UUID
+}
+
+// NewContentAlias creates a new ContentAlias with a normalized path.
+func NewContentAlias(siteID, contentID uuid.UUID, aliasPath string, manual bool) *ContentAlias {
+	return &ContentAlias{
+		ID:        uuid.New(),
+		SiteID:    siteID,
+		ContentID: contentID,
+		Path:      NormalizeAliasPath(aliasPath),
+		Manual:    manual,
+		CreatedAt: time.Now(),
+	}
+}
+
+// NormalizeAliasPath returns p as a clean root relative path with a trailing
+// slash, e.g. old/post becomes /old/post/. Empty and root paths return "".
+func NormalizeAliasPath(p string) string {
+	p = strings.TrimSpace(p)
+	if i := strings.IndexAny(p, "?#"); i >= 0 {
+		p = p[:i]
+	}
+	p = strings.TrimSuffix(p, "index.html")
+
+	p = path.Clean("/" + p)
+	if p == "/" {
+		return ""
+	}
+	return p + "/"
+}
@@ -0,0 +1,55 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNormalizeAliasPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "adds leading and trailing slash", path: "old/post", want: "/old/post/"},
+		{name: "keeps clean path", path: "/old/post/", want: "/old/post/"},
+		{name: "strips index file", path: "/old/post/index.html", want: "/old/post/"},
+		{name: "strips query and fragment", path: "/old/post/?ref=x#top", want: "/old/post/"},
+		{name: "cleans dot segments", path: "/a/../old//post", want: "/old/post/"},
+		{name: "trims spaces", path: "  /old/  ", want: "/old/"},
+		{name: "root is empty", path: "/", want: ""},
+		{name: "empty is empty", path: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeAliasPath(tt.path); got != tt.want {
+				t.Errorf("NormalizeAliasPath(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewContentAlias(t *testing.T) {
+	siteID := uuid.New()
+	contentID := uuid.New()
+
+	alias := NewContentAlias(siteID, contentID, "old/post", true)
+
+	if alias.ID == uuid.Nil {
+		t.Error("ID should be set")
+	}
+	if alias.SiteID != siteID || alias.ContentID != contentID {
+		t.Error("SiteID and ContentID should be set")
+	}
+	if alias.Path != "/old/post/" {
+		t.Errorf("Path = %q, want %q", alias.Path, "/old/post/")
+	}
+	if !alias.Manual {
+		t.Error("Manual should be true")
+	}
+	if alias.CreatedAt.IsZero() {
+		t.Error("CreatedAt should be set")
+	}
+}
@@ -0,0 +1,537 @@
+package ssg
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+// Types of the custom fields of a content type.
+const (
+	FieldTypeString    = "string"
+	FieldTypeText      = "text"
+	FieldTypeNumber    = "number"
+	FieldTypeDate      = "date"
+	FieldTypeBool      = "bool"
+	FieldTypeImage     = "image"
+	FieldTypeReference = "reference"
+	FieldTypeList      = "list"
+)
+
+// FieldTypes lists the custom field types in the order the admin offers them.
+var FieldTypes = []string{
+	FieldTypeString, FieldTypeText, FieldTypeNumber, FieldTypeDate,
+	FieldTypeBool, FieldTypeImage, FieldTypeReference, FieldTypeList,
+}
+
+// BuiltinKinds are the kinds of content available without a content type.
+// A content type named after one of them adds sections, layout and fields
+// to it.
+var BuiltinKinds = []string{"article", "blog", "series", "page"}
+
+// FieldDateLayout is the layout date field values are stored with.
+const FieldDateLayout = "2006-01-02"
+
+// ErrInvalidContentType is returned when a content type or its field
+// schema is not valid.
+var ErrInvalidContentType = errors.New("invalid content type")
+
+// ErrContentKindTaken is returned when a content type uses the name of
+// another content type of the site.
+var ErrContentKindTaken = errors.New("content kind already defined")
+
+// ErrUnknownContentKind is returned when content uses a kind that is neither
+// built in nor defined by a content type of the site.
+var ErrUnknownContentKind = errors.New("unknown content kind")
+
+// ErrSectionNotAllowed is returned when content is placed in a section its
+// content type does not allow.
+var ErrSectionNotAllowed = errors.New("section not allowed for content kind")
+
+// ErrInvalidContentField is returned when a custom field value does not
+// match the schema of the content type.
+var ErrInvalidContentField = errors.New("invalid content field")
+
+var fieldNameRe = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// ContentType describes a kind of content of a site: the sections it may be
+// placed in, its default layout and the custom fields it has on top of the
+// common content columns.
+type ContentType struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Site relationship
+	SiteID uuid.UUID `json:"site_id" db:"site_id"`
+
+	// ContentType specific fields
+	// Name is the kind of the content of this type, e.g. "recipe".
+	Name       string    `json:"name" db:"name"`
+	Label      string    `json:"label" db:"label"`
+	LayoutID   uuid.UUID `json:"layout_id" db:"layout_id"`
+	LayoutName string    `json:"layout_name" db:"layout_name"`
+	// SectionIDs are the sections content of the type may be placed in.
+	// Empty allows every section.
+	SectionIDs []uuid.UUID `json:"section_ids" db:"-"`
+	Fields     []FieldDef  `json:"fields" db:"-"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// FieldDef is a custom field of a content type. Position orders the fields
+// of a type, starting at 1.
+type FieldDef struct {
+	ContentTypeID uuid.UUID `json:"-" db:"content_type_id"`
+	Name          string    `json:"name" db:"name"`
+	Label         string    `json:"label" db:"label"`
+	Type          string    `json:"type" db:"type"`
+	Required      bool      `json:"required" db:"required"`
+	Position      int       `json:"position" db:"position"`
+}
+
+// ContentTypeSection allows content of a type in a section.
+type ContentTypeSection struct {
+	ContentTypeID uuid.UUID `json:"content_type_id" db:"content_type_id"`
+	SectionID     uuid.UUID `json:"section_id" db:"section_id"`
+}
+
+// ContentField is the stored value of a custom field of a content.
+type ContentField struct {
+	ContentID uuid.UUID `json:"content_id" db:"content_id"`
+	Name      string    `json:"name" db:"name"`
+	Value     string    `json:"value" db:"value"`
+}
+
+// NewContentType creates a new ContentType.
+func NewContentType(name, label string) ContentType {
+	ct := ContentType{
+		Name:  name,
+		Label: label,
+	}
+
+	return ct
+}
+
+// Type returns the type of the entity.
+func (ct *ContentType) Type() string {
+	return "content-type"
+}
+
+// GetID returns the unique identifier of the entity.
+func (ct *ContentType) GetID() uuid.UUID {
+	return ct.ID
+}
+
+// GenID delegates to the functional helper.
+func (ct *ContentType) GenID() {
+	hm.GenID(ct)
+}
+
+// SetID sets the unique identifier of the entity.
+func (ct *ContentType) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if ct.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		ct.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (ct *ContentType) GetShortID() string {
+	return ct.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (ct *ContentType) GenShortID() {
+	hm.GenShortID(ct)
+}
+
+// SetShortID sets the short ID of the entity.
+func (ct *ContentType) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if ct.ShortID == "" || shouldForce {
+		ct.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (ct *ContentType) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(ct, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (ct *ContentType) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(ct, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (ct *ContentType) GetCreatedBy() uuid.UUID {
+	return ct.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (ct *ContentType) GetUpdatedBy() uuid.UUID {
+	return ct.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (ct *ContentType) GetCreatedAt() time.Time {
+	return ct.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (ct *ContentType) GetUpdatedAt() time.Time {
+	return ct.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (ct *ContentType) SetCreatedAt(createdAt time.Time) {
+	ct.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (ct *ContentType) SetUpdatedAt(updatedAt time.Time) {
+	ct.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (ct *ContentType) SetCreatedBy(createdBy uuid.UUID) {
+	ct.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (ct *ContentType) SetUpdatedBy(updatedBy uuid.UUID) {
+	ct.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the ContentType is uninitialized.
+func (ct *ContentType) IsZero() bool {
+	return ct.ID == uuid.Nil
+}
+
+// Slug returns the kind of the content of the type.
+func (ct *ContentType) Slug() string {
+	return ct.Name
+}
+
+func (ct *ContentType) OptValue() string {
+	return ct.Name
+}
+
+func (ct *ContentType) OptLabel() string {
+	if ct.Label != "" {
+		return ct.Label
+	}
+	return humanize(ct.Name)
+}
+
+func (ct *ContentType) Ref() string {
+	return ct.ref
+}
+
+func (ct *ContentType) SetRef(ref string) {
+	ct.ref = ref
+}
+
+// Field returns the field name of the type.
+func (ct *ContentType) Field(name string) (FieldDef, bool) {
+	for _, f := range ct.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return FieldDef{}, false
+}
+
+// AllowsSection reports whether content of the type may be placed in the
+// section of id.
+func (ct *ContentType) AllowsSection(id uuid.UUID) bool {
+	if len(ct.SectionIDs) == 0 {
+		return true
+	}
+	for _, s := range ct.SectionIDs {
+		if s == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Normalize lowercases the name of the type and its fields, labels the
+// fields without one and numbers their positions in order.
+func (ct *ContentType) Normalize() {
+	ct.Name = NormalizeSlug(ct.Name)
+	for i := range ct.Fields {
+		f := &ct.Fields[i]
+		f.Name = strings.ToLower(strings.TrimSpace(f.Name))
+		f.Type = strings.ToLower(strings.TrimSpace(f.Type))
+		if f.Label == "" {
+			f.Label = humanize(f.Name)
+		}
+		f.Position = i + 1
+	}
+}
+
+// Validate checks the name of the type and its field schema.
+func (ct *ContentType) Validate() error {
+	if ct.Name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidContentType)
+	}
+
+	seen := make(map[string]bool, len(ct.Fields))
+	for _, f := range ct.Fields {
+		if !fieldNameRe.MatchString(f.Name) {
+			return fmt.Errorf("%w: field name %q must start with a letter and only contain lowercase letters, numbers and underscores", ErrInvalidContentType, f.Name)
+		}
+		if seen[f.Name] {
+			return fmt.Errorf("%w: field %q is defined twice", ErrInvalidContentType, f.Name)
+		}
+		seen[f.Name] = true
+		if !validFieldType(f.Type) {
+			return fmt.Errorf("%w: field %q has unknown type %q", ErrInvalidContentType, f.Name, f.Type)
+		}
+	}
+
+	return nil
+}
+
+// CheckFields ensures values match the field schema of the type: every
+// value belongs to a field and parses as its type, and required fields are
+// set.
+func (ct *ContentType) CheckFields(values map[string]string) error {
+	if err := ct.CheckFieldValues(values); err != nil {
+		return err
+	}
+
+	for _, f := range ct.Fields {
+		if f.Required && strings.TrimSpace(values[f.Name]) == "" {
+			return fmt.Errorf("%w: %s is required", ErrInvalidContentField, f.Name)
+		}
+	}
+
+	return nil
+}
+
+// CheckFieldValues ensures every value belongs to a field of the type and
+// parses as its type. Unlike CheckFields, required fields may be unset.
+func (ct *ContentType) CheckFieldValues(values map[string]string) error {
+	for name, value := range values {
+		f, ok := ct.Field(name)
+		if !ok {
+			return fmt.Errorf("%w: %s has no field %q", ErrInvalidContentField, ct.Name, name)
+		}
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		if _, err := ParseFieldValue(f, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validFieldType(t string) bool {
+	for _, ft := range FieldTypes {
+		if ft == t {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBuiltinKind reports whether kind is available without a content type.
+func IsBuiltinKind(kind string) bool {
+	for _, k := range BuiltinKinds {
+		if strings.EqualFold(k, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// FindContentType returns the content type of kind among types.
+func FindContentType(types []ContentType, kind string) (ContentType, bool) {
+	for _, ct := range types {
+		if strings.EqualFold(ct.Name, kind) {
+			return ct, true
+		}
+	}
+	return ContentType{}, false
+}
+
+// ParseFieldValue returns the stored value of field f typed for templates:
+// a float64 for numbers, a time.Time for dates, a bool, a uuid.UUID for
+// references, a []string of the non empty lines for lists and a string for
+// the rest.
+func ParseFieldValue(f FieldDef, value string) (any, error) {
+	invalid := func(err error) (any, error) {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalidContentField, f.Name, err)
+	}
+
+	switch f.Type {
+	case FieldTypeNumber:
+		n, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return invalid(fmt.Errorf("%q is not a number", value))
+		}
+		return n, nil
+
+	case FieldTypeDate:
+		t, err := time.Parse(FieldDateLayout, strings.TrimSpace(value))
+		if err != nil {
+			return invalid(fmt.Errorf("%q is not a date, use YYYY-MM-DD", value))
+		}
+		return t, nil
+
+	case FieldTypeBool:
+		b, err := strconv.ParseBool(strings.TrimSpace(value))
+		if err != nil {
+			return invalid(fmt.Errorf("%q is not true or false", value))
+		}
+		return b, nil
+
+	case FieldTypeReference:
+		id, err := uuid.Parse(strings.TrimSpace(value))
+		if err != nil {
+			return invalid(fmt.Errorf("%q is not a content ID", value))
+		}
+		return id, nil
+
+	case FieldTypeList:
+		items := []string{}
+		for _, line := range strings.Split(value, "\n") {
+			if item := strings.TrimSpace(line); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+
+	return value, nil
+}
+
+// FormatFieldValue returns the stored form of a typed field value, the
+// inverse of ParseFieldValue. It also accepts the values decoded from YAML
+// front matter.
+func FormatFieldValue(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case time.Time:
+		return t.Format(FieldDateLayout)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(t)
+	case bool:
+		return strconv.FormatBool(t)
+	case uuid.UUID:
+		return t.String()
+	case []string:
+		return strings.Join(t, "\n")
+	case []any:
+		items := make([]string, len(t))
+		for i, item := range t {
+			items[i] = FormatFieldValue(item)
+		}
+		return strings.Join(items, "\n")
+	case Content:
+		return t.ID.String()
+	}
+	return fmt.Sprint(v)
+}
+
+// ResolveContentTypes sets the custom fields of contents from the stored
+// values in fields. FieldValues holds them typed by the schema of the
+// content type of their kind, with references resolved to the referenced
+// content. Values without a field in the schema, or that no longer parse,
+// are kept as strings. Layout is set to the default layout of the type.
+func ResolveContentTypes(contents []Content, types []ContentType, fields []ContentField) {
+	byContent := make(map[uuid.UUID]map[string]string)
+	for _, f := range fields {
+		if byContent[f.ContentID] == nil {
+			byContent[f.ContentID] = make(map[string]string)
+		}
+		byContent[f.ContentID][f.Name] = f.Value
+	}
+
+	byID := make(map[uuid.UUID]int, len(contents))
+	for i, c := range contents {
+		byID[c.ID] = i
+	}
+
+	type reference struct {
+		content int
+		field   string
+	}
+	var references []reference
+	for i := range contents {
+		c := &contents[i]
+		c.Fields = byContent[c.ID]
+		ct, ok := FindContentType(types, c.Kind)
+		if ok {
+			c.Layout = ct.LayoutName
+		}
+		if len(c.Fields) == 0 {
+			continue
+		}
+
+		c.FieldValues = make(map[string]any, len(c.Fields))
+		for name, value := range c.Fields {
+			c.FieldValues[name] = value
+			f, ok := ct.Field(name)
+			if !ok || strings.TrimSpace(value) == "" {
+				continue
+			}
+			v, err := ParseFieldValue(f, value)
+			if err != nil {
+				continue
+			}
+			c.FieldValues[name] = v
+			if f.Type == FieldTypeReference {
+				references = append(references, reference{content: i, field: name})
+			}
+		}
+	}
+
+	// References are resolved last so the referenced content has its own
+	// fields set.
+	for _, ref := range references {
+		values := contents[ref.content].FieldValues
+		if j, ok := byID[values[ref.field].(uuid.UUID)]; ok {
+			values[ref.field] = contents[j]
+		}
+	}
+}
+
+// ContentFieldList returns values as stored content fields of contentID,
+// sorted by name. Empty values are left out.
+func ContentFieldList(contentID uuid.UUID, values map[string]string) []ContentField {
+	list := make([]ContentField, 0, len(values))
+	for name, value := range values {
+		if strings.TrimSpace(value) == "" {
+			continue
+		}
+		list = append(list, ContentField{ContentID: contentID, Name: name, Value: value})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list
+}
@@ -0,0 +1,216 @@
+package ssg
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestContentTypeValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		ct      ContentType
+		wantErr bool
+	}{
+		{
+			name: "valid schema",
+			ct: ContentType{Name: "Recipe", Fields: []FieldDef{
+				{Name: "Servings", Type: "NUMBER"},
+				{Name: "cooked_at", Type: FieldTypeDate},
+			}},
+		},
+		{
+			name:    "missing name",
+			ct:      ContentType{},
+			wantErr: true,
+		},
+		{
+			name:    "invalid field name",
+			ct:      ContentType{Name: "recipe", Fields: []FieldDef{{Name: "1st", Type: FieldTypeString}}},
+			wantErr: true,
+		},
+		{
+			name: "duplicated field",
+			ct: ContentType{Name: "recipe", Fields: []FieldDef{
+				{Name: "servings", Type: FieldTypeNumber},
+				{Name: "servings", Type: FieldTypeString},
+			}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown field type",
+			ct:      ContentType{Name: "recipe", Fields: []FieldDef{{Name: "servings", Type: "money"}}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.ct.Normalize()
+			err := tt.ct.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidContentType) {
+				t.Errorf("Validate() error = %v, want ErrInvalidContentType", err)
+			}
+		})
+	}
+}
+
+func TestContentTypeNormalize(t *testing.T) {
+	ct := ContentType{Name: "Book Review", Fields: []FieldDef{
+		{Name: "Rating", Type: "Number"},
+		{Name: "read_on", Type: "date", Label: "Read"},
+	}}
+
+	ct.Normalize()
+
+	if ct.Name != "book-review" {
+		t.Errorf("Name = %q, want %q", ct.Name, "book-review")
+	}
+	want := []FieldDef{
+		{Name: "rating", Label: "Rating", Type: FieldTypeNumber, Position: 1},
+		{Name: "read_on", Label: "Read", Type: FieldTypeDate, Position: 2},
+	}
+	for i := range want {
+		if ct.Fields[i] != want[i] {
+			t.Errorf("Fields[%d] = %+v, want %+v", i, ct.Fields[i], want[i])
+		}
+	}
+}
+
+func TestContentTypeCheckFields(t *testing.T) {
+	ct := ContentType{Name: "recipe", Fields: []FieldDef{
+		{Name: "servings", Type: FieldTypeNumber, Required: true},
+		{Name: "vegan", Type: FieldTypeBool},
+	}}
+
+	tests := []struct {
+		name    string
+		values  map[string]string
+		wantErr bool
+	}{
+		{name: "valid values", values: map[string]string{"servings": "4", "vegan": "true"}},
+		{name: "empty optional value", values: map[string]string{"servings": "4", "vegan": ""}},
+		{name: "missing required field", values: map[string]string{"vegan": "false"}, wantErr: true},
+		{name: "unknown field", values: map[string]string{"servings": "4", "spicy": "yes"}, wantErr: true},
+		{name: "value of wrong type", values: map[string]string{"servings": "four"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ct.CheckFields(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckFields() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidContentField) {
+				t.Errorf("CheckFields() error = %v, want ErrInvalidContentField", err)
+			}
+		})
+	}
+}
+
+func TestContentTypeAllowsSection(t *testing.T) {
+	allowed := uuid.New()
+
+	unrestricted := ContentType{}
+	if !unrestricted.AllowsSection(uuid.New()) {
+		t.Error("AllowsSection() = false for a type without sections, want true")
+	}
+
+	restricted := ContentType{SectionIDs: []uuid.UUID{allowed}}
+	if !restricted.AllowsSection(allowed) {
+		t.Error("AllowsSection() = false for an allowed section, want true")
+	}
+	if restricted.AllowsSection(uuid.New()) {
+		t.Error("AllowsSection() = true for another section, want false")
+	}
+}
+
+func TestParseFieldValue(t *testing.T) {
+	ref := uuid.New()
+
+	tests := []struct {
+		name  string
+		field FieldDef
+		value string
+		want  any
+	}{
+		{name: "string", field: FieldDef{Type: FieldTypeString}, value: "Pasta", want: "Pasta"},
+		{name: "number", field: FieldDef{Type: FieldTypeNumber}, value: "2.5", want: 2.5},
+		{name: "date", field: FieldDef{Type: FieldTypeDate}, value: "2025-03-01", want: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "bool", field: FieldDef{Type: FieldTypeBool}, value: "true", want: true},
+		{name: "reference", field: FieldDef{Type: FieldTypeReference}, value: ref.String(), want: ref},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFieldValue(tt.field, tt.value)
+			if err != nil {
+				t.Fatalf("ParseFieldValue() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ParseFieldValue() = %v, want %v", got, tt.want)
+			}
+			if back := FormatFieldValue(got); back != tt.value {
+				t.Errorf("FormatFieldValue() = %q, want %q", back, tt.value)
+			}
+		})
+	}
+
+	list, err := ParseFieldValue(FieldDef{Type: FieldTypeList}, "flour\n\n water \nsalt")
+	if err != nil {
+		t.Fatalf("ParseFieldValue() error = %v", err)
+	}
+	items, ok := list.([]string)
+	if !ok || len(items) != 3 || items[1] != "water" {
+		t.Errorf("ParseFieldValue() = %v, want [flour water salt]", list)
+	}
+
+	if _, err := ParseFieldValue(FieldDef{Name: "cooked_at", Type: FieldTypeDate}, "March 1st"); !errors.Is(err, ErrInvalidContentField) {
+		t.Errorf("ParseFieldValue() error = %v, want ErrInvalidContentField", err)
+	}
+}
+
+func TestResolveContentTypes(t *testing.T) {
+	layoutName := "recipe-layout"
+	recipes := ContentType{Name: "recipe", LayoutName: layoutName, Fields: []FieldDef{
+		{Name: "servings", Type: FieldTypeNumber},
+		{Name: "related", Type: FieldTypeReference},
+	}}
+
+	related := Content{ID: uuid.New(), Kind: "article", Heading: "Bread"}
+	recipe := Content{ID: uuid.New(), Kind: "Recipe", Heading: "Pasta"}
+	contents := []Content{recipe, related}
+
+	fields := []ContentField{
+		{ContentID: recipe.ID, Name: "servings", Value: "4"},
+		{ContentID: recipe.ID, Name: "related", Value: related.ID.String()},
+		{ContentID: recipe.ID, Name: "removed", Value: "kept"},
+	}
+
+	ResolveContentTypes(contents, []ContentType{recipes}, fields)
+
+	got := contents[0]
+	if got.Layout != layoutName {
+		t.Errorf("Layout = %q, want %q", got.Layout, layoutName)
+	}
+	if got.Fields["servings"] != "4" {
+		t.Errorf("Fields[servings] = %q, want %q", got.Fields["servings"], "4")
+	}
+	if got.FieldValues["servings"] != 4.0 {
+		t.Errorf("FieldValues[servings] = %v, want 4", got.FieldValues["servings"])
+	}
+	if ref, ok := got.FieldValues["related"].(Content); !ok || ref.Heading != "Bread" {
+		t.Errorf("FieldValues[related] = %v, want the referenced content", got.FieldValues["related"])
+	}
+	if got.FieldValues["removed"] != "kept" {
+		t.Errorf("FieldValues[removed] = %v, want %q", got.FieldValues["removed"], "kept")
+	}
+	if contents[1].Layout != "" || contents[1].FieldValues != nil {
+		t.Errorf("content without type = %+v, want no layout nor fields", contents[1])
+	}
+}
@@ -0,0 +1,403 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v2"
+)
+
+// Formats of site data files, by extension.
+const (
+	DataFormatYAML = "yaml"
+	DataFormatJSON = "json"
+	DataFormatTOML = "toml"
+	DataFormatCSV  = "csv"
+)
+
+// ErrInvalidDataFile is returned when a data file cannot be parsed or has an
+// invalid name.
+var ErrInvalidDataFile = errors.New("invalid data file")
+
+// ErrDataFileNotFound is returned when a data file does not exist.
+var ErrDataFileNotFound = errors.New("data file not found")
+
+// ErrDataFileExists is returned when creating a data file that exists.
+var ErrDataFileExists = errors.New("data file already exists")
+
+var (
+	dataFileNameRe = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]*\.(yaml|yml|json|toml|csv)$`)
+	yamlLineRe     = regexp.MustCompile(`line (\d+):\s*`)
+)
+
+// DataFile is a file of the site data directory. Its content is available to
+// templates as .Site.Data.<key>, the key being the file name without its
+// extension.
+type DataFile struct {
+	Name      string    `json:"name"`
+	Format    string    `json:"format"`
+	Content   string    `json:"content,omitempty"`
+	Size      int64     `json:"size"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Key returns the name the file data is exposed with in templates.
+func (f DataFile) Key() string {
+	return DataFileKey(f.Name)
+}
+
+// DataFileError reports a data file that cannot be parsed and where. Line is
+// 0 when the problem is not tied to a line.
+type DataFileError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *DataFileError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s: line %d: %v", e.File, e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", e.File, e.Err)
+}
+
+func (e *DataFileError) Unwrap() error {
+	return e.Err
+}
+
+// Is makes every DataFileError match ErrInvalidDataFile.
+func (e *DataFileError) Is(target error) bool {
+	return target == ErrInvalidDataFile
+}
+
+// ValidDataFileName reports whether name is a data file name: letters,
+// numbers, hyphens and underscores with a supported extension.
+func ValidDataFileName(name string) bool {
+	return dataFileNameRe.MatchString(name)
+}
+
+// DataFileFormat returns the format of the data file name from its
+// extension, empty when unsupported.
+func DataFileFormat(name string) string {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".yaml", ".yml":
+		return DataFormatYAML
+	case ".json":
+		return DataFormatJSON
+	case ".toml":
+		return DataFormatTOML
+	case ".csv":
+		return DataFormatCSV
+	}
+	return ""
+}
+
+// DataFileKey returns the name the data of file name is exposed with.
+func DataFileKey(name string) string {
+	return strings.TrimSuffix(name, filepath.Ext(name))
+}
+
+// ParseDataFile parses the content of the data file name. YAML, JSON and
+// TOML files give maps and lists; CSV files give a list of rows keyed by the
+// header in their first line. Errors are *DataFileError.
+func ParseDataFile(name string, data []byte) (any, error) {
+	fail := func(line int, err error) (any, error) {
+		return nil, &DataFileError{File: name, Line: line, Err: err}
+	}
+
+	switch DataFileFormat(name) {
+	case DataFormatYAML:
+		var v any
+		if err := yaml.Unmarshal(data, &v); err != nil {
+			line, msg := 0, strings.TrimPrefix(err.Error(), "yaml: ")
+			if m := yamlLineRe.FindStringSubmatchIndex(msg); m != nil {
+				line, _ = strconv.Atoi(msg[m[2]:m[3]])
+				msg = strings.TrimSpace(msg[:m[0]] + msg[m[1]:])
+			}
+			return fail(line, errors.New(strings.TrimSuffix(msg, ":")))
+		}
+		return normalizeYAML(v), nil
+
+	case DataFormatJSON:
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			var syntaxErr *json.SyntaxError
+			var typeErr *json.UnmarshalTypeError
+			switch {
+			case errors.As(err, &syntaxErr):
+				return fail(lineAt(data, syntaxErr.Offset), err)
+			case errors.As(err, &typeErr):
+				return fail(lineAt(data, typeErr.Offset), err)
+			}
+			return fail(0, err)
+		}
+		return v, nil
+
+	case DataFormatTOML:
+		v := make(map[string]any)
+		if _, err := toml.Decode(string(data), &v); err != nil {
+			var parseErr toml.ParseError
+			if errors.As(err, &parseErr) {
+				return fail(parseErr.Position.Line, errors.New(parseErr.Message))
+			}
+			return fail(0, err)
+		}
+		return normalizeTOML(v), nil
+
+	case DataFormatCSV:
+		return parseCSV(name, data)
+	}
+
+	return fail(0, fmt.Errorf("unsupported format %q", filepath.Ext(name)))
+}
+
+// parseCSV returns the rows of a CSV file keyed by its header.
+func parseCSV(name string, data []byte) (any, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	records, err := r.ReadAll()
+	if err != nil {
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			return nil, &DataFileError{File: name, Line: parseErr.Line, Err: parseErr.Err}
+		}
+		return nil, &DataFileError{File: name, Err: err}
+	}
+
+	rows := []any{}
+	if len(records) == 0 {
+		return rows, nil
+	}
+
+	header := records[0]
+	seen := make(map[string]bool, len(header))
+	for _, h := range header {
+		if h == "" || seen[h] {
+			return nil, &DataFileError{File: name, Line: 1, Err: fmt.Errorf("header %q must be unique and not empty", h)}
+		}
+		seen[h] = true
+	}
+
+	for _, record := range records[1:] {
+		row := make(map[string]any, len(header))
+		for i, h := range header {
+			row[h] = record[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// normalizeYAML converts the maps decoded by yaml into maps keyed by string,
+// as the ones decoded from JSON.
+func normalizeYAML(v any) any {
+	switch t := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[fmt.Sprint(k)] = normalizeYAML(val)
+		}
+		return m
+	case []any:
+		for i := range t {
+			t[i] = normalizeYAML(t[i])
+		}
+		return t
+	}
+	return v
+}
+
+// normalizeTOML turns the dates and times decoded from TOML into strings and
+// arrays of tables into lists, as they are given by the other formats. Local
+// dates and times keep no offset.
+func normalizeTOML(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, val := range t {
+			t[k] = normalizeTOML(val)
+		}
+	case []any:
+		for i := range t {
+			t[i] = normalizeTOML(t[i])
+		}
+	case []map[string]any:
+		list := make([]any, len(t))
+		for i := range t {
+			list[i] = normalizeTOML(t[i])
+		}
+		return list
+	case time.Time:
+		// The decoder marks local values with these locations
+		switch t.Location().String() {
+		case "date-local":
+			return t.Format(time.DateOnly)
+		case "time-local":
+			return t.Format("15:04:05.999999999")
+		case "datetime-local":
+			return t.Format("2006-01-02T15:04:05.999999999")
+		}
+		return t.Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+// lineAt returns the line of data at offset, starting at 1.
+func lineAt(data []byte, offset int64) int {
+	if offset > int64(len(data)) {
+		offset = int64(len(data))
+	}
+	return bytes.Count(data[:offset], []byte("\n")) + 1
+}
+
+// LoadSiteData loads the data files in dir by key. A missing directory gives
+// no data. Files with other extensions and directories are ignored. Every
+// invalid file is reported in the returned error.
+func LoadSiteData(dir string) (map[string]any, error) {
+	data := make(map[string]any)
+
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return data, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read data directory: %w", err)
+	}
+
+	var errs []error
+	files := make(map[string]string)
+	for _, e := range entries {
+		if e.IsDir() || DataFileFormat(e.Name()) == "" || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+
+		key := DataFileKey(e.Name())
+		if prev, ok := files[key]; ok {
+			errs = append(errs, &DataFileError{File: e.Name(), Err: fmt.Errorf("key %q is already defined by %s", key, prev)})
+			continue
+		}
+		files[key] = e.Name()
+
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			errs = append(errs, &DataFileError{File: e.Name(), Err: err})
+			continue
+		}
+		v, err := ParseDataFile(e.Name(), content)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		data[key] = v
+	}
+
+	return data, errors.Join(errs...)
+}
+
+// ListDataFiles returns the data files in dir sorted by name, without their
+// content.
+func ListDataFiles(dir string) ([]DataFile, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return []DataFile{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read data directory: %w", err)
+	}
+
+	files := []DataFile{}
+	for _, e := range entries {
+		if e.IsDir() || !ValidDataFileName(e.Name()) {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil {
+			return nil, fmt.Errorf("cannot stat data file: %w", err)
+		}
+		files = append(files, DataFile{
+			Name:      e.Name(),
+			Format:    DataFileFormat(e.Name()),
+			Size:      info.Size(),
+			UpdatedAt: info.ModTime(),
+		})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	return files, nil
+}
+
+// ReadDataFile returns the data file name in dir with its content.
+func ReadDataFile(dir, name string) (DataFile, error) {
+	if !ValidDataFileName(name) {
+		return DataFile{}, fmt.Errorf("%w: %s", ErrDataFileNotFound, name)
+	}
+
+	p := filepath.Join(dir, name)
+	info, err := os.Stat(p)
+	if err != nil {
+		return DataFile{}, fmt.Errorf("%w: %s", ErrDataFileNotFound, name)
+	}
+	content, err := os.ReadFile(p)
+	if err != nil {
+		return DataFile{}, fmt.Errorf("cannot read data file: %w", err)
+	}
+
+	return DataFile{
+		Name:      name,
+		Format:    DataFileFormat(name),
+		Content:   string(content),
+		Size:      info.Size(),
+		UpdatedAt: info.ModTime(),
+	}, nil
+}
+
+// WriteDataFile validates file and writes it to dir. It fails when another
+// file already defines its key with a different extension.
+func WriteDataFile(dir string, file DataFile) error {
+	if !ValidDataFileName(file.Name) {
+		return &DataFileError{File: file.Name, Err: errors.New("name must only contain letters, numbers, hyphens and underscores, with a .yaml, .yml, .json, .toml or .csv extension")}
+	}
+	if _, err := ParseDataFile(file.Name, []byte(file.Content)); err != nil {
+		return err
+	}
+
+	files, err := ListDataFiles(dir)
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if f.Key() == file.Key() && f.Name != file.Name {
+			return &DataFileError{File: file.Name, Err: fmt.Errorf("key %q is already defined by %s", file.Key(), f.Name)}
+		}
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("cannot create data directory: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, file.Name), []byte(file.Content), 0644); err != nil {
+		return fmt.Errorf("cannot write data file: %w", err)
+	}
+	return nil
+}
+
+// DeleteDataFile removes the data file name from dir.
+func DeleteDataFile(dir, name string) error {
+	if !ValidDataFileName(name) {
+		return fmt.Errorf("%w: %s", ErrDataFileNotFound, name)
+	}
+	err := os.Remove(filepath.Join(dir, name))
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w: %s", ErrDataFileNotFound, name)
+	}
+	return err
+}
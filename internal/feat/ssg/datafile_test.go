@@ -0,0 +1,184 @@
+package ssg
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseDataFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    any
+	}{
+		{
+			name:    "yaml",
+			file:    "team.yaml",
+			content: "members:\n  - name: Ada\n    role: lead\n",
+			want:    map[string]any{"members": []any{map[string]any{"name": "Ada", "role": "lead"}}},
+		},
+		{
+			name:    "json",
+			file:    "links.json",
+			content: `[{"title": "Docs", "url": "/docs/"}]`,
+			want:    []any{map[string]any{"title": "Docs", "url": "/docs/"}},
+		},
+		{
+			name:    "toml",
+			file:    "site.toml",
+			content: "title = \"Clio\"\n[social]\nmastodon = \"@clio\"\n",
+			want:    map[string]any{"title": "Clio", "social": map[string]any{"mastodon": "@clio"}},
+		},
+		{
+			name:    "toml dates and arrays of tables",
+			file:    "events.toml",
+			content: "updated = 2024-03-01T10:00:00Z\n[[events]]\nname = \"Launch\"\nday = 2024-03-01\nat = 09:30:00\nseats = 40\n",
+			want: map[string]any{
+				"updated": "2024-03-01T10:00:00Z",
+				"events":  []any{map[string]any{"name": "Launch", "day": "2024-03-01", "at": "09:30:00", "seats": int64(40)}},
+			},
+		},
+		{
+			name:    "csv",
+			file:    "prices.csv",
+			content: "plan,price\nbasic,5\npro,12\n",
+			want:    []any{map[string]any{"plan": "basic", "price": "5"}, map[string]any{"plan": "pro", "price": "12"}},
+		},
+		{
+			name:    "empty csv",
+			file:    "empty.csv",
+			content: "",
+			want:    []any{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDataFile(tt.file, []byte(tt.content))
+			if err != nil {
+				t.Fatalf("ParseDataFile() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseDataFile() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDataFileErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		wantLine int
+	}{
+		{name: "yaml", file: "team.yaml", content: "title: Clio\nname: Ada\n\tbad: x\n", wantLine: 3},
+		{name: "json", file: "links.json", content: "[\n  {\"title\": \"Docs\"},\n  {\"title\" \"Blog\"}\n]", wantLine: 3},
+		{name: "toml", file: "site.toml", content: "title = \"Clio\"\ntitle = \"Again\"\n", wantLine: 2},
+		{name: "csv", file: "prices.csv", content: "plan,price\nbasic,5\npro\n", wantLine: 3},
+		{name: "csv header", file: "prices.csv", content: "plan,plan\nbasic,5\n", wantLine: 1},
+		{name: "unsupported", file: "notes.txt", content: "hello", wantLine: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseDataFile(tt.file, []byte(tt.content))
+			if !errors.Is(err, ErrInvalidDataFile) {
+				t.Fatalf("ParseDataFile() error = %v, want ErrInvalidDataFile", err)
+			}
+			var dataErr *DataFileError
+			if !errors.As(err, &dataErr) {
+				t.Fatalf("ParseDataFile() error = %T, want *DataFileError", err)
+			}
+			if dataErr.File != tt.file || dataErr.Line != tt.wantLine {
+				t.Errorf("ParseDataFile() error at %s:%d, want %s:%d (%v)", dataErr.File, dataErr.Line, tt.file, tt.wantLine, err)
+			}
+		})
+	}
+}
+
+func TestLoadSiteData(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"team.yaml":    "- name: Ada\n",
+		"links.json":   `{"docs": "/docs/"}`,
+		"notes.txt":    "ignored",
+		".hidden.json": "{",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	data, err := LoadSiteData(dir)
+	if err != nil {
+		t.Fatalf("LoadSiteData() error = %v", err)
+	}
+	if len(data) != 2 || data["team"] == nil || data["links"] == nil {
+		t.Errorf("LoadSiteData() = %v", data)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "team.json"), []byte(`[]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "broken.toml"), []byte("a = \n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err = LoadSiteData(dir)
+	if err == nil {
+		t.Fatal("LoadSiteData() with invalid files should fail")
+	}
+	for _, want := range []string{`team.yaml: key "team" is already defined by team.json`, "broken.toml: line 1:"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("LoadSiteData() error = %v, want it to contain %q", err, want)
+		}
+	}
+
+	data, err = LoadSiteData(filepath.Join(dir, "missing"))
+	if err != nil || len(data) != 0 {
+		t.Errorf("LoadSiteData() of a missing directory = %v, %v", data, err)
+	}
+}
+
+func TestDataFileLifecycle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "data")
+
+	if err := WriteDataFile(dir, DataFile{Name: "team.yaml", Content: "- name: Ada\n"}); err != nil {
+		t.Fatalf("WriteDataFile() error = %v", err)
+	}
+	if err := WriteDataFile(dir, DataFile{Name: "team.json", Content: "[]"}); !errors.Is(err, ErrInvalidDataFile) {
+		t.Errorf("WriteDataFile() with a clashing key error = %v", err)
+	}
+	if err := WriteDataFile(dir, DataFile{Name: "../team.yaml", Content: ""}); !errors.Is(err, ErrInvalidDataFile) {
+		t.Errorf("WriteDataFile() with an invalid name error = %v", err)
+	}
+	if err := WriteDataFile(dir, DataFile{Name: "links.json", Content: "{"}); !errors.Is(err, ErrInvalidDataFile) {
+		t.Errorf("WriteDataFile() with invalid content error = %v", err)
+	}
+
+	files, err := ListDataFiles(dir)
+	if err != nil || len(files) != 1 || files[0].Name != "team.yaml" || files[0].Format != DataFormatYAML {
+		t.Fatalf("ListDataFiles() = %v, %v", files, err)
+	}
+
+	file, err := ReadDataFile(dir, "team.yaml")
+	if err != nil || file.Content != "- name: Ada\n" || file.Key() != "team" {
+		t.Errorf("ReadDataFile() = %+v, %v", file, err)
+	}
+	if _, err := ReadDataFile(dir, "missing.yaml"); !errors.Is(err, ErrDataFileNotFound) {
+		t.Errorf("ReadDataFile() of a missing file error = %v", err)
+	}
+
+	if err := DeleteDataFile(dir, "team.yaml"); err != nil {
+		t.Errorf("DeleteDataFile() error = %v", err)
+	}
+	if err := DeleteDataFile(dir, "team.yaml"); !errors.Is(err, ErrDataFileNotFound) {
+		t.Errorf("DeleteDataFile() of a missing file error = %v", err)
+	}
+}
@@ -0,0 +1,204 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// FeedFile is the name of the Atom feed written at the root of each
+	// locale of a site, e.g. /feed.xml and /es/feed.xml.
+	FeedFile = "feed.xml"
+	// FeedMediaType is the media type of the feeds.
+	FeedMediaType = "application/atom+xml"
+
+	atomNamespace = "http://www.w3.org/2005/Atom"
+	// clioNamespace qualifies the reading stats Atom has no element for.
+	clioNamespace = "https://github.com/hermesgen/clio/ns/feed"
+)
+
+// Feed is the Atom feed of the content of a locale, newest first.
+type Feed struct {
+	Locale  string
+	Path    string
+	Entries []Content
+}
+
+// FeedPath returns the path of the feed of locale.
+func FeedPath(locale, defaultLocale string) string {
+	return LocalizePath("/"+FeedFile, locale, defaultLocale)
+}
+
+// BuildLocaleFeeds returns a feed for the default locale and for every other
+// locale with dated content, the content the archive lists. Each feed keeps
+// the newest maxEntries entries, all of them when maxEntries is zero or less.
+// Content must have its locale resolved.
+func BuildLocaleFeeds(allContent []Content, mode, defaultLocale string, maxEntries int) []Feed {
+	byLocale := make(map[string][]Content)
+	for _, c := range allContent {
+		if archived(c, mode) {
+			locale := localeOrDefault(c.Locale, defaultLocale)
+			byLocale[locale] = append(byLocale[locale], c)
+		}
+	}
+
+	locales := []string{defaultLocale}
+	for locale := range byLocale {
+		if locale != defaultLocale {
+			locales = append(locales, locale)
+		}
+	}
+	sort.Strings(locales[1:])
+
+	feeds := make([]Feed, 0, len(locales))
+	for _, locale := range locales {
+		entries := byLocale[locale]
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].PublishedAt.After(*entries[j].PublishedAt)
+		})
+		if maxEntries > 0 && len(entries) > maxEntries {
+			entries = entries[:maxEntries]
+		}
+		feeds = append(feeds, Feed{
+			Locale:  locale,
+			Path:    FeedPath(locale, defaultLocale),
+			Entries: entries,
+		})
+	}
+
+	return feeds
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	NS      string      `xml:"xmlns,attr"`
+	ClioNS  string      `xml:"xmlns:clio,attr"`
+	Lang    string      `xml:"xml:lang,attr,omitempty"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Author  atomPerson  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomEntry struct {
+	ID          string       `xml:"id"`
+	Title       string       `xml:"title"`
+	Link        atomLink     `xml:"link"`
+	Published   string       `xml:"published"`
+	Updated     string       `xml:"updated"`
+	Authors     []atomPerson `xml:"author"`
+	Summary     string       `xml:"summary,omitempty"`
+	WordCount   int          `xml:"clio:wordCount,omitempty"`
+	ReadingTime int          `xml:"clio:readingTime,omitempty"`
+}
+
+// RenderFeed returns feed as an Atom document. Links are resolved against
+// the site base URL, which must be set as feeds need absolute URLs.
+// Entries are identified by content ID, so they keep their identity when
+// their permalink changes. Entries without authors fall back to the feed
+// author, the site. Word count and reading time, when computed, go in
+// clio:wordCount and clio:readingTime elements.
+func RenderFeed(feed Feed, site SiteInfo, mode, defaultLocale string) ([]byte, error) {
+	if site.BaseURL == "" {
+		return nil, fmt.Errorf("site base URL is required to render feeds")
+	}
+
+	home := site.URL(LocalizePath("/", feed.Locale, defaultLocale))
+	doc := atomFeed{
+		NS:     atomNamespace,
+		ClioNS: clioNamespace,
+		Lang:   feed.Locale,
+		ID:     site.URL(feed.Path),
+		Title:  site.Name,
+		Links: []atomLink{
+			{Rel: "self", Type: FeedMediaType, Href: site.URL(feed.Path)},
+			{Rel: "alternate", Type: "text/html", Href: home},
+		},
+		Author: atomPerson{Name: site.Name, URI: home},
+	}
+
+	var updated time.Time
+	for _, c := range feed.Entries {
+		entryUpdated := feedEntryUpdated(c)
+		if entryUpdated.After(updated) {
+			updated = entryUpdated
+		}
+
+		doc.Entries = append(doc.Entries, atomEntry{
+			ID:          "urn:uuid:" + c.ID.String(),
+			Title:       c.Heading,
+			Link:        atomLink{Rel: "alternate", Type: "text/html", Href: site.URL(GetContentPath(c, mode) + "/")},
+			Published:   c.PublishedAt.UTC().Format(time.RFC3339),
+			Updated:     entryUpdated.UTC().Format(time.RFC3339),
+			Authors:     feedAuthors(c.Authors, site),
+			Summary:     feedSummary(c.Summary, c.Meta.Excerpt, c.Meta.Summary, c.Excerpt),
+			WordCount:   c.WordCount,
+			ReadingTime: c.ReadingTime,
+		})
+	}
+	if updated.IsZero() {
+		updated = time.Now()
+	}
+	doc.Updated = updated.UTC().Format(time.RFC3339)
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("cannot render feed: %w", err)
+	}
+	buf.WriteString("\n")
+
+	return buf.Bytes(), nil
+}
+
+// feedSummary returns the first non empty candidate with whitespace
+// collapsed. Unlike page descriptions, summaries are not shortened.
+func feedSummary(candidates ...string) string {
+	for _, c := range candidates {
+		if c = strings.Join(strings.Fields(c), " "); c != "" {
+			return c
+		}
+	}
+	return ""
+}
+
+// feedAuthors returns the authors of an entry, linked to their author page.
+func feedAuthors(authors []Author, site SiteInfo) []atomPerson {
+	var people []atomPerson
+	for i := range authors {
+		authorURL := authors[i].URL
+		if authorURL == "" {
+			authorURL = authors[i].Path()
+		}
+		people = append(people, atomPerson{Name: authors[i].Name, URI: site.URL(authorURL)})
+	}
+	return people
+}
+
+// feedEntryUpdated returns when content last changed, its publication date
+// when it was not updated after being published.
+func feedEntryUpdated(c Content) time.Time {
+	if c.UpdatedAt.After(*c.PublishedAt) {
+		return c.UpdatedAt
+	}
+	return *c.PublishedAt
+}
@@ -0,0 +1,204 @@
+package ssg
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildLocaleFeeds(t *testing.T) {
+	contents := archiveTestContent()
+	es := time.Date(2024, time.June, 1, 10, 0, 0, 0, time.UTC)
+	contents = append(contents, Content{Heading: "Hola", Kind: "blog", SectionPath: "/", Locale: "es", PublishedAt: &es})
+	for i := range contents {
+		if contents[i].Locale == "" {
+			contents[i].Locale = "en"
+		}
+	}
+
+	tests := []struct {
+		name       string
+		mode       string
+		maxEntries int
+		want       map[string][]string
+	}{
+		{
+			name: "structured mode",
+			mode: "structured",
+			want: map[string][]string{
+				"/feed.xml":    {"Third", "Second", "First", "Old"},
+				"/es/feed.xml": {"Hola"},
+			},
+		},
+		{
+			name: "blog mode lists root blog posts only",
+			mode: "blog",
+			want: map[string][]string{
+				"/feed.xml":    {"Third", "First", "Old"},
+				"/es/feed.xml": {"Hola"},
+			},
+		},
+		{
+			name:       "keeps the newest entries",
+			mode:       "structured",
+			maxEntries: 2,
+			want: map[string][]string{
+				"/feed.xml":    {"Third", "Second"},
+				"/es/feed.xml": {"Hola"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			feeds := BuildLocaleFeeds(contents, tt.mode, "en", tt.maxEntries)
+
+			if len(feeds) != len(tt.want) {
+				t.Fatalf("got %d feeds, want %d", len(feeds), len(tt.want))
+			}
+			if feeds[0].Locale != "en" {
+				t.Errorf("first feed locale = %q, want the default one", feeds[0].Locale)
+			}
+			for _, feed := range feeds {
+				want, ok := tt.want[feed.Path]
+				if !ok {
+					t.Errorf("unexpected feed %s", feed.Path)
+					continue
+				}
+				var got []string
+				for _, c := range feed.Entries {
+					got = append(got, c.Heading)
+				}
+				if strings.Join(got, ",") != strings.Join(want, ",") {
+					t.Errorf("feed %s entries = %v, want %v", feed.Path, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderFeed(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	content := Content{
+		ID:          uuid.New(),
+		Heading:     "Hola & adiós",
+		Kind:        "blog",
+		SectionPath: "/",
+		Locale:      "es",
+		Summary:     "Un  resumen\ncorto",
+		PublishedAt: &published,
+		UpdatedAt:   published.Add(48 * time.Hour),
+		WordCount:   420,
+		ReadingTime: 2,
+		Authors: []Author{
+			{Name: "Ana", URL: "/es/authors/ana/"},
+			{Name: "Jane Doe"},
+		},
+	}
+	derived := Content{
+		ID:          uuid.New(),
+		Heading:     "Sin resumen",
+		Kind:        "blog",
+		SectionPath: "/",
+		Locale:      "es",
+		Excerpt:     "Primer párrafo del cuerpo.",
+		PublishedAt: &published,
+	}
+	feed := Feed{Locale: "es", Path: "/es/feed.xml", Entries: []Content{content, derived}}
+	site := SiteInfo{Name: "Site", BaseURL: "https://example.com"}
+
+	data, err := RenderFeed(feed, site, "structured", "en")
+	if err != nil {
+		t.Fatalf("RenderFeed() error = %v", err)
+	}
+
+	var got struct {
+		Lang    string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+		ID      string `xml:"id"`
+		Updated string `xml:"updated"`
+		Links   []struct {
+			Rel  string `xml:"rel,attr"`
+			Href string `xml:"href,attr"`
+		} `xml:"link"`
+		Entries []struct {
+			ID        string `xml:"id"`
+			Title     string `xml:"title"`
+			Published string `xml:"published"`
+			Summary   string `xml:"summary"`
+			Authors   []struct {
+				Name string `xml:"name"`
+				URI  string `xml:"uri"`
+			} `xml:"author"`
+			Link struct {
+				Href string `xml:"href,attr"`
+			} `xml:"link"`
+			WordCount   int `xml:"https://github.com/hermesgen/clio/ns/feed wordCount"`
+			ReadingTime int `xml:"https://github.com/hermesgen/clio/ns/feed readingTime"`
+		} `xml:"entry"`
+	}
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("feed is not valid XML: %v\n%s", err, data)
+	}
+
+	if got.Lang != "es" {
+		t.Errorf("xml:lang = %q, want es", got.Lang)
+	}
+	if got.ID != "https://example.com/es/feed.xml" {
+		t.Errorf("id = %q", got.ID)
+	}
+	if got.Updated != "2024-03-03T10:00:00Z" {
+		t.Errorf("updated = %q, want the last entry update", got.Updated)
+	}
+	if len(got.Links) != 2 || got.Links[0].Rel != "self" || got.Links[1].Href != "https://example.com/es/" {
+		t.Errorf("links = %+v", got.Links)
+	}
+	if len(got.Entries) != 2 {
+		t.Fatalf("got %d entries, want 2", len(got.Entries))
+	}
+	entry := got.Entries[0]
+	if entry.ID != "urn:uuid:"+content.ID.String() {
+		t.Errorf("entry id = %q", entry.ID)
+	}
+	if entry.Title != content.Heading {
+		t.Errorf("entry title = %q, want %q", entry.Title, content.Heading)
+	}
+	if entry.Link.Href != site.URL(GetContentPath(content, "structured")+"/") {
+		t.Errorf("entry link = %q", entry.Link.Href)
+	}
+	if entry.Published != "2024-03-01T10:00:00Z" {
+		t.Errorf("entry published = %q", entry.Published)
+	}
+	if entry.Summary != "Un resumen corto" {
+		t.Errorf("entry summary = %q", entry.Summary)
+	}
+	if entry.WordCount != 420 || entry.ReadingTime != 2 {
+		t.Errorf("entry reading stats = %d words, %d min, want 420 words, 2 min", entry.WordCount, entry.ReadingTime)
+	}
+	wantAuthors := []string{"Ana https://example.com/es/authors/ana/", "Jane Doe https://example.com/authors/jane-doe/"}
+	var gotAuthors []string
+	for _, a := range entry.Authors {
+		gotAuthors = append(gotAuthors, a.Name+" "+a.URI)
+	}
+	if strings.Join(gotAuthors, ",") != strings.Join(wantAuthors, ",") {
+		t.Errorf("entry authors = %v, want %v", gotAuthors, wantAuthors)
+	}
+	if len(got.Entries[1].Authors) != 0 {
+		t.Errorf("entry without authors = %+v, want the feed author to apply", got.Entries[1].Authors)
+	}
+	if got.Entries[1].Summary != derived.Excerpt {
+		t.Errorf("entry without summary = %q, want the derived excerpt", got.Entries[1].Summary)
+	}
+	if !strings.Contains(string(data), "<clio:wordCount>420</clio:wordCount>") {
+		t.Errorf("feed does not prefix reading stats:\n%s", data)
+	}
+	if strings.Contains(string(data), "<clio:wordCount>0") {
+		t.Errorf("feed renders empty reading stats:\n%s", data)
+	}
+
+	if _, err := RenderFeed(feed, SiteInfo{Name: "Site"}, "structured", "en"); err == nil {
+		t.Error("RenderFeed() without base URL should fail")
+	}
+}
@@ -0,0 +1,431 @@
+package ssg
+
+import (
+	"fmt"
+	"html"
+	"html/template"
+	"net/url"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// SiteData is the site as seen by templates, available to every page as
+// .Site.
+type SiteData struct {
+	Name     string
+	Mode     string
+	BaseURL  string
+	Locale   string            // Default locale of the site
+	Params   map[string]string // Site params by ref key
+	Sections []Section
+	Tags     []Tag
+	// Data holds the content of the site data files by file name without
+	// extension. See LoadSiteData.
+	Data map[string]any
+}
+
+// TemplateFuncs holds the data the functions of the generated site templates
+// read. See FuncMap for the functions.
+type TemplateFuncs struct {
+	Site     SiteData
+	Contents []Content
+	// Variants holds the variants of the site images by image file path and
+	// variant kind.
+	Variants map[string]map[string]ImageVariant
+	// Param looks a site param up by ref key, returning def when unset.
+	Param func(key, def string) string
+	// Markdown selects the syntax markdownify renders, the one of the site.
+	Markdown MarkdownOptions
+
+	markdown *Processor // Built on first use of markdownify
+}
+
+// FuncMap returns the functions available to layouts, partials and
+// shortcodes:
+//
+//	date LAYOUT LOCALE TIME          Formats TIME, a time.Time or *time.Time,
+//	                                 with month and day names in LOCALE.
+//	translate LOCALE KEY [ARGS]      UI string KEY in LOCALE, formatted with
+//	                                 ARGS if any.
+//	absURL PATH                      PATH as an absolute URL of the site.
+//	relURL PATH                      PATH prefixed with the base path of the site.
+//	truncate N TEXT                  TEXT cut to N characters at a word boundary.
+//	plainify HTML                    HTML without tags.
+//	markdownify TEXT                 TEXT rendered as Markdown, without the
+//	                                 paragraph wrapping a single line.
+//	imageVariant PATH KIND           URL of the KIND variant of the image at
+//	                                 PATH, or of the image when there is none.
+//	param KEY [DEFAULT]              Site param with ref key KEY.
+//	recentBySection PATH N [LOCALE]  Newest N published content of the
+//	                                 section at PATH and its subsections.
+//	recentByTag TAG N [LOCALE]       Newest N published content tagged TAG,
+//	                                 by name or slug.
+//	first N LIST, last N LIST        The first or last N items of LIST.
+//	after N LIST                     The items of LIST after the first N.
+//	reverse LIST                     LIST in reverse order.
+//	in LIST VALUE                    Whether LIST contains VALUE.
+func (f *TemplateFuncs) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"date":            FormatDate,
+		"translate":       translate,
+		"absURL":          f.absURL,
+		"relURL":          f.relURL,
+		"truncate":        Truncate,
+		"plainify":        Plainify,
+		"markdownify":     f.markdownify,
+		"imageVariant":    f.imageVariant,
+		"param":           f.param,
+		"recentBySection": f.recentBySection,
+		"recentByTag":     f.recentByTag,
+		"first":           first,
+		"last":            last,
+		"after":           after,
+		"reverse":         reverse,
+		"in":              in,
+	}
+}
+
+// FormatDate formats t, a time.Time or *time.Time, with the Go layout and the
+// month and day names of locale. A nil or zero time formats as an empty
+// string. Locales without names keep the English ones.
+func FormatDate(layout, locale string, t any) string {
+	var tm time.Time
+	switch v := t.(type) {
+	case time.Time:
+		tm = v
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		tm = *v
+	default:
+		return ""
+	}
+	if tm.IsZero() {
+		return ""
+	}
+
+	s := tm.Format(layout)
+	names, ok := dateNames[locale]
+	if !ok {
+		names, ok = dateNames[baseLanguage(locale)]
+	}
+	if !ok {
+		return s
+	}
+	return dateNameRe.ReplaceAllStringFunc(s, func(name string) string {
+		return names.localize(name)
+	})
+}
+
+// translate returns the UI string key in locale, formatted with args if any.
+func translate(locale, key string, args ...any) string {
+	s := Translate(locale, key)
+	if len(args) > 0 {
+		return fmt.Sprintf(s, args...)
+	}
+	return s
+}
+
+// Truncate returns s cut to n characters at a word boundary, with an ellipsis
+// when cut.
+func Truncate(n int, s string) string {
+	s = strings.TrimSpace(s)
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+
+	cut := string([]rune(s)[:n])
+	if i := strings.LastIndexAny(cut, " \t\n"); i > 0 {
+		cut = cut[:i]
+	}
+	return strings.TrimRight(cut, " \t\n.,;:") + "…"
+}
+
+var tagRe = regexp.MustCompile(`<[^>]*>`)
+
+// Plainify returns s, a string or template.HTML, without HTML tags and with
+// its entities unescaped.
+func Plainify(s any) string {
+	return html.UnescapeString(tagRe.ReplaceAllString(fmt.Sprint(s), ""))
+}
+
+// markdownify renders s as Markdown with the site syntax. The paragraph
+// wrapping a single line is removed so that the result can be used inline,
+// e.g. in headings.
+func (f *TemplateFuncs) markdownify(s string) template.HTML {
+	if f.markdown == nil {
+		f.markdown = NewMarkdownProcessorWithOptions(f.Markdown)
+	}
+
+	out, err := f.markdown.ToHTML([]byte(s))
+	if err != nil {
+		return template.HTML(template.HTMLEscapeString(s))
+	}
+
+	out = strings.TrimSpace(out)
+	inner := strings.TrimSuffix(strings.TrimPrefix(out, "<p>"), "</p>")
+	if len(inner) == len(out)-len("<p></p>") && !strings.Contains(inner, "<p>") {
+		out = inner
+	}
+	return template.HTML(out)
+}
+
+// absURL returns p as an absolute URL of the site. Without a base URL it
+// returns p relative to the site root.
+func (f *TemplateFuncs) absURL(p string) string {
+	if isAbsURL(p) {
+		return p
+	}
+	return f.siteOrigin() + f.relURL(p)
+}
+
+// relURL returns p prefixed with the path of the site base URL, for sites
+// served below the root of their host.
+func (f *TemplateFuncs) relURL(p string) string {
+	if isAbsURL(p) {
+		return p
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	if strings.HasPrefix(p, f.basePath()+"/") {
+		return p
+	}
+	return f.basePath() + p
+}
+
+// basePath returns the path of the site base URL without its trailing slash,
+// empty for sites served at the root.
+func (f *TemplateFuncs) basePath() string {
+	u, err := url.Parse(f.Site.BaseURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSuffix(u.Path, "/")
+}
+
+// siteOrigin returns the scheme and host of the site base URL, empty when it
+// has none.
+func (f *TemplateFuncs) siteOrigin() string {
+	u, err := url.Parse(f.Site.BaseURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return u.Scheme + "://" + u.Host
+}
+
+func isAbsURL(p string) bool {
+	return strings.HasPrefix(p, "http://") || strings.HasPrefix(p, "https://") || strings.HasPrefix(p, "//")
+}
+
+// imageVariant returns the URL of the kind variant of the image at p, its
+// file path or its URL below /static/images. The image URL is returned when
+// it has no such variant.
+func (f *TemplateFuncs) imageVariant(p, kind string) template.URL {
+	filePath := strings.TrimPrefix(strings.TrimPrefix(p, "/static/images"), "/")
+	original := template.URL(f.relURL("/static/images/" + filePath))
+
+	variant, ok := f.Variants[filePath][kind]
+	if !ok || variant.BlobRef == "" {
+		return original
+	}
+	if strings.HasPrefix(variant.BlobRef, "data:image/") {
+		return template.URL(variant.BlobRef)
+	}
+	if isAbsURL(variant.BlobRef) {
+		return template.URL(variant.BlobRef)
+	}
+	return template.URL(f.relURL("/static/images/" + strings.TrimPrefix(variant.BlobRef, "/")))
+}
+
+func (f *TemplateFuncs) param(key string, def ...string) string {
+	d := ""
+	if len(def) > 0 {
+		d = def[0]
+	}
+	if f.Param == nil {
+		return d
+	}
+	return f.Param(key, d)
+}
+
+// recentBySection returns the newest n published content listed on indexes
+// of the section at sectionPath and its subsections, in locale when given.
+func (f *TemplateFuncs) recentBySection(sectionPath string, n int, locale ...string) []Content {
+	sectionPath = "/" + strings.Trim(sectionPath, "/")
+	return f.recent(n, locale, func(c Content) bool {
+		p := "/" + strings.Trim(c.SectionPath, "/")
+		return p == sectionPath || sectionPath == "/" || strings.HasPrefix(p, sectionPath+"/")
+	})
+}
+
+// recentByTag returns the newest n published content tagged tag, by name or
+// slug, in locale when given.
+func (f *TemplateFuncs) recentByTag(tag string, n int, locale ...string) []Content {
+	return f.recent(n, locale, func(c Content) bool {
+		for i := range c.Tags {
+			if strings.EqualFold(c.Tags[i].Name, tag) || c.Tags[i].Slug() == tag {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+func (f *TemplateFuncs) recent(n int, locale []string, match func(Content) bool) []Content {
+	var result []Content
+	for _, c := range f.Contents {
+		if c.Draft || !listedKind(c.Kind) || !match(c) {
+			continue
+		}
+		if len(locale) > 0 && locale[0] != "" && localeOrDefault(c.Locale, f.Site.Locale) != locale[0] {
+			continue
+		}
+		result = append(result, c)
+	}
+	sortNewestFirst(result)
+	return limit(result, n)
+}
+
+// first returns the first n items of list.
+func first(n int, list any) (any, error) {
+	v, err := sliceValue(list)
+	if err != nil {
+		return nil, err
+	}
+	return v.Slice(0, clamp(n, v.Len())).Interface(), nil
+}
+
+// last returns the last n items of list.
+func last(n int, list any) (any, error) {
+	v, err := sliceValue(list)
+	if err != nil {
+		return nil, err
+	}
+	return v.Slice(v.Len()-clamp(n, v.Len()), v.Len()).Interface(), nil
+}
+
+// after returns the items of list after the first n.
+func after(n int, list any) (any, error) {
+	v, err := sliceValue(list)
+	if err != nil {
+		return nil, err
+	}
+	return v.Slice(clamp(n, v.Len()), v.Len()).Interface(), nil
+}
+
+// reverse returns a copy of list in reverse order.
+func reverse(list any) (any, error) {
+	v, err := sliceValue(list)
+	if err != nil {
+		return nil, err
+	}
+	out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+	for i := 0; i < v.Len(); i++ {
+		out.Index(i).Set(v.Index(v.Len() - 1 - i))
+	}
+	return out.Interface(), nil
+}
+
+// in reports whether list contains value.
+func in(list any, value any) (bool, error) {
+	v, err := sliceValue(list)
+	if err != nil {
+		return false, err
+	}
+	for i := 0; i < v.Len(); i++ {
+		if reflect.DeepEqual(v.Index(i).Interface(), value) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func sliceValue(list any) (reflect.Value, error) {
+	v := reflect.ValueOf(list)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return reflect.Value{}, fmt.Errorf("cannot use %T as a list", list)
+	}
+	if v.Kind() == reflect.Array {
+		s := reflect.MakeSlice(reflect.SliceOf(v.Type().Elem()), v.Len(), v.Len())
+		reflect.Copy(s, v)
+		v = s
+	}
+	return v, nil
+}
+
+func clamp(n, max int) int {
+	if n < 0 {
+		return 0
+	}
+	if n > max {
+		return max
+	}
+	return n
+}
+
+// localDateNames holds the month and weekday names of a language, January
+// and Sunday first. Abbreviations are their first three letters.
+type localDateNames struct {
+	months [12]string
+	days   [7]string
+}
+
+func (n localDateNames) localize(name string) string {
+	for i := time.January; i <= time.December; i++ {
+		if name == i.String() {
+			return n.months[i-1]
+		}
+		if name == i.String()[:3] {
+			return abbreviate(n.months[i-1])
+		}
+	}
+	for i := time.Sunday; i <= time.Saturday; i++ {
+		if name == i.String() {
+			return n.days[i]
+		}
+		if name == i.String()[:3] {
+			return abbreviate(n.days[i])
+		}
+	}
+	return name
+}
+
+func abbreviate(name string) string {
+	r := []rune(name)
+	if len(r) <= 3 {
+		return name
+	}
+	return string(r[:3])
+}
+
+var dateNameRe = regexp.MustCompile(`\b(January|February|March|April|May|June|July|August|September|October|November|December|` +
+	`Jan|Feb|Mar|Apr|Jun|Jul|Aug|Sep|Oct|Nov|Dec|` +
+	`Sunday|Monday|Tuesday|Wednesday|Thursday|Friday|Saturday|Sun|Mon|Tue|Wed|Thu|Fri|Sat)\b`)
+
+var dateNames = map[string]localDateNames{
+	"de": {
+		months: [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		days:   [7]string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"},
+	},
+	"es": {
+		months: [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		days:   [7]string{"domingo", "lunes", "martes", "miércoles", "jueves", "viernes", "sábado"},
+	},
+	"fr": {
+		months: [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		days:   [7]string{"dimanche", "lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi"},
+	},
+	"it": {
+		months: [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		days:   [7]string{"domenica", "lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato"},
+	},
+	"pt": {
+		months: [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		days:   [7]string{"domingo", "segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado"},
+	},
+}
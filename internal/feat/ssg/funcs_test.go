@@ -0,0 +1,209 @@
+package ssg
+
+import (
+	"bytes"
+	"html/template"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestFormatDate(t *testing.T) {
+	day := time.Date(2024, 5, 6, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		layout string
+		locale string
+		t      any
+		want   string
+	}{
+		{name: "english", layout: "Monday, January 2, 2006", locale: "en", t: day, want: "Monday, May 6, 2024"},
+		{name: "spanish", layout: "Monday 2 January 2006", locale: "es", t: &day, want: "lunes 6 mayo 2024"},
+		{name: "regional locale", layout: "2. January 2006", locale: "de-AT", t: day, want: "6. Mai 2024"},
+		{name: "abbreviations", layout: "Mon Jan 2", locale: "fr", t: day, want: "lun mai 6"},
+		{name: "nil time", layout: "2006", locale: "en", t: (*time.Time)(nil), want: ""},
+		{name: "zero time", layout: "2006", locale: "en", t: time.Time{}, want: ""},
+		{name: "unknown locale", layout: "January", locale: "xx", t: day, want: "May"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatDate(tt.layout, tt.locale, tt.t); got != tt.want {
+				t.Errorf("FormatDate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTranslateFunc(t *testing.T) {
+	if got := translate("es", "%d min read", 5); got != "5 min de lectura" {
+		t.Errorf("translate() = %q, want %q", got, "5 min de lectura")
+	}
+	if got := translate("es", "Archive"); got != "Archivo" {
+		t.Errorf("translate() = %q, want %q", got, "Archivo")
+	}
+}
+
+func TestTruncatePlainifyMarkdownify(t *testing.T) {
+	if got := Truncate(12, "The quick brown fox"); got != "The quick…" {
+		t.Errorf("Truncate() = %q", got)
+	}
+	if got := Truncate(50, "Short"); got != "Short" {
+		t.Errorf("Truncate() short = %q", got)
+	}
+	if got := Plainify(template.HTML("<p>Fish &amp; <em>chips</em></p>")); got != "Fish & chips" {
+		t.Errorf("Plainify() = %q", got)
+	}
+	f := &TemplateFuncs{}
+	if got := f.markdownify("Hello *world*"); got != "Hello <em>world</em>" {
+		t.Errorf("markdownify() inline = %q", got)
+	}
+	if got := f.markdownify("One\n\nTwo"); got != "<p>One</p>\n<p>Two</p>" {
+		t.Errorf("markdownify() paragraphs = %q", got)
+	}
+
+	f = &TemplateFuncs{Markdown: MarkdownOptions{Typographer: true}}
+	if got := f.markdownify("Wait..."); got != "Wait&hellip;" {
+		t.Errorf("markdownify() with site syntax = %q", got)
+	}
+}
+
+func TestTemplateFuncsURLs(t *testing.T) {
+	tests := []struct {
+		baseURL string
+		path    string
+		wantRel string
+		wantAbs string
+	}{
+		{baseURL: "", path: "/about/", wantRel: "/about/", wantAbs: "/about/"},
+		{baseURL: "https://example.com/", path: "about/", wantRel: "/about/", wantAbs: "https://example.com/about/"},
+		{baseURL: "https://example.github.io/blog/", path: "/about/", wantRel: "/blog/about/", wantAbs: "https://example.github.io/blog/about/"},
+		{baseURL: "https://example.github.io/blog", path: "/blog/about/", wantRel: "/blog/about/", wantAbs: "https://example.github.io/blog/about/"},
+		{baseURL: "https://example.com/", path: "https://other.org/x", wantRel: "https://other.org/x", wantAbs: "https://other.org/x"},
+	}
+
+	for _, tt := range tests {
+		f := &TemplateFuncs{Site: SiteData{BaseURL: tt.baseURL}}
+		if got := f.relURL(tt.path); got != tt.wantRel {
+			t.Errorf("relURL(%q) with %q = %q, want %q", tt.path, tt.baseURL, got, tt.wantRel)
+		}
+		if got := f.absURL(tt.path); got != tt.wantAbs {
+			t.Errorf("absURL(%q) with %q = %q, want %q", tt.path, tt.baseURL, got, tt.wantAbs)
+		}
+	}
+}
+
+func TestTemplateFuncsLookups(t *testing.T) {
+	day := func(d int) *time.Time {
+		t := time.Date(2024, 5, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	golang := Tag{Name: "Go", SlugField: "go"}
+	f := &TemplateFuncs{
+		Site: SiteData{Locale: "en"},
+		Contents: []Content{
+			{ID: uuid.New(), Kind: "article", Heading: "Old", SectionPath: "/guides", PublishedAt: day(1), Tags: []Tag{golang}},
+			{ID: uuid.New(), Kind: "article", Heading: "New", SectionPath: "/guides/go", PublishedAt: day(3), Tags: []Tag{golang}},
+			{ID: uuid.New(), Kind: "article", Heading: "Nuevo", SectionPath: "/guides", PublishedAt: day(4), Locale: "es"},
+			{ID: uuid.New(), Kind: "article", Heading: "Draft", SectionPath: "/guides", PublishedAt: day(5), Draft: true},
+			{ID: uuid.New(), Kind: "page", Heading: "Page", SectionPath: "/guides", PublishedAt: day(6)},
+			{ID: uuid.New(), Kind: "article", Heading: "Other", SectionPath: "/guidesx", PublishedAt: day(7)},
+		},
+		Variants: map[string]map[string]ImageVariant{
+			"photo.jpg": {PlaceholderVariantKind: {BlobRef: "data:image/jpeg;base64,AA=="}, "thumb": {BlobRef: "photo-thumb.jpg"}},
+		},
+		Param: func(key, def string) string {
+			if key == "ssg.site.name" {
+				return "Clio"
+			}
+			return def
+		},
+	}
+
+	headings := func(content []Content) []string {
+		var got []string
+		for _, c := range content {
+			got = append(got, c.Heading)
+		}
+		return got
+	}
+
+	if got := headings(f.recentBySection("/guides/", 5)); !reflect.DeepEqual(got, []string{"Nuevo", "New", "Old"}) {
+		t.Errorf("recentBySection() = %v", got)
+	}
+	if got := headings(f.recentBySection("/guides", 1, "en")); !reflect.DeepEqual(got, []string{"New"}) {
+		t.Errorf("recentBySection() in en = %v", got)
+	}
+	if got := headings(f.recentByTag("go", 5)); !reflect.DeepEqual(got, []string{"New", "Old"}) {
+		t.Errorf("recentByTag() = %v", got)
+	}
+
+	if got := f.imageVariant("/static/images/photo.jpg", "thumb"); got != "/static/images/photo-thumb.jpg" {
+		t.Errorf("imageVariant(thumb) = %q", got)
+	}
+	if got := f.imageVariant("photo.jpg", PlaceholderVariantKind); got != "data:image/jpeg;base64,AA==" {
+		t.Errorf("imageVariant(placeholder) = %q", got)
+	}
+	if got := f.imageVariant("photo.jpg", "web"); got != "/static/images/photo.jpg" {
+		t.Errorf("imageVariant(missing) = %q", got)
+	}
+
+	if got := f.param("ssg.site.name"); got != "Clio" {
+		t.Errorf("param() = %q", got)
+	}
+	if got := f.param("missing", "def"); got != "def" {
+		t.Errorf("param() default = %q", got)
+	}
+}
+
+func TestTemplateFuncsSlices(t *testing.T) {
+	list := []string{"a", "b", "c", "d"}
+
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{name: "first", tmpl: `{{ first 2 . }}`, want: "[a b]"},
+		{name: "first beyond length", tmpl: `{{ first 9 . }}`, want: "[a b c d]"},
+		{name: "last", tmpl: `{{ last 1 . }}`, want: "[d]"},
+		{name: "after", tmpl: `{{ after 3 . }}`, want: "[d]"},
+		{name: "reverse", tmpl: `{{ reverse . }}`, want: "[d c b a]"},
+		{name: "in", tmpl: `{{ in . "c" }} {{ in . "z" }}`, want: "true false"},
+		{name: "chained", tmpl: `{{ range first 2 (reverse .) }}{{ . }}{{ end }}`, want: "dc"},
+	}
+
+	f := &TemplateFuncs{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("t").Funcs(f.FuncMap()).Parse(tt.tmpl))
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, list); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("got %q, want %q", buf.String(), tt.want)
+			}
+		})
+	}
+
+	if _, err := first(1, "not a list"); err == nil {
+		t.Error("first() with a string should fail")
+	}
+}
+
+func TestEmbeddedThemeParsesWithFuncMap(t *testing.T) {
+	f := &TemplateFuncs{}
+	fsys := os.DirFS("../../../assets/ssg")
+	_, err := template.New("layout.html").Funcs(f.FuncMap()).ParseFS(fsys, ThemeLayoutFile, ThemePartialsPattern)
+	if err != nil {
+		t.Fatalf("ParseFS() error = %v", err)
+	}
+	if _, err := NewShortcodes(fsys, nil, f.FuncMap()); err != nil {
+		t.Fatalf("NewShortcodes() error = %v", err)
+	}
+}
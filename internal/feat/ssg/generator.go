@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"gopkg.in/yaml.v2"
 
@@ -38,7 +40,7 @@ func (g *Generator) Generate(ctx context.Context, siteSlug string, contents []Co
 
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "title", Value: content.Heading})
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "slug", Value: content.Slug()})
-		frontMatter = append(frontMatter, yaml.MapItem{Key: "permalink", Value: ""}) // TODO: Construct full permalink
+		frontMatter = append(frontMatter, yaml.MapItem{Key: "permalink", Value: content.Permalink})
 
 		// Taxonomy
 		var tags []string
@@ -48,7 +50,11 @@ func (g *Generator) Generate(ctx context.Context, siteSlug string, contents []Co
 		if len(tags) > 0 {
 			frontMatter = append(frontMatter, yaml.MapItem{Key: "tags", Value: tags})
 		}
-		frontMatter = append(frontMatter, yaml.MapItem{Key: "layout", Value: content.SectionName}) // Assuming layout is related to section
+		layout := content.Layout
+		if layout == "" {
+			layout = content.SectionName // Assuming layout is related to section
+		}
+		frontMatter = append(frontMatter, yaml.MapItem{Key: "layout", Value: layout})
 
 		// Status
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "draft", Value: content.Draft})
@@ -61,7 +67,11 @@ func (g *Generator) Generate(ctx context.Context, siteSlug string, contents []Co
 
 		// Media
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "image", Value: content.HeaderImageURL})
-		frontMatter = append(frontMatter, yaml.MapItem{Key: "social-image", Value: content.HeaderImageURL})
+		socialImage := content.SocialImageURL
+		if socialImage == "" {
+			socialImage = content.HeaderImageURL
+		}
+		frontMatter = append(frontMatter, yaml.MapItem{Key: "social-image", Value: socialImage})
 
 		// Timestamps
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "published-at", Value: content.PublishedAt})
@@ -80,7 +90,13 @@ func (g *Generator) Generate(ctx context.Context, siteSlug string, contents []Co
 		frontMatter = append(frontMatter, yaml.MapItem{Key: "share", Value: content.Meta.Share})
 
 		// Localization
-		frontMatter = append(frontMatter, yaml.MapItem{Key: "locale", Value: ""}) // TODO: Add locale field
+		frontMatter = append(frontMatter, yaml.MapItem{Key: "locale", Value: content.Locale})
+		frontMatter = append(frontMatter, yaml.MapItem{Key: "translation-group", Value: content.TranslationGroup})
+
+		// Custom fields
+		if fields := frontMatterFields(content); len(fields) > 0 {
+			frontMatter = append(frontMatter, yaml.MapItem{Key: "fields", Value: fields})
+		}
 
 		// --- End of Frontmatter ---
 
@@ -110,3 +126,28 @@ func (g *Generator) Generate(ctx context.Context, siteSlug string, contents []Co
 
 	return nil
 }
+
+// frontMatterFields returns the custom fields of content sorted by name.
+// Typed values are kept for YAML, except dates and references which are
+// written as they are stored.
+func frontMatterFields(content Content) yaml.MapSlice {
+	names := make([]string, 0, len(content.Fields))
+	for name := range content.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fields := make(yaml.MapSlice, 0, len(names))
+	for _, name := range names {
+		v, ok := content.FieldValues[name]
+		if !ok {
+			v = content.Fields[name]
+		}
+		switch v.(type) {
+		case time.Time, Content:
+			v = FormatFieldValue(v)
+		}
+		fields = append(fields, yaml.MapItem{Key: name, Value: v})
+	}
+	return fields
+}
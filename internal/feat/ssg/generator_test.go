@@ -309,3 +309,107 @@ func TestGeneratorGenerateYAMLStructure(t *testing.T) {
 		t.Errorf("Body content = %q, want %q", bodyContent, content.Body)
 	}
 }
+
+func TestGeneratorGenerateSocialImage(t *testing.T) {
+	tests := []struct {
+		name    string
+		content Content
+		want    string
+	}{
+		{
+			name:    "uses social card",
+			content: Content{Heading: "Card", SocialImageURL: "/card/social-card.png", HeaderImageURL: "/header.jpg"},
+			want:    "/card/social-card.png",
+		},
+		{
+			name:    "falls back to header image",
+			content: Content{Heading: "Header", HeaderImageURL: "/header.jpg"},
+			want:    "/header.jpg",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			cfg := hm.NewConfig()
+			cfg.Set(SSGKey.SitesBasePath, tempDir)
+			gen := NewGenerator(hm.XParams{Cfg: cfg})
+
+			if err := gen.Generate(context.Background(), "site", []Content{tt.content}); err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(GetSiteMarkdownPath(tempDir, "site"), tt.content.Slug()+".md"))
+			if err != nil {
+				t.Fatalf("Failed to read file: %v", err)
+			}
+
+			if !strings.Contains(string(data), "social-image: "+tt.want) {
+				t.Errorf("social-image not set to %q in:\n%s", tt.want, data)
+			}
+		})
+	}
+}
+
+func TestGeneratorGenerateFields(t *testing.T) {
+	related := Content{ID: uuid.New(), Heading: "Bread"}
+	content := Content{ID: uuid.New(), Heading: "Pasta", Kind: "recipe", SectionName: "food", Layout: "recipe-layout"}
+	types := []ContentType{{Name: "recipe", LayoutName: "recipe-layout", Fields: []FieldDef{
+		{Name: "servings", Type: FieldTypeNumber},
+		{Name: "cooked_at", Type: FieldTypeDate},
+		{Name: "vegan", Type: FieldTypeBool},
+		{Name: "related", Type: FieldTypeReference},
+		{Name: "ingredients", Type: FieldTypeList},
+	}}}
+	stored := map[string]string{
+		"servings":    "4",
+		"cooked_at":   "2025-03-01",
+		"vegan":       "true",
+		"related":     related.ID.String(),
+		"ingredients": "flour\nwater",
+	}
+	contents := []Content{content, related}
+	ResolveContentTypes(contents, types, ContentFieldList(content.ID, stored))
+
+	tempDir := t.TempDir()
+	cfg := hm.NewConfig()
+	cfg.Set(SSGKey.SitesBasePath, tempDir)
+	gen := NewGenerator(hm.XParams{Cfg: cfg})
+
+	if err := gen.Generate(context.Background(), "site", contents[:1]); err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(GetSiteMarkdownPath(tempDir, "site"), content.Slug()+".md"))
+	if err != nil {
+		t.Fatalf("Failed to read file: %v", err)
+	}
+
+	if !strings.Contains(string(data), "layout: recipe-layout") {
+		t.Errorf("layout not set from content type in:\n%s", data)
+	}
+
+	parts := strings.Split(string(data), "---")
+	if len(parts) < 3 {
+		t.Fatalf("Expected at least 3 parts (2 delimiters), got %d", len(parts))
+	}
+	var frontMatter struct {
+		Fields yaml.MapSlice `yaml:"fields"`
+	}
+	if err := yaml.Unmarshal([]byte(parts[1]), &frontMatter); err != nil {
+		t.Fatalf("Failed to parse YAML: %v", err)
+	}
+
+	fields := make(map[string]string, len(frontMatter.Fields))
+	for _, item := range frontMatter.Fields {
+		fields[item.Key.(string)] = FormatFieldValue(item.Value)
+	}
+	if len(fields) != len(stored) {
+		t.Fatalf("fields = %v, want %v", fields, stored)
+	}
+	for name, want := range stored {
+		if fields[name] != want {
+			t.Errorf("field %s = %q, want %q", name, fields[name], want)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+package ssg
+
+import (
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// DefaultLocale is the site locale used when the site does not set one.
+const DefaultLocale = "en"
+
+// ErrInvalidLocale is returned when a content locale is not a language tag.
+var ErrInvalidLocale = errors.New("invalid locale")
+
+// ErrTranslationTaken is returned when a translation group already has a
+// content in the same locale.
+var ErrTranslationTaken = errors.New("translation group already has content in this locale")
+
+var localeRe = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// Translation is one language version of a page.
+type Translation struct {
+	Locale  string
+	Name    string // Name of the language in itself, e.g. Español
+	URL     string
+	Default bool // Version in the site default locale, used for x-default
+	Current bool
+}
+
+// NormalizeLocale returns locale as a language tag with a lowercase language
+// and an uppercase region, e.g. pt_br becomes pt-BR.
+func NormalizeLocale(locale string) string {
+	parts := strings.Split(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		if len(parts[i]) == 2 {
+			parts[i] = strings.ToUpper(parts[i])
+		}
+	}
+	return strings.Join(parts, "-")
+}
+
+// ValidLocale reports whether locale is a normalized language tag.
+func ValidLocale(locale string) bool {
+	return localeRe.MatchString(locale)
+}
+
+func localeOrDefault(locale, defaultLocale string) string {
+	if locale == "" {
+		return defaultLocale
+	}
+	return locale
+}
+
+// BuildContentTranslations returns the published versions of content in
+// every locale of its translation group, sorted by locale. Content without
+// translations returns nil. Contents must have their permalinks resolved.
+func BuildContentTranslations(content Content, contents []Content, site SiteInfo, mode, defaultLocale string) []Translation {
+	if content.TranslationGroup == "" {
+		return nil
+	}
+
+	var translations []Translation
+	for _, c := range contents {
+		if c.TranslationGroup != content.TranslationGroup || c.Draft {
+			continue
+		}
+		translations = append(translations, Translation{
+			Locale:  c.Locale,
+			Name:    LocaleName(c.Locale),
+			URL:     site.URL(GetContentPath(c, mode) + "/"),
+			Default: c.Locale == defaultLocale,
+			Current: c.ID == content.ID,
+		})
+	}
+
+	if len(translations) < 2 {
+		return nil
+	}
+
+	sortTranslations(translations)
+	return translations
+}
+
+// BuildIndexTranslations returns the versions of index in the locales that
+// have an index at the same base path, sorted by locale.
+func BuildIndexTranslations(index *Index, indexes []*Index, site SiteInfo, defaultLocale string) []Translation {
+	if index.Locale == "" {
+		return nil
+	}
+
+	var translations []Translation
+	for _, idx := range indexes {
+		if idx.BasePath() != index.BasePath() || idx.Type != index.Type {
+			continue
+		}
+		translations = append(translations, Translation{
+			Locale:  idx.Locale,
+			Name:    LocaleName(idx.Locale),
+			URL:     site.URL(idx.Path),
+			Default: idx.Locale == defaultLocale,
+			Current: idx == index,
+		})
+	}
+
+	if len(translations) < 2 {
+		return nil
+	}
+
+	sortTranslations(translations)
+	return translations
+}
+
+func sortTranslations(translations []Translation) {
+	sort.Slice(translations, func(i, j int) bool {
+		return translations[i].Locale < translations[j].Locale
+	})
+}
+
+// LocalizeBreadcrumbs prefixes the crumb URLs with the locale and translates
+// the names of the crumbs the generator labels.
+func LocalizeBreadcrumbs(crumbs []Breadcrumb, locale, defaultLocale string) []Breadcrumb {
+	for i := range crumbs {
+		crumbs[i].URL = LocalizePath(crumbs[i].URL, locale, defaultLocale)
+		if crumbs[i].Label {
+			crumbs[i].Name = Translate(locale, crumbs[i].Name)
+		}
+	}
+	return crumbs
+}
+
+// LocalizeMenu returns a copy of sections with their paths prefixed with the
+// locale.
+func LocalizeMenu(sections []Section, locale, defaultLocale string) []Section {
+	if LocalePrefix(locale, defaultLocale) == "" {
+		return sections
+	}
+
+	menu := make([]Section, len(sections))
+	for i, s := range sections {
+		s.Path = LocalizePath(s.Path, locale, defaultLocale)
+		menu[i] = s
+	}
+	return menu
+}
+
+// Translate returns the text of key, a UI string of the embedded templates,
+// in locale. The language of a regional locale is used when the region has
+// no catalog, and key itself when the language has none.
+func Translate(locale, key string) string {
+	for _, l := range []string{locale, baseLanguage(locale)} {
+		if s, ok := uiStrings[l][key]; ok {
+			return s
+		}
+	}
+	return key
+}
+
+// LocaleName returns the name of the language of locale in that language,
+// or locale itself when unknown.
+func LocaleName(locale string) string {
+	if name, ok := localeNames[locale]; ok {
+		return name
+	}
+	if name, ok := localeNames[baseLanguage(locale)]; ok {
+		return name + " (" + locale + ")"
+	}
+	return locale
+}
+
+func baseLanguage(locale string) string {
+	if i := strings.Index(locale, "-"); i > 0 {
+		return locale[:i]
+	}
+	return locale
+}
+
+var localeNames = map[string]string{
+	"de": "Deutsch",
+	"en": "English",
+	"es": "Español",
+	"fr": "Français",
+	"it": "Italiano",
+	"pt": "Português",
+}
+
+// uiStrings holds the translations of the UI strings of the embedded
+// templates. English is the source language and needs no catalog.
+var uiStrings = map[string]map[string]string{
+	"de": {
+		"Home":                    "Startseite",
+		"Blog":                    "Blog",
+		"Breadcrumb":              "Brotkrümelnavigation",
+		"Language":                "Sprache",
+		"Previous":                "Zurück",
+		"Next":                    "Weiter",
+		"Page %d of %d":           "Seite %d von %d",
+		"Related in this section": "Verwandt in diesem Bereich",
+		"Recent in this section":  "Neu in diesem Bereich",
+		"Related in all sections": "Verwandt in allen Bereichen",
+		"Recent in all sections":  "Neu in allen Bereichen",
+		"Related in this blog":    "Verwandt in diesem Blog",
+		"Recent in this blog":     "Neu in diesem Blog",
+		"Series Navigation":       "Serien-Navigation",
+		"Series Index":            "Serienübersicht",
+		"%d min read":             "%d Min. Lesezeit",
+		"Archive":                 "Archiv",
+		"Featured":                "Empfohlen",
+		"Latest":                  "Neueste",
+		"Pinned":                  "Angeheftet",
+		"By":                      "Von",
+		"January":                 "Januar",
+		"February":                "Februar",
+		"March":                   "März",
+		"April":                   "April",
+		"May":                     "Mai",
+		"June":                    "Juni",
+		"July":                    "Juli",
+		"August":                  "August",
+		"September":               "September",
+		"October":                 "Oktober",
+		"November":                "November",
+		"December":                "Dezember",
+		"Series":                  "Serien",
+		"Submenu":                 "Submenü",
+		"Footer":                  "Fußzeile",
+		"Ongoing":                 "Laufend",
+		"Complete":                "Abgeschlossen",
+		"%s Blog":                 "%s-Blog",
+		"%s Series":               "%s-Serien",
+		"%s - Page %d":            "%s - Seite %d",
+
+		// Comments
+		"Comments":                        "Kommentare",
+		"Leave a comment":                 "Kommentar schreiben",
+		"Name":                            "Name",
+		"Email (optional, not published)": "E-Mail (optional, wird nicht veröffentlicht)",
+		"Comment":                         "Kommentar",
+		"Send":                            "Senden",
+		"Comments are reviewed before they are published.":      "Kommentare werden vor der Veröffentlichung geprüft.",
+		"Thanks! Your comment will appear once it is approved.": "Danke! Dein Kommentar erscheint, sobald er freigegeben ist.",
+	},
+	"es": {
+		"Home":                    "Inicio",
+		"Blog":                    "Blog",
+		"Breadcrumb":              "Ruta de navegación",
+		"Language":                "Idioma",
+		"Previous":                "Anterior",
+		"Next":                    "Siguiente",
+		"Page %d of %d":           "Página %d de %d",
+		"Related in this section": "Relacionado en esta sección",
+		"Recent in this section":  "Reciente en esta sección",
+		"Related in all sections": "Relacionado en todas las secciones",
+		"Recent in all sections":  "Reciente en todas las secciones",
+		"Related in this blog":    "Relacionado en este blog",
+		"Recent in this blog":     "Reciente en este blog",
+		"Series Navigation":       "Navegación de la serie",
+		"Series Index":            "Índice de la serie",
+		"%d min read":             "%d min de lectura",
+		"Archive":                 "Archivo",
+		"Featured":                "Destacados",
+		"Latest":                  "Lo último",
+		"Pinned":                  "Fijados",
+		"By":                      "Por",
+		"January":                 "Enero",
+		"February":                "Febrero",
+		"March":                   "Marzo",
+		"April":                   "Abril",
+		"May":                     "Mayo",
+		"June":                    "Junio",
+		"July":                    "Julio",
+		"August":                  "Agosto",
+		"September":               "Septiembre",
+		"October":                 "Octubre",
+		"November":                "Noviembre",
+		"December":                "Diciembre",
+		"Series":                  "Series",
+		"Submenu":                 "Submenú",
+		"Footer":                  "Pie de página",
+		"Ongoing":                 "En curso",
+		"Complete":                "Completa",
+		"%s Blog":                 "Blog de %s",
+		"%s Series":               "Series de %s",
+		"%s - Page %d":            "%s - Página %d",
+
+		// Comments
+		"Comments":                        "Comentarios",
+		"Leave a comment":                 "Deja un comentario",
+		"Name":                            "Nombre",
+		"Email (optional, not published)": "Correo (opcional, no se publica)",
+		"Comment":                         "Comentario",
+		"Send":                            "Enviar",
+		"Comments are reviewed before they are published.":      "Los comentarios se revisan antes de publicarse.",
+		"Thanks! Your comment will appear once it is approved.": "¡Gracias! Tu comentario aparecerá cuando se apruebe.",
+	},
+	"fr": {
+		"Home":                    "Accueil",
+		"Blog":                    "Blog",
+		"Breadcrumb":              "Fil d'Ariane",
+		"Language":                "Langue",
+		"Previous":                "Précédent",
+		"Next":                    "Suivant",
+		"Page %d of %d":           "Page %d sur %d",
+		"Related in this section": "Articles liés dans cette section",
+		"Recent in this section":  "Récents dans cette section",
+		"Related in all sections": "Articles liés dans toutes les sections",
+		"Recent in all sections":  "Récents dans toutes les sections",
+		"Related in this blog":    "Articles liés dans ce blog",
+		"Recent in this blog":     "Récents dans ce blog",
+		"Series Navigation":       "Navigation de la série",
+		"Series Index":            "Sommaire de la série",
+		"%d min read":             "%d min de lecture",
+		"Archive":                 "Archives",
+		"Featured":                "À la une",
+		"Latest":                  "Derniers articles",
+		"Pinned":                  "Épinglés",
+		"By":                      "Par",
+		"January":                 "Janvier",
+		"February":                "Février",
+		"March":                   "Mars",
+		"April":                   "Avril",
+		"May":                     "Mai",
+		"June":                    "Juin",
+		"July":                    "Juillet",
+		"August":                  "Août",
+		"September":               "Septembre",
+		"October":                 "Octobre",
+		"November":                "Novembre",
+		"December":                "Décembre",
+		"Series":                  "Séries",
+		"Submenu":                 "Sous-menu",
+		"Footer":                  "Pied de page",
+		"Ongoing":                 "En cours",
+		"Complete":                "Terminée",
+		"%s Blog":                 "Blog %s",
+		"%s Series":               "Séries %s",
+		"%s - Page %d":            "%s - Page %d",
+
+		// Comments
+		"Comments":                        "Commentaires",
+		"Leave a comment":                 "Laisser un commentaire",
+		"Name":                            "Nom",
+		"Email (optional, not published)": "E-mail (facultatif, non publié)",
+		"Comment":                         "Commentaire",
+		"Send":                            "Envoyer",
+		"Comments are reviewed before they are published.":      "Les commentaires sont vérifiés avant d’être publiés.",
+		"Thanks! Your comment will appear once it is approved.": "Merci ! Votre commentaire apparaîtra une fois approuvé.",
+	},
+	"it": {
+		"Home":                    "Home",
+		"Blog":                    "Blog",
+		"Breadcrumb":              "Percorso di navigazione",
+		"Language":                "Lingua",
+		"Previous":                "Precedente",
+		"Next":                    "Successivo",
+		"Page %d of %d":           "Pagina %d di %d",
+		"Related in this section": "Correlati in questa sezione",
+		"Recent in this section":  "Recenti in questa sezione",
+		"Related in all sections": "Correlati in tutte le sezioni",
+		"Recent in all sections":  "Recenti in tutte le sezioni",
+		"Related in this blog":    "Correlati in questo blog",
+		"Recent in this blog":     "Recenti in questo blog",
+		"Series Navigation":       "Navigazione della serie",
+		"Series Index":            "Indice della serie",
+		"%d min read":             "%d min di lettura",
+		"Archive":                 "Archivio",
+		"Featured":                "In evidenza",
+		"Latest":                  "Ultimi",
+		"Pinned":                  "Fissati",
+		"By":                      "Di",
+		"January":                 "Gennaio",
+		"February":                "Febbraio",
+		"March":                   "Marzo",
+		"April":                   "Aprile",
+		"May":                     "Maggio",
+		"June":                    "Giugno",
+		"July":                    "Luglio",
+		"August":                  "Agosto",
+		"September":               "Settembre",
+		"October":                 "Ottobre",
+		"November":                "Novembre",
+		"December":                "Dicembre",
+		"Series":                  "Serie",
+		"Submenu":                 "Sottomenu",
+		"Footer":                  "Piè di pagina",
+		"Ongoing":                 "In corso",
+		"Complete":                "Completata",
+		"%s Blog":                 "Blog %s",
+		"%s Series":               "Serie %s",
+		"%s - Page %d":            "%s - Pagina %d",
+
+		// Comments
+		"Comments":                        "Commenti",
+		"Leave a comment":                 "Lascia un commento",
+		"Name":                            "Nome",
+		"Email (optional, not published)": "Email (facoltativa, non pubblicata)",
+		"Comment":                         "Commento",
+		"Send":                            "Invia",
+		"Comments are reviewed before they are published.":      "I commenti vengono controllati prima della pubblicazione.",
+		"Thanks! Your comment will appear once it is approved.": "Grazie! Il tuo commento apparirà dopo l’approvazione.",
+	},
+	"pt": {
+		"Home":                    "Início",
+		"Blog":                    "Blog",
+		"Breadcrumb":              "Trilha de navegação",
+		"Language":                "Idioma",
+		"Previous":                "Anterior",
+		"Next":                    "Próximo",
+		"Page %d of %d":           "Página %d de %d",
+		"Related in this section": "Relacionados nesta seção",
+		"Recent in this section":  "Recentes nesta seção",
+		"Related in all sections": "Relacionados em todas as seções",
+		"Recent in all sections":  "Recentes em todas as seções",
+		"Related in this blog":    "Relacionados neste blog",
+		"Recent in this blog":     "Recentes neste blog",
+		"Series Navigation":       "Navegação da série",
+		"Series Index":            "Índice da série",
+		"%d min read":             "%d min de leitura",
+		"Archive":                 "Arquivo",
+		"Featured":                "Destaques",
+		"Latest":                  "Mais recentes",
+		"Pinned":                  "Fixados",
+		"By":                      "Por",
+		"January":                 "Janeiro",
+		"February":                "Fevereiro",
+		"March":                   "Março",
+		"April":                   "Abril",
+		"May":                     "Maio",
+		"June":                    "Junho",
+		"July":                    "Julho",
+		"August":                  "Agosto",
+		"September":               "Setembro",
+		"October":                 "Outubro",
+		"November":                "Novembro",
+		"December":                "Dezembro",
+		"Series":                  "Séries",
+		"Submenu":                 "Submenu",
+		"Footer":                  "Rodapé",
+		"Ongoing":                 "Em andamento",
+		"Complete":                "Concluída",
+		"%s Blog":                 "Blog %s",
+		"%s Series":               "Séries %s",
+		"%s - Page %d":            "%s - Página %d",
+
+		// Comments
+		"Comments":                        "Comentários",
+		"Leave a comment":                 "Deixe um comentário",
+		"Name":                            "Nome",
+		"Email (optional, not published)": "E-mail (opcional, não é publicado)",
+		"Comment":                         "Comentário",
+		"Send":                            "Enviar",
+		"Comments are reviewed before they are published.":      "Os comentários são revistos antes de serem publicados.",
+		"Thanks! Your comment will appear once it is approved.": "Obrigado! O seu comentário aparecerá depois de aprovado.",
+	},
+}
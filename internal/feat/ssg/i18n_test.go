@@ -0,0 +1,185 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNormalizeLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+	}{
+		{locale: "es", want: "es"},
+		{locale: " ES ", want: "es"},
+		{locale: "pt_br", want: "pt-BR"},
+		{locale: "PT-br", want: "pt-BR"},
+		{locale: "zh-Hant", want: "zh-Hant"},
+		{locale: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		if got := NormalizeLocale(tt.locale); got != tt.want {
+			t.Errorf("NormalizeLocale(%q) = %q, want %q", tt.locale, got, tt.want)
+		}
+	}
+}
+
+func TestValidLocale(t *testing.T) {
+	for _, l := range []string{"en", "es", "pt-BR", "zh-Hant", "ast"} {
+		if !ValidLocale(l) {
+			t.Errorf("ValidLocale(%q) = false, want true", l)
+		}
+	}
+	for _, l := range []string{"", "e", "english", "es/", "../es", "es-"} {
+		if ValidLocale(l) {
+			t.Errorf("ValidLocale(%q) = true, want false", l)
+		}
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		name   string
+		locale string
+		key    string
+		want   string
+	}{
+		{name: "known locale", locale: "es", key: "Next", want: "Siguiente"},
+		{name: "regional locale falls back to language", locale: "pt-BR", key: "Next", want: "Próximo"},
+		{name: "source language returns key", locale: "en", key: "Next", want: "Next"},
+		{name: "unknown locale returns key", locale: "ja", key: "Next", want: "Next"},
+		{name: "unknown key returns key", locale: "es", key: "Unknown", want: "Unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Translate(tt.locale, tt.key); got != tt.want {
+				t.Errorf("Translate(%q, %q) = %q, want %q", tt.locale, tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageDataT(t *testing.T) {
+	data := PageData{Locale: "es"}
+
+	if got := data.T("Page %d of %d", 2, 5); got != "Página 2 de 5" {
+		t.Errorf("T() = %q, want %q", got, "Página 2 de 5")
+	}
+	if got := data.T("Home"); got != "Inicio" {
+		t.Errorf("T() = %q, want %q", got, "Inicio")
+	}
+}
+
+func TestLocaleName(t *testing.T) {
+	if got := LocaleName("es"); got != "Español" {
+		t.Errorf("LocaleName(es) = %q", got)
+	}
+	if got := LocaleName("pt-BR"); got != "Português (pt-BR)" {
+		t.Errorf("LocaleName(pt-BR) = %q", got)
+	}
+	if got := LocaleName("ja"); got != "ja" {
+		t.Errorf("LocaleName(ja) = %q", got)
+	}
+}
+
+func TestBuildContentTranslations(t *testing.T) {
+	site := SiteInfo{BaseURL: "https://example.com"}
+	en := Content{ID: uuid.New(), Heading: "Hello", ShortID: "aaa111", Locale: "en", TranslationGroup: "hello", Permalink: "/hello/"}
+	es := Content{ID: uuid.New(), Heading: "Hola", ShortID: "bbb222", Locale: "es", TranslationGroup: "hello", Permalink: "/es/hola/"}
+	fr := Content{ID: uuid.New(), Heading: "Salut", ShortID: "ccc333", Locale: "fr", TranslationGroup: "hello", Draft: true}
+	other := Content{ID: uuid.New(), Heading: "Other", ShortID: "ddd444", Locale: "en", Permalink: "/other/"}
+	contents := []Content{es, en, fr, other}
+
+	got := BuildContentTranslations(es, contents, site, "blog", "en")
+
+	want := []Translation{
+		{Locale: "en", Name: "English", URL: "https://example.com/hello/", Default: true},
+		{Locale: "es", Name: "Español", URL: "https://example.com/es/hola/", Current: true},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d translations, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("translation[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if got := BuildContentTranslations(other, contents, site, "blog", "en"); got != nil {
+		t.Errorf("content without group got %+v, want nil", got)
+	}
+	if got := BuildContentTranslations(en, []Content{en, fr}, site, "blog", "en"); got != nil {
+		t.Errorf("draft translations got %+v, want nil", got)
+	}
+}
+
+func TestBuildIndexTranslations(t *testing.T) {
+	site := SiteInfo{BaseURL: "https://example.com"}
+	contents := []Content{
+		{Heading: "Hello", ShortID: "aaa111", Kind: "blog", SectionPath: "/", Locale: "en"},
+		{Heading: "Hola", ShortID: "bbb222", Kind: "blog", SectionPath: "/", Locale: "es"},
+	}
+	indexes := BuildLocaleIndexes(contents, nil, "blog", "en")
+
+	var esRoot *Index
+	for _, idx := range indexes {
+		if idx.Path == "/es/" {
+			esRoot = idx
+		}
+	}
+	if esRoot == nil {
+		t.Fatalf("no /es/ index in %d indexes", len(indexes))
+	}
+
+	got := BuildIndexTranslations(esRoot, indexes, site, "en")
+	if len(got) != 2 {
+		t.Fatalf("got %d translations, want 2: %+v", len(got), got)
+	}
+	if got[0].URL != "https://example.com/" || !got[0].Default {
+		t.Errorf("translation[0] = %+v, want default root", got[0])
+	}
+	if got[1].URL != "https://example.com/es/" || !got[1].Current {
+		t.Errorf("translation[1] = %+v, want current /es/", got[1])
+	}
+}
+
+func TestLocalizeBreadcrumbs(t *testing.T) {
+	crumbs := []Breadcrumb{
+		{Name: "Home", URL: "/", Label: true},
+		{Name: "Home", URL: "/home/"},
+		{Name: "Blog", URL: "/blog/", Label: true},
+		{Name: "Hola", URL: "/es/blog/hola/", Current: true},
+	}
+
+	got := LocalizeBreadcrumbs(crumbs, "es", "en")
+
+	want := []Breadcrumb{
+		{Name: "Inicio", URL: "/es/", Label: true},
+		{Name: "Home", URL: "/es/home/"},
+		{Name: "Blog", URL: "/es/blog/", Label: true},
+		{Name: "Hola", URL: "/es/blog/hola/", Current: true},
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("crumb[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestLocalizeMenu(t *testing.T) {
+	sections := []Section{{Name: "Docs", Path: "/docs"}}
+
+	got := LocalizeMenu(sections, "es", "en")
+	if got[0].Path != "/es/docs" {
+		t.Errorf("Path = %q, want %q", got[0].Path, "/es/docs")
+	}
+	if sections[0].Path != "/docs" {
+		t.Error("LocalizeMenu should not modify the sections")
+	}
+	if got := LocalizeMenu(sections, "en", "en"); got[0].Path != "/docs" {
+		t.Errorf("default locale Path = %q, want %q", got[0].Path, "/docs")
+	}
+}
@@ -18,14 +18,28 @@ type Image struct {
 	SiteID uuid.UUID `json:"site_id" db:"site_id"`
 
 	// File information
-	FileName string `json:"file_name" db:"file_name"`
-	FilePath string `json:"file_path" db:"file_path"`
-	Width    int    `json:"width" db:"width"`
-	Height   int    `json:"height" db:"height"`
+	FileName     string `json:"file_name" db:"file_name"`
+	FilePath     string `json:"file_path" db:"file_path"`
+	Width        int    `json:"width" db:"width"`
+	Height       int    `json:"height" db:"height"`
+	FilesizeByte int64  `json:"filesize_bytes" db:"filesize_bytes"`
+	Mime         string `json:"mime" db:"mime"`
+
+	// Placeholder rendering
+	DominantColor string `json:"dominant_color" db:"dominant_color"`
 
 	// Accessibility fields
-	Title   string `json:"title" db:"title"`
-	AltText string `json:"alt_text" db:"alt_text"`
+	Title           string `json:"title" db:"title"`
+	AltText         string `json:"alt_text" db:"alt_text"`
+	AltLang         string `json:"alt_lang" db:"alt_lang"`
+	LongDescription string `json:"long_description" db:"long_description"`
+	Caption         string `json:"caption" db:"caption"`
+	Decorative      bool   `json:"decorative" db:"decorative"`
+
+	// Rights and provenance
+	Credit    string `json:"credit" db:"credit"`
+	License   string `json:"license" db:"license"`
+	SourceURL string `json:"source_url" db:"source_url"`
 
 	// Audit
 	CreatedBy uuid.UUID `json:"-" db:"created_by"`
@@ -32,23 +32,30 @@ type ImageProcessResult struct {
 	RelativePath string            // Relative path for web access
 	Filename     string            // Generated filename
 	Directory    string            // Directory where image was stored
+	Width        int               // Width in pixels after orientation is applied
+	Height       int               // Height in pixels after orientation is applied
+	Size         int64             // Stored file size in bytes
+	Mime         string            // Mime type of the decoded image
+	Placeholder  *Placeholder      // Low quality placeholder and dominant color, nil if unavailable
 	Metadata     map[string]string // Image metadata (size, format, etc.)
 }
 
 // ImageManager handles all image-related operations
 type ImageManager struct {
 	hm.Core
+	pm            *ParamManager
 	baseImagePath string // Base path for all images (e.g., "./assets/images")
 }
 
 // NewImageManager creates a new ImageManager instance
 
 // NewImageManagerWithParams creates an ImageManager with XParams.
-func NewImageManager(params hm.XParams) *ImageManager {
+func NewImageManager(pm *ParamManager, params hm.XParams) *ImageManager {
 	core := hm.NewCore("image-manager", params)
 	imagesPath := core.Cfg().StrValOrDef(SSGKey.ImagesPath, "_workspace/documents/assets/images")
 	return &ImageManager{
 		Core:          core,
+		pm:            pm,
 		baseImagePath: imagesPath,
 	}
 }
@@ -68,7 +75,13 @@ func (im *ImageManager) ProcessUpload(ctx context.Context, file multipart.File,
 		return nil, fmt.Errorf("failed to generate directory path: %w", err)
 	}
 
-	filename, err := im.generateFilename(content, section, imageType, header.Filename)
+	processed, err := im.processFile(ctx, file, header)
+	if err != nil {
+		return nil, err
+	}
+
+	originalName := strings.TrimSuffix(header.Filename, filepath.Ext(header.Filename)) + processed.Extension
+	filename, err := im.generateFilename(content, section, imageType, originalName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate filename: %w", err)
 	}
@@ -83,17 +96,30 @@ func (im *ImageManager) ProcessUpload(ctx context.Context, file multipart.File,
 	}
 
 	fullPath := filepath.Join(fullDirectory, filename)
-	if err := im.saveFile(file, fullPath); err != nil {
+	if err := im.saveFile(processed.Data, fullPath); err != nil {
 		return nil, fmt.Errorf("failed to save file: %w", err)
 	}
 
-	metadata := im.extractMetadata(header)
+	placeholder, err := GeneratePlaceholder(processed.Data, processed.Orientation)
+	if err != nil {
+		im.Log().Errorf("Cannot generate placeholder for %s: %v", fullPath, err)
+	}
+
+	metadata := im.extractMetadata(header, processed)
+	if placeholder != nil {
+		metadata["dominant_color"] = placeholder.DominantColor
+	}
 
 	result := &ImageProcessResult{
 		FilePath:     fullPath,
 		RelativePath: filepath.Join(directory, filename),
 		Filename:     filename,
 		Directory:    directory,
+		Width:        processed.Width,
+		Height:       processed.Height,
+		Size:         int64(len(processed.Data)),
+		Mime:         processed.Mime,
+		Placeholder:  placeholder,
 		Metadata:     metadata,
 	}
 
@@ -227,31 +253,53 @@ func (im *ImageManager) handleReplacement(directory string, imageType ImageType,
 	return nil
 }
 
-func (im *ImageManager) saveFile(src multipart.File, destPath string) error {
+// processFile decodes the upload and strips its metadata unless the site
+// is configured to keep it.
+func (im *ImageManager) processFile(ctx context.Context, src multipart.File, header *multipart.FileHeader) (*ProcessedImage, error) {
 	if _, err := src.Seek(0, 0); err != nil {
-		return fmt.Errorf("failed to reset file pointer: %w", err)
+		return nil, fmt.Errorf("failed to reset file pointer: %w", err)
 	}
 
-	dst, err := os.Create(destPath)
+	data, err := io.ReadAll(src)
 	if err != nil {
-		return fmt.Errorf("failed to create destination file: %w", err)
+		return nil, fmt.Errorf("failed to read uploaded file: %w", err)
 	}
-	defer dst.Close()
 
-	if _, err := io.Copy(dst, src); err != nil {
-		return fmt.Errorf("failed to copy file content: %w", err)
+	processed, err := ProcessImage(data, header.Header.Get("Content-Type"), im.keepMetadata(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("invalid image upload: %w", err)
+	}
+
+	return processed, nil
+}
+
+// keepMetadata reports whether the current site keeps EXIF data on upload.
+func (im *ImageManager) keepMetadata(ctx context.Context) bool {
+	if im.pm == nil {
+		return im.Cfg().BoolVal(SSGKey.ImagesKeepMetadata, false)
+	}
+	return im.pm.Get(ctx, SSGKey.ImagesKeepMetadata, "false") == "true"
+}
+
+func (im *ImageManager) saveFile(data []byte, destPath string) error {
+	if err := os.WriteFile(destPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write destination file: %w", err)
 	}
 
 	return nil
 }
 
-// extractMetadata extracts basic metadata from the uploaded file
-func (im *ImageManager) extractMetadata(header *multipart.FileHeader) map[string]string {
+// extractMetadata extracts metadata from the uploaded file and its decoded image
+func (im *ImageManager) extractMetadata(header *multipart.FileHeader, processed *ProcessedImage) map[string]string {
 	metadata := make(map[string]string)
 
 	metadata["original_filename"] = header.Filename
-	metadata["content_type"] = header.Header.Get("Content-Type")
-	metadata["size"] = fmt.Sprintf("%d", header.Size)
+	metadata["original_size"] = fmt.Sprintf("%d", header.Size)
+	metadata["content_type"] = processed.Mime
+	metadata["format"] = processed.Format
+	metadata["size"] = fmt.Sprintf("%d", len(processed.Data))
+	metadata["width"] = fmt.Sprintf("%d", processed.Width)
+	metadata["height"] = fmt.Sprintf("%d", processed.Height)
 	metadata["upload_time"] = time.Now().Format(time.RFC3339)
 
 	return metadata
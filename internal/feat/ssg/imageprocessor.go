@@ -0,0 +1,576 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	"image/jpeg"
+	_ "image/png"
+	"strings"
+
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+)
+
+const (
+	jpegQuality = 92
+
+	// MaxImagePixels is the largest width × height decoded. Compressed
+	// formats can declare huge sizes in a small file, so the size is checked
+	// before the pixels are allocated.
+	MaxImagePixels = 40_000_000
+)
+
+// ErrImageTooLarge is returned for images with more than MaxImagePixels.
+var ErrImageTooLarge = errors.New("image is too large")
+
+// ProcessedImage holds the sanitized bytes of an upload together with
+// the facts extracted from decoding it.
+type ProcessedImage struct {
+	Data        []byte
+	Format      string // Decoded format: jpeg, png, gif or webp
+	Mime        string
+	Extension   string
+	Width       int
+	Height      int
+	Orientation int
+}
+
+var imageFormats = map[string]struct {
+	mime string
+	ext  string
+}{
+	"jpeg": {mime: "image/jpeg", ext: ".jpg"},
+	"png":  {mime: "image/png", ext: ".png"},
+	"gif":  {mime: "image/gif", ext: ".gif"},
+	"webp": {mime: "image/webp", ext: ".webp"},
+}
+
+// ProcessImage decodes an uploaded image and checks that it matches the
+// declared content type. The whole image is decoded, so truncated or
+// corrupt uploads are rejected, and so are images over MaxImagePixels. Unless keepMetadata is set, EXIF
+// orientation is applied to the pixels and location and other metadata are
+// removed.
+func ProcessImage(data []byte, declaredType string, keepMetadata bool) (*ProcessedImage, error) {
+	img, format, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+	bounds := img.Bounds()
+
+	info, ok := imageFormats[format]
+	if !ok {
+		return nil, fmt.Errorf("unsupported image format: %s", format)
+	}
+
+	if err := checkDeclaredType(declaredType, info.mime); err != nil {
+		return nil, err
+	}
+
+	result := &ProcessedImage{
+		Data:        data,
+		Format:      format,
+		Mime:        info.mime,
+		Extension:   info.ext,
+		Width:       bounds.Dx(),
+		Height:      bounds.Dy(),
+		Orientation: readOrientation(data, format),
+	}
+
+	if keepMetadata {
+		if swapsAxes(result.Orientation) {
+			result.Width, result.Height = result.Height, result.Width
+		}
+		return result, nil
+	}
+
+	switch format {
+	case "jpeg":
+		err = result.sanitizeJPEG(img)
+	case "png":
+		result.Data = stripPNGMetadata(data)
+	case "gif":
+		result.Data = stripGIFMetadata(data)
+	case "webp":
+		result.Data, err = stripWebPMetadata(data, result.Orientation)
+		if swapsAxes(result.Orientation) {
+			result.Width, result.Height = result.Height, result.Width
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// decodeImage decodes data once its declared size is known to be within
+// MaxImagePixels.
+func decodeImage(data []byte) (image.Image, string, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot decode image: %w", err)
+	}
+	if int64(cfg.Width)*int64(cfg.Height) > MaxImagePixels {
+		return nil, "", fmt.Errorf("%w: %dx%d pixels", ErrImageTooLarge, cfg.Width, cfg.Height)
+	}
+
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("cannot decode image: %w", err)
+	}
+	return img, format, nil
+}
+
+// checkDeclaredType rejects uploads whose client supplied content type
+// disagrees with the decoded format. Generic or missing types are accepted.
+func checkDeclaredType(declared, actual string) error {
+	declared = strings.ToLower(strings.TrimSpace(strings.Split(declared, ";")[0]))
+	switch declared {
+	case "", "application/octet-stream":
+		return nil
+	case "image/jpg", "image/pjpeg":
+		declared = "image/jpeg"
+	}
+
+	if declared != actual {
+		return fmt.Errorf("content type %s does not match decoded image type %s", declared, actual)
+	}
+
+	return nil
+}
+
+// sanitizeJPEG drops metadata segments. When the orientation is not the
+// default one the decoded image is rotated and re-encoded instead, as the
+// orientation tag goes away with the rest of the EXIF data.
+func (p *ProcessedImage) sanitizeJPEG(img image.Image) error {
+	if p.Orientation <= 1 {
+		p.Data = stripJPEGMetadata(p.Data)
+		return nil
+	}
+
+	img = applyOrientation(img, p.Orientation)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return fmt.Errorf("cannot encode image: %w", err)
+	}
+
+	bounds := img.Bounds()
+	p.Data = buf.Bytes()
+	p.Width = bounds.Dx()
+	p.Height = bounds.Dy()
+	p.Orientation = 1
+
+	return nil
+}
+
+func swapsAxes(orientation int) bool {
+	return orientation >= 5 && orientation <= 8
+}
+
+// applyOrientation transforms img according to an EXIF orientation value.
+func applyOrientation(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	src, ok := img.(*image.RGBA)
+	if !ok {
+		src = image.NewRGBA(img.Bounds())
+		draw.Draw(src, src.Bounds(), img, img.Bounds().Min, draw.Src)
+	}
+	return orientRGBA(src, orientation)
+}
+
+// orientRGBA transforms src according to an EXIF orientation value, copying
+// pixels straight between the buffers.
+func orientRGBA(src *image.RGBA, orientation int) *image.RGBA {
+	if orientation <= 1 || orientation > 8 {
+		return src
+	}
+
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	if swapsAxes(orientation) {
+		dw, dh = h, w
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < h; y++ {
+		row := src.Pix[src.PixOffset(b.Min.X, b.Min.Y+y):]
+		for x := 0; x < w; x++ {
+			var dx, dy int
+			switch orientation {
+			case 2:
+				dx, dy = w-1-x, y
+			case 3:
+				dx, dy = w-1-x, h-1-y
+			case 4:
+				dx, dy = x, h-1-y
+			case 5:
+				dx, dy = y, x
+			case 6:
+				dx, dy = h-1-y, x
+			case 7:
+				dx, dy = h-1-y, w-1-x
+			case 8:
+				dx, dy = y, w-1-x
+			}
+			di := dy*dst.Stride + dx*4
+			copy(dst.Pix[di:di+4], row[x*4:x*4+4])
+		}
+	}
+
+	return dst
+}
+
+// readOrientation returns the EXIF orientation (1-8) or 1 when absent.
+func readOrientation(data []byte, format string) int {
+	var tiff []byte
+	switch format {
+	case "jpeg":
+		tiff = jpegExif(data)
+	case "webp":
+		tiff = webpChunk(data, "EXIF")
+		tiff = bytes.TrimPrefix(tiff, []byte("Exif\x00\x00"))
+	}
+
+	if o := tiffOrientation(tiff); o >= 1 && o <= 8 {
+		return o
+	}
+
+	return 1
+}
+
+// tiffOrientation reads tag 0x0112 from the first IFD of a TIFF block.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 0
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	offset := int(order.Uint32(tiff[4:8]))
+	if offset+2 > len(tiff) {
+		return 0
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			return int(order.Uint16(tiff[entry+8:]))
+		}
+	}
+
+	return 0
+}
+
+// orientationExif builds a minimal TIFF block carrying only the orientation tag.
+func orientationExif(orientation int) []byte {
+	tiff := make([]byte, 26)
+	copy(tiff, "II")
+	binary.LittleEndian.PutUint16(tiff[2:], 42)
+	binary.LittleEndian.PutUint32(tiff[4:], 8)
+	binary.LittleEndian.PutUint16(tiff[8:], 1)
+	binary.LittleEndian.PutUint16(tiff[10:], 0x0112)
+	binary.LittleEndian.PutUint16(tiff[12:], 3)
+	binary.LittleEndian.PutUint32(tiff[14:], 1)
+	binary.LittleEndian.PutUint16(tiff[18:], uint16(orientation))
+	return tiff
+}
+
+// jpegExif returns the TIFF payload of the first Exif APP1 segment.
+func jpegExif(data []byte) []byte {
+	for _, seg := range jpegSegments(data) {
+		if seg.marker == 0xE1 && bytes.HasPrefix(seg.payload, []byte("Exif\x00\x00")) {
+			return seg.payload[6:]
+		}
+	}
+	return nil
+}
+
+type jpegSegment struct {
+	marker  byte
+	start   int
+	end     int
+	payload []byte
+}
+
+// jpegSegments lists the marker segments preceding the scan data.
+func jpegSegments(data []byte) []jpegSegment {
+	var segments []jpegSegment
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil
+	}
+
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			return segments
+		}
+		marker := data[pos+1]
+		if marker == 0xDA || marker == 0xD9 {
+			return segments
+		}
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+		if length < 2 || end > len(data) {
+			return segments
+		}
+		segments = append(segments, jpegSegment{
+			marker:  marker,
+			start:   pos,
+			end:     end,
+			payload: data[pos+4 : end],
+		})
+		pos = end
+	}
+
+	return segments
+}
+
+// stripJPEGMetadata removes EXIF, XMP, IPTC and comment segments.
+// ICC profiles (APP2) and JFIF/Adobe markers are preserved.
+func stripJPEGMetadata(data []byte) []byte {
+	segments := jpegSegments(data)
+	if len(segments) == 0 {
+		return data
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:2]...)
+	for _, seg := range segments {
+		switch seg.marker {
+		case 0xE1, 0xED, 0xFE:
+			continue
+		}
+		out = append(out, data[seg.start:seg.end]...)
+	}
+	out = append(out, data[segments[len(segments)-1].end:]...)
+
+	return out
+}
+
+var pngMetadataChunks = map[string]bool{
+	"eXIf": true,
+	"tEXt": true,
+	"zTXt": true,
+	"iTXt": true,
+	"tIME": true,
+}
+
+// stripPNGMetadata drops textual, time and EXIF chunks from a PNG stream.
+func stripPNGMetadata(data []byte) []byte {
+	const sigLen = 8
+	if len(data) < sigLen {
+		return data
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:sigLen]...)
+	pos := sigLen
+	for pos+12 <= len(data) {
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+		if length < 0 || end > len(data) {
+			return data
+		}
+		if !pngMetadataChunks[string(data[pos+4:pos+8])] {
+			out = append(out, data[pos:end]...)
+		}
+		pos = end
+	}
+
+	return out
+}
+
+// gifLoopExtensions are the application extensions that control animation
+// looping. They are kept, as dropping them changes how the image plays.
+var gifLoopExtensions = map[string]bool{
+	"NETSCAPE2.0": true,
+	"ANIMEXTS1.0": true,
+}
+
+// stripGIFMetadata drops comment extensions and application extensions,
+// such as XMP, other than the looping ones. Data that cannot be walked is
+// returned unchanged.
+func stripGIFMetadata(data []byte) []byte {
+	const headerLen = 13
+	if len(data) < headerLen {
+		return data
+	}
+
+	pos := headerLen
+	if flags := data[10]; flags&0x80 != 0 {
+		pos += 3 << ((flags & 0x07) + 1)
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:min(pos, len(data))]...)
+	for pos < len(data) {
+		start := pos
+		switch data[pos] {
+		case 0x3B: // Trailer
+			return append(out, 0x3B)
+		case 0x21: // Extension
+			if pos+2 > len(data) {
+				return data
+			}
+			label := data[pos+1]
+			end, ok := gifSubBlocksEnd(data, pos+2)
+			if !ok {
+				return data
+			}
+			pos = end
+			if label == 0xFE || (label == 0xFF && !gifLoopExtensions[gifAppID(data[start+2:end])]) {
+				continue
+			}
+		case 0x2C: // Image descriptor
+			if pos+10 > len(data) {
+				return data
+			}
+			flags := data[pos+9]
+			pos += 10
+			if flags&0x80 != 0 {
+				pos += 3 << ((flags & 0x07) + 1)
+			}
+			// LZW minimum code size, then the image data sub-blocks.
+			end, ok := gifSubBlocksEnd(data, pos+1)
+			if !ok {
+				return data
+			}
+			pos = end
+		default:
+			return data
+		}
+		out = append(out, data[start:pos]...)
+	}
+
+	return data
+}
+
+// gifSubBlocksEnd returns the position after the sub-blocks starting at pos,
+// terminator included.
+func gifSubBlocksEnd(data []byte, pos int) (int, bool) {
+	for pos < len(data) {
+		size := int(data[pos])
+		pos++
+		if size == 0 {
+			return pos, true
+		}
+		pos += size
+	}
+	return 0, false
+}
+
+// gifAppID returns the identifier and authentication code of an
+// application extension, read from its first sub-block.
+func gifAppID(blocks []byte) string {
+	if len(blocks) < 12 || blocks[0] != 11 {
+		return ""
+	}
+	return string(blocks[1:12])
+}
+
+// webpChunk returns the payload of the first RIFF chunk with the given id.
+func webpChunk(data []byte, id string) []byte {
+	for _, c := range webpChunks(data) {
+		if c.id == id {
+			return c.payload
+		}
+	}
+	return nil
+}
+
+type riffChunk struct {
+	id      string
+	payload []byte
+}
+
+func webpChunks(data []byte) []riffChunk {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil
+	}
+
+	var chunks []riffChunk
+	pos := 12
+	for pos+8 <= len(data) {
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		end := pos + 8 + size
+		if size < 0 || end > len(data) {
+			return chunks
+		}
+		chunks = append(chunks, riffChunk{id: string(data[pos : pos+4]), payload: data[pos+8 : end]})
+		pos = end + size%2
+	}
+
+	return chunks
+}
+
+// stripWebPMetadata removes EXIF and XMP chunks from an extended WebP file.
+// There is no WebP encoder available, so a non default orientation is kept
+// as a minimal EXIF chunk holding only that tag.
+func stripWebPMetadata(data []byte, orientation int) ([]byte, error) {
+	chunks := webpChunks(data)
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("malformed webp container")
+	}
+
+	var body bytes.Buffer
+	for _, c := range chunks {
+		switch c.id {
+		case "XMP ":
+			continue
+		case "EXIF":
+			if orientation <= 1 {
+				continue
+			}
+			c.payload = orientationExif(orientation)
+		case "VP8X":
+			flags := make([]byte, len(c.payload))
+			copy(flags, c.payload)
+			if len(flags) > 0 {
+				flags[0] &^= 0x04
+				if orientation <= 1 {
+					flags[0] &^= 0x08
+				}
+			}
+			c.payload = flags
+		}
+		writeRIFFChunk(&body, c)
+	}
+
+	var out bytes.Buffer
+	out.WriteString("RIFF")
+	_ = binary.Write(&out, binary.LittleEndian, uint32(body.Len()+4))
+	out.WriteString("WEBP")
+	out.Write(body.Bytes())
+
+	return out.Bytes(), nil
+}
+
+func writeRIFFChunk(buf *bytes.Buffer, c riffChunk) {
+	buf.WriteString(c.id)
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(c.payload)))
+	buf.Write(c.payload)
+	if len(c.payload)%2 == 1 {
+		buf.WriteByte(0)
+	}
+}
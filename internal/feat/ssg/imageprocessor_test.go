@@ -0,0 +1,291 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func testJPEG(t *testing.T, w, h int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for x := 0; x < w; x++ {
+		img.Set(x, 0, color.RGBA{255, 0, 0, 255})
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+		t.Fatalf("cannot encode jpeg: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// withExif inserts an APP1 Exif segment carrying the given orientation
+// plus a fake GPS marker right after the SOI marker.
+func withExif(data []byte, orientation int) []byte {
+	payload := append([]byte("Exif\x00\x00"), orientationExif(orientation)...)
+	payload = append(payload, []byte("GPS-48.8584N")...)
+
+	seg := []byte{0xFF, 0xE1, 0, 0}
+	binary.BigEndian.PutUint16(seg[2:], uint16(len(payload)+2))
+	seg = append(seg, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, seg...)
+	return append(out, data[2:]...)
+}
+
+// testGIF encodes a looping animated GIF and adds a comment extension and
+// an XMP application extension carrying a fake GPS marker.
+func testGIF(t *testing.T) []byte {
+	t.Helper()
+	frame := image.NewPaletted(image.Rect(0, 0, 2, 2), color.Palette{color.Black, color.White})
+	var buf bytes.Buffer
+	anim := &gif.GIF{Image: []*image.Paletted{frame, frame}, Delay: []int{10, 10}}
+	if err := gif.EncodeAll(&buf, anim); err != nil {
+		t.Fatalf("cannot encode gif: %v", err)
+	}
+	data := buf.Bytes()
+
+	comment := append([]byte{0x21, 0xFE, 11}, []byte("GPS-comment")...)
+	comment = append(comment, 0)
+	xmp := append([]byte{0x21, 0xFF, 11}, []byte("XMP DataXMP")...)
+	xmp = append(xmp, 8)
+	xmp = append(xmp, []byte("GPS-xmp!")...)
+	xmp = append(xmp, 0)
+
+	// The encoder writes no global color table, so extensions can go right
+	// after the logical screen descriptor.
+	out := append([]byte{}, data[:13]...)
+	out = append(out, comment...)
+	out = append(out, xmp...)
+	return append(out, data[13:]...)
+}
+
+// oversizedGIF returns a small GIF whose logical screen declares 65535x65535
+// pixels, as a decompression bomb would.
+func oversizedGIF(t *testing.T) []byte {
+	t.Helper()
+	data := testGIF(t)
+	binary.LittleEndian.PutUint16(data[6:], 0xFFFF)
+	binary.LittleEndian.PutUint16(data[8:], 0xFFFF)
+	return data
+}
+
+func TestProcessImage(t *testing.T) {
+	var pngBuf bytes.Buffer
+	if err := png.Encode(&pngBuf, image.NewRGBA(image.Rect(0, 0, 3, 2))); err != nil {
+		t.Fatalf("cannot encode png: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		data         []byte
+		declared     string
+		keepMetadata bool
+		wantErr      bool
+		wantFormat   string
+		wantWidth    int
+		wantHeight   int
+		wantGPS      bool
+	}{
+		{
+			name:       "decodes jpeg",
+			data:       testJPEG(t, 4, 2),
+			declared:   "image/jpeg",
+			wantFormat: "jpeg",
+			wantWidth:  4,
+			wantHeight: 2,
+		},
+		{
+			name:       "decodes png without declared type",
+			data:       pngBuf.Bytes(),
+			wantFormat: "png",
+			wantWidth:  3,
+			wantHeight: 2,
+		},
+		{
+			name:     "rejects mismatched content type",
+			data:     pngBuf.Bytes(),
+			declared: "image/jpeg",
+			wantErr:  true,
+		},
+		{
+			name:     "rejects non image data",
+			data:     []byte("not an image"),
+			declared: "image/png",
+			wantErr:  true,
+		},
+		{
+			name:     "rejects truncated image",
+			data:     testJPEG(t, 4, 2)[:120],
+			declared: "image/jpeg",
+			wantErr:  true,
+		},
+		{
+			name:     "rejects images over the pixel cap",
+			data:     oversizedGIF(t),
+			declared: "image/gif",
+			wantErr:  true,
+		},
+		{
+			name:       "strips gif comments and xmp",
+			data:       testGIF(t),
+			declared:   "image/gif",
+			wantFormat: "gif",
+			wantWidth:  2,
+			wantHeight: 2,
+		},
+		{
+			name:       "applies orientation and strips exif",
+			data:       withExif(testJPEG(t, 4, 2), 6),
+			declared:   "image/jpeg",
+			wantFormat: "jpeg",
+			wantWidth:  2,
+			wantHeight: 4,
+		},
+		{
+			name:       "strips exif without orientation change",
+			data:       withExif(testJPEG(t, 4, 2), 1),
+			declared:   "image/jpeg",
+			wantFormat: "jpeg",
+			wantWidth:  4,
+			wantHeight: 2,
+		},
+		{
+			name:         "keeps metadata when requested",
+			data:         withExif(testJPEG(t, 4, 2), 6),
+			declared:     "image/jpeg",
+			keepMetadata: true,
+			wantFormat:   "jpeg",
+			wantWidth:    2,
+			wantHeight:   4,
+			wantGPS:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProcessImage(tt.data, tt.declared, tt.keepMetadata)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ProcessImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.Format != tt.wantFormat {
+				t.Errorf("Format = %q, want %q", got.Format, tt.wantFormat)
+			}
+			if got.Width != tt.wantWidth || got.Height != tt.wantHeight {
+				t.Errorf("dimensions = %dx%d, want %dx%d", got.Width, got.Height, tt.wantWidth, tt.wantHeight)
+			}
+			if hasGPS := bytes.Contains(got.Data, []byte("GPS-")); hasGPS != tt.wantGPS {
+				t.Errorf("GPS data present = %v, want %v", hasGPS, tt.wantGPS)
+			}
+
+			cfg, _, err := image.DecodeConfig(bytes.NewReader(got.Data))
+			if err != nil {
+				t.Fatalf("processed data does not decode: %v", err)
+			}
+			if !tt.keepMetadata && (cfg.Width != tt.wantWidth || cfg.Height != tt.wantHeight) {
+				t.Errorf("stored dimensions = %dx%d, want %dx%d", cfg.Width, cfg.Height, tt.wantWidth, tt.wantHeight)
+			}
+		})
+	}
+}
+
+func TestStripPNGMetadata(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 1, 1))); err != nil {
+		t.Fatalf("cannot encode png: %v", err)
+	}
+	data := buf.Bytes()
+
+	text := []byte("Comment\x00secret")
+	chunk := make([]byte, 8, 12+len(text))
+	binary.BigEndian.PutUint32(chunk, uint32(len(text)))
+	copy(chunk[4:], "tEXt")
+	chunk = append(chunk, text...)
+	chunk = append(chunk, 0, 0, 0, 0)
+
+	// Insert the text chunk right after the IHDR chunk.
+	ihdrEnd := 8 + 12 + 13
+	withText := append([]byte{}, data[:ihdrEnd]...)
+	withText = append(withText, chunk...)
+	withText = append(withText, data[ihdrEnd:]...)
+
+	got := stripPNGMetadata(withText)
+
+	if bytes.Contains(got, []byte("secret")) {
+		t.Error("stripPNGMetadata() kept tEXt chunk")
+	}
+	if !bytes.Equal(got, data) {
+		t.Error("stripPNGMetadata() altered image chunks")
+	}
+}
+
+func TestStripGIFMetadata(t *testing.T) {
+	data := testGIF(t)
+
+	got := stripGIFMetadata(data)
+
+	if bytes.Contains(got, []byte("GPS-")) {
+		t.Error("stripGIFMetadata() kept comment or XMP extension")
+	}
+	if !bytes.Contains(got, []byte("NETSCAPE2.0")) {
+		t.Error("stripGIFMetadata() dropped looping extension")
+	}
+	anim, err := gif.DecodeAll(bytes.NewReader(got))
+	if err != nil {
+		t.Fatalf("stripped data does not decode: %v", err)
+	}
+	if len(anim.Image) != 2 || anim.LoopCount != 0 {
+		t.Errorf("stripped gif has %d frames and loop count %d, want 2 and 0", len(anim.Image), anim.LoopCount)
+	}
+}
+
+func TestDecodeImageTooLarge(t *testing.T) {
+	if _, _, err := decodeImage(oversizedGIF(t)); !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("decodeImage() error = %v, want %v", err, ErrImageTooLarge)
+	}
+	if _, err := GeneratePlaceholder(oversizedGIF(t), 1); !errors.Is(err, ErrImageTooLarge) {
+		t.Errorf("GeneratePlaceholder() error = %v, want %v", err, ErrImageTooLarge)
+	}
+}
+
+func TestApplyOrientation(t *testing.T) {
+	// 3x2 image with a marked top left pixel
+	src := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	mark := color.NRGBA{255, 0, 0, 255}
+	src.Set(0, 0, mark)
+
+	tests := []struct {
+		orientation int
+		wantW       int
+		wantH       int
+		wantMark    image.Point
+	}{
+		{orientation: 2, wantW: 3, wantH: 2, wantMark: image.Pt(2, 0)},
+		{orientation: 3, wantW: 3, wantH: 2, wantMark: image.Pt(2, 1)},
+		{orientation: 6, wantW: 2, wantH: 3, wantMark: image.Pt(1, 0)},
+		{orientation: 8, wantW: 2, wantH: 3, wantMark: image.Pt(0, 2)},
+	}
+
+	for _, tt := range tests {
+		got := applyOrientation(src, tt.orientation)
+		b := got.Bounds()
+		if b.Dx() != tt.wantW || b.Dy() != tt.wantH {
+			t.Errorf("orientation %d: size = %dx%d, want %dx%d", tt.orientation, b.Dx(), b.Dy(), tt.wantW, tt.wantH)
+			continue
+		}
+		if r, _, _, _ := got.At(tt.wantMark.X, tt.wantMark.Y).RGBA(); r != 0xFFFF {
+			t.Errorf("orientation %d: mark not at %v", tt.orientation, tt.wantMark)
+		}
+	}
+}
@@ -3,19 +3,57 @@ package ssg
 import (
 	"sort"
 	"strings"
+
+	"github.com/google/uuid"
 )
 
 // Index represents a single generated index page, containing the list of content
 // that belongs to it.
 type Index struct {
 	Path    string    // The output path for the index, e.g., "/news/" or "/blog/".
-	Type    string    // Type of index (section, blog, series) to determine sorting.
+	Type    string    // Type of index (section, blog, series, series-overview, archive, tag).
 	Content []Content // The list of content items for this index.
+	Locale  string    // Locale of the content listed, empty when the site has only one.
+
+	prefix string // Locale prefix of Path, empty for the default locale.
+}
+
+// BasePath returns the index path without its locale prefix.
+func (idx *Index) BasePath() string {
+	p := strings.TrimPrefix(idx.Path, idx.prefix)
+	if p == "" {
+		return "/"
+	}
+	return p
+}
+
+// Section returns the section an index belongs to, or nil when none matches.
+// Blog, series and series overview indexes belong to the section they are
+// nested in.
+func (idx *Index) Section(sections []Section) *Section {
+	p := strings.Trim(idx.BasePath(), "/")
+	if idx.Type == "blog" || idx.Type == "series" || idx.Type == "series-overview" {
+		if i := strings.LastIndex(p, "/"); i >= 0 {
+			p = p[:i]
+		} else {
+			p = ""
+		}
+	}
+
+	for i := range sections {
+		if strings.Trim(sections[i].Path, "/") == p {
+			return &sections[i]
+		}
+	}
+
+	return nil
 }
 
 // BuildIndexes analyzes all site content and sections to generate the data for all
 // required index pages (global, section, blog, and series).
 // The mode parameter determines URL structure: "structured" or "blog".
+// Section indexes also list the content of the sections nested below them
+// when the section rolls it up.
 func BuildIndexes(allContent []Content, allSections []Section, mode string) []*Index {
 	// Use a map for efficient lookup and to avoid duplicate index paths.
 	indexes := make(map[string]*Index)
@@ -33,6 +71,18 @@ func BuildIndexes(allContent []Content, allSections []Section, mode string) []*I
 		}
 	}
 
+	// Sections rolling up descendant content, by the sections nested in them.
+	rollUps := make(map[uuid.UUID][]string)
+	if mode == "structured" {
+		for _, section := range allSections {
+			for _, ancestor := range SectionAncestors(section, allSections) {
+				if ancestor.RollUp {
+					rollUps[section.ID] = append(rollUps[section.ID], ancestor.Path)
+				}
+			}
+		}
+	}
+
 	// Distribute content into the appropriate indexes.
 	for _, content := range allContent {
 		kind := strings.ToLower(content.Kind)
@@ -46,6 +96,11 @@ func BuildIndexes(allContent []Content, allSections []Section, mode string) []*I
 			if sectionIndex, ok := indexes[content.SectionPath]; ok {
 				sectionIndex.Content = append(sectionIndex.Content, content)
 			}
+			for _, p := range rollUps[content.SectionID] {
+				if ancestorIndex, ok := indexes[p]; ok {
+					ancestorIndex.Content = append(ancestorIndex.Content, content)
+				}
+			}
 		}
 
 		// Add to the global root index.
@@ -114,3 +169,42 @@ func BuildIndexes(allContent []Content, allSections []Section, mode string) []*I
 
 	return result
 }
+
+// BuildLocaleIndexes builds the indexes of every locale in allContent.
+// Indexes of the default locale keep their paths, the others are prefixed
+// with their locale, e.g. /es/blog/. Content must have its locale resolved.
+func BuildLocaleIndexes(allContent []Content, allSections []Section, mode, defaultLocale string) []*Index {
+	return buildLocaleIndexes(allContent, defaultLocale, func(contents []Content) []*Index {
+		return BuildIndexes(contents, allSections, mode)
+	})
+}
+
+// buildLocaleIndexes calls build with the content of each locale and
+// localizes the paths of the indexes it returns.
+func buildLocaleIndexes(allContent []Content, defaultLocale string, build func([]Content) []*Index) []*Index {
+	byLocale := make(map[string][]Content)
+	locales := []string{defaultLocale}
+	for _, c := range allContent {
+		if _, ok := byLocale[c.Locale]; !ok && c.Locale != defaultLocale {
+			locales = append(locales, c.Locale)
+		}
+		byLocale[c.Locale] = append(byLocale[c.Locale], c)
+	}
+
+	if len(locales) == 1 {
+		return build(allContent)
+	}
+
+	var result []*Index
+	for _, locale := range locales {
+		prefix := LocalePrefix(locale, defaultLocale)
+		for _, idx := range build(byLocale[locale]) {
+			idx.Locale = locale
+			idx.prefix = prefix
+			idx.Path = LocalizePath(idx.Path, locale, defaultLocale)
+			result = append(result, idx)
+		}
+	}
+
+	return result
+}
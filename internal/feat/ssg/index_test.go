@@ -137,3 +137,124 @@ func setupIndexTestData(t *testing.T) (sections []ssg.Section, content []ssg.Con
 
 	return sections, content
 }
+
+func TestBuildIndexesRollUp(t *testing.T) {
+	guides := ssg.Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	golang := ssg.Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	modules := ssg.Section{ID: uuid.New(), Name: "Modules", Path: "/guides/go/modules", ParentID: golang.ID}
+	content := []ssg.Content{
+		{ID: uuid.New(), SectionID: guides.ID, Kind: "Article", Heading: "Overview", SectionPath: guides.Path},
+		{ID: uuid.New(), SectionID: golang.ID, Kind: "Article", Heading: "Go basics", SectionPath: golang.Path},
+		{ID: uuid.New(), SectionID: modules.ID, Kind: "Article", Heading: "Go modules", SectionPath: modules.Path},
+	}
+
+	counts := func(sections []ssg.Section) map[string]int {
+		got := make(map[string]int)
+		for _, idx := range ssg.BuildIndexes(content, sections, "structured") {
+			got[idx.Path] = len(idx.Content)
+		}
+		return got
+	}
+
+	got := counts([]ssg.Section{guides, golang, modules})
+	if got["/guides"] != 1 || got["/guides/go"] != 1 || got["/guides/go/modules"] != 1 {
+		t.Errorf("BuildIndexes() without roll up counts = %v", got)
+	}
+
+	guides.RollUp = true
+	got = counts([]ssg.Section{guides, golang, modules})
+	if got["/guides"] != 3 || got["/guides/go"] != 1 {
+		t.Errorf("BuildIndexes() with roll up counts = %v", got)
+	}
+	if got["/"] != 3 {
+		t.Errorf("BuildIndexes() root count = %d, want 3", got["/"])
+	}
+}
+
+func TestIndexSection(t *testing.T) {
+	sections, _ := setupIndexTestData(t)
+
+	tests := []struct {
+		name     string
+		index    ssg.Index
+		wantName string
+	}{
+		{name: "root index", index: ssg.Index{Path: "/", Type: "section"}, wantName: "root"},
+		{name: "section index", index: ssg.Index{Path: "/news/", Type: "section"}, wantName: "news"},
+		{name: "blog index", index: ssg.Index{Path: "/tech/blog/", Type: "blog"}, wantName: "tech"},
+		{name: "root blog index", index: ssg.Index{Path: "/blog/", Type: "blog"}, wantName: "root"},
+		{name: "series index", index: ssg.Index{Path: "/tech/go-series/", Type: "series"}, wantName: "tech"},
+		{name: "unknown section", index: ssg.Index{Path: "/missing/", Type: "section"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.index.Section(sections)
+
+			if tt.wantName == "" {
+				if got != nil {
+					t.Errorf("Section() = %q, want nil", got.Name)
+				}
+				return
+			}
+			if got == nil || got.Name != tt.wantName {
+				t.Errorf("Section() = %v, want %q", got, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestBuildLocaleIndexes(t *testing.T) {
+	sections := []ssg.Section{
+		{ID: uuid.New(), Name: "root", Path: "/"},
+		{ID: uuid.New(), Name: "News", Path: "/news/"},
+	}
+	content := []ssg.Content{
+		{Heading: "News EN", Kind: "article", SectionPath: "/news/", Locale: "en"},
+		{Heading: "News ES", Kind: "article", SectionPath: "/news/", Locale: "es"},
+		{Heading: "Blog ES", Kind: "blog", SectionPath: "/", Locale: "es"},
+	}
+
+	indexes := ssg.BuildLocaleIndexes(content, sections, "structured", "en")
+
+	got := make(map[string]*ssg.Index)
+	for _, idx := range indexes {
+		got[idx.Path] = idx
+	}
+
+	for path, locale := range map[string]string{"/": "en", "/news/": "en", "/es/": "es", "/es/news/": "es", "/es/blog/": "es"} {
+		idx, ok := got[path]
+		if !ok {
+			t.Errorf("missing index %s", path)
+			continue
+		}
+		if idx.Locale != locale {
+			t.Errorf("index %s locale = %q, want %q", path, idx.Locale, locale)
+		}
+	}
+	if _, ok := got["/blog/"]; ok {
+		t.Error("unexpected /blog/ index for the default locale")
+	}
+
+	if len(got["/es/news/"].Content) != 1 || got["/es/news/"].Content[0].Heading != "News ES" {
+		t.Errorf("/es/news/ content = %+v", got["/es/news/"].Content)
+	}
+	if base := got["/es/news/"].BasePath(); base != "/news/" {
+		t.Errorf("BasePath() = %q, want %q", base, "/news/")
+	}
+	if s := got["/es/news/"].Section(sections); s == nil || s.Name != "News" {
+		t.Errorf("Section() = %+v, want News", s)
+	}
+}
+
+func TestBuildLocaleIndexesSingleLocale(t *testing.T) {
+	content := []ssg.Content{
+		{Heading: "Post", Kind: "blog", SectionPath: "/", Locale: "en"},
+	}
+
+	indexes := ssg.BuildLocaleIndexes(content, nil, "blog", "en")
+
+	if len(indexes) != 1 || indexes[0].Path != "/" || indexes[0].Locale != "" {
+		t.Fatalf("indexes = %+v, want unlocalized root", indexes)
+	}
+}
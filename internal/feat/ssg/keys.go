@@ -9,15 +9,52 @@ type SSGKeys struct {
 	MarkdownPath   string
 	HTMLPath       string
 	LayoutPath     string
+	ThemesPath     string
 	HeaderStyle    string
 	AssetsPath     string
 	ImagesPath     string
 	BlocksMaxItems string
 	IndexMaxItems  string
+	FeedMaxItems   string
+
+	ImagesKeepMetadata string
+
+	ReadingWordsPerMinute string
+
+	MarkdownFootnotes       string
+	MarkdownDefinitionLists string
+	MarkdownTypographer     string
+	MarkdownAttributes      string
+	MarkdownHeadingAnchors  string
+	MarkdownEmoji           string
+	MarkdownMath            string
+
+	SiteName    string
+	SiteBaseURL string
+	SiteLocale  string
+	ThemeName   string
+
+	PermalinkPattern     string
+	RedirectsFileEnabled string
+
+	SocialCardsEnabled    string
+	SocialCardsTemplate   string
+	SocialCardsBackground string
+	SocialCardsAccent     string
 
 	SearchGoogleEnabled string
 	SearchGoogleID      string
 
+	CommentsURL        string
+	CommentsRateLimit  string
+	CommentsRateWindow string
+
+	HomeBlocks         string
+	HomeFeaturedItems  string
+	HomeSectionItems   string
+	HomePinned         string
+	HomeSectionIndexes string
+
 	PublishRepoURL         string
 	PublishBranch          string
 	PublishPagesSubdir     string
@@ -37,15 +74,52 @@ var SSGKey = SSGKeys{
 	MarkdownPath:   "ssg.markdown.path",
 	HTMLPath:       "ssg.html.path",
 	LayoutPath:     "ssg.layout.path",
+	ThemesPath:     "ssg.themes.path",
 	HeaderStyle:    "ssg.header.style",
 	AssetsPath:     "ssg.assets.path",
 	ImagesPath:     "ssg.images.path",
 	BlocksMaxItems: "ssg.blocks.maxitems",
 	IndexMaxItems:  "ssg.index.maxitems",
+	FeedMaxItems:   "ssg.feed.maxitems",
+
+	ImagesKeepMetadata: "ssg.images.keep.metadata",
+
+	ReadingWordsPerMinute: "ssg.reading.wpm",
+
+	MarkdownFootnotes:       "ssg.markdown.footnotes",
+	MarkdownDefinitionLists: "ssg.markdown.definition.lists",
+	MarkdownTypographer:     "ssg.markdown.typographer",
+	MarkdownAttributes:      "ssg.markdown.attributes",
+	MarkdownHeadingAnchors:  "ssg.markdown.heading.anchors",
+	MarkdownEmoji:           "ssg.markdown.emoji",
+	MarkdownMath:            "ssg.markdown.math",
+
+	SiteName:    "ssg.site.name",
+	SiteBaseURL: "ssg.site.base.url",
+	SiteLocale:  "ssg.site.locale",
+	ThemeName:   "ssg.theme.name",
+
+	PermalinkPattern:     "ssg.permalink.pattern",
+	RedirectsFileEnabled: "ssg.redirects.file.enabled",
+
+	SocialCardsEnabled:    "ssg.social.cards.enabled",
+	SocialCardsTemplate:   "ssg.social.cards.template",
+	SocialCardsBackground: "ssg.social.cards.background",
+	SocialCardsAccent:     "ssg.social.cards.accent",
 
 	SearchGoogleEnabled: "ssg.search.google.enabled",
 	SearchGoogleID:      "ssg.search.google.id",
 
+	CommentsURL:        "ssg.comments.url",
+	CommentsRateLimit:  "ssg.comments.rate.limit",
+	CommentsRateWindow: "ssg.comments.rate.window",
+
+	HomeBlocks:         "ssg.home.blocks",
+	HomeFeaturedItems:  "ssg.home.featured.items",
+	HomeSectionItems:   "ssg.home.section.items",
+	HomePinned:         "ssg.home.pinned",
+	HomeSectionIndexes: "ssg.home.section.indexes",
+
 	PublishRepoURL:         "ssg.publish.repo.url",
 	PublishBranch:          "ssg.publish.branch",
 	PublishPagesSubdir:     "ssg.publish.pages.subdir",
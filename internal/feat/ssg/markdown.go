@@ -0,0 +1,87 @@
+package ssg
+
+import (
+	"github.com/wyatt915/goldmark-treeblood"
+	"github.com/yuin/goldmark"
+	emoji "github.com/yuin/goldmark-emoji"
+	gmast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// MarkdownOptions selects the optional Markdown syntax enabled for a site on
+// top of GitHub Flavored Markdown.
+type MarkdownOptions struct {
+	Footnotes       bool // [^1] references and their notes
+	DefinitionLists bool // Term followed by ": definition" lines
+	Typographer     bool // Smart quotes, dashes and ellipses
+	Attributes      bool // {#id .class} after headings
+	HeadingAnchors  bool // Heading IDs and a link to each heading
+	Emoji           bool // :smile: shortcodes
+	Math            bool // $inline$ and $$block$$ TeX rendered as MathML
+}
+
+func (o MarkdownOptions) extensions() []goldmark.Extender {
+	var exts []goldmark.Extender
+	if o.Footnotes {
+		exts = append(exts, extension.Footnote)
+	}
+	if o.DefinitionLists {
+		exts = append(exts, extension.DefinitionList)
+	}
+	if o.Typographer {
+		exts = append(exts, extension.Typographer)
+	}
+	if o.Emoji {
+		exts = append(exts, emoji.Emoji)
+	}
+	if o.Math {
+		exts = append(exts, treeblood.MathML())
+	}
+	return exts
+}
+
+func (o MarkdownOptions) parserOptions() []parser.Option {
+	var opts []parser.Option
+	if o.Attributes {
+		opts = append(opts, parser.WithAttribute())
+	}
+	if o.HeadingAnchors {
+		opts = append(opts,
+			parser.WithAutoHeadingID(),
+			parser.WithASTTransformers(util.Prioritized(headingAnchorTransformer{}, 1000)),
+		)
+	}
+	return opts
+}
+
+// headingAnchorTransformer appends a link to itself to every heading with an
+// ID, so readers can copy the URL of a section.
+type headingAnchorTransformer struct{}
+
+func (headingAnchorTransformer) Transform(doc *gmast.Document, reader text.Reader, pc parser.Context) {
+	_ = gmast.Walk(doc, func(n gmast.Node, entering bool) (gmast.WalkStatus, error) {
+		if !entering || n.Kind() != gmast.KindHeading {
+			return gmast.WalkContinue, nil
+		}
+
+		id, ok := n.AttributeString("id")
+		if !ok {
+			return gmast.WalkSkipChildren, nil
+		}
+		idBytes, ok := id.([]byte)
+		if !ok || len(idBytes) == 0 {
+			return gmast.WalkSkipChildren, nil
+		}
+
+		link := gmast.NewLink()
+		link.Destination = append([]byte("#"), idBytes...)
+		link.SetAttributeString("class", []byte("heading-anchor"))
+		link.AppendChild(link, gmast.NewString([]byte("#")))
+		n.AppendChild(n, link)
+
+		return gmast.WalkSkipChildren, nil
+	})
+}
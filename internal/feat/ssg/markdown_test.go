@@ -0,0 +1,96 @@
+package ssg
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestMarkdownOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     MarkdownOptions
+		markdown string
+		want     string
+		disabled string
+	}{
+		{
+			name:     "footnotes",
+			opts:     MarkdownOptions{Footnotes: true},
+			markdown: "Text[^1]\n\n[^1]: The note.",
+			want:     `<div class="footnotes" role="doc-endnotes">`,
+		},
+		{
+			name:     "definition lists",
+			opts:     MarkdownOptions{DefinitionLists: true},
+			markdown: "Term\n: Definition",
+			want:     "<dl>\n<dt>Term</dt>\n<dd>Definition</dd>\n</dl>",
+		},
+		{
+			name:     "typographer",
+			opts:     MarkdownOptions{Typographer: true},
+			markdown: `"Quoted" -- dash...`,
+			want:     "&ldquo;Quoted&rdquo; &ndash; dash&hellip;",
+		},
+		{
+			name:     "attributes",
+			opts:     MarkdownOptions{Attributes: true},
+			markdown: "# Title {#intro .lead}",
+			want:     `<h1 id="intro" class="lead">Title</h1>`,
+		},
+		{
+			name:     "heading anchors",
+			opts:     MarkdownOptions{HeadingAnchors: true},
+			markdown: "## Getting started",
+			want:     `<h2 id="getting-started">Getting started<a href="#getting-started" class="heading-anchor">#</a></h2>`,
+		},
+		{
+			name:     "emoji",
+			opts:     MarkdownOptions{Emoji: true},
+			markdown: "Hi :smile:",
+			want:     "&#x1f604;",
+		},
+		{
+			name:     "math",
+			opts:     MarkdownOptions{Math: true},
+			markdown: "Euler $e^{i\\pi}$",
+			want:     "<math",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewMarkdownProcessorWithOptions(tt.opts).ToHTML([]byte(tt.markdown))
+			if err != nil {
+				t.Fatalf("ToHTML() error = %v", err)
+			}
+			if !strings.Contains(got, tt.want) {
+				t.Errorf("ToHTML() = %q, want it to contain %q", got, tt.want)
+			}
+
+			plain, err := NewMarkdownProcessor().ToHTML([]byte(tt.markdown))
+			if err != nil {
+				t.Fatalf("ToHTML() error = %v", err)
+			}
+			if strings.Contains(plain, tt.want) {
+				t.Errorf("ToHTML() without the option = %q, should not contain %q", plain, tt.want)
+			}
+		})
+	}
+}
+
+func TestServiceMarkdownOptions(t *testing.T) {
+	repo := newMockServiceRepo()
+	repo.paramsByRef[SSGKey.MarkdownFootnotes] = Param{ID: uuid.New(), RefKey: SSGKey.MarkdownFootnotes, Value: "true"}
+	repo.paramsByRef[SSGKey.MarkdownMath] = Param{ID: uuid.New(), RefKey: SSGKey.MarkdownMath, Value: "false"}
+	svc := newTestService(repo)
+
+	got := svc.markdownOptions(context.Background())
+
+	want := MarkdownOptions{Footnotes: true}
+	if got != want {
+		t.Errorf("markdownOptions() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,626 @@
+package ssg
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+// Menu locations. A site has at most one menu per location.
+const (
+	MenuLocationMain    = "main"
+	MenuLocationFooter  = "footer"
+	MenuLocationSubmenu = "submenu"
+)
+
+// Menu item targets.
+const (
+	MenuTargetSection = "section"
+	MenuTargetContent = "content"
+	MenuTargetTag     = "tag"
+	MenuTargetURL     = "url"
+)
+
+// ErrInvalidMenuLocation is returned when a menu location is not one of the
+// known ones.
+var ErrInvalidMenuLocation = errors.New("invalid menu location")
+
+// ErrMenuLocationTaken is returned when another menu of the site already
+// uses a location.
+var ErrMenuLocationTaken = errors.New("menu location already taken")
+
+// ErrInvalidMenuItem is returned when a menu item has no valid target or
+// would nest deeper than two levels.
+var ErrInvalidMenuItem = errors.New("invalid menu item")
+
+// Menu is a navigation menu of a site, shown at its location.
+type Menu struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Site relationship
+	SiteID uuid.UUID `json:"site_id" db:"site_id"`
+
+	// Menu specific fields
+	Name     string     `json:"name" db:"name"`
+	Location string     `json:"location" db:"location"`
+	Items    []MenuItem `json:"items,omitempty" db:"-"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// NewMenu creates a new Menu.
+func NewMenu(name, location string) Menu {
+	return Menu{
+		Name:     name,
+		Location: location,
+	}
+}
+
+// Type returns the type of the entity.
+func (m *Menu) Type() string {
+	return "menu"
+}
+
+// GetID returns the unique identifier of the entity.
+func (m *Menu) GetID() uuid.UUID {
+	return m.ID
+}
+
+// GenID delegates to the functional helper.
+func (m *Menu) GenID() {
+	hm.GenID(m)
+}
+
+// SetID sets the unique identifier of the entity.
+func (m *Menu) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if m.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		m.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (m *Menu) GetShortID() string {
+	return m.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (m *Menu) GenShortID() {
+	hm.GenShortID(m)
+}
+
+// SetShortID sets the short ID of the entity.
+func (m *Menu) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if m.ShortID == "" || shouldForce {
+		m.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (m *Menu) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(m, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (m *Menu) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(m, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (m *Menu) GetCreatedBy() uuid.UUID {
+	return m.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (m *Menu) GetUpdatedBy() uuid.UUID {
+	return m.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (m *Menu) GetCreatedAt() time.Time {
+	return m.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (m *Menu) GetUpdatedAt() time.Time {
+	return m.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (m *Menu) SetCreatedAt(createdAt time.Time) {
+	m.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (m *Menu) SetUpdatedAt(updatedAt time.Time) {
+	m.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (m *Menu) SetCreatedBy(createdBy uuid.UUID) {
+	m.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (m *Menu) SetUpdatedBy(updatedBy uuid.UUID) {
+	m.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the Menu is uninitialized.
+func (m *Menu) IsZero() bool {
+	return m.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (m *Menu) Slug() string {
+	return hm.Normalize(m.Name) + "-" + m.GetShortID()
+}
+
+func (m *Menu) OptValue() string {
+	return m.GetID().String()
+}
+
+func (m *Menu) OptLabel() string {
+	return m.Name
+}
+
+func (m *Menu) Ref() string {
+	return m.ref
+}
+
+func (m *Menu) SetRef(ref string) {
+	m.ref = ref
+}
+
+// MenuItem is an entry of a menu. It points to a section, content or tag of
+// the site, or to a URL. Items without parent are top level; items with one
+// are shown below it. Siblings are ordered by Position.
+type MenuItem struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Menu relationship
+	MenuID   uuid.UUID `json:"menu_id" db:"menu_id"`
+	ParentID uuid.UUID `json:"parent_id" db:"parent_id"`
+
+	// Item specific fields
+	Label      string    `json:"label" db:"label"`
+	TargetType string    `json:"target_type" db:"target_type"`
+	TargetID   uuid.UUID `json:"target_id" db:"target_id"`
+	URL        string    `json:"url" db:"url"`
+	Position   int       `json:"position" db:"position"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// NewMenuItem creates a new MenuItem of menuID.
+func NewMenuItem(menuID uuid.UUID, label, targetType string) MenuItem {
+	return MenuItem{
+		MenuID:     menuID,
+		Label:      label,
+		TargetType: targetType,
+	}
+}
+
+// Type returns the type of the entity.
+func (i *MenuItem) Type() string {
+	return "menu-item"
+}
+
+// GetID returns the unique identifier of the entity.
+func (i *MenuItem) GetID() uuid.UUID {
+	return i.ID
+}
+
+// GenID delegates to the functional helper.
+func (i *MenuItem) GenID() {
+	hm.GenID(i)
+}
+
+// SetID sets the unique identifier of the entity.
+func (i *MenuItem) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if i.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		i.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (i *MenuItem) GetShortID() string {
+	return i.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (i *MenuItem) GenShortID() {
+	hm.GenShortID(i)
+}
+
+// SetShortID sets the short ID of the entity.
+func (i *MenuItem) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if i.ShortID == "" || shouldForce {
+		i.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (i *MenuItem) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(i, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (i *MenuItem) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(i, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (i *MenuItem) GetCreatedBy() uuid.UUID {
+	return i.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (i *MenuItem) GetUpdatedBy() uuid.UUID {
+	return i.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (i *MenuItem) GetCreatedAt() time.Time {
+	return i.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (i *MenuItem) GetUpdatedAt() time.Time {
+	return i.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (i *MenuItem) SetCreatedAt(createdAt time.Time) {
+	i.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (i *MenuItem) SetUpdatedAt(updatedAt time.Time) {
+	i.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (i *MenuItem) SetCreatedBy(createdBy uuid.UUID) {
+	i.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (i *MenuItem) SetUpdatedBy(updatedBy uuid.UUID) {
+	i.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the MenuItem is uninitialized.
+func (i *MenuItem) IsZero() bool {
+	return i.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (i *MenuItem) Slug() string {
+	return hm.Normalize(i.Label) + "-" + i.GetShortID()
+}
+
+func (i *MenuItem) OptValue() string {
+	return i.GetID().String()
+}
+
+func (i *MenuItem) OptLabel() string {
+	return i.Label
+}
+
+func (i *MenuItem) Ref() string {
+	return i.ref
+}
+
+func (i *MenuItem) SetRef(ref string) {
+	i.ref = ref
+}
+
+// ValidMenuLocation reports whether location is a known menu location.
+func ValidMenuLocation(location string) bool {
+	return location == MenuLocationMain || location == MenuLocationFooter || location == MenuLocationSubmenu
+}
+
+// ValidMenuTarget reports whether target is a known menu item target.
+func ValidMenuTarget(target string) bool {
+	switch target {
+	case MenuTargetSection, MenuTargetContent, MenuTargetTag, MenuTargetURL:
+		return true
+	}
+	return false
+}
+
+// SortMenuItems orders items as they are shown: every top level item by
+// position, followed by its children by position.
+func SortMenuItems(items []MenuItem) []MenuItem {
+	byPosition := func(list []MenuItem) {
+		sort.SliceStable(list, func(a, b int) bool {
+			return list[a].Position < list[b].Position
+		})
+	}
+
+	var top []MenuItem
+	children := make(map[uuid.UUID][]MenuItem)
+	for _, item := range items {
+		if item.ParentID == uuid.Nil {
+			top = append(top, item)
+			continue
+		}
+		children[item.ParentID] = append(children[item.ParentID], item)
+	}
+	byPosition(top)
+
+	sorted := make([]MenuItem, 0, len(items))
+	for _, item := range top {
+		sorted = append(sorted, item)
+		kids := children[item.ID]
+		byPosition(kids)
+		sorted = append(sorted, kids...)
+	}
+
+	return sorted
+}
+
+// MenuLink is a resolved link of a menu, ready to be rendered.
+type MenuLink struct {
+	Name     string
+	Path     string
+	External bool       // Points outside the site.
+	Current  bool       // Points to the page being rendered.
+	Active   bool       // The page being rendered is this link or below it.
+	Children []MenuLink // Second level links.
+}
+
+// MenuResolver turns menu items into links to the pages generated for a
+// site, in the locale of each page.
+type MenuResolver struct {
+	mode          string
+	defaultLocale string
+	sections      map[uuid.UUID]Section
+	contents      map[uuid.UUID]Content
+	translations  map[string]map[string]Content
+	tags          map[uuid.UUID]Tag
+}
+
+// NewMenuResolver returns a resolver for the sections, published contents
+// and tags of a site.
+func NewMenuResolver(sections []Section, contents []Content, tags []Tag, mode, defaultLocale string) *MenuResolver {
+	r := &MenuResolver{
+		mode:          mode,
+		defaultLocale: defaultLocale,
+		sections:      make(map[uuid.UUID]Section, len(sections)),
+		contents:      make(map[uuid.UUID]Content, len(contents)),
+		translations:  make(map[string]map[string]Content),
+		tags:          make(map[uuid.UUID]Tag, len(tags)),
+	}
+
+	for _, s := range sections {
+		r.sections[s.ID] = s
+	}
+	for _, c := range contents {
+		if c.Draft {
+			continue
+		}
+		r.contents[c.ID] = c
+		if c.TranslationGroup != "" {
+			if r.translations[c.TranslationGroup] == nil {
+				r.translations[c.TranslationGroup] = make(map[string]Content)
+			}
+			r.translations[c.TranslationGroup][localeOrDefault(c.Locale, defaultLocale)] = c
+		}
+	}
+	for _, t := range tags {
+		r.tags[t.ID] = t
+	}
+
+	return r
+}
+
+// Resolve returns the links of items in locale, nested two levels deep.
+// Items whose target no longer exists, or is a draft, are left out along
+// with their children. The links matching currentPath are flagged.
+func (r *MenuResolver) Resolve(items []MenuItem, locale, currentPath string) []MenuLink {
+	locale = localeOrDefault(locale, r.defaultLocale)
+
+	var links []MenuLink
+	index := make(map[uuid.UUID]int)
+	for _, item := range SortMenuItems(items) {
+		link, ok := r.link(item, locale)
+		if !ok {
+			continue
+		}
+		if item.ParentID == uuid.Nil {
+			index[item.ID] = len(links)
+			links = append(links, link)
+			continue
+		}
+		if i, ok := index[item.ParentID]; ok {
+			links[i].Children = append(links[i].Children, link)
+		}
+	}
+
+	return MarkActiveLinks(links, LocalizePath("/", locale, r.defaultLocale), currentPath)
+}
+
+// link resolves the target of item in locale. Items without label are named
+// after their target.
+func (r *MenuResolver) link(item MenuItem, locale string) (MenuLink, bool) {
+	link := MenuLink{Name: item.Label}
+
+	switch item.TargetType {
+	case MenuTargetSection:
+		section, ok := r.sections[item.TargetID]
+		if !ok || (r.mode != "structured" && strings.Trim(section.Path, "/") != "") {
+			return link, false
+		}
+		link.Path = LocalizePath(sectionIndexPath(section.Path), locale, r.defaultLocale)
+		if link.Name == "" {
+			link.Name = section.Name
+		}
+	case MenuTargetContent:
+		content, ok := r.contents[item.TargetID]
+		if !ok {
+			return link, false
+		}
+		if t, ok := r.translations[content.TranslationGroup][locale]; ok && content.TranslationGroup != "" {
+			content = t
+		}
+		link.Path = content.Permalink
+		if link.Name == "" {
+			link.Name = content.Heading
+		}
+	case MenuTargetTag:
+		tag, ok := r.tags[item.TargetID]
+		if !ok {
+			return link, false
+		}
+		link.Path = LocalizePath(TagIndexPath(tag.Slug()), locale, r.defaultLocale)
+		if link.Name == "" {
+			link.Name = tag.Name
+		}
+	case MenuTargetURL:
+		if item.URL == "" {
+			return link, false
+		}
+		link.Path = item.URL
+		link.External = externalURL(item.URL)
+		if link.Name == "" {
+			link.Name = item.URL
+		}
+	default:
+		return link, false
+	}
+
+	return link, true
+}
+
+// SiteMenus holds the menus of a site by location and resolves them for each
+// generated page.
+type SiteMenus struct {
+	resolver      *MenuResolver
+	items         map[string][]MenuItem
+	sections      []Section
+	mode          string
+	defaultLocale string
+}
+
+// NewSiteMenus returns the menus of a site ready to be resolved against its
+// sections, contents and tags.
+func NewSiteMenus(menus []Menu, sections []Section, contents []Content, tags []Tag, mode, defaultLocale string) *SiteMenus {
+	m := &SiteMenus{
+		resolver:      NewMenuResolver(sections, contents, tags, mode, defaultLocale),
+		items:         make(map[string][]MenuItem, len(menus)),
+		sections:      sections,
+		mode:          mode,
+		defaultLocale: defaultLocale,
+	}
+	for _, menu := range menus {
+		m.items[menu.Location] = menu.Items
+	}
+	return m
+}
+
+// Main returns the main menu of the page at currentPath. Sites without one
+// get DefaultMainMenu.
+func (m *SiteMenus) Main(locale, currentPath string) []MenuLink {
+	if items, ok := m.items[MenuLocationMain]; ok {
+		return m.resolver.Resolve(items, locale, currentPath)
+	}
+	return DefaultMainMenu(m.sections, m.mode, locale, m.defaultLocale, currentPath)
+}
+
+// Footer returns the footer menu of the page at currentPath, if the site has
+// one.
+func (m *SiteMenus) Footer(locale, currentPath string) []MenuLink {
+	return m.resolver.Resolve(m.items[MenuLocationFooter], locale, currentPath)
+}
+
+// Submenu returns the submenu of the page at currentPath and whether the
+// site defines one. Without it, pages show the links built by BuildSubmenu.
+func (m *SiteMenus) Submenu(locale, currentPath string) ([]MenuLink, bool) {
+	items, ok := m.items[MenuLocationSubmenu]
+	if !ok {
+		return nil, false
+	}
+	return m.resolver.Resolve(items, locale, currentPath), true
+}
+
+// DefaultMainMenu returns the main menu of sites without one: a link to the
+// home page followed, in structured mode, by one per top level section with
+// the sections nested in it as children.
+func DefaultMainMenu(sections []Section, mode, locale, defaultLocale, currentPath string) []MenuLink {
+	home := LocalizePath("/", locale, defaultLocale)
+	links := []MenuLink{{Name: Translate(locale, "Home"), Path: home}}
+	if mode == "structured" {
+		sectionLink := func(s Section) MenuLink {
+			return MenuLink{Name: s.Name, Path: LocalizePath(sectionIndexPath(s.Path), locale, defaultLocale)}
+		}
+		for _, s := range TopLevelSections(sections) {
+			link := sectionLink(s)
+			for _, child := range SectionChildren(s.ID, sections) {
+				link.Children = append(link.Children, sectionLink(child))
+			}
+			links = append(links, link)
+		}
+	}
+
+	return MarkActiveLinks(links, home, currentPath)
+}
+
+// MarkActiveLinks flags the links pointing to currentPath as current, and
+// them and the links of the sections containing it as active. The home page,
+// at homePath, is only active on itself.
+func MarkActiveLinks(links []MenuLink, homePath, currentPath string) []MenuLink {
+	for i := range links {
+		l := &links[i]
+		l.Children = MarkActiveLinks(l.Children, homePath, currentPath)
+		l.Current = currentPath != "" && l.Path == currentPath
+		l.Active = l.Current
+		if !l.External && currentPath != "" && l.Path != homePath && strings.HasSuffix(l.Path, "/") && strings.HasPrefix(currentPath, l.Path) {
+			l.Active = true
+		}
+		for _, child := range l.Children {
+			if child.Active {
+				l.Active = true
+			}
+		}
+	}
+	return links
+}
+
+// sectionIndexPath returns the path of the index of a section.
+func sectionIndexPath(sectionPath string) string {
+	p := path.Join("/", sectionPath)
+	if p == "/" {
+		return p
+	}
+	return p + "/"
+}
+
+// externalURL reports whether u points outside the site.
+func externalURL(u string) bool {
+	return strings.Contains(u, "://") || strings.HasPrefix(u, "//") || strings.HasPrefix(u, "mailto:")
+}
@@ -0,0 +1,210 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSortMenuItems(t *testing.T) {
+	docs, about := uuid.New(), uuid.New()
+	items := []MenuItem{
+		{ID: uuid.New(), Label: "API", ParentID: docs, Position: 2},
+		{ID: docs, Label: "Docs", Position: 2},
+		{ID: uuid.New(), Label: "Guides", ParentID: docs, Position: 1},
+		{ID: about, Label: "About", Position: 1},
+	}
+
+	got := SortMenuItems(items)
+
+	want := []string{"About", "Docs", "Guides", "API"}
+	if len(got) != len(want) {
+		t.Fatalf("got %d items, want %d", len(got), len(want))
+	}
+	for i, label := range want {
+		if got[i].Label != label {
+			t.Errorf("item %d = %q, want %q", i, got[i].Label, label)
+		}
+	}
+}
+
+func menuTestSite() ([]Section, []Content, []Tag) {
+	guidesID := uuid.New()
+	sections := []Section{
+		{ID: uuid.New(), Name: "root", Path: "/"},
+		{ID: guidesID, Name: "Guides", Path: "/guides"},
+	}
+	contents := []Content{
+		{ID: uuid.New(), Heading: "About us", Permalink: "/about/", TranslationGroup: "about", Locale: "en"},
+		{ID: uuid.New(), Heading: "Sobre nosotros", Permalink: "/es/about/", TranslationGroup: "about", Locale: "es"},
+		{ID: uuid.New(), Heading: "Draft", Permalink: "/draft/", Draft: true},
+	}
+	tags := []Tag{{ID: uuid.New(), ShortID: "a1b2c3", Name: "Go Lang"}}
+	return sections, contents, tags
+}
+
+func TestMenuResolverResolve(t *testing.T) {
+	sections, contents, tags := menuTestSite()
+	guides := sections[1]
+	docs := uuid.New()
+	items := []MenuItem{
+		{ID: docs, TargetType: MenuTargetSection, TargetID: guides.ID, Position: 1},
+		{ID: uuid.New(), ParentID: docs, Label: "Go", TargetType: MenuTargetTag, TargetID: tags[0].ID, Position: 1},
+		{ID: uuid.New(), TargetType: MenuTargetContent, TargetID: contents[0].ID, Position: 2},
+		{ID: uuid.New(), Label: "Code", TargetType: MenuTargetURL, URL: "https://github.com/hermesgen/clio", Position: 3},
+		{ID: uuid.New(), Label: "Gone", TargetType: MenuTargetSection, TargetID: uuid.New(), Position: 4},
+		{ID: uuid.New(), Label: "Hidden", TargetType: MenuTargetContent, TargetID: contents[2].ID, Position: 5},
+	}
+
+	resolver := NewMenuResolver(sections, contents, tags, "structured", "en")
+
+	tests := []struct {
+		name        string
+		locale      string
+		currentPath string
+		want        []MenuLink
+	}{
+		{
+			name:        "default locale",
+			currentPath: "/tags/go-lang-a1b2c3/",
+			want: []MenuLink{
+				{Name: "Guides", Path: "/guides/", Active: true, Children: []MenuLink{
+					{Name: "Go", Path: "/tags/go-lang-a1b2c3/", Current: true, Active: true},
+				}},
+				{Name: "About us", Path: "/about/"},
+				{Name: "Code", Path: "https://github.com/hermesgen/clio", External: true},
+			},
+		},
+		{
+			name:        "translated targets",
+			locale:      "es",
+			currentPath: "/es/guides/post/",
+			want: []MenuLink{
+				{Name: "Guides", Path: "/es/guides/", Active: true, Children: []MenuLink{
+					{Name: "Go", Path: "/es/tags/go-lang-a1b2c3/"},
+				}},
+				{Name: "Sobre nosotros", Path: "/es/about/"},
+				{Name: "Code", Path: "https://github.com/hermesgen/clio", External: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolver.Resolve(items, tt.locale, tt.currentPath)
+			assertMenuLinks(t, got, tt.want)
+		})
+	}
+}
+
+func TestMenuResolverBlogModeSkipsSections(t *testing.T) {
+	sections, contents, tags := menuTestSite()
+	items := []MenuItem{
+		{ID: uuid.New(), TargetType: MenuTargetSection, TargetID: sections[0].ID, Label: "Home", Position: 1},
+		{ID: uuid.New(), TargetType: MenuTargetSection, TargetID: sections[1].ID, Position: 2},
+	}
+
+	got := NewMenuResolver(sections, contents, tags, "blog", "en").Resolve(items, "en", "/")
+
+	assertMenuLinks(t, got, []MenuLink{{Name: "Home", Path: "/", Current: true, Active: true}})
+}
+
+func TestDefaultMainMenu(t *testing.T) {
+	sections, _, _ := menuTestSite()
+
+	tests := []struct {
+		name        string
+		mode        string
+		locale      string
+		currentPath string
+		want        []MenuLink
+	}{
+		{
+			name:        "structured lists sections",
+			mode:        "structured",
+			currentPath: "/guides/post/",
+			want: []MenuLink{
+				{Name: "Home", Path: "/"},
+				{Name: "Guides", Path: "/guides/", Active: true},
+			},
+		},
+		{
+			name:        "localized home is only active on itself",
+			mode:        "structured",
+			locale:      "es",
+			currentPath: "/es/guides/",
+			want: []MenuLink{
+				{Name: "Inicio", Path: "/es/"},
+				{Name: "Guides", Path: "/es/guides/", Current: true, Active: true},
+			},
+		},
+		{
+			name:        "blog mode has only home",
+			mode:        "blog",
+			currentPath: "/",
+			want:        []MenuLink{{Name: "Home", Path: "/", Current: true, Active: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DefaultMainMenu(sections, tt.mode, tt.locale, "en", tt.currentPath)
+			assertMenuLinks(t, got, tt.want)
+		})
+	}
+}
+
+func TestDefaultMainMenuNestsSections(t *testing.T) {
+	guides := Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	sections := []Section{
+		{ID: uuid.New(), Name: "root", Path: "/"},
+		guides,
+		{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID},
+	}
+
+	got := DefaultMainMenu(sections, "structured", "", "en", "/guides/go/")
+
+	assertMenuLinks(t, got, []MenuLink{
+		{Name: "Home", Path: "/"},
+		{Name: "Guides", Path: "/guides/", Active: true, Children: []MenuLink{
+			{Name: "Go", Path: "/guides/go/", Current: true, Active: true},
+		}},
+	})
+}
+
+func TestSiteMenus(t *testing.T) {
+	sections, contents, tags := menuTestSite()
+	menus := []Menu{
+		{Location: MenuLocationFooter, Items: []MenuItem{
+			{ID: uuid.New(), Label: "Contact", TargetType: MenuTargetURL, URL: "mailto:hi@example.com"},
+		}},
+		{Location: MenuLocationSubmenu},
+	}
+
+	siteMenus := NewSiteMenus(menus, sections, contents, tags, "structured", "en")
+
+	if got := siteMenus.Main("en", "/"); len(got) != 2 || got[0].Name != "Home" {
+		t.Errorf("Main() = %+v, want default main menu", got)
+	}
+	assertMenuLinks(t, siteMenus.Footer("en", "/"), []MenuLink{{Name: "Contact", Path: "mailto:hi@example.com", External: true}})
+	if got, ok := siteMenus.Submenu("en", "/"); !ok || len(got) != 0 {
+		t.Errorf("Submenu() = %+v, %v, want empty custom submenu", got, ok)
+	}
+	if _, ok := NewSiteMenus(nil, sections, contents, tags, "structured", "en").Submenu("en", "/"); ok {
+		t.Errorf("Submenu() ok = true without submenu, want false")
+	}
+}
+
+func assertMenuLinks(t *testing.T, got, want []MenuLink) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %+v, want %+v", got, want)
+	}
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Name != w.Name || g.Path != w.Path || g.External != w.External || g.Current != w.Current || g.Active != w.Active {
+			t.Errorf("link %d = %+v, want %+v", i, g, w)
+		}
+		assertMenuLinks(t, g.Children, w.Children)
+	}
+}
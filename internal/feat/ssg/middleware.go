@@ -136,6 +136,31 @@ func (mw *SiteContextMw) APIHandler(next http.Handler) http.Handler {
 	})
 }
 
+// PublicHandler injects the site named by the site query parameter, for
+// requests coming from generated pages.
+func (mw *SiteContextMw) PublicHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		siteSlug := r.URL.Query().Get("site")
+		if siteSlug == "" {
+			http.Error(w, "site parameter is required", http.StatusBadRequest)
+			return
+		}
+
+		site, err := mw.siteRepoProvider.GetSiteBySlug(ctx, siteSlug)
+		if err != nil {
+			mw.Log().Info("Site not found", "slug", siteSlug)
+			http.Error(w, "Site not found", http.StatusNotFound)
+			return
+		}
+
+		ctx = context.WithValue(ctx, siteSlugKey, siteSlug)
+		ctx = context.WithValue(ctx, siteIDKey, site.ID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
 func (mw *SiteContextMw) Handler(next http.Handler) http.Handler {
 	return mw.WebHandler(next)
 }
@@ -2,14 +2,20 @@ package ssg
 
 import (
 	"html/template"
+	"time"
 
 	"github.com/hermesgen/hm"
 )
 
 // PageData holds all the data needed to render a complete HTML page.
 type PageData struct {
-	HeaderStyle        string
-	AssetPath          string
+	Site        SiteData
+	HeaderStyle string
+	AssetPath   string
+	// Menu lists the top level sections in structured mode.
+	//
+	// Deprecated: use MainMenu, which follows the menus edited in the
+	// admin. Menu is kept for layouts written before site menus.
 	Menu               []Section
 	IsIndex            bool
 	ListPageContent    []Content
@@ -19,6 +25,28 @@ type PageData struct {
 	Config             *hm.Config
 	Search             SearchData
 	SectionHeaderImage string
+	SEO                SEO
+	Breadcrumbs        []Breadcrumb
+	StructuredData     template.JS
+	Locale             string
+	HomePath           string
+	Translations       []Translation
+	IsArchive          bool
+	Archive            []ArchiveYear
+	Submenu            []MenuLink
+	MainMenu           []MenuLink
+	FooterMenu         []MenuLink
+	Composition        *IndexComposition
+	Author             *Author
+	Theme              ThemeInfo
+	// Feed is the URL of the feed of the page locale, empty when the site
+	// has no feeds.
+	Feed string
+}
+
+// T returns the UI string key in the page locale, formatted with args if any.
+func (p PageData) T(key string, args ...any) string {
+	return translate(p.Locale, key, args...)
 }
 
 // SearchData holds the configuration for the search functionality.
@@ -34,8 +62,21 @@ type PageContent struct {
 	HeaderImage        string
 	HeaderImageAlt     string
 	HeaderImageCaption string
+	SocialImage        string
+	PublishedAt        *time.Time
 	Body               template.HTML
 	Kind               string
+	Excerpt            string
+	WordCount          int
+	ReadingTime        int
+	Authors            []Author
+	Fields             map[string]any
+	Layout             string
+	// CommentsOpen is true when the content takes comments, which are
+	// posted to CommentURL. Comments holds the approved ones.
+	CommentsOpen bool
+	CommentURL   string
+	Comments     []Comment
 }
 
 // PaginationData holds data for rendering pagination controls.
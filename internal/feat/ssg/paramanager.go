@@ -68,6 +68,25 @@ func (pm *ParamManager) GetSiteMode(ctx context.Context) string {
 	return mode
 }
 
+// GetSiteName returns the display name of the site, or def when not set.
+func (pm *ParamManager) GetSiteName(ctx context.Context, def string) string {
+	if name := pm.Get(ctx, SSGKey.SiteName, ""); name != "" {
+		return name
+	}
+	return def
+}
+
+// GetSiteLocale returns the default locale of the site, DefaultLocale when
+// not set or invalid.
+func (pm *ParamManager) GetSiteLocale(ctx context.Context) string {
+	locale := NormalizeLocale(pm.Get(ctx, SSGKey.SiteLocale, DefaultLocale))
+	if !ValidLocale(locale) {
+		pm.Log().Error("Invalid site locale, defaulting to "+DefaultLocale, "locale", locale)
+		return DefaultLocale
+	}
+	return locale
+}
+
 // SetSiteMode sets the site mode to either "structured" or "blog".
 func (pm *ParamManager) SetSiteMode(ctx context.Context, mode string) error {
 	if pm.repo == nil {
@@ -2,6 +2,7 @@ package ssg
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"testing"
 
@@ -82,7 +83,11 @@ func (m *mockRepo) CreateContent(ctx context.Context, content *Content) error {
 func (m *mockRepo) GetContent(ctx context.Context, id uuid.UUID) (Content, error) {
 	return Content{}, nil
 }
+func (m *mockRepo) GetContentBySlug(ctx context.Context, slug string) (Content, error) {
+	return Content{}, sql.ErrNoRows
+}
 func (m *mockRepo) UpdateContent(ctx context.Context, content *Content) error { return nil }
+func (m *mockRepo) MoveContent(ctx context.Context, move ContentMove) error    { return nil }
 func (m *mockRepo) DeleteContent(ctx context.Context, id uuid.UUID) error     { return nil }
 func (m *mockRepo) GetAllContentWithMeta(ctx context.Context) ([]Content, error) {
 	return nil, nil
@@ -96,6 +101,7 @@ func (m *mockRepo) GetSection(ctx context.Context, id uuid.UUID) (Section, error
 }
 func (m *mockRepo) GetSections(ctx context.Context) ([]Section, error)   { return nil, nil }
 func (m *mockRepo) UpdateSection(ctx context.Context, section Section) error { return nil }
+func (m *mockRepo) MoveSections(ctx context.Context, move SectionMove) error { return nil }
 func (m *mockRepo) DeleteSection(ctx context.Context, id uuid.UUID) error    { return nil }
 func (m *mockRepo) CreateLayout(ctx context.Context, layout Layout) error    { return nil }
 func (m *mockRepo) GetLayout(ctx context.Context, id uuid.UUID) (Layout, error) {
@@ -114,6 +120,79 @@ func (m *mockRepo) GetTagByName(ctx context.Context, name string) (Tag, error) {
 func (m *mockRepo) GetAllTags(ctx context.Context) ([]Tag, error)   { return nil, nil }
 func (m *mockRepo) UpdateTag(ctx context.Context, tag Tag) error    { return nil }
 func (m *mockRepo) DeleteTag(ctx context.Context, id uuid.UUID) error { return nil }
+func (m *mockRepo) CreateSeries(ctx context.Context, series Series) error { return nil }
+func (m *mockRepo) GetSeries(ctx context.Context, id uuid.UUID) (Series, error) {
+	return Series{}, nil
+}
+func (m *mockRepo) GetAllSeries(ctx context.Context) ([]Series, error)        { return nil, nil }
+func (m *mockRepo) UpdateSeries(ctx context.Context, series Series) error     { return nil }
+func (m *mockRepo) DeleteSeries(ctx context.Context, id uuid.UUID) error      { return nil }
+func (m *mockRepo) UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error {
+	return nil
+}
+func (m *mockRepo) CreateAuthor(ctx context.Context, author Author) error       { return nil }
+func (m *mockRepo) GetAuthor(ctx context.Context, id uuid.UUID) (Author, error) { return Author{}, nil }
+func (m *mockRepo) GetAllAuthors(ctx context.Context) ([]Author, error)         { return nil, nil }
+func (m *mockRepo) UpdateAuthor(ctx context.Context, author Author) error       { return nil }
+func (m *mockRepo) DeleteAuthor(ctx context.Context, id uuid.UUID) error        { return nil }
+func (m *mockRepo) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]Author, error) {
+	return nil, nil
+}
+func (m *mockRepo) GetContentAuthors(ctx context.Context) ([]ContentAuthor, error) { return nil, nil }
+func (m *mockRepo) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error {
+	return nil
+}
+func (m *mockRepo) CreateContentType(ctx context.Context, contentType ContentType) error { return nil }
+func (m *mockRepo) GetContentType(ctx context.Context, id uuid.UUID) (ContentType, error) {
+	return ContentType{}, nil
+}
+func (m *mockRepo) GetContentTypes(ctx context.Context) ([]ContentType, error)              { return nil, nil }
+func (m *mockRepo) UpdateContentType(ctx context.Context, contentType ContentType) error { return nil }
+func (m *mockRepo) DeleteContentType(ctx context.Context, id uuid.UUID) error             { return nil }
+func (m *mockRepo) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]ContentField, error) {
+	return nil, nil
+}
+func (m *mockRepo) GetContentFields(ctx context.Context) ([]ContentField, error) { return nil, nil }
+func (m *mockRepo) SetContentFields(ctx context.Context, contentID uuid.UUID, fields []ContentField) error {
+	return nil
+}
+func (m *mockRepo) CreateArchetype(ctx context.Context, archetype Archetype) error { return nil }
+func (m *mockRepo) GetArchetype(ctx context.Context, id uuid.UUID) (Archetype, error) {
+	return Archetype{}, nil
+}
+func (m *mockRepo) GetArchetypes(ctx context.Context) ([]Archetype, error)         { return nil, nil }
+func (m *mockRepo) UpdateArchetype(ctx context.Context, archetype Archetype) error { return nil }
+func (m *mockRepo) DeleteArchetype(ctx context.Context, id uuid.UUID) error        { return nil }
+func (m *mockRepo) CreateComment(ctx context.Context, comment Comment) error       { return nil }
+func (m *mockRepo) GetComment(ctx context.Context, id uuid.UUID) (Comment, error) {
+	return Comment{}, nil
+}
+func (m *mockRepo) GetComments(ctx context.Context, status string) ([]Comment, error) {
+	return nil, nil
+}
+func (m *mockRepo) UpdateComment(ctx context.Context, comment Comment) error         { return nil }
+func (m *mockRepo) DeleteComment(ctx context.Context, id uuid.UUID) error            { return nil }
+func (m *mockRepo) AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error { return nil }
+func (m *mockRepo) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+func (m *mockRepo) ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error {
+	return nil
+}
+func (m *mockRepo) CreateMenu(ctx context.Context, menu Menu) error         { return nil }
+func (m *mockRepo) GetMenu(ctx context.Context, id uuid.UUID) (Menu, error) { return Menu{}, nil }
+func (m *mockRepo) GetMenus(ctx context.Context) ([]Menu, error)            { return nil, nil }
+func (m *mockRepo) UpdateMenu(ctx context.Context, menu Menu) error         { return nil }
+func (m *mockRepo) DeleteMenu(ctx context.Context, id uuid.UUID) error      { return nil }
+func (m *mockRepo) CreateMenuItem(ctx context.Context, item MenuItem) error { return nil }
+func (m *mockRepo) GetMenuItem(ctx context.Context, id uuid.UUID) (MenuItem, error) {
+	return MenuItem{}, nil
+}
+func (m *mockRepo) GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]MenuItem, error) {
+	return nil, nil
+}
+func (m *mockRepo) UpdateMenuItem(ctx context.Context, item MenuItem) error { return nil }
+func (m *mockRepo) DeleteMenuItem(ctx context.Context, id uuid.UUID) error  { return nil }
 func (m *mockRepo) GetParam(ctx context.Context, id uuid.UUID) (Param, error) {
 	return Param{}, nil
 }
@@ -152,6 +231,14 @@ func (m *mockRepo) DeleteContentImage(ctx context.Context, id uuid.UUID) error {
 func (m *mockRepo) GetContentImagesByContentID(ctx context.Context, contentID uuid.UUID) ([]ContentImage, error) {
 	return nil, nil
 }
+func (m *mockRepo) CreateContentAlias(ctx context.Context, alias *ContentAlias) error { return nil }
+func (m *mockRepo) GetContentAliases(ctx context.Context) ([]ContentAlias, error) {
+	return nil, nil
+}
+func (m *mockRepo) GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]ContentAlias, error) {
+	return nil, nil
+}
+func (m *mockRepo) DeleteContentAlias(ctx context.Context, id uuid.UUID) error { return nil }
 func (m *mockRepo) CreateSectionImage(ctx context.Context, sectionImage *SectionImage) error {
 	return nil
 }
@@ -2,23 +2,103 @@ package ssg
 
 import (
 	"fmt"
+	"path"
 	"path/filepath"
 	"strings"
 )
 
 // GetContentPath returns the URL path for a content item based on site mode.
+// A permalink resolved from the site pattern takes precedence, otherwise:
 // In structured mode: /{section-path}/{slug}/
 // In blog mode: /{slug}/
+// The trailing slash is not included.
 func GetContentPath(content Content, mode string) string {
-	slug := content.Slug()
+	permalink := content.Permalink
+	if permalink == "" {
+		permalink = ExpandPermalink(DefaultPermalinkPattern(mode), content)
+	}
+
+	return strings.TrimSuffix(permalink, "/")
+}
 
+// DefaultPermalinkPattern returns the permalink pattern used when the site
+// does not set one.
+func DefaultPermalinkPattern(mode string) string {
 	if mode == "blog" {
-		// Blog mode: all content at root
-		return filepath.Join("/", slug, "/")
+		return "/:slug/"
+	}
+	return "/:section/:slug/"
+}
+
+// ValidPermalinkPattern reports whether pattern can be used to build content
+// URLs. It must include :slug so every content gets a unique URL.
+func ValidPermalinkPattern(pattern string) bool {
+	return strings.Contains(pattern, ":slug")
+}
+
+// ExpandPermalink replaces the pattern tokens with the values of content and
+// returns a clean URL path with a trailing slash. Supported tokens are
+// :section, :slug, :kind, :year, :month and :day. Dates come from the
+// publication date, or the creation date for unpublished content.
+// Index pages ignore the pattern and take the path of their section.
+func ExpandPermalink(pattern string, content Content) string {
+	if IsIndexPage(content) {
+		pattern = "/:section/"
+	}
+
+	date := content.CreatedAt
+	if content.PublishedAt != nil && !content.PublishedAt.IsZero() {
+		date = *content.PublishedAt
 	}
 
-	// Structured mode: respect section paths
-	return filepath.Join("/", content.SectionPath, slug, "/")
+	r := strings.NewReplacer(
+		":section", strings.Trim(content.SectionPath, "/"),
+		":slug", content.Slug(),
+		":kind", strings.ToLower(content.Kind),
+		":year", fmt.Sprintf("%04d", date.Year()),
+		":month", fmt.Sprintf("%02d", int(date.Month())),
+		":day", fmt.Sprintf("%02d", date.Day()),
+	)
+
+	p := path.Join("/", r.Replace(pattern))
+	if p == "/" {
+		return p
+	}
+	return p + "/"
+}
+
+// IsIndexPage reports whether content is a manual index page: a page with
+// the slug index, rendered in place of the generated index of its section.
+func IsIndexPage(content Content) bool {
+	return strings.ToLower(content.Kind) == "page" && content.Slug() == "index"
+}
+
+// ContentPermalink returns the public path of content: the pattern expanded
+// and, for content not in the default locale, prefixed with its locale.
+func ContentPermalink(pattern string, content Content, defaultLocale string) string {
+	return LocalizePath(ExpandPermalink(pattern, content), content.Locale, defaultLocale)
+}
+
+// LocalePrefix returns the path prefix of locale, e.g. /es. Content in the
+// default locale, or without one, is published unprefixed.
+func LocalePrefix(locale, defaultLocale string) string {
+	if locale == "" || locale == defaultLocale {
+		return ""
+	}
+	return "/" + locale
+}
+
+// LocalizePath prefixes p with the locale prefix. Already prefixed paths are
+// returned unchanged.
+func LocalizePath(p, locale, defaultLocale string) string {
+	prefix := LocalePrefix(locale, defaultLocale)
+	if prefix == "" || p == prefix || strings.HasPrefix(p, prefix+"/") {
+		return p
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return prefix + p
 }
 
 // GetIndexPath returns the URL path for an index based on content type and site mode.
@@ -77,10 +157,18 @@ func GetPaginationPath(indexPath string, page int, mode string) string {
 // GetContentFilePath returns the filesystem path for a content HTML file.
 // This is used for HTML generation.
 func GetContentFilePath(htmlPath string, content Content, mode string) string {
-	if mode == "blog" {
-		return filepath.Join(htmlPath, content.Slug(), "index.html")
-	}
-	return filepath.Join(htmlPath, content.SectionPath, content.Slug(), "index.html")
+	return filepath.Join(htmlPath, filepath.FromSlash(GetContentPath(content, mode)), "index.html")
+}
+
+// GetSocialCardPath returns the URL path of the social card of a content item.
+func GetSocialCardPath(content Content, mode string) string {
+	return path.Join(GetContentPath(content, mode), SocialCardFile)
+}
+
+// GetSocialCardFilePath returns the filesystem path for a content social card,
+// next to the content HTML file.
+func GetSocialCardFilePath(htmlPath string, content Content, mode string) string {
+	return filepath.Join(filepath.Dir(GetContentFilePath(htmlPath, content, mode)), SocialCardFile)
 }
 
 // GetIndexFilePath returns the filesystem path for an index HTML file.
@@ -146,6 +234,24 @@ func GetSiteAssetsPath(sitesBasePath, siteSlug string) string {
 	return filepath.Join(GetSiteDocsPath(sitesBasePath, siteSlug), "assets")
 }
 
+// GetSiteDataPath returns the path of the data files of a site, next to its
+// markdown tree.
+func GetSiteDataPath(sitesBasePath, siteSlug string) string {
+	return filepath.Join(GetSiteDocsPath(sitesBasePath, siteSlug), "data")
+}
+
+// GetSiteThemePath returns the path of the theme overrides of a site: files
+// laid out like a theme that replace the ones of the site theme.
+func GetSiteThemePath(sitesBasePath, siteSlug string) string {
+	return filepath.Join(GetSiteDocsPath(sitesBasePath, siteSlug), "theme")
+}
+
+// GetThemesPath returns the path of the themes installed in a workspace.
+// e.g., _workspace/themes
+func GetThemesPath(workspacePath string) string {
+	return filepath.Join(workspacePath, "themes")
+}
+
 // GetSiteImagesPath returns the images path for a specific site.
 func GetSiteImagesPath(sitesBasePath, siteSlug string) string {
 	return filepath.Join(GetSiteAssetsPath(sitesBasePath, siteSlug), "images")
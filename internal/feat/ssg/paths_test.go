@@ -2,6 +2,7 @@ package ssg
 
 import (
 	"testing"
+	"time"
 )
 
 func TestGetContentPath(t *testing.T) {
@@ -41,6 +42,17 @@ func TestGetContentPath(t *testing.T) {
 			mode: "structured",
 			want: "/home-page-def456",
 		},
+		{
+			name: "resolved permalink takes precedence",
+			content: Content{
+				Heading:     "My Article",
+				ShortID:     "xyz789",
+				SectionPath: "docs/guides",
+				Permalink:   "/2025/03/my-article/",
+			},
+			mode: "structured",
+			want: "/2025/03/my-article",
+		},
 	}
 
 	for _, tt := range tests {
@@ -54,6 +66,111 @@ func TestGetContentPath(t *testing.T) {
 	}
 }
 
+func TestExpandPermalink(t *testing.T) {
+	published := time.Date(2025, 3, 7, 10, 0, 0, 0, time.UTC)
+	content := Content{
+		Heading:     "My Article",
+		ShortID:     "xyz789",
+		SlugField:   "my-article",
+		Kind:        "Blog",
+		SectionPath: "/docs/guides",
+		PublishedAt: &published,
+		CreatedAt:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		content Content
+		want    string
+	}{
+		{
+			name:    "section and slug",
+			pattern: "/:section/:slug/",
+			content: content,
+			want:    "/docs/guides/my-article/",
+		},
+		{
+			name:    "date based",
+			pattern: "/:year/:month/:day/:slug/",
+			content: content,
+			want:    "/2025/03/07/my-article/",
+		},
+		{
+			name:    "slug only",
+			pattern: "/:slug/",
+			content: content,
+			want:    "/my-article/",
+		},
+		{
+			name:    "kind prefix without slashes",
+			pattern: ":kind/:slug",
+			content: content,
+			want:    "/blog/my-article/",
+		},
+		{
+			name:    "root section collapses",
+			pattern: "/:section/:slug/",
+			content: Content{Heading: "Home Page", ShortID: "def456", SectionPath: "/"},
+			want:    "/home-page-def456/",
+		},
+		{
+			name:    "unpublished content uses creation date",
+			pattern: "/:year/:slug/",
+			content: Content{SlugField: "draft", CreatedAt: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+			want:    "/2024/draft/",
+		},
+		{
+			name:    "index page takes its section path",
+			pattern: "/:year/:slug/",
+			content: Content{SlugField: "index", Kind: "page", SectionPath: "/docs/guides", PublishedAt: &published},
+			want:    "/docs/guides/",
+		},
+		{
+			name:    "root index page is the home page",
+			pattern: "/:section/:slug/",
+			content: Content{SlugField: "index", Kind: "Page", SectionPath: "/"},
+			want:    "/",
+		},
+		{
+			name:    "index slug of other kinds is kept",
+			pattern: "/:section/:slug/",
+			content: Content{SlugField: "index", Kind: "article", SectionPath: "/docs"},
+			want:    "/docs/index/",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandPermalink(tt.pattern, tt.content)
+
+			if got != tt.want {
+				t.Errorf("ExpandPermalink() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidPermalinkPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    bool
+	}{
+		{pattern: "/:section/:slug/", want: true},
+		{pattern: "/:year/:month/:slug/", want: true},
+		{pattern: "/:year/:month/", want: false},
+		{pattern: "", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			if got := ValidPermalinkPattern(tt.pattern); got != tt.want {
+				t.Errorf("ValidPermalinkPattern(%q) = %v, want %v", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetIndexPath(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -196,6 +313,18 @@ func TestGetContentFilePath(t *testing.T) {
 			mode: "structured",
 			want: "/var/www/html/docs/tutorials/guide-xyz789/index.html",
 		},
+		{
+			name:     "resolved permalink",
+			htmlPath: "/var/www/html",
+			content: Content{
+				Heading:     "Guide",
+				ShortID:     "xyz789",
+				SectionPath: "docs/tutorials",
+				Permalink:   "/2025/03/guide/",
+			},
+			mode: "structured",
+			want: "/var/www/html/2025/03/guide/index.html",
+		},
 	}
 
 	for _, tt := range tests {
@@ -209,6 +338,45 @@ func TestGetContentFilePath(t *testing.T) {
 	}
 }
 
+func TestGetSocialCardPaths(t *testing.T) {
+	content := Content{
+		Heading:     "Guide",
+		ShortID:     "xyz789",
+		SectionPath: "docs",
+	}
+
+	tests := []struct {
+		name     string
+		mode     string
+		wantURL  string
+		wantFile string
+	}{
+		{
+			name:     "blog mode",
+			mode:     "blog",
+			wantURL:  "/guide-xyz789/social-card.png",
+			wantFile: "/var/www/html/guide-xyz789/social-card.png",
+		},
+		{
+			name:     "structured mode",
+			mode:     "structured",
+			wantURL:  "/docs/guide-xyz789/social-card.png",
+			wantFile: "/var/www/html/docs/guide-xyz789/social-card.png",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetSocialCardPath(content, tt.mode); got != tt.wantURL {
+				t.Errorf("GetSocialCardPath() = %v, want %v", got, tt.wantURL)
+			}
+			if got := GetSocialCardFilePath("/var/www/html", content, tt.mode); got != tt.wantFile {
+				t.Errorf("GetSocialCardFilePath() = %v, want %v", got, tt.wantFile)
+			}
+		})
+	}
+}
+
 func TestGetIndexFilePath(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -551,3 +719,39 @@ func TestGetSiteImagesPath(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalizePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		locale string
+		want   string
+	}{
+		{name: "default locale is unprefixed", path: "/blog/post/", locale: "en", want: "/blog/post/"},
+		{name: "empty locale is unprefixed", path: "/blog/post/", locale: "", want: "/blog/post/"},
+		{name: "other locale is prefixed", path: "/blog/post/", locale: "es", want: "/es/blog/post/"},
+		{name: "root is prefixed", path: "/", locale: "es", want: "/es/"},
+		{name: "relative path is made absolute", path: "blog", locale: "es", want: "/es/blog"},
+		{name: "prefixed path is unchanged", path: "/es/blog/", locale: "es", want: "/es/blog/"},
+		{name: "similar prefix is prefixed", path: "/espresso/", locale: "es", want: "/es/espresso/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := LocalizePath(tt.path, tt.locale, "en"); got != tt.want {
+				t.Errorf("LocalizePath(%q, %q) = %q, want %q", tt.path, tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContentPermalink(t *testing.T) {
+	content := Content{Heading: "Hola", ShortID: "abc123", SectionPath: "blog", Locale: "es"}
+
+	if got := ContentPermalink("/:section/:slug/", content, "en"); got != "/es/blog/hola-abc123/" {
+		t.Errorf("ContentPermalink() = %q, want %q", got, "/es/blog/hola-abc123/")
+	}
+	if got := ContentPermalink("/:section/:slug/", content, "es"); got != "/blog/hola-abc123/" {
+		t.Errorf("ContentPermalink() in default locale = %q, want %q", got, "/blog/hola-abc123/")
+	}
+}
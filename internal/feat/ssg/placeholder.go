@@ -0,0 +1,128 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/jpeg"
+
+	"github.com/google/uuid"
+	"golang.org/x/image/draw"
+)
+
+const (
+	// PlaceholderVariantKind identifies the image variant holding the low quality placeholder.
+	PlaceholderVariantKind = "placeholder"
+
+	placeholderMaxSide = 16
+	placeholderQuality = 40
+)
+
+// Placeholder is a tiny rendition of an image shown while the real one loads.
+type Placeholder struct {
+	DataURI       string // Inline base64 JPEG, meant to be upscaled and blurred by CSS
+	DominantColor string // Hex color, e.g. #a1b2c3
+	Width         int
+	Height        int
+	Size          int64
+}
+
+// GeneratePlaceholder decodes an image and derives its low quality placeholder
+// and dominant color. The orientation is applied when data still carries it.
+// Images over MaxImagePixels are rejected.
+func GeneratePlaceholder(data []byte, orientation int) (*Placeholder, error) {
+	img, _, err := decodeImage(data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Scale first, rotating the thumbnail is much cheaper than the image
+	bounds := img.Bounds()
+	w, h := placeholderSize(bounds.Dx(), bounds.Dy())
+	small := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.ApproxBiLinear.Scale(small, small.Bounds(), img, bounds, draw.Src, nil)
+	small = orientRGBA(small, orientation)
+	w, h = small.Bounds().Dx(), small.Bounds().Dy()
+
+	// JPEG has no alpha channel, flatten transparent areas onto white
+	flat := image.NewRGBA(small.Bounds())
+	draw.Draw(flat, flat.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(flat, flat.Bounds(), small, image.Point{}, draw.Over)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, flat, &jpeg.Options{Quality: placeholderQuality}); err != nil {
+		return nil, fmt.Errorf("cannot encode placeholder: %w", err)
+	}
+
+	return &Placeholder{
+		DataURI:       "data:image/jpeg;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()),
+		DominantColor: dominantColor(small),
+		Width:         w,
+		Height:        h,
+		Size:          int64(buf.Len()),
+	}, nil
+}
+
+// Variant returns the placeholder as an image variant of imageID.
+func (p *Placeholder) Variant(imageID uuid.UUID) ImageVariant {
+	variant := NewImageVariant()
+	variant.ImageID = imageID
+	variant.Kind = PlaceholderVariantKind
+	variant.Width = p.Width
+	variant.Height = p.Height
+	variant.FilesizeByte = p.Size
+	variant.Mime = "image/jpeg"
+	variant.BlobRef = p.DataURI
+	return variant
+}
+
+func placeholderSize(w, h int) (int, int) {
+	if w <= 0 || h <= 0 {
+		return 1, 1
+	}
+	if w >= h {
+		return placeholderMaxSide, max(1, h*placeholderMaxSide/w)
+	}
+	return max(1, w*placeholderMaxSide/h), placeholderMaxSide
+}
+
+// dominantColor buckets pixels in a coarse color cube and returns the
+// average color of the most populated bucket. Transparent pixels are ignored.
+func dominantColor(img *image.RGBA) string {
+	type bucket struct {
+		count   int
+		r, g, b int
+	}
+	buckets := make(map[int]*bucket)
+
+	var best *bucket
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			c := img.RGBAAt(x, y)
+			if c.A < 128 {
+				continue
+			}
+			key := int(c.R>>5)<<6 | int(c.G>>5)<<3 | int(c.B>>5)
+			bk, ok := buckets[key]
+			if !ok {
+				bk = &bucket{}
+				buckets[key] = bk
+			}
+			bk.count++
+			bk.r += int(c.R)
+			bk.g += int(c.G)
+			bk.b += int(c.B)
+			if best == nil || bk.count > best.count {
+				best = bk
+			}
+		}
+	}
+
+	if best == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", best.r/best.count, best.g/best.count, best.b/best.count)
+}
@@ -0,0 +1,81 @@
+package ssg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestGeneratePlaceholder(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 64, 32))
+	for y := 0; y < 32; y++ {
+		for x := 0; x < 64; x++ {
+			c := color.RGBA{200, 30, 30, 255}
+			if x < 8 {
+				c = color.RGBA{20, 20, 200, 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("cannot encode png: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		orientation int
+		wantWidth   int
+		wantHeight  int
+	}{
+		{name: "keeps aspect ratio", orientation: 1, wantWidth: 16, wantHeight: 8},
+		{name: "applies orientation", orientation: 6, wantWidth: 8, wantHeight: 16},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := GeneratePlaceholder(buf.Bytes(), tt.orientation)
+			if err != nil {
+				t.Fatalf("GeneratePlaceholder() error = %v", err)
+			}
+
+			if !strings.HasPrefix(p.DataURI, "data:image/jpeg;base64,") {
+				t.Errorf("DataURI = %q, want jpeg data URI", p.DataURI)
+			}
+			if p.Width != tt.wantWidth || p.Height != tt.wantHeight {
+				t.Errorf("size = %dx%d, want %dx%d", p.Width, p.Height, tt.wantWidth, tt.wantHeight)
+			}
+			if !strings.HasPrefix(p.DominantColor, "#c") {
+				t.Errorf("DominantColor = %q, want a red tone", p.DominantColor)
+			}
+		})
+	}
+}
+
+func TestGeneratePlaceholderInvalidData(t *testing.T) {
+	if _, err := GeneratePlaceholder([]byte("not an image"), 1); err == nil {
+		t.Error("GeneratePlaceholder() expected error for invalid data")
+	}
+}
+
+func TestPlaceholderVariant(t *testing.T) {
+	imageID := uuid.New()
+	p := Placeholder{DataURI: "data:image/jpeg;base64,AAAA", Width: 16, Height: 9, Size: 3}
+
+	v := p.Variant(imageID)
+
+	if v.ImageID != imageID {
+		t.Errorf("ImageID = %v, want %v", v.ImageID, imageID)
+	}
+	if v.Kind != PlaceholderVariantKind {
+		t.Errorf("Kind = %q, want %q", v.Kind, PlaceholderVariantKind)
+	}
+	if v.BlobRef != p.DataURI {
+		t.Errorf("BlobRef = %q, want %q", v.BlobRef, p.DataURI)
+	}
+}
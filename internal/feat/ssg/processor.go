@@ -2,16 +2,19 @@ package ssg
 
 import (
 	"bytes"
-	"fmt"
 	"regexp"
-	"strings"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/extension"
 )
 
 type Processor struct {
-	parser goldmark.Markdown
+	parser     goldmark.Markdown
+	options    MarkdownOptions
+	shortcodes *Shortcodes
+	page       *shortcodePage
+	refs       *ContentRefs
+	warnings   []error
 }
 
 // NewMarkdownProcessor creates and configures a new Markdown processor.
@@ -24,20 +27,66 @@ func NewMarkdownProcessor() *Processor {
 
 // NewMarkdownProcessorWithImageContext creates a processor with image context for enhanced rendering.
 func NewMarkdownProcessorWithImageContext(imageContext *ImageContext) *Processor {
-	md := goldmark.New(
-		goldmark.WithExtensions(
-			extension.GFM,
-			// Add extensions here, e.g., syntax.New()
-		),
-	)
+	return NewMarkdownProcessorWithOptions(MarkdownOptions{})
+}
+
+// NewMarkdownProcessorWithOptions creates a processor that also supports the
+// optional syntax enabled in opts.
+func NewMarkdownProcessorWithOptions(opts MarkdownOptions) *Processor {
+	p := &Processor{
+		options: opts,
+		page:    &shortcodePage{ctx: &ShortcodeContext{}},
+	}
+	p.build()
+	return p
+}
 
-	return &Processor{
-		parser: md,
+func (p *Processor) build() {
+	extensions := append([]goldmark.Extender{extension.GFM}, p.options.extensions()...)
+	if p.shortcodes != nil {
+		extensions = append(extensions, &shortcodeExtension{shortcodes: p.shortcodes, page: p.page})
 	}
+
+	p.parser = goldmark.New(
+		goldmark.WithExtensions(extensions...),
+		goldmark.WithParserOptions(p.options.parserOptions()...),
+	)
+}
+
+// WithContentRefs makes the processor resolve cross references to other
+// contents before converting.
+func (p *Processor) WithContentRefs(refs *ContentRefs) *Processor {
+	p.refs = refs
+	return p
+}
+
+// WithShortcodes makes the processor render the shortcodes of the Markdown
+// body using the templates of shortcodes.
+func (p *Processor) WithShortcodes(shortcodes *Shortcodes) *Processor {
+	p.shortcodes = shortcodes
+	p.build()
+	return p
+}
+
+// SetPage sets the page the next conversions render shortcodes for.
+func (p *Processor) SetPage(ctx *ShortcodeContext) {
+	p.page.ctx = ctx
+}
+
+// Warnings returns the problems found in the last conversion that did not
+// stop it, such as references to missing content.
+func (p *Processor) Warnings() []error {
+	return p.warnings
 }
 
 // ToHTML converts a Markdown string to an HTML string.
 func (p *Processor) ToHTML(markdown []byte) (string, error) {
+	p.warnings = nil
+	markdown = []byte(RemoveMoreMarker(string(markdown)))
+	if p.refs != nil {
+		markdown, p.warnings = p.refs.Resolve(markdown)
+	}
+
 	var buf bytes.Buffer
 	if err := p.parser.Convert(markdown, &buf); err != nil {
 		return "", err
@@ -63,14 +112,12 @@ func (p *Processor) ToHTMLWithImageContext(markdown []byte, imageContext *ImageC
 
 // enhanceImagesInHTML post-processes HTML to enhance images with captions and metadata
 func enhanceImagesInHTML(html string, imageContext *ImageContext) string {
-	// Regex to match img tags with alt text containing pipe separator
 	imgRegex := regexp.MustCompile(`<img([^>]*?)alt="([^"]*?)"([^>]*?)>`)
+	srcRegex := regexp.MustCompile(`src="([^"]*)"`)
+	altRegex := regexp.MustCompile(`alt="([^"]*)"`)
 
-	// Process each img tag
-	result := imgRegex.ReplaceAllStringFunc(html, func(match string) string {
-		srcRegex := regexp.MustCompile(`src="([^"]*)"`)
-		altRegex := regexp.MustCompile(`alt="([^"]*)"`)
-
+	seq := 0
+	return imgRegex.ReplaceAllStringFunc(html, func(match string) string {
 		srcMatch := srcRegex.FindStringSubmatch(match)
 		altMatch := altRegex.FindStringSubmatch(match)
 
@@ -78,27 +125,11 @@ func enhanceImagesInHTML(html string, imageContext *ImageContext) string {
 			return match
 		}
 
-		srcValue := srcMatch[1]
-		altValue := altMatch[1]
-
-		var altText, longDescription string
-		if strings.Contains(altValue, "|||") {
-			parts := strings.SplitN(altValue, "|||", 2)
-			altText = strings.TrimSpace(parts[0])
-			longDescription = strings.TrimSpace(parts[1])
-			fmt.Printf("[DEBUG] Found long description in HTML alt: alt='%s', longDesc='%s'\n", altText, longDescription)
-		} else {
-			altText = altValue
+		seq++
+		meta, found := imageContext.Lookup(srcMatch[1])
+		if !found {
+			return renderImageHTML(srcMatch[1], altMatch[1], nil, seq)
 		}
-
-		enhancedImg := fmt.Sprintf(`<img src="%s" alt="%s" class="prose-img">`, srcValue, altText)
-
-		if longDescription != "" {
-			return fmt.Sprintf(`<figure class="prose-figure">%s<figcaption class="prose-figcaption">%s</figcaption></figure>`, enhancedImg, longDescription)
-		}
-
-		return enhancedImg
+		return renderImageHTML(srcMatch[1], altMatch[1], &meta, seq)
 	})
-
-	return result
 }
@@ -199,6 +199,38 @@ func TestEnhanceImagesInHTML(t *testing.T) {
 			imageContext: &ImageContext{Images: make(map[string]ImageMetadata)},
 			want:         `<img src="1.jpg" alt="first" class="prose-img"><img src="2.jpg" alt="second" class="prose-img">`,
 		},
+		{
+			name: "uses registered alt text and language",
+			html: `<img src="/static/images/post/a.jpg" alt="markdown alt">`,
+			imageContext: &ImageContext{Images: map[string]ImageMetadata{
+				"post/a.jpg": {AltText: "Registered alt", AltLang: "es"},
+			}},
+			want: `<img src="/static/images/post/a.jpg" alt="Registered alt" lang="es" class="prose-img">`,
+		},
+		{
+			name: "renders empty alt for decorative images",
+			html: `<img src="/static/images/post/a.jpg" alt="markdown alt">`,
+			imageContext: &ImageContext{Images: map[string]ImageMetadata{
+				"post/a.jpg": {AltText: "Ignored", Decorative: true},
+			}},
+			want: `<img src="/static/images/post/a.jpg" alt="" role="presentation" class="prose-img">`,
+		},
+		{
+			name: "links long description with aria-describedby",
+			html: `<img src="/static/images/post/a.jpg" alt="chart">`,
+			imageContext: &ImageContext{Images: map[string]ImageMetadata{
+				"post/a.jpg": {AltText: "Sales chart", LongDescription: "Sales grew 10% & more"},
+			}},
+			want: `<figure class="prose-figure"><img src="/static/images/post/a.jpg" alt="Sales chart" aria-describedby="img-desc-1" class="prose-img"><div id="img-desc-1" class="prose-longdesc">Sales grew 10% &amp; more</div></figure>`,
+		},
+		{
+			name: "renders caption with credit and license",
+			html: `<img src="/static/images/post/a.jpg" alt="cat">`,
+			imageContext: &ImageContext{Images: map[string]ImageMetadata{
+				"post/a.jpg": {AltText: "A cat", Caption: "Our cat", Credit: "Jane Doe", License: "CC BY 4.0", SourceURL: "https://example.com/cat"},
+			}},
+			want: `<figure class="prose-figure"><img src="/static/images/post/a.jpg" alt="A cat" class="prose-img"><figcaption class="prose-figcaption">Our cat<span class="prose-credit"><a href="https://example.com/cat" class="prose-a">Jane Doe</a></span><span class="prose-license">CC BY 4.0</span></figcaption></figure>`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -211,3 +243,30 @@ func TestEnhanceImagesInHTML(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessorWithContentRefs(t *testing.T) {
+	refs := NewContentRefs([]Content{
+		{Heading: "Target", ShortID: "aaa111", Permalink: "/target/"},
+	}, "blog")
+	p := NewMarkdownProcessor().WithContentRefs(refs)
+
+	got, err := p.ToHTML([]byte("[[aaa111]] and [[zzz999]]"))
+	if err != nil {
+		t.Fatalf("ToHTML() error = %v", err)
+	}
+
+	want := "<p><a href=\"/target/\">Target</a> and zzz999</p>\n"
+	if got != want {
+		t.Errorf("ToHTML() = %q, want %q", got, want)
+	}
+	if len(p.Warnings()) != 1 {
+		t.Errorf("Warnings() = %v, want 1 warning", p.Warnings())
+	}
+
+	if _, err := p.ToHTML([]byte("[[aaa111]]")); err != nil {
+		t.Fatalf("ToHTML() error = %v", err)
+	}
+	if len(p.Warnings()) != 0 {
+		t.Errorf("Warnings() not reset: %v", p.Warnings())
+	}
+}
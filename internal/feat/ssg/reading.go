@@ -0,0 +1,157 @@
+package ssg
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const (
+	// DefaultWordsPerMinute is the reading speed used when the site does not
+	// set one.
+	DefaultWordsPerMinute = 200
+	// ExcerptWords is the length of derived excerpts.
+	ExcerptWords = 55
+	// MoreMarker ends the excerpt of a content explicitly.
+	MoreMarker = "<!--more-->"
+
+	// cjkReadingFactor scales the reading speed for languages written
+	// without spaces, whose words are counted per character.
+	cjkReadingFactor = 2.5
+)
+
+var (
+	mdFenceRe     = regexp.MustCompile("(?ms)^\\s*(```|~~~).*?^\\s*(```|~~~)\\s*$")
+	mdImageRe     = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	mdLinkRe      = regexp.MustCompile(`\[([^\]]*)\]\([^)]*\)`)
+	mdHTMLRe      = regexp.MustCompile(`<[^>]+>`)
+	mdShortcodeRe = regexp.MustCompile(`\{\{<[^>]*>\}\}`)
+	mdMarkupRe    = regexp.MustCompile("(?m)^\\s{0,3}(#{1,6}\\s+|>\\s?|[-*+]\\s+|\\d+[.)]\\s+)|[`~]")
+
+	// Emphasis markers open after and close before a word boundary, so
+	// intraword underscores as in snake_case are kept.
+	mdEmphasisOpenRe  = regexp.MustCompile(`(?m)(^|[^\pL\pN*_])[*_]+`)
+	mdEmphasisCloseRe = regexp.MustCompile(`(?m)[*_]+([^\pL\pN*_]|$)`)
+)
+
+// PlainText strips the Markdown syntax of markdown, leaving the text a
+// reader sees. Code blocks and shortcodes are dropped, and so are cross
+// references without link text; see ContentRefs.Text to keep their headings.
+func PlainText(markdown string) string {
+	s := mdFenceRe.ReplaceAllString(markdown, "")
+	s = mdShortcodeRe.ReplaceAllString(s, "")
+	s = mdImageRe.ReplaceAllString(s, "$1")
+	s = mdLinkRe.ReplaceAllString(s, "$1")
+	s = refWikiRe.ReplaceAllString(s, "$2")
+	s = mdHTMLRe.ReplaceAllString(s, "")
+	s = mdMarkupRe.ReplaceAllString(s, "")
+	s = mdEmphasisOpenRe.ReplaceAllString(s, "$1")
+	s = mdEmphasisCloseRe.ReplaceAllString(s, "$1")
+	return strings.TrimSpace(s)
+}
+
+// WordCount returns the number of words of text. Characters of scripts
+// written without spaces, such as Chinese or Japanese, count as one word
+// each.
+func WordCount(text string) int {
+	count := 0
+	inWord := false
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			count++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsNumber(r):
+			if !inWord {
+				count++
+				inWord = true
+			}
+		case unicode.IsSpace(r) || unicode.IsPunct(r) && r != '\'' && r != '-':
+			inWord = false
+		}
+	}
+	return count
+}
+
+// ReadingTime returns the minutes needed to read words words at wpm words
+// per minute, at least one. Locales counted per character read faster.
+func ReadingTime(words, wpm int, locale string) int {
+	if wpm <= 0 {
+		wpm = DefaultWordsPerMinute
+	}
+	rate := float64(wpm)
+	if countsCharacters(locale) {
+		rate *= cjkReadingFactor
+	}
+
+	minutes := int(float64(words)/rate + 0.5)
+	if minutes < 1 {
+		return 1
+	}
+	return minutes
+}
+
+// DeriveExcerpt returns the plain text excerpt of a Markdown body: the text
+// before the more marker when there is one, or else the first paragraphs up
+// to about ExcerptWords words.
+func DeriveExcerpt(markdown string) string {
+	if before, _, found := strings.Cut(markdown, MoreMarker); found {
+		return strings.Join(strings.Fields(PlainText(before)), " ")
+	}
+
+	var words []string
+	for _, para := range strings.Split(PlainText(markdown), "\n\n") {
+		words = append(words, strings.Fields(para)...)
+		if len(words) >= ExcerptWords {
+			break
+		}
+	}
+	if len(words) <= ExcerptWords {
+		return strings.Join(words, " ")
+	}
+	return strings.Join(words[:ExcerptWords], " ") + "…"
+}
+
+// RemoveMoreMarker removes the more marker from a Markdown body.
+func RemoveMoreMarker(markdown string) string {
+	return strings.Replace(markdown, MoreMarker, "", 1)
+}
+
+// SetReadingStats sets the word count, reading time and excerpt of contents.
+// The excerpt is the first of the content summary, the meta excerpt, the
+// meta summary or one derived from the body. Cross references in the body
+// read as their link text or, without one, the heading refs resolves them to.
+// refs may be nil.
+func SetReadingStats(contents []Content, wpm int, refs *ContentRefs) {
+	for i := range contents {
+		c := &contents[i]
+		body := refs.Text(c.Body)
+		c.WordCount = WordCount(PlainText(body))
+		c.ReadingTime = ReadingTime(c.WordCount, wpm, c.Locale)
+		c.Excerpt = firstNonEmpty(c.Summary, c.Meta.Excerpt, c.Meta.Summary)
+		if c.Excerpt == "" {
+			c.Excerpt = DeriveExcerpt(body)
+		}
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func countsCharacters(locale string) bool {
+	switch baseLanguage(locale) {
+	case "zh", "ja":
+		return true
+	}
+	return false
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
@@ -0,0 +1,188 @@
+package ssg
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPlainText(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "headings and emphasis",
+			markdown: "# Title\n\nSome **bold** and _italic_ text.",
+			want:     "Title\n\nSome bold and italic text.",
+		},
+		{
+			name:     "links and images",
+			markdown: "See [the docs](/docs/) and ![a cat](/cat.png).",
+			want:     "See the docs and a cat.",
+		},
+		{
+			name:     "code blocks are dropped",
+			markdown: "Before\n\n```go\nfunc main() {}\n```\n\nAfter",
+			want:     "Before\n\nAfter",
+		},
+		{
+			name:     "shortcodes are dropped",
+			markdown: "Look {{< figure id=\"x\" >}} here",
+			want:     "Look  here",
+		},
+		{
+			name:     "intraword underscores are kept",
+			markdown: "Set snake_case and __init__ in *my_var*.",
+			want:     "Set snake_case and init in my_var.",
+		},
+		{
+			name:     "references",
+			markdown: "See [[abc123def456|the intro]], [[abc123def456]] and [it](clio:abc123def456).",
+			want:     "See the intro,  and it.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlainText(tt.markdown); got != tt.want {
+				t.Errorf("PlainText() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWordCount(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want int
+	}{
+		{name: "empty", text: "", want: 0},
+		{name: "words", text: "The quick brown fox", want: 4},
+		{name: "punctuation", text: "Hello, world! It's well-known.", want: 4},
+		{name: "numbers", text: "Version 2 of 10", want: 4},
+		{name: "cjk characters", text: "日本語の文章", want: 6},
+		{name: "mixed", text: "Go 言語", want: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WordCount(tt.text); got != tt.want {
+				t.Errorf("WordCount(%q) = %d, want %d", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadingTime(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  int
+		wpm    int
+		locale string
+		want   int
+	}{
+		{name: "minimum one minute", words: 10, wpm: 200, want: 1},
+		{name: "rounded", words: 500, wpm: 200, want: 3},
+		{name: "default speed", words: 400, wpm: 0, want: 2},
+		{name: "custom speed", words: 600, wpm: 300, want: 2},
+		{name: "cjk locale", words: 1000, wpm: 200, locale: "ja", want: 2},
+		{name: "regional locale", words: 1000, wpm: 200, locale: "zh-TW", want: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ReadingTime(tt.words, tt.wpm, tt.locale); got != tt.want {
+				t.Errorf("ReadingTime() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeriveExcerpt(t *testing.T) {
+	long := strings.Repeat("word ", ExcerptWords+10)
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+	}{
+		{
+			name:     "more marker",
+			markdown: "First **part**.\n\n<!--more-->\n\nRest of the body.",
+			want:     "First part.",
+		},
+		{
+			name:     "short body",
+			markdown: "# Title\n\nA short body.",
+			want:     "Title A short body.",
+		},
+		{
+			name:     "bare reference",
+			markdown: "See [[abc123def456]] for details.",
+			want:     "See for details.",
+		},
+		{
+			name:     "long body is truncated",
+			markdown: long,
+			want:     strings.TrimSpace(strings.Repeat("word ", ExcerptWords)) + "…",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeriveExcerpt(tt.markdown); got != tt.want {
+				t.Errorf("DeriveExcerpt() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetReadingStats(t *testing.T) {
+	contents := []Content{
+		{Body: "Derived from the body."},
+		{Body: "Body.", Summary: "The summary."},
+		{Body: "Body.", Meta: Meta{Excerpt: "The meta excerpt.", Summary: "The meta summary."}},
+		{Body: "Body.", Meta: Meta{Summary: "The meta summary."}},
+	}
+
+	SetReadingStats(contents, 200, nil)
+
+	want := []string{"Derived from the body.", "The summary.", "The meta excerpt.", "The meta summary."}
+	for i, c := range contents {
+		if c.Excerpt != want[i] {
+			t.Errorf("contents[%d].Excerpt = %q, want %q", i, c.Excerpt, want[i])
+		}
+		if c.ReadingTime != 1 {
+			t.Errorf("contents[%d].ReadingTime = %d, want 1", i, c.ReadingTime)
+		}
+	}
+	if contents[0].WordCount != 4 {
+		t.Errorf("WordCount = %d, want 4", contents[0].WordCount)
+	}
+}
+
+func TestProcessorRemovesMoreMarker(t *testing.T) {
+	html, err := NewMarkdownProcessor().ToHTML([]byte("Intro\n\n<!--more-->\n\nRest"))
+	if err != nil {
+		t.Fatalf("ToHTML() error = %v", err)
+	}
+	if strings.Contains(html, "more") {
+		t.Errorf("ToHTML() = %q, want no more marker", html)
+	}
+}
+
+func TestSetReadingStatsResolvesReferences(t *testing.T) {
+	target := Content{ShortID: "abc123def456", Heading: "Getting Started"}
+	contents := []Content{{Body: "Read [[abc123def456]] first, then [[fff000fff000]]."}}
+
+	SetReadingStats(contents, 200, NewContentRefs([]Content{target}, "blog"))
+
+	if want := "Read Getting Started first, then ."; contents[0].Excerpt != want {
+		t.Errorf("Excerpt = %q, want %q", contents[0].Excerpt, want)
+	}
+	if contents[0].WordCount != 5 {
+		t.Errorf("WordCount = %d, want 5", contents[0].WordCount)
+	}
+}
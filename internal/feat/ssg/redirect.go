@@ -0,0 +1,89 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// RedirectsFile is the name of the redirect rules file understood by static
+// hosts such as Netlify and Cloudflare Pages.
+const RedirectsFile = "_redirects"
+
+// Redirect maps an old public path to the current path of a content.
+type Redirect struct {
+	From string
+	To   string
+}
+
+var redirectStubTmpl = template.Must(template.New("redirect").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+    <meta charset="UTF-8">
+    <title>Redirecting…</title>
+    <meta name="robots" content="noindex">
+    <link rel="canonical" href="{{.}}">
+    <meta http-equiv="refresh" content="0; url={{.}}">
+</head>
+<body>
+    <p>This page has moved to <a href="{{.}}">{{.}}</a>.</p>
+</body>
+</html>
+`))
+
+// BuildRedirects resolves aliases to the current path of their content.
+// Aliases of drafts or unknown content, and aliases matching a live page or
+// a reserved path such as an index, are skipped. Results are sorted by From.
+func BuildRedirects(aliases []ContentAlias, contents []Content, reserved []string, mode string) []Redirect {
+	targets := make(map[uuid.UUID]string, len(contents))
+	live := make(map[string]bool, len(contents)+len(reserved))
+	for _, c := range contents {
+		p := GetContentPath(c, mode) + "/"
+		live[p] = true
+		if !c.Draft {
+			targets[c.ID] = p
+		}
+	}
+	for _, r := range reserved {
+		live[r] = true
+	}
+
+	var redirects []Redirect
+	for _, a := range aliases {
+		from := NormalizeAliasPath(a.Path)
+		to, ok := targets[a.ContentID]
+		if !ok || from == "" || live[from] {
+			continue
+		}
+		live[from] = true
+		redirects = append(redirects, Redirect{From: from, To: to})
+	}
+
+	sort.Slice(redirects, func(i, j int) bool {
+		return redirects[i].From < redirects[j].From
+	})
+
+	return redirects
+}
+
+// RenderRedirectStub returns an HTML page that sends browsers and crawlers
+// to target using a meta refresh and a canonical link.
+func RenderRedirectStub(target string) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := redirectStubTmpl.Execute(&buf, target); err != nil {
+		return nil, fmt.Errorf("cannot render redirect stub: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// RenderRedirectsFile returns the redirects as permanent rules, one per line.
+func RenderRedirectsFile(redirects []Redirect) []byte {
+	var buf bytes.Buffer
+	for _, r := range redirects {
+		fmt.Fprintf(&buf, "%s %s 301\n", r.From, r.To)
+	}
+	return buf.Bytes()
+}
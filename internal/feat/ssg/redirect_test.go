@@ -0,0 +1,89 @@
+package ssg
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestBuildRedirects(t *testing.T) {
+	post := Content{ID: uuid.New(), Heading: "Post", ShortID: "abc123", SectionPath: "blog"}
+	draft := Content{ID: uuid.New(), Heading: "Draft", ShortID: "def456", SectionPath: "blog", Draft: true}
+
+	aliases := []ContentAlias{
+		{ContentID: post.ID, Path: "/old/post/"},
+		{ContentID: post.ID, Path: "/another/"},
+		{ContentID: post.ID, Path: "/blog/post-abc123/"},
+		{ContentID: post.ID, Path: "/blog/"},
+		{ContentID: draft.ID, Path: "/old/draft/"},
+		{ContentID: uuid.New(), Path: "/orphan/"},
+		{ContentID: post.ID, Path: "/"},
+	}
+
+	got := BuildRedirects(aliases, []Content{post, draft}, []string{"/blog/"}, "structured")
+
+	want := []Redirect{
+		{From: "/another/", To: "/blog/post-abc123/"},
+		{From: "/old/post/", To: "/blog/post-abc123/"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d redirects, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("redirect[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestBuildRedirectsSkipsDuplicatePaths(t *testing.T) {
+	first := Content{ID: uuid.New(), Heading: "First", ShortID: "aaa111"}
+	second := Content{ID: uuid.New(), Heading: "Second", ShortID: "bbb222"}
+
+	aliases := []ContentAlias{
+		{ContentID: first.ID, Path: "/old/"},
+		{ContentID: second.ID, Path: "/old"},
+	}
+
+	got := BuildRedirects(aliases, []Content{first, second}, nil, "blog")
+
+	if len(got) != 1 {
+		t.Fatalf("got %d redirects, want 1: %+v", len(got), got)
+	}
+	if got[0].To != "/first-aaa111/" {
+		t.Errorf("To = %q, want %q", got[0].To, "/first-aaa111/")
+	}
+}
+
+func TestRenderRedirectStub(t *testing.T) {
+	stub, err := RenderRedirectStub("https://example.com/blog/post/")
+	if err != nil {
+		t.Fatalf("RenderRedirectStub() error = %v", err)
+	}
+
+	html := string(stub)
+	for _, want := range []string{
+		`<link rel="canonical" href="https://example.com/blog/post/">`,
+		`<meta http-equiv="refresh" content="0; url=https://example.com/blog/post/">`,
+		`<meta name="robots" content="noindex">`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("stub missing %q:\n%s", want, html)
+		}
+	}
+}
+
+func TestRenderRedirectsFile(t *testing.T) {
+	redirects := []Redirect{
+		{From: "/old/", To: "/new/"},
+		{From: "/older/", To: "/new/"},
+	}
+
+	got := string(RenderRedirectsFile(redirects))
+	want := "/old/ /new/ 301\n/older/ /new/ 301\n"
+
+	if got != want {
+		t.Errorf("RenderRedirectsFile() = %q, want %q", got, want)
+	}
+}
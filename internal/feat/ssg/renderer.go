@@ -2,12 +2,13 @@ package ssg
 
 import (
 	"fmt"
+	"html"
 	"strings"
 
 	gmast "github.com/yuin/goldmark/ast"
 	extast "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/renderer"
-	"github.com/yuin/goldmark/renderer/html"
+	gmhtml "github.com/yuin/goldmark/renderer/html"
 	"github.com/yuin/goldmark/util"
 )
 
@@ -18,25 +19,34 @@ type ImageContext struct {
 
 // ImageMetadata holds accessibility and semantic information for an image
 type ImageMetadata struct {
-	AltText string
-	Title   string
+	AltText         string
+	AltLang         string
+	Title           string
+	LongDescription string
+	Caption         string
+	Decorative      bool
+	Credit          string
+	License         string
+	SourceURL       string
 }
 
 // TailwindRenderer is a custom renderer for goldmark that adds Tailwind CSS classes.
 type TailwindRenderer struct {
-	html.Config
+	gmhtml.Config
 	ImageContext *ImageContext
+	imageCount   int
 }
 
 // ImageRenderer is a simple renderer that only handles image nodes
 type ImageRenderer struct {
 	ImageContext *ImageContext
+	imageCount   int
 }
 
 // NewTailwindRenderer creates a new TailwindRenderer with optional image context.
-func NewTailwindRenderer(imageContext *ImageContext, opts ...html.Option) renderer.NodeRenderer {
+func NewTailwindRenderer(imageContext *ImageContext, opts ...gmhtml.Option) renderer.NodeRenderer {
 	r := &TailwindRenderer{
-		Config:       html.NewConfig(),
+		Config:       gmhtml.NewConfig(),
 		ImageContext: imageContext,
 	}
 	for _, opt := range opts {
@@ -253,72 +263,10 @@ func (r *TailwindRenderer) renderLink(w util.BufWriter, source []byte, node gmas
 }
 
 func (r *TailwindRenderer) renderImage(w util.BufWriter, source []byte, node gmast.Node, entering bool) (gmast.WalkStatus, error) {
-	n := node.(*gmast.Image)
-	imgSrc := string(n.Destination)
-
-	var altText, figCaption, figCaptionHTML string
-
-	textAlt := ""
-	if n.FirstChild() != nil {
-		if text, ok := n.FirstChild().(*gmast.Text); ok {
-			textAlt = string(text.Segment.Value(source))
-		}
-	}
-
-	var markdownAltText, markdownLongDescription string
-	if strings.Contains(textAlt, "|||") {
-		parts := strings.SplitN(textAlt, "|||", 2)
-		markdownAltText = strings.TrimSpace(parts[0])
-		markdownLongDescription = strings.TrimSpace(parts[1])
-	} else {
-		markdownAltText = textAlt
-	}
-
-	hasMetadata := false
-	if r.ImageContext != nil && r.ImageContext.Images != nil {
-		imgPath := imgSrc
-		imgPath = strings.TrimPrefix(imgPath, "/static/images/")
-		imgPath = strings.TrimPrefix(imgPath, "/static/images")
-		imgPath = strings.ReplaceAll(imgPath, "//", "/")
-		imgPath = strings.TrimPrefix(imgPath, "/")
-
-		if metadata, found := r.ImageContext.Images[imgPath]; found {
-			hasMetadata = true
-			altText = metadata.AltText
-
-			if altText == "" {
-				altText = textAlt
-			}
-		}
-	}
-
-	if !hasMetadata {
-		altText = markdownAltText
-		figCaption = markdownLongDescription
-
-		if figCaption != "" {
-			figCaptionHTML = fmt.Sprintf("<figcaption class=\"prose-figcaption\">%s</figcaption>", figCaption)
-		}
-	} else {
-		if markdownLongDescription != "" {
-			figCaption = markdownLongDescription
-			figCaptionHTML = fmt.Sprintf("<figcaption class=\"prose-figcaption\">%s</figcaption>", figCaption)
-		}
-	}
-
 	if entering {
-		if figCaption != "" {
-			_, _ = w.WriteString("<figure class=\"prose-figure\">")
-		}
-
-		_, _ = w.WriteString(fmt.Sprintf("<img src=\"%s\" alt=\"%s\" class=\"prose-img\">",
-			imgSrc, altText))
-
-		if figCaption != "" {
-			_, _ = w.WriteString(figCaptionHTML + "</figure>")
-		}
+		r.imageCount++
+		_, _ = w.WriteString(renderImageNode(node.(*gmast.Image), source, r.ImageContext, r.imageCount))
 	}
-
 	return gmast.WalkSkipChildren, nil
 }
 
@@ -336,72 +284,129 @@ func (r *ImageRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 }
 
 func (r *ImageRenderer) renderImage(w util.BufWriter, source []byte, node gmast.Node, entering bool) (gmast.WalkStatus, error) {
-	n := node.(*gmast.Image)
-	imgSrc := string(n.Destination)
+	if entering {
+		r.imageCount++
+		_, _ = w.WriteString(renderImageNode(node.(*gmast.Image), source, r.ImageContext, r.imageCount))
+	}
+	return gmast.WalkSkipChildren, nil
+}
 
-	var altText, figCaption, figCaptionHTML string
+func renderImageNode(n *gmast.Image, source []byte, imageContext *ImageContext, seq int) string {
+	alt := ""
+	if text, ok := n.FirstChild().(*gmast.Text); ok {
+		alt = string(util.EscapeHTML(text.Segment.Value(source)))
+	}
 
-	textAlt := ""
-	if n.FirstChild() != nil {
-		if text, ok := n.FirstChild().(*gmast.Text); ok {
-			textAlt = string(text.Segment.Value(source))
-		}
+	src := string(n.Destination)
+	meta, found := imageContext.Lookup(src)
+	if !found {
+		return renderImageHTML(src, alt, nil, seq)
 	}
+	return renderImageHTML(src, alt, &meta, seq)
+}
 
-	var markdownAltText, markdownLongDescription string
-	if strings.Contains(textAlt, "|||") {
-		parts := strings.SplitN(textAlt, "|||", 2)
-		markdownAltText = strings.TrimSpace(parts[0])
-		markdownLongDescription = strings.TrimSpace(parts[1])
-		fmt.Printf("[DEBUG] Found long description in alt text: alt='%s', longDesc='%s'\n", markdownAltText, markdownLongDescription)
-	} else {
-		markdownAltText = textAlt
+// Lookup returns the registered metadata for an image source, if any.
+func (c *ImageContext) Lookup(src string) (ImageMetadata, bool) {
+	if c == nil || c.Images == nil {
+		return ImageMetadata{}, false
 	}
 
-	hasMetadata := false
-	if r.ImageContext != nil && r.ImageContext.Images != nil {
-		imgPath := imgSrc
-		imgPath = strings.TrimPrefix(imgPath, "/static/images/")
-		imgPath = strings.TrimPrefix(imgPath, "/static/images")
-		imgPath = strings.ReplaceAll(imgPath, "//", "/")
-		imgPath = strings.TrimPrefix(imgPath, "/")
+	imgPath := strings.TrimPrefix(src, "/static/images/")
+	imgPath = strings.TrimPrefix(imgPath, "/static/images")
+	imgPath = strings.ReplaceAll(imgPath, "//", "/")
+	imgPath = strings.TrimPrefix(imgPath, "/")
 
-		if metadata, found := r.ImageContext.Images[imgPath]; found {
-			hasMetadata = true
-			altText = metadata.AltText
+	meta, found := c.Images[imgPath]
+	return meta, found
+}
 
-			if altText == "" {
-				altText = textAlt
-			}
+// splitLegacyAlt splits alt texts using the "alt|||long description" form
+// that predates storing image metadata in the registry.
+func splitLegacyAlt(alt string) (string, string) {
+	if !strings.Contains(alt, "|||") {
+		return alt, ""
+	}
+	parts := strings.SplitN(alt, "|||", 2)
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+}
+
+// renderImageHTML builds the markup for a content image. alt is expected to be
+// HTML escaped already. When metadata is available it takes precedence over the
+// markdown alt text and the image is wrapped in a figure whenever there is a
+// caption, credit, license or long description to show.
+func renderImageHTML(src, alt string, meta *ImageMetadata, seq int) string {
+	markdownAlt, legacyCaption := splitLegacyAlt(alt)
+
+	if meta == nil {
+		img := fmt.Sprintf(`<img src="%s" alt="%s" class="prose-img">`, src, markdownAlt)
+		if legacyCaption == "" {
+			return img
 		}
+		return fmt.Sprintf(`<figure class="prose-figure">%s<figcaption class="prose-figcaption">%s</figcaption></figure>`, img, legacyCaption)
 	}
 
-	if !hasMetadata {
-		altText = markdownAltText
-		figCaption = markdownLongDescription
+	altText := markdownAlt
+	if meta.AltText != "" {
+		altText = html.EscapeString(meta.AltText)
+	}
 
-		if figCaption != "" {
-			figCaptionHTML = fmt.Sprintf("<figcaption class=\"prose-figcaption\">%s</figcaption>", figCaption)
-		}
+	var attrs, longDesc string
+	if meta.Decorative {
+		altText = ""
+		attrs = ` role="presentation"`
 	} else {
-		if markdownLongDescription != "" {
-			figCaption = markdownLongDescription
-			figCaptionHTML = fmt.Sprintf("<figcaption class=\"prose-figcaption\">%s</figcaption>", figCaption)
+		if meta.AltLang != "" {
+			attrs += fmt.Sprintf(` lang="%s"`, html.EscapeString(meta.AltLang))
+		}
+		if meta.LongDescription != "" {
+			descID := fmt.Sprintf("img-desc-%d", seq)
+			attrs += fmt.Sprintf(` aria-describedby="%s"`, descID)
+			longDesc = fmt.Sprintf(`<div id="%s" class="prose-longdesc">%s</div>`, descID, html.EscapeString(meta.LongDescription))
 		}
 	}
 
-	if entering {
-		if figCaption != "" {
-			_, _ = w.WriteString("<figure class=\"prose-figure\">")
-		}
+	img := fmt.Sprintf(`<img src="%s" alt="%s"%s class="prose-img">`, src, altText, attrs)
+
+	caption := legacyCaption
+	if meta.Caption != "" {
+		caption = html.EscapeString(meta.Caption)
+	}
+	credits := renderImageCredits(meta)
 
-		_, _ = w.WriteString(fmt.Sprintf("<img src=\"%s\" alt=\"%s\" class=\"prose-img\">",
-			imgSrc, altText))
+	if caption == "" && credits == "" && longDesc == "" {
+		return img
+	}
+
+	var b strings.Builder
+	b.WriteString(`<figure class="prose-figure">`)
+	b.WriteString(img)
+	b.WriteString(longDesc)
+	if caption != "" || credits != "" {
+		b.WriteString(`<figcaption class="prose-figcaption">`)
+		b.WriteString(caption)
+		b.WriteString(credits)
+		b.WriteString(`</figcaption>`)
+	}
+	b.WriteString(`</figure>`)
 
-		if figCaption != "" {
-			_, _ = w.WriteString(figCaptionHTML + "</figure>")
+	return b.String()
+}
+
+// renderImageCredits renders the credit and license lines of a figcaption.
+func renderImageCredits(meta *ImageMetadata) string {
+	var b strings.Builder
+
+	if meta.Credit != "" {
+		credit := html.EscapeString(meta.Credit)
+		if meta.SourceURL != "" {
+			credit = fmt.Sprintf(`<a href="%s" class="prose-a">%s</a>`, html.EscapeString(meta.SourceURL), credit)
 		}
+		fmt.Fprintf(&b, `<span class="prose-credit">%s</span>`, credit)
 	}
 
-	return gmast.WalkSkipChildren, nil
+	if meta.License != "" {
+		fmt.Fprintf(&b, `<span class="prose-license">%s</span>`, html.EscapeString(meta.License))
+	}
+
+	return b.String()
 }
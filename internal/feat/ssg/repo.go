@@ -16,7 +16,9 @@ type Repo interface {
 
 	CreateContent(ctx context.Context, content *Content) error
 	GetContent(ctx context.Context, id uuid.UUID) (Content, error)
+	GetContentBySlug(ctx context.Context, slug string) (Content, error)
 	UpdateContent(ctx context.Context, content *Content) error
+	MoveContent(ctx context.Context, move ContentMove) error
 	DeleteContent(ctx context.Context, id uuid.UUID) error
 	GetAllContentWithMeta(ctx context.Context) ([]Content, error)
 	GetContentWithPaginationAndSearch(ctx context.Context, offset, limit int, searchQuery string) ([]Content, int, error)
@@ -25,6 +27,7 @@ type Repo interface {
 	GetSection(ctx context.Context, id uuid.UUID) (Section, error)
 	GetSections(ctx context.Context) ([]Section, error)
 	UpdateSection(ctx context.Context, section Section) error
+	MoveSections(ctx context.Context, move SectionMove) error
 	DeleteSection(ctx context.Context, id uuid.UUID) error
 
 	CreateLayout(ctx context.Context, layout Layout) error
@@ -40,6 +43,57 @@ type Repo interface {
 	UpdateTag(ctx context.Context, tag Tag) error
 	DeleteTag(ctx context.Context, id uuid.UUID) error
 
+	CreateSeries(ctx context.Context, series Series) error
+	GetSeries(ctx context.Context, id uuid.UUID) (Series, error)
+	GetAllSeries(ctx context.Context) ([]Series, error)
+	UpdateSeries(ctx context.Context, series Series) error
+	DeleteSeries(ctx context.Context, id uuid.UUID) error
+	UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error
+
+	CreateAuthor(ctx context.Context, author Author) error
+	GetAuthor(ctx context.Context, id uuid.UUID) (Author, error)
+	GetAllAuthors(ctx context.Context) ([]Author, error)
+	UpdateAuthor(ctx context.Context, author Author) error
+	DeleteAuthor(ctx context.Context, id uuid.UUID) error
+	GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]Author, error)
+	GetContentAuthors(ctx context.Context) ([]ContentAuthor, error)
+	SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error
+
+	CreateContentType(ctx context.Context, contentType ContentType) error
+	GetContentType(ctx context.Context, id uuid.UUID) (ContentType, error)
+	GetContentTypes(ctx context.Context) ([]ContentType, error)
+	UpdateContentType(ctx context.Context, contentType ContentType) error
+	DeleteContentType(ctx context.Context, id uuid.UUID) error
+	GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]ContentField, error)
+	GetContentFields(ctx context.Context) ([]ContentField, error)
+	SetContentFields(ctx context.Context, contentID uuid.UUID, fields []ContentField) error
+
+	CreateArchetype(ctx context.Context, archetype Archetype) error
+	GetArchetype(ctx context.Context, id uuid.UUID) (Archetype, error)
+	GetArchetypes(ctx context.Context) ([]Archetype, error)
+	UpdateArchetype(ctx context.Context, archetype Archetype) error
+	DeleteArchetype(ctx context.Context, id uuid.UUID) error
+
+	CreateComment(ctx context.Context, comment Comment) error
+	GetComment(ctx context.Context, id uuid.UUID) (Comment, error)
+	GetComments(ctx context.Context, status string) ([]Comment, error)
+	UpdateComment(ctx context.Context, comment Comment) error
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error
+	GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error)
+	ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error
+
+	CreateMenu(ctx context.Context, menu Menu) error
+	GetMenu(ctx context.Context, id uuid.UUID) (Menu, error)
+	GetMenus(ctx context.Context) ([]Menu, error)
+	UpdateMenu(ctx context.Context, menu Menu) error
+	DeleteMenu(ctx context.Context, id uuid.UUID) error
+	CreateMenuItem(ctx context.Context, item MenuItem) error
+	GetMenuItem(ctx context.Context, id uuid.UUID) (MenuItem, error)
+	GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]MenuItem, error)
+	UpdateMenuItem(ctx context.Context, item MenuItem) error
+	DeleteMenuItem(ctx context.Context, id uuid.UUID) error
+
 	CreateParam(ctx context.Context, param *Param) error
 	GetParam(ctx context.Context, id uuid.UUID) (Param, error)
 	GetParamByName(ctx context.Context, name string) (Param, error)
@@ -69,6 +123,12 @@ type Repo interface {
 	DeleteContentImage(ctx context.Context, id uuid.UUID) error
 	GetContentImagesByContentID(ctx context.Context, contentID uuid.UUID) ([]ContentImage, error)
 
+	// ContentAlias methods
+	CreateContentAlias(ctx context.Context, alias *ContentAlias) error
+	GetContentAliases(ctx context.Context) ([]ContentAlias, error)
+	GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]ContentAlias, error)
+	DeleteContentAlias(ctx context.Context, id uuid.UUID) error
+
 	// SectionImage relationship methods
 	CreateSectionImage(ctx context.Context, sectionImage *SectionImage) error
 	DeleteSectionImage(ctx context.Context, id uuid.UUID) error
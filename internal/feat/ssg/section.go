@@ -1,6 +1,9 @@
 package ssg
 
 import (
+	"errors"
+	"path"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -8,7 +11,21 @@ import (
 	"github.com/hermesgen/hm"
 )
 
-// Section model.
+// ErrInvalidSectionParent is returned when the parent of a section does not
+// exist, or the root section is given one.
+var ErrInvalidSectionParent = errors.New("invalid section parent")
+
+// ErrSectionCycle is returned when a section would be nested below itself or
+// one of its descendants.
+var ErrSectionCycle = errors.New("section cannot be nested below itself")
+
+// ErrSectionHasChildren is returned when deleting a section other sections
+// are nested in.
+var ErrSectionHasChildren = errors.New("section has child sections")
+
+// Section model. Sections nest below their parent and their path is derived
+// from the ancestry, e.g. /guides/go. Sections without parent hang from the
+// root section.
 type Section struct {
 	// Common
 	ID      uuid.UUID `json:"id" db:"id"`
@@ -24,6 +41,8 @@ type Section struct {
 	Path        string    `json:"path" db:"path"`
 	LayoutID    uuid.UUID `json:"layout_id" db:"layout_id"`
 	LayoutName  string    `json:"layout_name" db:"layout_name"`
+	ParentID    uuid.UUID `json:"parent_id" db:"parent_id"`
+	RollUp      bool      `json:"roll_up" db:"roll_up"` // Index lists the content of descendant sections too
 
 	// Audit
 	CreatedBy uuid.UUID `json:"-" db:"created_by"`
@@ -160,3 +179,133 @@ func (s *Section) Ref() string {
 func (s *Section) SetRef(ref string) {
 	s.ref = ref
 }
+
+// IsRoot reports whether s is the root section of the site.
+func (s *Section) IsRoot() bool {
+	return strings.Trim(s.Path, "/") == ""
+}
+
+// Segment returns the last element of the section path, the part the
+// section adds to the path of its parent.
+func (s *Section) Segment() string {
+	p := strings.Trim(s.Path, "/")
+	if i := strings.LastIndex(p, "/"); i >= 0 {
+		return p[i+1:]
+	}
+	return p
+}
+
+// SectionMove is a change of section paths stored at once: the moved
+// sections with their new path, the aliases keeping the previous paths of
+// their content and the aliases of content moved back to one of them.
+type SectionMove struct {
+	Sections       []Section
+	Aliases        []*ContentAlias
+	ClearedAliases []uuid.UUID
+}
+
+// SectionPath returns the path of section derived from its parent in
+// sections: the parent path followed by the section segment. It fails when
+// the parent is unknown or the section would end up nested below itself.
+func SectionPath(section Section, sections []Section) (string, error) {
+	if section.IsRoot() {
+		if section.ParentID != uuid.Nil {
+			return "", ErrInvalidSectionParent
+		}
+		return "/", nil
+	}
+	if section.ParentID == uuid.Nil {
+		return path.Join("/", section.Segment()), nil
+	}
+
+	byID := sectionsByID(sections)
+	parent, ok := byID[section.ParentID]
+	if !ok {
+		return "", ErrInvalidSectionParent
+	}
+	seen := make(map[uuid.UUID]bool)
+	for p, ok := parent, true; ok && !seen[p.ID]; p, ok = byID[p.ParentID] {
+		if p.ID == section.ID {
+			return "", ErrSectionCycle
+		}
+		seen[p.ID] = true
+	}
+
+	return path.Join("/", parent.Path, section.Segment()), nil
+}
+
+// SectionAncestors returns the sections section is nested in, nearest
+// first. The root section is not included.
+func SectionAncestors(section Section, sections []Section) []Section {
+	byID := sectionsByID(sections)
+	seen := map[uuid.UUID]bool{section.ID: true}
+
+	var ancestors []Section
+	for p, ok := byID[section.ParentID]; ok && !seen[p.ID]; p, ok = byID[p.ParentID] {
+		seen[p.ID] = true
+		if !p.IsRoot() {
+			ancestors = append(ancestors, p)
+		}
+	}
+
+	return ancestors
+}
+
+// SectionDescendants returns the sections nested below the section with id,
+// parents before their children.
+func SectionDescendants(id uuid.UUID, sections []Section) []Section {
+	seen := map[uuid.UUID]bool{id: true}
+	queue := []uuid.UUID{id}
+
+	var descendants []Section
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+		for _, s := range sections {
+			if s.ParentID == parentID && !seen[s.ID] {
+				seen[s.ID] = true
+				descendants = append(descendants, s)
+				queue = append(queue, s.ID)
+			}
+		}
+	}
+
+	return descendants
+}
+
+// TopLevelSections returns the sections hanging directly from the root
+// section, the root section itself excluded.
+func TopLevelSections(sections []Section) []Section {
+	byID := sectionsByID(sections)
+
+	var top []Section
+	for _, s := range sections {
+		if s.IsRoot() {
+			continue
+		}
+		if parent, ok := byID[s.ParentID]; !ok || parent.IsRoot() {
+			top = append(top, s)
+		}
+	}
+
+	return top
+}
+
+// SectionChildren returns the sections whose parent is the section with id.
+func SectionChildren(id uuid.UUID, sections []Section) []Section {
+	var children []Section
+	for _, s := range sections {
+		if s.ParentID == id && s.ID != id {
+			children = append(children, s)
+		}
+	}
+	return children
+}
+
+func sectionsByID(sections []Section) map[uuid.UUID]Section {
+	byID := make(map[uuid.UUID]Section, len(sections))
+	for _, s := range sections {
+		byID[s.ID] = s
+	}
+	return byID
+}
@@ -1,6 +1,8 @@
 package ssg
 
 import (
+	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -536,3 +538,72 @@ func TestSectionSetRef(t *testing.T) {
 		t.Errorf("SetRef() set %v, want %v", s.ref, ref)
 	}
 }
+
+func TestSectionPath(t *testing.T) {
+	root := Section{ID: uuid.New(), Name: "root", Path: "/"}
+	guides := Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	golang := Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	sections := []Section{root, guides, golang}
+
+	tests := []struct {
+		name    string
+		section Section
+		want    string
+		wantErr error
+	}{
+		{name: "top level section", section: Section{ID: uuid.New(), Path: "tech"}, want: "/tech"},
+		{name: "nested below root", section: Section{ID: uuid.New(), Path: "/tech", ParentID: root.ID}, want: "/tech"},
+		{name: "nested section", section: Section{ID: uuid.New(), Path: "/old/modules", ParentID: golang.ID}, want: "/guides/go/modules"},
+		{name: "moved to top level", section: Section{ID: golang.ID, Path: "/guides/go"}, want: "/go"},
+		{name: "root section", section: root, want: "/"},
+		{name: "root section with parent", section: Section{ID: root.ID, Path: "/", ParentID: guides.ID}, wantErr: ErrInvalidSectionParent},
+		{name: "unknown parent", section: Section{ID: uuid.New(), Path: "/tech", ParentID: uuid.New()}, wantErr: ErrInvalidSectionParent},
+		{name: "nested below itself", section: Section{ID: guides.ID, Path: "/guides", ParentID: guides.ID}, wantErr: ErrSectionCycle},
+		{name: "nested below a descendant", section: Section{ID: guides.ID, Path: "/guides", ParentID: golang.ID}, wantErr: ErrSectionCycle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SectionPath(tt.section, sections)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SectionPath() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("SectionPath() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSectionTree(t *testing.T) {
+	root := Section{ID: uuid.New(), Name: "root", Path: "/"}
+	guides := Section{ID: uuid.New(), Name: "Guides", Path: "/guides", ParentID: root.ID}
+	golang := Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	modules := Section{ID: uuid.New(), Name: "Modules", Path: "/guides/go/modules", ParentID: golang.ID}
+	news := Section{ID: uuid.New(), Name: "News", Path: "/news"}
+	sections := []Section{modules, root, guides, golang, news}
+
+	names := func(sections []Section) string {
+		var n []string
+		for _, s := range sections {
+			n = append(n, s.Name)
+		}
+		return strings.Join(n, ",")
+	}
+
+	if got := names(SectionAncestors(modules, sections)); got != "Go,Guides" {
+		t.Errorf("SectionAncestors() = %q, want %q", got, "Go,Guides")
+	}
+	if got := names(SectionDescendants(guides.ID, sections)); got != "Go,Modules" {
+		t.Errorf("SectionDescendants() = %q, want %q", got, "Go,Modules")
+	}
+	if got := names(TopLevelSections(sections)); got != "Guides,News" {
+		t.Errorf("TopLevelSections() = %q, want %q", got, "Guides,News")
+	}
+	if got := names(SectionChildren(golang.ID, sections)); got != "Modules" {
+		t.Errorf("SectionChildren() = %q, want %q", got, "Modules")
+	}
+	if got := modules.Segment(); got != "modules" {
+		t.Errorf("Segment() = %q, want %q", got, "modules")
+	}
+}
@@ -0,0 +1,202 @@
+package ssg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const seoDescriptionMaxLen = 160
+
+// SiteInfo holds site wide values used when rendering pages.
+type SiteInfo struct {
+	Name    string
+	BaseURL string // e.g. https://example.com, empty keeps URLs root relative
+}
+
+// URL returns p resolved against the site base URL.
+// Already absolute URLs are returned unchanged.
+func (s SiteInfo) URL(p string) string {
+	if strings.HasPrefix(p, "http://") || strings.HasPrefix(p, "https://") {
+		return p
+	}
+	if p == "" {
+		p = "/"
+	}
+	if !strings.HasPrefix(p, "/") {
+		p = "/" + p
+	}
+	return strings.TrimSuffix(s.BaseURL, "/") + p
+}
+
+// SEO holds the resolved metadata rendered in the head of a generated page.
+type SEO struct {
+	Title         string
+	Description   string
+	Keywords      string
+	Robots        string
+	URL           string
+	Canonical     string
+	Type          string // og:type, article or website
+	SiteName      string
+	Image         string
+	ImageAlt      string
+	PublishedTime string // RFC 3339
+	ModifiedTime  string // RFC 3339
+}
+
+// TwitterCard returns the Twitter card type matching the available image.
+func (s SEO) TwitterCard() string {
+	if s.Image != "" {
+		return "summary_large_image"
+	}
+	return "summary"
+}
+
+// DocumentTitle returns the title suffixed with the site name.
+func (s SEO) DocumentTitle() string {
+	if s.SiteName == "" || s.Title == s.SiteName {
+		return s.Title
+	}
+	if s.Title == "" {
+		return s.SiteName
+	}
+	return s.Title + " | " + s.SiteName
+}
+
+// NewContentSEO resolves the SEO metadata of a content page.
+// image is the URL of the social image of the page, if any.
+func NewContentSEO(content Content, site SiteInfo, mode, image string) SEO {
+	pageURL := site.URL(GetContentPath(content, mode) + "/")
+
+	seo := SEO{
+		Title:       content.Heading,
+		Description: seoDescription(content.Meta.Description, content.Summary, content.Meta.Summary, content.Meta.Excerpt, content.Excerpt),
+		Keywords:    content.Meta.Keywords,
+		Robots:      content.Meta.Robots,
+		URL:         pageURL,
+		Canonical:   pageURL,
+		Type:        contentOGType(content.Kind),
+		SiteName:    site.Name,
+	}
+
+	if content.Meta.CanonicalURL != "" {
+		seo.Canonical = site.URL(content.Meta.CanonicalURL)
+	}
+
+	if image != "" {
+		seo.Image = site.URL(image)
+		seo.ImageAlt = content.HeaderImageAlt
+	}
+
+	if content.PublishedAt != nil && !content.PublishedAt.IsZero() {
+		seo.PublishedTime = content.PublishedAt.Format(time.RFC3339)
+	}
+	if !content.UpdatedAt.IsZero() {
+		seo.ModifiedTime = content.UpdatedAt.Format(time.RFC3339)
+	}
+
+	return seo
+}
+
+// NewIndexSEO resolves the SEO metadata of an index page. section is the
+// section the index belongs to, nil when there is none. Generated titles are
+// in locale.
+func NewIndexSEO(index *Index, section *Section, site SiteInfo, mode, locale string, page int) SEO {
+	pageURL := site.URL(GetPaginationPath(index.Path, page, mode))
+
+	seo := SEO{
+		Title:     indexTitle(index, section, site, locale),
+		URL:       pageURL,
+		Canonical: pageURL,
+		Type:      "website",
+		SiteName:  site.Name,
+	}
+
+	if section != nil {
+		seo.Description = seoDescription(section.Description)
+	}
+
+	if page > 1 {
+		seo.Title = fmt.Sprintf(Translate(locale, "%s - Page %d"), seo.Title, page)
+	}
+
+	return seo
+}
+
+func indexTitle(index *Index, section *Section, site SiteInfo, locale string) string {
+	switch index.Type {
+	case "blog":
+		if section != nil && section.Path != "/" && section.Name != "" {
+			return fmt.Sprintf(Translate(locale, "%s Blog"), section.Name)
+		}
+		return Translate(locale, "Blog")
+	case "series":
+		return seriesIndexTitle(index)
+	case "series-overview":
+		if section != nil && section.Path != "/" && section.Name != "" && section.Name != "root" {
+			return fmt.Sprintf(Translate(locale, "%s Series"), section.Name)
+		}
+		return Translate(locale, "Series")
+	case "archive":
+		return archiveTitle(index, locale)
+	case "tag":
+		return tagTitle(index)
+	case "author":
+		return authorTitle(index)
+	}
+
+	if index.Path == "/" || section == nil || section.Name == "" || section.Name == "root" {
+		if site.Name != "" {
+			return site.Name
+		}
+		return Translate(locale, "Home")
+	}
+
+	return section.Name
+}
+
+func contentOGType(kind string) string {
+	switch strings.ToLower(kind) {
+	case "article", "blog", "series":
+		return "article"
+	default:
+		return "website"
+	}
+}
+
+// seoDescription returns the first non empty candidate with whitespace
+// collapsed, cut at a word boundary to a length search engines display.
+func seoDescription(candidates ...string) string {
+	for _, c := range candidates {
+		c = strings.Join(strings.Fields(c), " ")
+		if c == "" {
+			continue
+		}
+
+		runes := []rune(c)
+		if len(runes) <= seoDescriptionMaxLen {
+			return c
+		}
+
+		cut := string(runes[:seoDescriptionMaxLen])
+		if i := strings.LastIndex(cut, " "); i > 0 {
+			cut = cut[:i]
+		}
+		return strings.TrimRightFunc(cut, unicode.IsPunct) + "…"
+	}
+
+	return ""
+}
+
+// humanize turns a slug into a title, e.g. go-basics becomes Go basics.
+func humanize(slug string) string {
+	s := strings.TrimSpace(strings.ReplaceAll(slug, "-", " "))
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,205 @@
+package ssg
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSiteInfoURL(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseURL string
+		path    string
+		want    string
+	}{
+		{name: "relative without base url", path: "/blog/post/", want: "/blog/post/"},
+		{name: "joins base url", baseURL: "https://example.com/", path: "/blog/post/", want: "https://example.com/blog/post/"},
+		{name: "adds leading slash", baseURL: "https://example.com", path: "img.png", want: "https://example.com/img.png"},
+		{name: "keeps absolute url", baseURL: "https://example.com", path: "https://cdn.example.com/a.png", want: "https://cdn.example.com/a.png"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (SiteInfo{BaseURL: tt.baseURL}).URL(tt.path); got != tt.want {
+				t.Errorf("URL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewContentSEO(t *testing.T) {
+	site := SiteInfo{Name: "My Site", BaseURL: "https://example.com"}
+	published := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		content         Content
+		image           string
+		wantDescription string
+		wantCanonical   string
+		wantType        string
+		wantImage       string
+		wantPublished   string
+	}{
+		{
+			name: "uses meta description",
+			content: Content{
+				Heading: "Post", ShortID: "abc", Kind: "blog", SectionPath: "/news",
+				Summary: "Summary", Meta: Meta{Description: "Description"}, PublishedAt: &published,
+			},
+			image:           "/news/post-abc/social-card.png",
+			wantDescription: "Description",
+			wantCanonical:   "https://example.com/news/post-abc/",
+			wantType:        "article",
+			wantImage:       "https://example.com/news/post-abc/social-card.png",
+			wantPublished:   "2025-01-02T03:04:05Z",
+		},
+		{
+			name:            "falls back to summary",
+			content:         Content{Heading: "Page", ShortID: "abc", Kind: "page", SectionPath: "/", Summary: "  A   summary "},
+			wantDescription: "A summary",
+			wantCanonical:   "https://example.com/page-abc/",
+			wantType:        "website",
+		},
+		{
+			name: "falls back to excerpt and honors canonical url",
+			content: Content{
+				Heading: "Article", ShortID: "abc", Kind: "article", SectionPath: "/",
+				Meta: Meta{Excerpt: "Excerpt", CanonicalURL: "https://other.com/original"},
+			},
+			wantDescription: "Excerpt",
+			wantCanonical:   "https://other.com/original",
+			wantType:        "article",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewContentSEO(tt.content, site, "structured", tt.image)
+
+			if got.Description != tt.wantDescription {
+				t.Errorf("Description = %q, want %q", got.Description, tt.wantDescription)
+			}
+			if got.Canonical != tt.wantCanonical {
+				t.Errorf("Canonical = %q, want %q", got.Canonical, tt.wantCanonical)
+			}
+			if got.Type != tt.wantType {
+				t.Errorf("Type = %q, want %q", got.Type, tt.wantType)
+			}
+			if got.Image != tt.wantImage {
+				t.Errorf("Image = %q, want %q", got.Image, tt.wantImage)
+			}
+			if got.PublishedTime != tt.wantPublished {
+				t.Errorf("PublishedTime = %q, want %q", got.PublishedTime, tt.wantPublished)
+			}
+			if got.SiteName != site.Name {
+				t.Errorf("SiteName = %q, want %q", got.SiteName, site.Name)
+			}
+		})
+	}
+}
+
+func TestNewIndexSEO(t *testing.T) {
+	site := SiteInfo{Name: "My Site"}
+	news := &Section{Name: "News", Path: "/news", Description: "Latest news"}
+
+	tests := []struct {
+		name            string
+		index           *Index
+		section         *Section
+		page            int
+		wantTitle       string
+		wantDescription string
+		wantURL         string
+	}{
+		{name: "root index", index: &Index{Path: "/", Type: "section"}, page: 1, wantTitle: "My Site", wantURL: "/"},
+		{name: "section index", index: &Index{Path: "/news/", Type: "section"}, section: news, page: 1, wantTitle: "News", wantDescription: "Latest news", wantURL: "/news/"},
+		{name: "paginated section index", index: &Index{Path: "/news/", Type: "section"}, section: news, page: 2, wantTitle: "News - Page 2", wantDescription: "Latest news", wantURL: "/news/page/2/"},
+		{name: "section blog index", index: &Index{Path: "/news/blog/", Type: "blog"}, section: news, page: 1, wantTitle: "News Blog", wantDescription: "Latest news", wantURL: "/news/blog/"},
+		{name: "series index", index: &Index{Path: "/news/go-basics/", Type: "series"}, section: news, page: 1, wantTitle: "Go basics", wantDescription: "Latest news", wantURL: "/news/go-basics/"},
+		{name: "named series index", index: &Index{Path: "/news/go-basics/", Type: "series", Content: []Content{{Series: "go-basics", SeriesName: "Go: The Basics"}}}, section: news, page: 1, wantTitle: "Go: The Basics", wantDescription: "Latest news", wantURL: "/news/go-basics/"},
+		{name: "series overview", index: &Index{Path: "/news/series/", Type: "series-overview"}, section: news, page: 1, wantTitle: "News Series", wantDescription: "Latest news", wantURL: "/news/series/"},
+		{name: "archive index", index: &Index{Path: "/archive/", Type: "archive"}, page: 1, wantTitle: "Archive", wantURL: "/archive/"},
+		{name: "year archive index", index: &Index{Path: "/2024/", Type: "archive"}, page: 1, wantTitle: "2024", wantURL: "/2024/"},
+		{name: "month archive index", index: &Index{Path: "/2024/03/", Type: "archive"}, page: 2, wantTitle: "March 2024 - Page 2", wantURL: "/2024/03/page/2/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewIndexSEO(tt.index, tt.section, site, "structured", "en", tt.page)
+
+			if got.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", got.Title, tt.wantTitle)
+			}
+			if got.Description != tt.wantDescription {
+				t.Errorf("Description = %q, want %q", got.Description, tt.wantDescription)
+			}
+			if got.URL != tt.wantURL {
+				t.Errorf("URL = %q, want %q", got.URL, tt.wantURL)
+			}
+			if got.Type != "website" {
+				t.Errorf("Type = %q, want website", got.Type)
+			}
+		})
+	}
+}
+
+func TestNewIndexSEOLocalized(t *testing.T) {
+	site := SiteInfo{}
+	news := &Section{Name: "Noticias", Path: "/news"}
+
+	tests := []struct {
+		name      string
+		index     *Index
+		section   *Section
+		page      int
+		wantTitle string
+	}{
+		{name: "home", index: &Index{Path: "/es/", Type: "section"}, page: 1, wantTitle: "Inicio"},
+		{name: "section blog", index: &Index{Path: "/es/news/blog/", Type: "blog"}, section: news, page: 1, wantTitle: "Blog de Noticias"},
+		{name: "series overview", index: &Index{Path: "/es/series/", Type: "series-overview"}, page: 1, wantTitle: "Series"},
+		{name: "month archive", index: &Index{Path: "/es/2024/03/", Type: "archive", prefix: "/es"}, page: 2, wantTitle: "marzo 2024 - Página 2"},
+		{name: "archive", index: &Index{Path: "/es/archive/", Type: "archive", prefix: "/es"}, page: 1, wantTitle: "Archivo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewIndexSEO(tt.index, tt.section, site, "structured", "es", tt.page)
+			if got.Title != tt.wantTitle {
+				t.Errorf("Title = %q, want %q", got.Title, tt.wantTitle)
+			}
+		})
+	}
+}
+
+func TestSEODocumentTitle(t *testing.T) {
+	tests := []struct {
+		name string
+		seo  SEO
+		want string
+	}{
+		{name: "with site name", seo: SEO{Title: "Post", SiteName: "Site"}, want: "Post | Site"},
+		{name: "title is site name", seo: SEO{Title: "Site", SiteName: "Site"}, want: "Site"},
+		{name: "without site name", seo: SEO{Title: "Post"}, want: "Post"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.seo.DocumentTitle(); got != tt.want {
+				t.Errorf("DocumentTitle() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSEODescriptionTruncates(t *testing.T) {
+	got := seoDescription(strings.Repeat("word ", 100))
+
+	if len([]rune(got)) > seoDescriptionMaxLen+1 {
+		t.Errorf("seoDescription() length = %d, want at most %d", len([]rune(got)), seoDescriptionMaxLen+1)
+	}
+	if !strings.HasSuffix(got, "word…") {
+		t.Errorf("seoDescription() = %q, want cut at word boundary", got)
+	}
+}
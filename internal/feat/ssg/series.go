@@ -0,0 +1,370 @@
+package ssg
+
+import (
+	"errors"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/hermesgen/hm"
+)
+
+const (
+	SeriesStatusOngoing  = "ongoing"
+	SeriesStatusComplete = "complete"
+)
+
+// ErrInvalidSeriesStatus is returned when a series status is not one of the
+// known ones.
+var ErrInvalidSeriesStatus = errors.New("invalid series status")
+
+// ErrInvalidSeriesOrder is returned when a new order of a series does not
+// list exactly its parts.
+var ErrInvalidSeriesOrder = errors.New("invalid series order")
+
+// Series groups content meant to be read in order. Parts reference it by
+// SeriesID and are ordered by their SeriesOrder.
+type Series struct {
+	// Common
+	ID      uuid.UUID `json:"id" db:"id"`
+	ShortID string    `json:"-" db:"short_id"`
+	ref     string    `json:"-"`
+
+	// Site relationship
+	SiteID uuid.UUID `json:"site_id" db:"site_id"`
+
+	// Series specific fields
+	SectionID   uuid.UUID `json:"section_id" db:"section_id"`
+	Name        string    `json:"name" db:"name"`
+	SlugField   string    `json:"slug" db:"slug"`
+	Description string    `json:"description" db:"description"`
+	CoverImage  string    `json:"cover_image" db:"cover_image"`
+	Status      string    `json:"status" db:"status"`
+
+	SectionPath string `json:"section_path,omitempty" db:"section_path"`
+	SectionName string `json:"section_name,omitempty" db:"section_name"`
+
+	// Audit
+	CreatedBy uuid.UUID `json:"-" db:"created_by"`
+	UpdatedBy uuid.UUID `json:"-" db:"updated_by"`
+	CreatedAt time.Time `json:"-" db:"created_at"`
+	UpdatedAt time.Time `json:"-" db:"updated_at"`
+}
+
+// NewSeries creates a new ongoing Series.
+func NewSeries(name, description string, sectionID uuid.UUID) Series {
+	s := Series{
+		Name:        name,
+		Description: description,
+		SectionID:   sectionID,
+		Status:      SeriesStatusOngoing,
+	}
+
+	return s
+}
+
+// Type returns the type of the entity.
+func (s *Series) Type() string {
+	return "series"
+}
+
+// GetID returns the unique identifier of the entity.
+func (s *Series) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GenID delegates to the functional helper.
+func (s *Series) GenID() {
+	hm.GenID(s)
+}
+
+// SetID sets the unique identifier of the entity.
+func (s *Series) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if s.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		s.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (s *Series) GetShortID() string {
+	return s.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (s *Series) GenShortID() {
+	hm.GenShortID(s)
+}
+
+// SetShortID sets the short ID of the entity.
+func (s *Series) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if s.ShortID == "" || shouldForce {
+		s.ShortID = shortID
+	}
+}
+
+// GenCreateValues delegates to the functional helper.
+func (s *Series) GenCreateValues(userID ...uuid.UUID) {
+	hm.SetCreateValues(s, userID...)
+}
+
+// GenUpdateValues delegates to the functional helper.
+func (s *Series) GenUpdateValues(userID ...uuid.UUID) {
+	hm.SetUpdateValues(s, userID...)
+}
+
+// CreatedBy returns the UUID of the user who created the entity.
+func (s *Series) GetCreatedBy() uuid.UUID {
+	return s.CreatedBy
+}
+
+// UpdatedBy returns the UUID of the user who last updated the entity.
+func (s *Series) GetUpdatedBy() uuid.UUID {
+	return s.UpdatedBy
+}
+
+// CreatedAt returns the creation time of the entity.
+func (s *Series) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// UpdatedAt returns the last update time of the entity.
+func (s *Series) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetCreatedAt implements the Auditable interface.
+func (s *Series) SetCreatedAt(createdAt time.Time) {
+	s.CreatedAt = createdAt
+}
+
+// SetUpdatedAt implements the Auditable interface.
+func (s *Series) SetUpdatedAt(updatedAt time.Time) {
+	s.UpdatedAt = updatedAt
+}
+
+// SetCreatedBy implements the Auditable interface.
+func (s *Series) SetCreatedBy(createdBy uuid.UUID) {
+	s.CreatedBy = createdBy
+}
+
+// SetUpdatedBy implements the Auditable interface.
+func (s *Series) SetUpdatedBy(updatedBy uuid.UUID) {
+	s.UpdatedBy = updatedBy
+}
+
+// IsZero returns true if the Series is uninitialized.
+func (s *Series) IsZero() bool {
+	return s.ID == uuid.Nil
+}
+
+// Slug returns the path segment of the series index. Unlike tags it has no
+// short ID suffix, so it matches the series name of legacy content.
+func (s *Series) Slug() string {
+	if s.SlugField != "" {
+		return s.SlugField
+	}
+	return NormalizeSlug(s.Name)
+}
+
+func (s *Series) OptValue() string {
+	return s.GetID().String()
+}
+
+func (s *Series) OptLabel() string {
+	return s.Name
+}
+
+func (s *Series) Ref() string {
+	return s.ref
+}
+
+func (s *Series) SetRef(ref string) {
+	s.ref = ref
+}
+
+// ValidSeriesStatus reports whether status is a known series status.
+func ValidSeriesStatus(status string) bool {
+	return status == SeriesStatusOngoing || status == SeriesStatusComplete
+}
+
+// SeriesOverviewPath returns the path of the index listing the series of a
+// section, e.g. /guides/series/ or /series/ for root.
+func SeriesOverviewPath(sectionPath string) string {
+	return path.Join("/", sectionPath, "series") + "/"
+}
+
+// ResolveSeries sets the series slug and name of the contents that reference
+// one of series, so renamed series are published under their current slug
+// and titled after their current name.
+func ResolveSeries(contents []Content, series []Series) {
+	byID := make(map[uuid.UUID]*Series, len(series))
+	for i := range series {
+		byID[series[i].ID] = &series[i]
+	}
+
+	for i := range contents {
+		if s, ok := byID[contents[i].SeriesID]; ok {
+			contents[i].Series = s.Slug()
+			contents[i].SeriesName = s.Name
+		}
+	}
+}
+
+// SeriesTitle returns the name of the series content is a part of. Series
+// only known by the slug of their parts are named after it.
+func SeriesTitle(content Content) string {
+	if content.SeriesName != "" {
+		return content.SeriesName
+	}
+	return humanize(content.Series)
+}
+
+// seriesIndexTitle returns the name of the series listed by a series index.
+func seriesIndexTitle(index *Index) string {
+	for _, c := range index.Content {
+		if c.SeriesName != "" {
+			return c.SeriesName
+		}
+	}
+	return humanize(path.Base(strings.TrimSuffix(index.Path, "/")))
+}
+
+// BuildSeriesOverviews returns, in structured mode, one index per section
+// with published series content. Each item of an overview represents a
+// series: its name, description, cover and status, linking to the series
+// index. Items are ordered by their latest part, newest first.
+func BuildSeriesOverviews(allContent []Content, allSeries []Series, mode, defaultLocale string) []*Index {
+	if mode != "structured" {
+		return nil
+	}
+
+	bySlug := make(map[string]Series, len(allSeries))
+	for _, s := range allSeries {
+		bySlug[s.Slug()] = s
+	}
+
+	indexes := make(map[string]*Index)
+	cards := make(map[string]*Content)
+	var order []string
+
+	for _, content := range allContent {
+		if content.Draft || strings.ToLower(content.Kind) != "series" || content.Series == "" {
+			continue
+		}
+
+		seriesPath := seriesIndexPath(content.SectionPath, content.Series)
+		card, ok := cards[seriesPath]
+		if !ok {
+			card = seriesCard(content, bySlug[content.Series], defaultLocale)
+			cards[seriesPath] = card
+			order = append(order, seriesPath)
+		}
+		if content.PublishedAt != nil && (card.PublishedAt == nil || content.PublishedAt.After(*card.PublishedAt)) {
+			card.PublishedAt = content.PublishedAt
+		}
+	}
+
+	for _, seriesPath := range order {
+		card := cards[seriesPath]
+		overviewPath := SeriesOverviewPath(card.SectionPath)
+		if _, ok := indexes[overviewPath]; !ok {
+			indexes[overviewPath] = &Index{Path: overviewPath, Type: "series-overview", Content: []Content{}}
+		}
+		indexes[overviewPath].Content = append(indexes[overviewPath].Content, *card)
+	}
+
+	result := make([]*Index, 0, len(indexes))
+	for _, index := range indexes {
+		sort.SliceStable(index.Content, func(i, j int) bool {
+			a, b := index.Content[i].PublishedAt, index.Content[j].PublishedAt
+			if a == nil || b == nil {
+				return a != nil
+			}
+			return a.After(*b)
+		})
+		result = append(result, index)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// BuildLocaleSeriesOverviews builds the series overviews of every locale in
+// allContent, prefixed like the ones of BuildLocaleIndexes.
+func BuildLocaleSeriesOverviews(allContent []Content, allSeries []Series, mode, defaultLocale string) []*Index {
+	return buildLocaleIndexes(allContent, defaultLocale, func(contents []Content) []*Index {
+		return BuildSeriesOverviews(contents, allSeries, mode, defaultLocale)
+	})
+}
+
+// seriesCard returns the list item representing the series of part. Series
+// only known by the slug of their parts are named after it.
+func seriesCard(part Content, series Series, defaultLocale string) *Content {
+	card := &Content{
+		Kind:        "series",
+		Heading:     humanize(part.Series),
+		Series:      part.Series,
+		SectionID:   part.SectionID,
+		SectionPath: part.SectionPath,
+		SectionName: part.SectionName,
+		Locale:      part.Locale,
+		Permalink:   LocalizePath(seriesIndexPath(part.SectionPath, part.Series), part.Locale, defaultLocale),
+	}
+
+	if series.Name != "" {
+		card.Heading = series.Name
+	}
+	card.Excerpt = series.Description
+	card.HeaderImageURL = series.CoverImage
+	if series.Status != "" {
+		card.SeriesStatus = Translate(part.Locale, hm.Cap(series.Status))
+	}
+
+	return card
+}
+
+// BuildSubmenu returns the links to the blog and series indexes of a section,
+// shown below the main menu when the section has published content of those
+// kinds in locale. Only structured sites have them. Paths are localized and
+// the one matching currentPath is flagged.
+func BuildSubmenu(contents []Content, sectionPath, mode, locale, defaultLocale, currentPath string) []MenuLink {
+	if mode != "structured" {
+		return nil
+	}
+
+	locale = localeOrDefault(locale, defaultLocale)
+	var hasBlog, hasSeries bool
+	for _, c := range contents {
+		if c.Draft || c.SectionPath != sectionPath || localeOrDefault(c.Locale, defaultLocale) != locale {
+			continue
+		}
+		switch strings.ToLower(c.Kind) {
+		case "blog":
+			hasBlog = true
+		case "series":
+			hasSeries = hasSeries || c.Series != ""
+		}
+	}
+
+	var links []MenuLink
+	if hasBlog {
+		links = append(links, MenuLink{Name: "Blog", Path: GetIndexPath(sectionPath, "blog", mode)})
+	}
+	if hasSeries {
+		links = append(links, MenuLink{Name: "Series", Path: SeriesOverviewPath(sectionPath)})
+	}
+
+	for i := range links {
+		links[i].Path = LocalizePath(links[i].Path, locale, defaultLocale)
+	}
+
+	return MarkActiveLinks(links, LocalizePath("/", locale, defaultLocale), currentPath)
+}
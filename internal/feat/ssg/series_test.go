@@ -0,0 +1,206 @@
+package ssg
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func seriesTestContent() ([]Content, []Series) {
+	date := func(year int, month time.Month, day int) *time.Time {
+		t := time.Date(year, month, day, 10, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	goID, rustID := uuid.New(), uuid.New()
+	series := []Series{
+		{ID: goID, Name: "Learning Go", SlugField: "learning-go", Description: "Go from zero", CoverImage: "/static/go.png", Status: SeriesStatusComplete},
+		{ID: rustID, Name: "Rust Notes", SlugField: "rust-notes", Status: SeriesStatusOngoing},
+	}
+
+	contents := []Content{
+		{Heading: "Go 1", Kind: "series", SeriesID: goID, Series: "learning-go", SectionPath: "/guides/", PublishedAt: date(2024, time.January, 1)},
+		{Heading: "Go 2", Kind: "series", SeriesID: goID, Series: "learning-go", SectionPath: "/guides/", PublishedAt: date(2024, time.February, 1)},
+		{Heading: "Rust 1", Kind: "series", SeriesID: rustID, Series: "rust-notes", SectionPath: "/guides/", PublishedAt: date(2024, time.March, 1)},
+		{Heading: "Rust draft", Kind: "series", SeriesID: rustID, Series: "rust-notes", SectionPath: "/guides/", Draft: true, PublishedAt: date(2024, time.April, 1)},
+		{Heading: "Legacy 1", Kind: "series", Series: "legacy-series", SectionPath: "/", PublishedAt: date(2023, time.May, 1)},
+		{Heading: "Post", Kind: "blog", SectionPath: "/guides/", PublishedAt: date(2024, time.May, 1)},
+	}
+
+	return contents, series
+}
+
+func TestSeriesOverviewPath(t *testing.T) {
+	tests := []struct {
+		sectionPath string
+		want        string
+	}{
+		{"/", "/series/"},
+		{"", "/series/"},
+		{"/guides/", "/guides/series/"},
+		{"guides", "/guides/series/"},
+	}
+
+	for _, tt := range tests {
+		if got := SeriesOverviewPath(tt.sectionPath); got != tt.want {
+			t.Errorf("SeriesOverviewPath(%q) = %q, want %q", tt.sectionPath, got, tt.want)
+		}
+	}
+}
+
+func TestSeriesSlug(t *testing.T) {
+	tests := []struct {
+		name   string
+		series Series
+		want   string
+	}{
+		{name: "uses slug field", series: Series{Name: "Learning Go", SlugField: "go"}, want: "go"},
+		{name: "derives slug from name", series: Series{Name: "Learning Go"}, want: "learning-go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.series.Slug(); got != tt.want {
+				t.Errorf("Slug() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveSeries(t *testing.T) {
+	id := uuid.New()
+	series := []Series{{ID: id, Name: "Renamed", SlugField: "renamed"}}
+	contents := []Content{
+		{Heading: "Part", SeriesID: id, Series: "old-name"},
+		{Heading: "Legacy", Series: "legacy"},
+	}
+
+	ResolveSeries(contents, series)
+
+	if contents[0].Series != "renamed" {
+		t.Errorf("part series = %q, want renamed", contents[0].Series)
+	}
+	if contents[0].SeriesName != "Renamed" {
+		t.Errorf("part series name = %q, want Renamed", contents[0].SeriesName)
+	}
+	if contents[1].Series != "legacy" {
+		t.Errorf("legacy series = %q, want legacy", contents[1].Series)
+	}
+}
+
+func TestBuildSeriesOverviews(t *testing.T) {
+	contents, series := seriesTestContent()
+
+	if got := BuildSeriesOverviews(contents, series, "blog", "en"); got != nil {
+		t.Errorf("blog mode got %d overviews, want none", len(got))
+	}
+
+	indexes := BuildSeriesOverviews(contents, series, "structured", "en")
+	want := map[string][]string{
+		"/series/":        {"Legacy series"},
+		"/guides/series/": {"Rust Notes", "Learning Go"},
+	}
+
+	if len(indexes) != len(want) {
+		t.Fatalf("got %d overviews, want %d", len(indexes), len(want))
+	}
+	for _, idx := range indexes {
+		headings, ok := want[idx.Path]
+		if !ok {
+			t.Errorf("unexpected overview %s", idx.Path)
+			continue
+		}
+		if idx.Type != "series-overview" {
+			t.Errorf("overview %s type = %q, want series-overview", idx.Path, idx.Type)
+		}
+		if len(idx.Content) != len(headings) {
+			t.Errorf("overview %s has %d items, want %d", idx.Path, len(idx.Content), len(headings))
+			continue
+		}
+		for i, heading := range headings {
+			if idx.Content[i].Heading != heading {
+				t.Errorf("overview %s item %d = %q, want %q", idx.Path, i, idx.Content[i].Heading, heading)
+			}
+		}
+	}
+
+	var card Content
+	for _, idx := range indexes {
+		for _, c := range idx.Content {
+			if c.Heading == "Learning Go" {
+				card = c
+			}
+		}
+	}
+	if card.Permalink != "/guides/learning-go/" {
+		t.Errorf("card permalink = %q, want /guides/learning-go/", card.Permalink)
+	}
+	if card.Excerpt != "Go from zero" || card.HeaderImageURL != "/static/go.png" {
+		t.Errorf("card excerpt, image = %q, %q", card.Excerpt, card.HeaderImageURL)
+	}
+	if card.SeriesStatus != "Complete" {
+		t.Errorf("card status = %q, want Complete", card.SeriesStatus)
+	}
+	if card.PublishedAt == nil || card.PublishedAt.Month() != time.February {
+		t.Errorf("card published at = %v, want latest part", card.PublishedAt)
+	}
+}
+
+func TestBuildSubmenu(t *testing.T) {
+	contents, _ := seriesTestContent()
+	contents = append(contents, Content{Heading: "Publicación", Kind: "blog", SectionPath: "/guides/", Locale: "es"})
+
+	tests := []struct {
+		name        string
+		sectionPath string
+		mode        string
+		locale      string
+		currentPath string
+		want        []MenuLink
+	}{
+		{
+			name:        "section with blog and series",
+			sectionPath: "/guides/",
+			mode:        "structured",
+			currentPath: "/guides/series/",
+			want: []MenuLink{
+				{Name: "Blog", Path: "/guides/blog/"},
+				{Name: "Series", Path: "/guides/series/", Current: true},
+			},
+		},
+		{
+			name:        "section with series only",
+			sectionPath: "/",
+			mode:        "structured",
+			want:        []MenuLink{{Name: "Series", Path: "/series/"}},
+		},
+		{
+			name:        "localized links",
+			sectionPath: "/guides/",
+			mode:        "structured",
+			locale:      "es",
+			want:        []MenuLink{{Name: "Blog", Path: "/es/guides/blog/"}},
+		},
+		{
+			name:        "blog mode has none",
+			sectionPath: "/guides/",
+			mode:        "blog",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildSubmenu(contents, tt.sectionPath, tt.mode, tt.locale, "en", tt.currentPath)
+
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i].Name != tt.want[i].Name || got[i].Path != tt.want[i].Path || got[i].Current != tt.want[i].Current {
+					t.Errorf("link %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
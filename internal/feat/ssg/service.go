@@ -5,15 +5,19 @@ import (
 	"context"
 	"crypto/sha256"
 	"database/sql"
-	"embed"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"io/fs"
 	"mime/multipart"
+	"net/url"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -48,6 +52,70 @@ type Service interface {
 	UpdateTag(ctx context.Context, tag Tag) error
 	DeleteTag(ctx context.Context, id uuid.UUID) error
 
+	CreateSeries(ctx context.Context, series Series) error
+	GetSeries(ctx context.Context, id uuid.UUID) (Series, error)
+	GetAllSeries(ctx context.Context) ([]Series, error)
+	UpdateSeries(ctx context.Context, series Series) error
+	DeleteSeries(ctx context.Context, id uuid.UUID) error
+	GetSeriesContents(ctx context.Context, id uuid.UUID) ([]Content, error)
+	ReorderSeries(ctx context.Context, id uuid.UUID, contentIDs []uuid.UUID) error
+
+	CreateAuthor(ctx context.Context, author Author) error
+	GetAuthor(ctx context.Context, id uuid.UUID) (Author, error)
+	GetAllAuthors(ctx context.Context) ([]Author, error)
+	UpdateAuthor(ctx context.Context, author Author) error
+	DeleteAuthor(ctx context.Context, id uuid.UUID) error
+	GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]Author, error)
+	SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error
+
+	// Content type related
+	CreateContentType(ctx context.Context, contentType ContentType) error
+	GetContentType(ctx context.Context, id uuid.UUID) (ContentType, error)
+	GetContentTypes(ctx context.Context) ([]ContentType, error)
+	UpdateContentType(ctx context.Context, contentType ContentType) error
+	DeleteContentType(ctx context.Context, id uuid.UUID) error
+	GetFieldsForContent(ctx context.Context, contentID uuid.UUID) (map[string]string, error)
+	SetContentFields(ctx context.Context, contentID uuid.UUID, fields map[string]string) error
+
+	// Archetype related
+	CreateArchetype(ctx context.Context, archetype Archetype) error
+	GetArchetype(ctx context.Context, id uuid.UUID) (Archetype, error)
+	GetArchetypes(ctx context.Context) ([]Archetype, error)
+	UpdateArchetype(ctx context.Context, archetype Archetype) error
+	DeleteArchetype(ctx context.Context, id uuid.UUID) error
+	ApplyArchetype(ctx context.Context, name string, content *Content) error
+
+	// Comment related
+	SubmitComment(ctx context.Context, comment *Comment) error
+	GetComment(ctx context.Context, id uuid.UUID) (Comment, error)
+	GetComments(ctx context.Context, status string) ([]Comment, error)
+	ModerateComment(ctx context.Context, id uuid.UUID, status string) error
+	DeleteComment(ctx context.Context, id uuid.UUID) error
+	GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error)
+	GenerateCommentPages(ctx context.Context) (int, error)
+
+	ListThemes(ctx context.Context) ([]ThemeManifest, error)
+	InstallTheme(ctx context.Context, src string) (ThemeManifest, error)
+	RemoveTheme(ctx context.Context, name string) error
+	SelectTheme(ctx context.Context, name string) error
+
+	// Data file related
+	ListDataFiles(ctx context.Context) ([]DataFile, error)
+	GetDataFile(ctx context.Context, name string) (DataFile, error)
+	SaveDataFile(ctx context.Context, file DataFile) error
+	DeleteDataFile(ctx context.Context, name string) error
+
+	CreateMenu(ctx context.Context, menu Menu) error
+	GetMenu(ctx context.Context, id uuid.UUID) (Menu, error)
+	GetMenus(ctx context.Context) ([]Menu, error)
+	UpdateMenu(ctx context.Context, menu Menu) error
+	DeleteMenu(ctx context.Context, id uuid.UUID) error
+	CreateMenuItem(ctx context.Context, item MenuItem) error
+	GetMenuItem(ctx context.Context, id uuid.UUID) (MenuItem, error)
+	UpdateMenuItem(ctx context.Context, item MenuItem) error
+	DeleteMenuItem(ctx context.Context, id uuid.UUID) error
+	MoveMenuItem(ctx context.Context, id uuid.UUID, direction string) error
+
 	CreateParam(ctx context.Context, param *Param) error
 	GetParam(ctx context.Context, id uuid.UUID) (Param, error)
 	GetParamByName(ctx context.Context, name string) (Param, error)
@@ -76,6 +144,14 @@ type Service interface {
 	GetContentImages(ctx context.Context, contentID uuid.UUID) ([]ImageWithMeta, error)
 	DeleteContentImage(ctx context.Context, contentID uuid.UUID, imagePath string) error
 
+	// Content Alias Management
+	GetContentAliases(ctx context.Context, contentID uuid.UUID) ([]ContentAlias, error)
+	AddContentAlias(ctx context.Context, contentID uuid.UUID, aliasPath string) (ContentAlias, error)
+	DeleteContentAlias(ctx context.Context, contentID, id uuid.UUID) error
+
+	// Content cross references
+	GetContentBacklinks(ctx context.Context, contentID uuid.UUID) ([]Content, error)
+
 	// Section Image Management
 	UploadSectionImage(ctx context.Context, sectionID uuid.UUID, file multipart.File, header *multipart.FileHeader, imageType ImageType, altText, caption string) (*ImageProcessResult, error)
 	DeleteSectionImage(ctx context.Context, sectionID uuid.UUID, imageType ImageType) error
@@ -100,7 +176,7 @@ type ImageManagerInterface interface {
 // BaseService is the concrete implementation of the Service interface.
 type BaseService struct {
 	*hm.Service
-	assetsFS embed.FS
+	assetsFS fs.FS
 	repo     Repo
 	gen      *Generator
 	pub      Publisher
@@ -108,7 +184,7 @@ type BaseService struct {
 	im       ImageManagerInterface
 }
 
-func NewService(assetsFS embed.FS, repo Repo, gen *Generator, publisher Publisher, pm *ParamManager, im *ImageManager, params hm.XParams) *BaseService {
+func NewService(assetsFS fs.FS, repo Repo, gen *Generator, publisher Publisher, pm *ParamManager, im *ImageManager, params hm.XParams) *BaseService {
 	if repo == nil {
 		panic("ssg.NewService: repo is required and cannot be nil")
 	}
@@ -211,6 +287,21 @@ func (svc *BaseService) GenerateMarkdown(ctx context.Context) error {
 		return fmt.Errorf("cannot get all content with meta: %w", err)
 	}
 
+	if err := svc.resolveContentTypes(ctx, svc.repo, contents); err != nil {
+		return err
+	}
+
+	if svc.pm != nil {
+		svc.resolvePermalinks(ctx, contents, svc.pm.GetSiteMode(ctx))
+	}
+
+	if svc.socialCardsEnabled(ctx) {
+		siteMode := svc.pm.GetSiteMode(ctx)
+		for i := range contents {
+			contents[i].SocialImageURL = GetSocialCardPath(contents[i], siteMode)
+		}
+	}
+
 	if err := svc.gen.Generate(ctx, siteSlug, contents); err != nil {
 		return fmt.Errorf("cannot generate markdown: %w", err)
 	}
@@ -220,7 +311,23 @@ func (svc *BaseService) GenerateMarkdown(ctx context.Context) error {
 }
 
 // GenerateHTMLFromContent generates HTML files from the content in the database.
+// Pages queued for a comment rebuild are up to date afterwards.
 func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
+	ids, err := svc.takeCommentRebuilds(ctx)
+	if err != nil {
+		return err
+	}
+	if err := svc.generateHTML(ctx, nil); err != nil {
+		svc.requeueCommentRebuilds(ctx, ids)
+		return err
+	}
+	return nil
+}
+
+// generateHTML generates the HTML files of the site. When only is not nil,
+// just the pages of the content in it are written, leaving indexes, assets
+// and redirects as they are.
+func (svc *BaseService) generateHTML(ctx context.Context, only map[uuid.UUID]bool) error {
 	svc.Log().Info("Service starting HTML generation")
 
 	repo := svc.getRepo(ctx)
@@ -243,34 +350,70 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 		return fmt.Errorf("cannot get sections: %w", err)
 	}
 
+	allSeries, err := repo.GetAllSeries(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get series: %w", err)
+	}
+	ResolveSeries(contents, allSeries)
+
+	authors, err := repo.GetAllAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get authors: %w", err)
+	}
+	contentAuthors, err := repo.GetContentAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get content authors: %w", err)
+	}
+	ResolveAuthors(contents, authors, contentAuthors, svc.pm.GetSiteLocale(ctx))
+
+	if err := svc.resolveContentTypes(ctx, repo, contents); err != nil {
+		return err
+	}
+
+	approved, err := repo.GetComments(ctx, CommentApproved)
+	if err != nil {
+		return fmt.Errorf("cannot get comments: %w", err)
+	}
+	comments := CommentsByContent(approved)
+
 	// Get site mode to determine UI behavior
 	siteMode := svc.pm.GetSiteMode(ctx)
 	svc.Log().Infof("Site mode: %s", siteMode)
 
-	// In blog mode, hide section menu (only root exists, no need to show sections)
+	svc.resolvePermalinks(ctx, contents, siteMode)
+	refs := NewContentRefs(contents, siteMode)
+	SetReadingStats(contents, svc.wordsPerMinute(ctx), refs)
+	defaultLocale := svc.pm.GetSiteLocale(ctx)
+
+	contentsByLocale := make(map[string][]Content)
+	for _, c := range contents {
+		contentsByLocale[c.Locale] = append(contentsByLocale[c.Locale], c)
+	}
+
+	archives := make(map[string][]ArchiveYear)
+	archiveSummary := func(locale string) []ArchiveYear {
+		if _, ok := archives[locale]; !ok {
+			archives[locale] = BuildArchiveSummary(contents, siteMode, locale, defaultLocale)
+		}
+		return archives[locale]
+	}
+
+	// In blog mode, hide section menu (only root exists, no need to show sections).
+	// Only layouts still reading the deprecated PageData.Menu use it.
 	var menuSections []Section
 	if siteMode == "structured" {
-		for _, s := range sections {
-			if s.Name != "root" {
-				menuSections = append(menuSections, s)
-			}
-		}
+		menuSections = TopLevelSections(sections)
 	}
 
-	layoutPath := svc.Cfg().StrValOrDef(SSGKey.LayoutPath, "assets/ssg/layout/layout.html")
-	tmpl, err := template.ParseFS(svc.assetsFS,
-		layoutPath,
-		"assets/ssg/partial/list.tmpl",
-		"assets/ssg/partial/blocks.tmpl",
-		"assets/ssg/partial/article-blocks.tmpl",
-		"assets/ssg/partial/blog-blocks.tmpl",
-		"assets/ssg/partial/series-blocks.tmpl",
-		"assets/ssg/partial/pagination.tmpl",
-		"assets/ssg/partial/google-search.tmpl",
-	)
+	menus, err := svc.GetMenus(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get menus: %w", err)
+	}
+	tags, err := repo.GetAllTags(ctx)
 	if err != nil {
-		return fmt.Errorf("cannot parse template from embedded fs: %w", err)
+		return fmt.Errorf("cannot get tags: %w", err)
 	}
+	siteMenus := NewSiteMenus(menus, sections, contents, tags, siteMode, defaultLocale)
 
 	siteSlug, ok := GetSiteSlugFromContext(ctx)
 	if !ok {
@@ -280,20 +423,70 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 	sitesBasePath := svc.Cfg().StrValOrDef(SSGKey.SitesBasePath, "_workspace/sites")
 	htmlPath := GetSiteHTMLPath(sitesBasePath, siteSlug)
 
-	if err := CopyStaticAssets(svc.assetsFS, htmlPath); err != nil {
-		return fmt.Errorf("cannot copy static assets: %w", err)
+	theme, err := svc.siteTheme(ctx, sitesBasePath, siteSlug)
+	if err != nil {
+		return fmt.Errorf("cannot resolve theme: %w", err)
+	}
+	getParam := func(key, def string) string {
+		return svc.pm.Get(ctx, key, def)
+	}
+	themeInfo := theme.Info(getParam)
+
+	site := svc.siteInfo(ctx, siteSlug)
+	feeds := svc.siteFeeds(ctx, contents, site, siteMode, defaultLocale)
+	siteData, err := svc.siteData(ctx, site, siteMode, sections, tags)
+	if err != nil {
+		return err
+	}
+	siteData.Data, err = LoadSiteData(GetSiteDataPath(sitesBasePath, siteSlug))
+	if err != nil {
+		return fmt.Errorf("cannot load site data: %w", err)
+	}
+	markdownOptions := svc.markdownOptions(ctx)
+	funcs := &TemplateFuncs{
+		Site:     siteData,
+		Contents: contents,
+		Variants: svc.imageVariants(ctx),
+		Param:    getParam,
+		Markdown: markdownOptions,
+	}
+
+	layoutPath := svc.Cfg().StrValOrDef(SSGKey.LayoutPath, ThemeLayoutFile)
+	tmpl, err := template.New(path.Base(layoutPath)).Funcs(funcs.FuncMap()).ParseFS(theme.FS, layoutPath, ThemePartialsPattern)
+	if err != nil {
+		return fmt.Errorf("cannot parse templates of theme %s: %w", theme.Manifest.Name, err)
+	}
+
+	layouts, err := repo.GetAllLayouts(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get layouts: %w", err)
+	}
+	shortcodes, err := NewShortcodes(theme.FS, layouts, funcs.FuncMap())
+	if err != nil {
+		return fmt.Errorf("cannot load shortcodes: %w", err)
 	}
+	var shortcodeErrs, refErrs []error
+	var formsLeftOut int
+
+	processor := NewMarkdownProcessorWithOptions(markdownOptions).WithContentRefs(refs).WithShortcodes(shortcodes)
+
+	if only == nil {
+		if err := CopyStaticAssets(theme.FS, htmlPath); err != nil {
+			return fmt.Errorf("cannot copy static assets: %w", err)
+		}
 
-	// Copy dynamic images from assets/images to html/static/images
-	docsDir := GetSiteDocsPath(sitesBasePath, siteSlug)
-	svc.Log().Info("Copying dynamic images", "from", filepath.Join(docsDir, "assets", "images"), "to", filepath.Join(htmlPath, "static", "images"))
-	if err := CopyDynamicImages(docsDir, htmlPath); err != nil {
-		svc.Log().Error("Failed to copy dynamic images", "error", err)
-		return fmt.Errorf("cannot copy dynamic images: %w", err)
+		// Copy dynamic images from assets/images to html/static/images
+		docsDir := GetSiteDocsPath(sitesBasePath, siteSlug)
+		svc.Log().Info("Copying dynamic images", "from", filepath.Join(docsDir, "assets", "images"), "to", filepath.Join(htmlPath, "static", "images"))
+		if err := CopyDynamicImages(docsDir, htmlPath); err != nil {
+			svc.Log().Error("Failed to copy dynamic images", "error", err)
+			return fmt.Errorf("cannot copy dynamic images: %w", err)
+		}
+		svc.Log().Info("Dynamic images copied successfully")
 	}
-	svc.Log().Info("Dynamic images copied successfully")
 
 	headerStyle := svc.Cfg().StrValOrDef(SSGKey.HeaderStyle, "boxed", true)
+	cards := svc.newSocialCards(ctx, htmlPath, site, theme.FS)
 	imageExtensions := []string{".png", ".jpg", ".jpeg", ".webp"}
 
 	// Prepare SearchData
@@ -305,6 +498,9 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 	svc.Log().Infof("SearchData: enabled=%v, id=%s", searchData.Enabled, searchData.ID)
 
 	for _, content := range contents {
+		if only != nil && !only[content.ID] {
+			continue
+		}
 		svc.Log().Debug("Processing content for HTML generation", "slug", content.Slug(), "section_path", content.SectionPath)
 		if content.Draft {
 			svc.Log().Debug("Skipping draft content", "slug", content.Slug())
@@ -358,42 +554,106 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 		for _, img := range contentImages {
 			svc.Log().Debug("Adding image to context", "filePath", img.FilePath, "altText", img.AltText)
 			imageContext.Images[img.FilePath] = ImageMetadata{
-				AltText: img.AltText,
-				Title:   img.Title,
+				AltText:         img.AltText,
+				AltLang:         img.AltLang,
+				Title:           img.Title,
+				LongDescription: img.LongDescription,
+				Caption:         img.Caption,
+				Decorative:      img.Decorative,
+				Credit:          img.Credit,
+				License:         img.License,
+				SourceURL:       img.SourceURL,
 			}
 		}
 
-		processor := NewMarkdownProcessor()
+		processor.SetPage(&ShortcodeContext{
+			Content:  content,
+			Images:   contentImages,
+			Contents: contentsByLocale[content.Locale],
+			Mode:     siteMode,
+			Site:     siteData,
+		})
 
 		htmlBody, err := processor.ToHTMLWithImageContext([]byte(content.Body), imageContext)
 		if err != nil {
 			svc.Log().Error("Error converting markdown to HTML", "slug", content.Slug(), "error", err)
+			var scErr *ShortcodeError
+			if errors.As(err, &scErr) {
+				shortcodeErrs = append(shortcodeErrs, fmt.Errorf("content %s: %w", content.Slug(), err))
+			}
 			continue
 		}
+		for _, w := range processor.Warnings() {
+			svc.Log().Error("Unresolved content reference", "slug", content.Slug(), "error", w)
+			refErrs = append(refErrs, fmt.Errorf("content %s: %w", content.Slug(), w))
+		}
 
 		if headerStyle == "boxed" || headerStyle == "overlay" {
 			htmlBody = svc.removeFirstH1(htmlBody)
 		}
 
+		socialImage := content.HeaderImageURL
+		if cards != nil {
+			cardURL, err := cards.write(content, htmlPath, siteMode)
+			if err != nil {
+				svc.Log().Error("Error generating social card", "slug", content.Slug(), "error", err)
+			} else {
+				socialImage = cardURL
+			}
+		}
+
 		pageContent := PageContent{
 			Heading:            content.Heading,
 			HeaderImage:        headerImagePath,
 			HeaderImageAlt:     content.HeaderImageAlt,
 			HeaderImageCaption: content.HeaderImageCaption,
+			SocialImage:        socialImage,
+			PublishedAt:        content.PublishedAt,
 			Body:               template.HTML(htmlBody),
 			Kind:               content.Kind,
+			Excerpt:            content.Excerpt,
+			WordCount:          content.WordCount,
+			ReadingTime:        content.ReadingTime,
+			Authors:            content.Authors,
+			Fields:             content.FieldValues,
+			Layout:             content.Layout,
+		}
+		if content.Meta.Comments {
+			pageContent.CommentsOpen = true
+			pageContent.Comments = comments[content.ID]
+			pageContent.CommentURL = svc.commentURL(ctx, siteSlug, content.ID)
+			if pageContent.CommentURL == "" {
+				formsLeftOut++
+			}
 		}
 
-		blocks := BuildBlocks(content, contents, int(svc.Cfg().IntVal(SSGKey.BlocksMaxItems, 5)))
+		blocks := BuildBlocks(content, contentsByLocale[content.Locale], int(svc.Cfg().IntVal(SSGKey.BlocksMaxItems, 5)))
 
 		data := PageData{
-			HeaderStyle: headerStyle,
-			AssetPath:   assetPath,
-			Menu:        menuSections,
-			Content:     pageContent,
-			Blocks:      blocks,
-			Search:      searchData,
+			Site:         siteData,
+			HeaderStyle:  headerStyle,
+			AssetPath:    assetPath,
+			Menu:         LocalizeMenu(menuSections, content.Locale, defaultLocale),
+			Content:      pageContent,
+			Blocks:       blocks,
+			Search:       searchData,
+			Locale:       content.Locale,
+			HomePath:     LocalizePath("/", content.Locale, defaultLocale),
+			Translations: BuildContentTranslations(content, contents, site, siteMode, defaultLocale),
+			Archive:      archiveSummary(content.Locale),
+			MainMenu:     siteMenus.Main(content.Locale, content.Permalink),
+			FooterMenu:   siteMenus.Footer(content.Locale, content.Permalink),
+			Theme:        themeInfo,
+			Feed:         feedURL(feeds, site, localeOrDefault(content.Locale, defaultLocale)),
+		}
+		if submenu, ok := siteMenus.Submenu(content.Locale, content.Permalink); ok {
+			data.Submenu = submenu
+		} else {
+			data.Submenu = BuildSubmenu(contents, content.SectionPath, siteMode, content.Locale, defaultLocale, content.Permalink)
 		}
+		data.SEO = NewContentSEO(content, site, siteMode, socialImage)
+		data.Breadcrumbs = LocalizeBreadcrumbs(BuildContentBreadcrumbs(content, sections, siteMode), content.Locale, defaultLocale)
+		data.StructuredData = ContentStructuredData(content, data.SEO, data.Breadcrumbs, site)
 
 		var buf bytes.Buffer
 		if err := tmpl.Execute(&buf, data); err != nil {
@@ -414,9 +674,52 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 			continue
 		}
 	}
+	if formsLeftOut > 0 {
+		svc.Log().Info("Comment forms left out: set the comments URL so published pages can take comments", "param", SSGKey.CommentsURL, "pages", formsLeftOut)
+	}
+
+	if only != nil {
+		if err := buildErrors(shortcodeErrs, refErrs); err != nil {
+			return err
+		}
+		svc.Log().Info("Service HTML generation finished", "pages", len(only))
+		return nil
+	}
 
 	// Generate index pages
-	indexes := BuildIndexes(contents, sections, siteMode)
+	indexes := BuildLocaleIndexes(contents, sections, siteMode, defaultLocale)
+	indexPaths := make(map[string]bool, len(indexes))
+	for _, idx := range indexes {
+		indexPaths[idx.Path] = true
+	}
+	for _, idx := range BuildLocaleArchiveIndexes(contents, siteMode, defaultLocale) {
+		if indexPaths[idx.Path] {
+			svc.Log().Info("Skipping archive index: path already used", "path", idx.Path)
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	for _, idx := range BuildLocaleSeriesOverviews(contents, allSeries, siteMode, defaultLocale) {
+		if indexPaths[idx.Path] {
+			svc.Log().Info("Skipping series overview: path already used", "path", idx.Path)
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	for _, idx := range BuildLocaleTagIndexes(contents, defaultLocale) {
+		if indexPaths[idx.Path] {
+			svc.Log().Info("Skipping tag index: path already used", "path", idx.Path)
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
+	for _, idx := range BuildLocaleAuthorIndexes(contents, defaultLocale) {
+		if indexPaths[idx.Path] {
+			svc.Log().Info("Skipping author index: path already used", "path", idx.Path)
+			continue
+		}
+		indexes = append(indexes, idx)
+	}
 	svc.Log().Infof("Built %d indexes (mode: %s)", len(indexes), siteMode)
 	for _, idx := range indexes {
 		svc.Log().Infof("  Index: path=%s, type=%s, content_count=%d", idx.Path, idx.Type, len(idx.Content))
@@ -425,12 +728,13 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 	// Create a lookup map for manual index pages
 	manualIndexPages := make(map[string]bool)
 	for _, c := range contents {
-		if strings.ToLower(c.Kind) == "page" && c.Slug() == "index" {
-			manualIndexPages[c.SectionPath] = true
+		if IsIndexPage(c) {
+			manualIndexPages[c.Permalink] = true
 		}
 	}
 
 	postsPerPage := int(svc.Cfg().IntVal(SSGKey.IndexMaxItems, 9))
+	composition := svc.composition(ctx)
 
 	for _, index := range indexes {
 		svc.Log().Infof("Processing index: path=%s, content_count=%d", index.Path, len(index.Content))
@@ -444,7 +748,7 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 		// Get section header image for this index
 		var sectionHeaderImage string
 		for _, section := range sections {
-			if section.Path == index.Path {
+			if section.Path == index.BasePath() {
 				headerPath, err := svc.GetSectionHeaderImage(ctx, section.ID)
 				if err == nil && headerPath != "" {
 					sectionHeaderImage = "/static/images/" + strings.TrimPrefix(headerPath, "/")
@@ -452,12 +756,17 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 				break
 			}
 		}
+		indexSection := index.Section(sections)
+		submenu, ok := siteMenus.Submenu(index.Locale, index.Path)
+		if !ok && indexSection != nil {
+			submenu = BuildSubmenu(contents, indexSection.Path, siteMode, index.Locale, defaultLocale, index.Path)
+		}
 
 		// Paginate the content
 		totalContent := len(index.Content)
 		// In blog mode, always generate root index even if empty (it's the homepage)
 		// In structured mode, skip empty indexes
-		if totalContent == 0 && !(siteMode == "blog" && index.Path == "/") {
+		if totalContent == 0 && !(siteMode == "blog" && index.BasePath() == "/") {
 			svc.Log().Info("Skipping empty index", "path", index.Path)
 			continue
 		}
@@ -492,15 +801,35 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 				pagination.NextPageURL = GetPaginationPath(index.Path, page+1, siteMode)
 			}
 
+			locale := localeOrDefault(index.Locale, defaultLocale)
 			data := PageData{
+				Site:               siteData,
 				HeaderStyle:        headerStyle,
 				AssetPath:          assetPath,
-				Menu:               menuSections,
+				Menu:               LocalizeMenu(menuSections, locale, defaultLocale),
 				IsIndex:            true,
 				ListPageContent:    pageContent,
 				Pagination:         pagination,
 				Search:             searchData,
 				SectionHeaderImage: sectionHeaderImage,
+				SEO:                NewIndexSEO(index, indexSection, site, siteMode, locale, page),
+				Locale:             locale,
+				HomePath:           LocalizePath("/", locale, defaultLocale),
+				Translations:       BuildIndexTranslations(index, indexes, site, defaultLocale),
+				IsArchive:          index.Type == "archive",
+				Archive:            archiveSummary(locale),
+				Submenu:            submenu,
+				MainMenu:           siteMenus.Main(locale, index.Path),
+				FooterMenu:         siteMenus.Footer(locale, index.Path),
+				Author:             IndexAuthor(index),
+				Theme:              themeInfo,
+				Feed:               feedURL(feeds, site, locale),
+			}
+			baseIndex := &Index{Path: index.BasePath(), Type: index.Type}
+			data.Breadcrumbs = LocalizeBreadcrumbs(BuildIndexBreadcrumbs(baseIndex, data.SEO.Title, sections, siteMode), locale, defaultLocale)
+			data.StructuredData = IndexStructuredData(index, pageContent, data.SEO, data.Breadcrumbs, site, siteMode)
+			if page == 1 && composition.Applies(index) {
+				data.Composition = BuildComposition(composition, index, contents, sections, siteMode, defaultLocale)
 			}
 
 			var buf bytes.Buffer
@@ -521,148 +850,1753 @@ func (svc *BaseService) GenerateHTMLFromContent(ctx context.Context) error {
 		}
 	}
 
-	svc.Log().Info("Service HTML generation finished")
-	return nil
-}
+	if err := svc.writeRedirects(ctx, htmlPath, contents, indexes, site, siteMode); err != nil {
+		svc.Log().Error("Error generating redirects", "error", err)
+	}
 
-// Content related
+	svc.writeFeeds(htmlPath, feeds, site, siteMode, defaultLocale)
 
-func (svc *BaseService) CreateContent(ctx context.Context, content *Content) error {
-	return svc.getRepo(ctx).CreateContent(ctx, content)
-}
+	if err := buildErrors(shortcodeErrs, refErrs); err != nil {
+		return err
+	}
 
-func (svc *BaseService) GetContent(ctx context.Context, id uuid.UUID) (Content, error) {
-	return svc.getRepo(ctx).GetContent(ctx, id)
+	svc.Log().Info("Service HTML generation finished")
+	return nil
 }
 
-func (svc *BaseService) UpdateContent(ctx context.Context, content *Content) error {
-	return svc.getRepo(ctx).UpdateContent(ctx, content)
+// buildErrors returns the problems found in content while generating the
+// site, nil when there are none. Pages with unresolved references are still
+// written, with the references rendered as plain text.
+func buildErrors(shortcodeErrs, refErrs []error) error {
+	var errs []error
+	if len(shortcodeErrs) > 0 {
+		errs = append(errs, fmt.Errorf("cannot render shortcodes: %w", errors.Join(shortcodeErrs...)))
+	}
+	if len(refErrs) > 0 {
+		errs = append(errs, fmt.Errorf("unresolved content references: %w", errors.Join(refErrs...)))
+	}
+	return errors.Join(errs...)
 }
 
-func (svc *BaseService) DeleteContent(ctx context.Context, id uuid.UUID) error {
-	return svc.getRepo(ctx).DeleteContent(ctx, id)
+// siteFeeds returns the feeds of the site being generated. Feeds need
+// absolute URLs, so there are none until the site base URL is set.
+func (svc *BaseService) siteFeeds(ctx context.Context, contents []Content, site SiteInfo, mode, defaultLocale string) []Feed {
+	if site.BaseURL == "" {
+		svc.Log().Info("Skipping feeds: site base URL not set", "param", SSGKey.SiteBaseURL)
+		return nil
+	}
+	maxItems := int(svc.Cfg().IntVal(SSGKey.FeedMaxItems, 20))
+	return BuildLocaleFeeds(contents, mode, defaultLocale, maxItems)
 }
 
-func (svc *BaseService) GetAllContentWithMeta(ctx context.Context) ([]Content, error) {
-	return svc.getRepo(ctx).GetAllContentWithMeta(ctx)
-}
+// writeFeeds writes the feeds of the site to its HTML directory.
+func (svc *BaseService) writeFeeds(htmlPath string, feeds []Feed, site SiteInfo, mode, defaultLocale string) {
+	for _, feed := range feeds {
+		data, err := RenderFeed(feed, site, mode, defaultLocale)
+		if err != nil {
+			svc.Log().Error("Error rendering feed", "path", feed.Path, "error", err)
+			continue
+		}
 
-func (svc *BaseService) GetContentWithPaginationAndSearch(ctx context.Context, offset, limit int, searchQuery string) ([]Content, int, error) {
-	return svc.getRepo(ctx).GetContentWithPaginationAndSearch(ctx, offset, limit, searchQuery)
+		outputPath := filepath.Join(htmlPath, filepath.FromSlash(feed.Path))
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			svc.Log().Error("Error creating directory for feed", "path", outputPath, "error", err)
+			continue
+		}
+		if err := os.WriteFile(outputPath, data, 0644); err != nil {
+			svc.Log().Error("Error writing feed", "path", outputPath, "error", err)
+			continue
+		}
+	}
+	svc.Log().Infof("Generated %d feeds", len(feeds))
 }
 
-// Section related
-func (svc *BaseService) CreateSection(ctx context.Context, section Section) error {
-	return svc.getRepo(ctx).CreateSection(ctx, section)
+// feedURL returns the URL of the feed of locale, empty when there is none.
+func feedURL(feeds []Feed, site SiteInfo, locale string) string {
+	for _, f := range feeds {
+		if f.Locale == locale {
+			return site.URL(f.Path)
+		}
+	}
+	return ""
 }
 
-func (svc *BaseService) GetSection(ctx context.Context, id uuid.UUID) (Section, error) {
-	return svc.getRepo(ctx).GetSection(ctx, id)
+// siteInfo returns the site wide values of the site being generated.
+func (svc *BaseService) siteInfo(ctx context.Context, siteSlug string) SiteInfo {
+	if svc.pm == nil {
+		return SiteInfo{Name: siteSlug}
+	}
+	return SiteInfo{
+		Name:    svc.pm.GetSiteName(ctx, siteSlug),
+		BaseURL: svc.pm.Get(ctx, SSGKey.SiteBaseURL, ""),
+	}
 }
 
-func (svc *BaseService) GetSections(ctx context.Context) ([]Section, error) {
-	return svc.getRepo(ctx).GetSections(ctx)
-}
+// siteData returns the site as seen by templates.
+func (svc *BaseService) siteData(ctx context.Context, site SiteInfo, mode string, sections []Section, tags []Tag) (SiteData, error) {
+	params, err := svc.getRepo(ctx).ListParams(ctx)
+	if err != nil {
+		return SiteData{}, fmt.Errorf("cannot get params: %w", err)
+	}
 
-func (svc *BaseService) UpdateSection(ctx context.Context, section Section) error {
-	return svc.getRepo(ctx).UpdateSection(ctx, section)
+	data := SiteData{
+		Name:     site.Name,
+		Mode:     mode,
+		BaseURL:  site.BaseURL,
+		Locale:   DefaultLocale,
+		Params:   make(map[string]string, len(params)),
+		Sections: sections,
+		Tags:     tags,
+	}
+	if svc.pm != nil {
+		data.Locale = svc.pm.GetSiteLocale(ctx)
+	}
+	for _, p := range params {
+		if p.RefKey != "" {
+			data.Params[p.RefKey] = p.Value
+		}
+	}
+	return data, nil
 }
 
-func (svc *BaseService) DeleteSection(ctx context.Context, id uuid.UUID) error {
-	return svc.getRepo(ctx).DeleteSection(ctx, id)
-}
+// imageVariants returns the variants of the site images by image file path
+// and variant kind. Images whose variants cannot be read are skipped.
+func (svc *BaseService) imageVariants(ctx context.Context) map[string]map[string]ImageVariant {
+	repo := svc.getRepo(ctx)
+	variants := make(map[string]map[string]ImageVariant)
 
-// Layout related
-func (svc *BaseService) CreateLayout(ctx context.Context, layout Layout) error {
-	return svc.getRepo(ctx).CreateLayout(ctx, layout)
+	images, err := repo.ListImages(ctx)
+	if err != nil {
+		svc.Log().Error("Cannot list images for template variants", "error", err)
+		return variants
+	}
+	for _, img := range images {
+		list, err := repo.ListImageVariantsByImageID(ctx, img.ID)
+		if err != nil {
+			svc.Log().Debug("Cannot list image variants", "imageID", img.ID, "error", err)
+			continue
+		}
+		byKind := make(map[string]ImageVariant, len(list))
+		for _, v := range list {
+			byKind[v.Kind] = v
+		}
+		variants[strings.TrimPrefix(img.FilePath, "/")] = byKind
+	}
+	return variants
 }
 
-func (svc *BaseService) GetLayout(ctx context.Context, id uuid.UUID) (Layout, error) {
-	return svc.getRepo(ctx).GetLayout(ctx, id)
+// resolvePermalinks sets the site default locale on content without one and
+// the permalink of every content from the site pattern.
+func (svc *BaseService) resolvePermalinks(ctx context.Context, contents []Content, mode string) {
+	pattern := svc.permalinkPattern(ctx, mode)
+	defaultLocale := svc.pm.GetSiteLocale(ctx)
+	for i := range contents {
+		if contents[i].Locale == "" {
+			contents[i].Locale = defaultLocale
+		}
+		contents[i].Permalink = ContentPermalink(pattern, contents[i], defaultLocale)
+	}
 }
 
-func (svc *BaseService) GetAllLayouts(ctx context.Context) ([]Layout, error) {
-	return svc.getRepo(ctx).GetAllLayouts(ctx)
+// wordsPerMinute returns the reading speed used for reading times. An
+// invalid value is logged and the default is used.
+func (svc *BaseService) wordsPerMinute(ctx context.Context) int {
+	val := svc.pm.Get(ctx, SSGKey.ReadingWordsPerMinute, "")
+	if val == "" {
+		return DefaultWordsPerMinute
+	}
+	wpm, err := strconv.Atoi(val)
+	if err != nil || wpm <= 0 {
+		svc.Log().Error("Invalid words per minute, using default", "value", val)
+		return DefaultWordsPerMinute
+	}
+	return wpm
 }
 
-func (svc *BaseService) UpdateLayout(ctx context.Context, layout Layout) error {
-	return svc.getRepo(ctx).UpdateLayout(ctx, layout)
+// siteTheme resolves the theme selected by the site, with the overrides in
+// its documents.
+func (svc *BaseService) siteTheme(ctx context.Context, sitesBasePath, siteSlug string) (*Theme, error) {
+	name := svc.pm.Get(ctx, SSGKey.ThemeName, DefaultThemeName)
+	return ResolveTheme(svc.assetsFS, svc.themesPath(), name, GetSiteThemePath(sitesBasePath, siteSlug))
 }
 
-func (svc *BaseService) DeleteLayout(ctx context.Context, id uuid.UUID) error {
-	return svc.getRepo(ctx).DeleteLayout(ctx, id)
+// themesPath returns the directory themes are installed in.
+func (svc *BaseService) themesPath() string {
+	workspacePath := svc.Cfg().StrValOrDef(SSGKey.WorkspacePath, "_workspace")
+	return svc.Cfg().StrValOrDef(SSGKey.ThemesPath, GetThemesPath(workspacePath))
 }
 
-// Tag related
-func (svc *BaseService) CreateTag(ctx context.Context, tag Tag) error {
-	return svc.getRepo(ctx).CreateTag(ctx, tag)
+// composition returns the composition of the home page and, when enabled,
+// the section indexes.
+func (svc *BaseService) composition(ctx context.Context) Composition {
+	atoi := func(key string) int {
+		n, _ := strconv.Atoi(svc.pm.Get(ctx, key, ""))
+		return n
+	}
+	return ParseComposition(
+		svc.pm.Get(ctx, SSGKey.HomeBlocks, ""),
+		svc.pm.Get(ctx, SSGKey.HomePinned, ""),
+		atoi(SSGKey.HomeFeaturedItems),
+		atoi(SSGKey.HomeSectionItems),
+		svc.pm.Get(ctx, SSGKey.HomeSectionIndexes, "false") == "true",
+	)
 }
 
-func (svc *BaseService) GetTag(ctx context.Context, id uuid.UUID) (Tag, error) {
-	return svc.getRepo(ctx).GetTag(ctx, id)
+// markdownOptions returns the optional Markdown syntax enabled for the site.
+func (svc *BaseService) markdownOptions(ctx context.Context) MarkdownOptions {
+	enabled := func(key string) bool {
+		return svc.pm.Get(ctx, key, "false") == "true"
+	}
+	return MarkdownOptions{
+		Footnotes:       enabled(SSGKey.MarkdownFootnotes),
+		DefinitionLists: enabled(SSGKey.MarkdownDefinitionLists),
+		Typographer:     enabled(SSGKey.MarkdownTypographer),
+		Attributes:      enabled(SSGKey.MarkdownAttributes),
+		HeadingAnchors:  enabled(SSGKey.MarkdownHeadingAnchors),
+		Emoji:           enabled(SSGKey.MarkdownEmoji),
+		Math:            enabled(SSGKey.MarkdownMath),
+	}
 }
 
-func (svc *BaseService) GetTagByName(ctx context.Context, name string) (Tag, error) {
-	return svc.getRepo(ctx).GetTagByName(ctx, name)
+// permalinkPattern returns the site permalink pattern. An invalid pattern is
+// logged and the mode default is used.
+func (svc *BaseService) permalinkPattern(ctx context.Context, mode string) string {
+	pattern := svc.pm.Get(ctx, SSGKey.PermalinkPattern, "")
+	if pattern == "" {
+		return DefaultPermalinkPattern(mode)
+	}
+	if !ValidPermalinkPattern(pattern) {
+		svc.Log().Error("Invalid permalink pattern, using default", "pattern", pattern)
+		return DefaultPermalinkPattern(mode)
+	}
+	return pattern
 }
 
-func (svc *BaseService) GetAllTags(ctx context.Context) ([]Tag, error) {
-	return svc.getRepo(ctx).GetAllTags(ctx)
-}
+// contentMoveAliases returns the aliases keeping the previous path of the
+// contents whose path differs in after, and the aliases to drop because
+// their content moved back to them.
+func (svc *BaseService) contentMoveAliases(ctx context.Context, before []Content, after map[uuid.UUID]Content) ([]*ContentAlias, []uuid.UUID, error) {
+	repo := svc.getRepo(ctx)
+	pattern := svc.permalinkPattern(ctx, svc.pm.GetSiteMode(ctx))
+	defaultLocale := svc.pm.GetSiteLocale(ctx)
+
+	var aliases []*ContentAlias
+	var cleared []uuid.UUID
+	for _, old := range before {
+		now, ok := after[old.ID]
+		if !ok {
+			continue
+		}
 
-func (svc *BaseService) UpdateTag(ctx context.Context, tag Tag) error {
-	return svc.getRepo(ctx).UpdateTag(ctx, tag)
-}
+		oldPath := ContentPermalink(pattern, old, defaultLocale)
+		newPath := ContentPermalink(pattern, now, defaultLocale)
+		if oldPath == newPath {
+			continue
+		}
+		aliases = append(aliases, NewContentAlias(now.SiteID, now.ID, oldPath, false))
 
-func (svc *BaseService) DeleteTag(ctx context.Context, id uuid.UUID) error {
-	return svc.getRepo(ctx).DeleteTag(ctx, id)
-}
+		// Content moved back to a previous path, it no longer redirects
+		existing, err := repo.GetContentAliasesByContentID(ctx, now.ID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot get content aliases: %w", err)
+		}
+		for _, a := range existing {
+			if a.Path == newPath {
+				cleared = append(cleared, a.ID)
+			}
+		}
+	}
 
-// Param related
-func (svc *BaseService) CreateParam(ctx context.Context, param *Param) error {
-	return svc.getRepo(ctx).CreateParam(ctx, param)
+	return aliases, cleared, nil
 }
 
-func (svc *BaseService) GetParam(ctx context.Context, id uuid.UUID) (Param, error) {
-	return svc.getRepo(ctx).GetParam(ctx, id)
-}
+// writeRedirects writes a redirect stub at every alias path and, when
+// enabled, the redirects file at the site root.
+func (svc *BaseService) writeRedirects(ctx context.Context, htmlPath string, contents []Content, indexes []*Index, site SiteInfo, mode string) error {
+	aliases, err := svc.getRepo(ctx).GetContentAliases(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get content aliases: %w", err)
+	}
 
-func (svc *BaseService) GetParamByName(ctx context.Context, name string) (Param, error) {
-	return svc.getRepo(ctx).GetParamByName(ctx, name)
-}
+	reserved := make([]string, 0, len(indexes))
+	for _, idx := range indexes {
+		reserved = append(reserved, NormalizeAliasPath(idx.Path))
+	}
 
-func (svc *BaseService) GetParamByRefKey(ctx context.Context, refKey string) (Param, error) {
-	return svc.getRepo(ctx).GetParamByRefKey(ctx, refKey)
-}
+	redirects := BuildRedirects(aliases, contents, reserved, mode)
+	for _, r := range redirects {
+		stub, err := RenderRedirectStub(site.URL(r.To))
+		if err != nil {
+			return err
+		}
 
-func (svc *BaseService) ListParams(ctx context.Context) ([]Param, error) {
-	return svc.getRepo(ctx).ListParams(ctx)
-}
+		outputPath := filepath.Join(htmlPath, filepath.FromSlash(r.From), "index.html")
+		if err := os.MkdirAll(filepath.Dir(outputPath), 0755); err != nil {
+			return fmt.Errorf("cannot create redirect directory: %w", err)
+		}
+		if err := os.WriteFile(outputPath, stub, 0644); err != nil {
+			return fmt.Errorf("cannot write redirect stub: %w", err)
+		}
+	}
 
-func (svc *BaseService) UpdateParam(ctx context.Context, param *Param) error {
-	return svc.getRepo(ctx).UpdateParam(ctx, param)
-}
+	if svc.pm.Get(ctx, SSGKey.RedirectsFileEnabled, "false") == "true" {
+		data := RenderRedirectsFile(redirects)
+		if err := os.WriteFile(filepath.Join(htmlPath, RedirectsFile), data, 0644); err != nil {
+			return fmt.Errorf("cannot write redirects file: %w", err)
+		}
+	}
 
-func (svc *BaseService) DeleteParam(ctx context.Context, id uuid.UUID) error {
-	return svc.getRepo(ctx).DeleteParam(ctx, id)
+	svc.Log().Infof("Generated %d redirects", len(redirects))
+	return nil
 }
 
-// Image related
-func (svc *BaseService) CreateImage(ctx context.Context, image *Image) error {
-	return svc.getRepo(ctx).CreateImage(ctx, image)
+func (svc *BaseService) socialCardsEnabled(ctx context.Context) bool {
+	if svc.pm == nil {
+		return false
+	}
+	return svc.pm.Get(ctx, SSGKey.SocialCardsEnabled, "true") == "true"
 }
 
-func (svc *BaseService) GetImage(ctx context.Context, id uuid.UUID) (Image, error) {
-	return svc.getRepo(ctx).GetImage(ctx, id)
-}
+// newSocialCards prepares social card rendering for a build. The card
+// templates of the site theme, overrides included, are added to the
+// built-in ones. It returns nil when cards are disabled or cannot be
+// rendered.
+func (svc *BaseService) newSocialCards(ctx context.Context, htmlPath string, site SiteInfo, themeFS fs.FS) *socialCards {
+	if !svc.socialCardsEnabled(ctx) {
+		return nil
+	}
 
-func (svc *BaseService) GetImageByShortID(ctx context.Context, shortID string) (Image, error) {
-	return svc.getRepo(ctx).GetImageByShortID(ctx, shortID)
-}
+	renderer, err := NewSocialCardRenderer()
+	if err != nil {
+		svc.Log().Error("Cannot create social card renderer", "error", err)
+		return nil
+	}
 
-func (svc *BaseService) ListImages(ctx context.Context) ([]Image, error) {
-	return svc.getRepo(ctx).ListImages(ctx)
-}
+	templates, err := LoadCardTemplates(themeFS)
+	if err != nil {
+		svc.Log().Error("Cannot load theme social card templates", "error", err)
+		templates = cardTemplates
+	}
+
+	cards := &socialCards{
+		renderer: renderer,
+		template: SelectCardTemplate(
+			templates,
+			svc.pm.Get(ctx, SSGKey.SocialCardsTemplate, "default"),
+			svc.pm.Get(ctx, SSGKey.SocialCardsAccent, ""),
+		),
+		siteName: site.Name,
+	}
+
+	if bg := svc.pm.Get(ctx, SSGKey.SocialCardsBackground, ""); bg != "" {
+		img, err := LoadCardBackground(filepath.Join(htmlPath, filepath.FromSlash(bg)))
+		if err != nil {
+			svc.Log().Error("Cannot load social card background", "path", bg, "error", err)
+		} else {
+			cards.background = img
+		}
+	}
+
+	return cards
+}
+
+// Content related
+
+func (svc *BaseService) CreateContent(ctx context.Context, content *Content) error {
+	if err := svc.checkContentSlug(ctx, content); err != nil {
+		return err
+	}
+	if err := svc.checkContentKind(ctx, content); err != nil {
+		return err
+	}
+	if err := svc.checkContentLocale(ctx, content); err != nil {
+		return err
+	}
+	if err := svc.assignSeries(ctx, content, nil); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateContent(ctx, content)
+}
+
+func (svc *BaseService) GetContent(ctx context.Context, id uuid.UUID) (Content, error) {
+	return svc.getRepo(ctx).GetContent(ctx, id)
+}
+
+func (svc *BaseService) UpdateContent(ctx context.Context, content *Content) error {
+	if err := svc.checkContentSlug(ctx, content); err != nil {
+		return err
+	}
+	if err := svc.checkContentKind(ctx, content); err != nil {
+		return err
+	}
+	if err := svc.checkContentLocale(ctx, content); err != nil {
+		return err
+	}
+
+	repo := svc.getRepo(ctx)
+	before, beforeErr := repo.GetContent(ctx, content.ID)
+
+	var previous *Content
+	if beforeErr == nil {
+		previous = &before
+	}
+	if err := svc.assignSeries(ctx, content, previous); err != nil {
+		return err
+	}
+
+	if beforeErr != nil || svc.pm == nil {
+		return repo.UpdateContent(ctx, content)
+	}
+
+	// A content whose path changes keeps the previous one as an alias
+	after, err := svc.contentAfterUpdate(ctx, before, *content)
+	if err != nil {
+		return err
+	}
+	move := ContentMove{Content: content}
+	move.Aliases, move.ClearedAliases, err = svc.contentMoveAliases(ctx, []Content{before}, map[uuid.UUID]Content{after.ID: after})
+	if err != nil {
+		return err
+	}
+
+	if err := repo.MoveContent(ctx, move); err != nil {
+		return err
+	}
+	for _, alias := range move.Aliases {
+		svc.Log().Info("Recorded content alias", "from", alias.Path, "content", alias.ContentID)
+	}
+
+	return nil
+}
+
+// contentAfterUpdate returns the content stored before an update as it will
+// be once update is saved. Fields the update does not write are kept.
+func (svc *BaseService) contentAfterUpdate(ctx context.Context, before, update Content) (Content, error) {
+	after := update
+	after.SiteID = before.SiteID
+	after.Kind = before.Kind
+	after.CreatedAt = before.CreatedAt
+	after.SectionPath = before.SectionPath
+
+	if after.SectionID != before.SectionID {
+		section, err := svc.getRepo(ctx).GetSection(ctx, after.SectionID)
+		if err != nil {
+			return Content{}, fmt.Errorf("cannot get content section: %w", err)
+		}
+		after.SectionPath = section.Path
+	}
+
+	return after, nil
+}
+
+// checkContentSlug normalizes the custom slug of content and ensures no other
+// content of the site uses it. An empty slug keeps the derived one.
+func (svc *BaseService) checkContentSlug(ctx context.Context, content *Content) error {
+	content.SlugField = NormalizeSlug(content.SlugField)
+	if content.SlugField == "" {
+		return nil
+	}
+
+	existing, err := svc.getRepo(ctx).GetContentBySlug(ctx, content.SlugField)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot check content slug: %w", err)
+	}
+
+	if existing.ID != content.ID {
+		return fmt.Errorf("%w: %s", ErrSlugTaken, content.SlugField)
+	}
+
+	return nil
+}
+
+// checkContentLocale normalizes the locale and translation group of content
+// and ensures no other content of the group is in the same locale.
+func (svc *BaseService) checkContentLocale(ctx context.Context, content *Content) error {
+	content.Locale = NormalizeLocale(content.Locale)
+	if content.Locale != "" && !ValidLocale(content.Locale) {
+		return fmt.Errorf("%w: %s", ErrInvalidLocale, content.Locale)
+	}
+
+	content.TranslationGroup = NormalizeSlug(content.TranslationGroup)
+	if content.TranslationGroup == "" {
+		return nil
+	}
+
+	defaultLocale := DefaultLocale
+	if svc.pm != nil {
+		defaultLocale = svc.pm.GetSiteLocale(ctx)
+	}
+
+	contents, err := svc.getRepo(ctx).GetAllContentWithMeta(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check content translations: %w", err)
+	}
+
+	locale := localeOrDefault(content.Locale, defaultLocale)
+	for _, c := range contents {
+		if c.ID == content.ID || c.TranslationGroup != content.TranslationGroup {
+			continue
+		}
+		if localeOrDefault(c.Locale, defaultLocale) == locale {
+			return fmt.Errorf("%w: %s", ErrTranslationTaken, locale)
+		}
+	}
+
+	return nil
+}
+
+// assignSeries sets the series slug of content from its SeriesID. Content
+// joining a series becomes its last part, parts keep their position on
+// update and content leaving a series loses it.
+func (svc *BaseService) assignSeries(ctx context.Context, content *Content, previous *Content) error {
+	if content.SeriesID == uuid.Nil {
+		content.Series = ""
+		content.SeriesOrder = 0
+		return nil
+	}
+
+	repo := svc.getRepo(ctx)
+	series, err := repo.GetSeries(ctx, content.SeriesID)
+	if err != nil {
+		return fmt.Errorf("cannot get series: %w", err)
+	}
+	content.Series = series.Slug()
+
+	if previous != nil && previous.SeriesID == content.SeriesID && previous.SeriesOrder > 0 {
+		content.SeriesOrder = previous.SeriesOrder
+		return nil
+	}
+
+	contents, err := repo.GetAllContentWithMeta(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get series parts: %w", err)
+	}
+	content.SeriesOrder = 1
+	for _, c := range contents {
+		if c.SeriesID == content.SeriesID && c.ID != content.ID && c.SeriesOrder >= content.SeriesOrder {
+			content.SeriesOrder = c.SeriesOrder + 1
+		}
+	}
+
+	return nil
+}
+
+func (svc *BaseService) DeleteContent(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteContent(ctx, id)
+}
+
+func (svc *BaseService) GetAllContentWithMeta(ctx context.Context) ([]Content, error) {
+	return svc.getRepo(ctx).GetAllContentWithMeta(ctx)
+}
+
+func (svc *BaseService) GetContentWithPaginationAndSearch(ctx context.Context, offset, limit int, searchQuery string) ([]Content, int, error) {
+	return svc.getRepo(ctx).GetContentWithPaginationAndSearch(ctx, offset, limit, searchQuery)
+}
+
+// Content alias related
+
+func (svc *BaseService) GetContentAliases(ctx context.Context, contentID uuid.UUID) ([]ContentAlias, error) {
+	return svc.getRepo(ctx).GetContentAliasesByContentID(ctx, contentID)
+}
+
+// AddContentAlias adds a manual alias redirecting aliasPath to the content.
+// Paths of live pages or of aliases of other content are rejected.
+func (svc *BaseService) AddContentAlias(ctx context.Context, contentID uuid.UUID, aliasPath string) (ContentAlias, error) {
+	repo := svc.getRepo(ctx)
+
+	content, err := repo.GetContent(ctx, contentID)
+	if err != nil {
+		return ContentAlias{}, fmt.Errorf("cannot get content: %w", err)
+	}
+
+	alias := NewContentAlias(content.SiteID, content.ID, aliasPath, true)
+	if alias.Path == "" {
+		return ContentAlias{}, fmt.Errorf("%w: %q", ErrInvalidAliasPath, aliasPath)
+	}
+
+	aliases, err := repo.GetContentAliases(ctx)
+	if err != nil {
+		return ContentAlias{}, fmt.Errorf("cannot get content aliases: %w", err)
+	}
+	for _, a := range aliases {
+		if a.Path == alias.Path && a.ContentID != content.ID {
+			return ContentAlias{}, fmt.Errorf("%w: %s", ErrAliasTaken, alias.Path)
+		}
+	}
+
+	if svc.pm != nil {
+		contents, err := repo.GetAllContentWithMeta(ctx)
+		if err != nil {
+			return ContentAlias{}, fmt.Errorf("cannot get content: %w", err)
+		}
+		pattern := svc.permalinkPattern(ctx, svc.pm.GetSiteMode(ctx))
+		defaultLocale := svc.pm.GetSiteLocale(ctx)
+		for _, c := range contents {
+			if ContentPermalink(pattern, c, defaultLocale) == alias.Path {
+				return ContentAlias{}, fmt.Errorf("%w: %s", ErrAliasTaken, alias.Path)
+			}
+		}
+	}
+
+	if err := repo.CreateContentAlias(ctx, alias); err != nil {
+		return ContentAlias{}, fmt.Errorf("cannot create content alias: %w", err)
+	}
+
+	return *alias, nil
+}
+
+// DeleteContentAlias removes an alias of the content.
+func (svc *BaseService) DeleteContentAlias(ctx context.Context, contentID, id uuid.UUID) error {
+	repo := svc.getRepo(ctx)
+
+	aliases, err := repo.GetContentAliasesByContentID(ctx, contentID)
+	if err != nil {
+		return fmt.Errorf("cannot get content aliases: %w", err)
+	}
+	for _, a := range aliases {
+		if a.ID == id {
+			return repo.DeleteContentAlias(ctx, id)
+		}
+	}
+
+	return fmt.Errorf("content alias %s not found", id)
+}
+
+// GetContentBacklinks returns the contents whose body references the content.
+func (svc *BaseService) GetContentBacklinks(ctx context.Context, contentID uuid.UUID) ([]Content, error) {
+	repo := svc.getRepo(ctx)
+
+	content, err := repo.GetContent(ctx, contentID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get content: %w", err)
+	}
+
+	contents, err := repo.GetAllContentWithMeta(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get all content with meta: %w", err)
+	}
+
+	backlinks := []Content{}
+	for _, c := range contents {
+		if c.ID == content.ID {
+			continue
+		}
+		for _, ref := range ExtractRefs(c.Body) {
+			if ref == content.ShortID {
+				backlinks = append(backlinks, c)
+				break
+			}
+		}
+	}
+
+	return backlinks, nil
+}
+
+// Section related
+func (svc *BaseService) CreateSection(ctx context.Context, section Section) error {
+	repo := svc.getRepo(ctx)
+
+	sections, err := repo.GetSections(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get sections: %w", err)
+	}
+	section.Path, err = SectionPath(section, sections)
+	if err != nil {
+		return err
+	}
+
+	return repo.CreateSection(ctx, section)
+}
+
+func (svc *BaseService) GetSection(ctx context.Context, id uuid.UUID) (Section, error) {
+	return svc.getRepo(ctx).GetSection(ctx, id)
+}
+
+func (svc *BaseService) GetSections(ctx context.Context) ([]Section, error) {
+	return svc.getRepo(ctx).GetSections(ctx)
+}
+
+func (svc *BaseService) UpdateSection(ctx context.Context, section Section) error {
+	repo := svc.getRepo(ctx)
+
+	sections, err := repo.GetSections(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get sections: %w", err)
+	}
+	section.Path, err = SectionPath(section, sections)
+	if err != nil {
+		return err
+	}
+
+	// Sections nested below one whose path changes move along with it, and
+	// so does the content of all of them
+	old, err := repo.GetSection(ctx, section.ID)
+	if err != nil || old.Path == section.Path {
+		return repo.UpdateSection(ctx, section)
+	}
+
+	move := SectionMove{Sections: []Section{section}}
+	paths := map[uuid.UUID]string{section.ID: section.Path}
+	for _, s := range SectionDescendants(section.ID, sections) {
+		s.Path = path.Join(paths[s.ParentID], s.Segment())
+		paths[s.ID] = s.Path
+		move.Sections = append(move.Sections, s)
+	}
+
+	if svc.pm != nil {
+		contents, err := repo.GetAllContentWithMeta(ctx)
+		if err != nil {
+			return fmt.Errorf("cannot get section content: %w", err)
+		}
+		var before []Content
+		after := make(map[uuid.UUID]Content)
+		for _, c := range contents {
+			if newPath, ok := paths[c.SectionID]; ok {
+				before = append(before, c)
+				c.SectionPath = newPath
+				after[c.ID] = c
+			}
+		}
+		move.Aliases, move.ClearedAliases, err = svc.contentMoveAliases(ctx, before, after)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := repo.MoveSections(ctx, move); err != nil {
+		return fmt.Errorf("cannot move section %s: %w", section.Name, err)
+	}
+	for _, alias := range move.Aliases {
+		svc.Log().Info("Recorded content alias", "from", alias.Path, "content", alias.ContentID)
+	}
+
+	return nil
+}
+
+func (svc *BaseService) DeleteSection(ctx context.Context, id uuid.UUID) error {
+	repo := svc.getRepo(ctx)
+
+	sections, err := repo.GetSections(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get sections: %w", err)
+	}
+	if len(SectionChildren(id, sections)) > 0 {
+		return ErrSectionHasChildren
+	}
+
+	return repo.DeleteSection(ctx, id)
+}
+
+// Layout related
+func (svc *BaseService) CreateLayout(ctx context.Context, layout Layout) error {
+	return svc.getRepo(ctx).CreateLayout(ctx, layout)
+}
+
+func (svc *BaseService) GetLayout(ctx context.Context, id uuid.UUID) (Layout, error) {
+	return svc.getRepo(ctx).GetLayout(ctx, id)
+}
+
+func (svc *BaseService) GetAllLayouts(ctx context.Context) ([]Layout, error) {
+	return svc.getRepo(ctx).GetAllLayouts(ctx)
+}
+
+func (svc *BaseService) UpdateLayout(ctx context.Context, layout Layout) error {
+	return svc.getRepo(ctx).UpdateLayout(ctx, layout)
+}
+
+func (svc *BaseService) DeleteLayout(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteLayout(ctx, id)
+}
+
+// Tag related
+func (svc *BaseService) CreateTag(ctx context.Context, tag Tag) error {
+	return svc.getRepo(ctx).CreateTag(ctx, tag)
+}
+
+func (svc *BaseService) GetTag(ctx context.Context, id uuid.UUID) (Tag, error) {
+	return svc.getRepo(ctx).GetTag(ctx, id)
+}
+
+func (svc *BaseService) GetTagByName(ctx context.Context, name string) (Tag, error) {
+	return svc.getRepo(ctx).GetTagByName(ctx, name)
+}
+
+func (svc *BaseService) GetAllTags(ctx context.Context) ([]Tag, error) {
+	return svc.getRepo(ctx).GetAllTags(ctx)
+}
+
+func (svc *BaseService) UpdateTag(ctx context.Context, tag Tag) error {
+	return svc.getRepo(ctx).UpdateTag(ctx, tag)
+}
+
+func (svc *BaseService) DeleteTag(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteTag(ctx, id)
+}
+
+// Series related
+
+// CreateSeries validates series and stores it.
+func (svc *BaseService) CreateSeries(ctx context.Context, series Series) error {
+	if err := svc.checkSeries(ctx, &series); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateSeries(ctx, series)
+}
+
+func (svc *BaseService) GetSeries(ctx context.Context, id uuid.UUID) (Series, error) {
+	return svc.getRepo(ctx).GetSeries(ctx, id)
+}
+
+func (svc *BaseService) GetAllSeries(ctx context.Context) ([]Series, error) {
+	return svc.getRepo(ctx).GetAllSeries(ctx)
+}
+
+// UpdateSeries validates series and stores it. Its parts follow a new slug.
+func (svc *BaseService) UpdateSeries(ctx context.Context, series Series) error {
+	if err := svc.checkSeries(ctx, &series); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).UpdateSeries(ctx, series)
+}
+
+// DeleteSeries removes a series. Its parts are kept as standalone content.
+func (svc *BaseService) DeleteSeries(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteSeries(ctx, id)
+}
+
+// GetSeriesContents returns the parts of a series in reading order.
+func (svc *BaseService) GetSeriesContents(ctx context.Context, id uuid.UUID) ([]Content, error) {
+	contents, err := svc.getRepo(ctx).GetAllContentWithMeta(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get all content with meta: %w", err)
+	}
+
+	parts := []Content{}
+	for _, c := range contents {
+		if c.SeriesID == id {
+			parts = append(parts, c)
+		}
+	}
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].SeriesOrder < parts[j].SeriesOrder
+	})
+
+	return parts, nil
+}
+
+// ReorderSeries sets the reading order of the parts of a series to the one
+// of contentIDs, which must list every part exactly once.
+func (svc *BaseService) ReorderSeries(ctx context.Context, id uuid.UUID, contentIDs []uuid.UUID) error {
+	parts, err := svc.GetSeriesContents(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if len(contentIDs) != len(parts) {
+		return fmt.Errorf("%w: got %d parts, want %d", ErrInvalidSeriesOrder, len(contentIDs), len(parts))
+	}
+	pending := make(map[uuid.UUID]bool, len(parts))
+	for _, p := range parts {
+		pending[p.ID] = true
+	}
+	for _, cid := range contentIDs {
+		if !pending[cid] {
+			return fmt.Errorf("%w: %s is not a part or is listed twice", ErrInvalidSeriesOrder, cid)
+		}
+		delete(pending, cid)
+	}
+
+	return svc.getRepo(ctx).UpdateSeriesOrder(ctx, id, contentIDs)
+}
+
+// checkSeries normalizes the slug and status of series and ensures no other
+// series of the site uses the slug.
+func (svc *BaseService) checkSeries(ctx context.Context, series *Series) error {
+	series.SlugField = NormalizeSlug(series.SlugField)
+	if series.SlugField == "" {
+		series.SlugField = NormalizeSlug(series.Name)
+	}
+
+	series.Status = strings.ToLower(strings.TrimSpace(series.Status))
+	if series.Status == "" {
+		series.Status = SeriesStatusOngoing
+	}
+	if !ValidSeriesStatus(series.Status) {
+		return fmt.Errorf("%w: %s", ErrInvalidSeriesStatus, series.Status)
+	}
+
+	all, err := svc.getRepo(ctx).GetAllSeries(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check series slug: %w", err)
+	}
+	for _, s := range all {
+		if s.ID != series.ID && s.Slug() == series.SlugField {
+			return fmt.Errorf("%w: %s", ErrSlugTaken, series.SlugField)
+		}
+	}
+
+	return nil
+}
+
+// Author related
+
+// CreateAuthor validates author and stores it.
+func (svc *BaseService) CreateAuthor(ctx context.Context, author Author) error {
+	if err := svc.checkAuthor(ctx, &author); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateAuthor(ctx, author)
+}
+
+func (svc *BaseService) GetAuthor(ctx context.Context, id uuid.UUID) (Author, error) {
+	return svc.getRepo(ctx).GetAuthor(ctx, id)
+}
+
+func (svc *BaseService) GetAllAuthors(ctx context.Context) ([]Author, error) {
+	return svc.getRepo(ctx).GetAllAuthors(ctx)
+}
+
+// UpdateAuthor validates author and stores it.
+func (svc *BaseService) UpdateAuthor(ctx context.Context, author Author) error {
+	if err := svc.checkAuthor(ctx, &author); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).UpdateAuthor(ctx, author)
+}
+
+// DeleteAuthor removes an author. Its content is kept, without attribution.
+func (svc *BaseService) DeleteAuthor(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteAuthor(ctx, id)
+}
+
+// GetAuthorsForContent returns the authors content is attributed to, in
+// order.
+func (svc *BaseService) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]Author, error) {
+	return svc.getRepo(ctx).GetAuthorsForContent(ctx, contentID)
+}
+
+// SetContentAuthors attributes content to the authors of authorIDs, in that
+// order, replacing its previous authors. Repeated authors are listed once.
+func (svc *BaseService) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error {
+	repo := svc.getRepo(ctx)
+	authors, err := repo.GetAllAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check content authors: %w", err)
+	}
+	known := make(map[uuid.UUID]bool, len(authors))
+	for _, a := range authors {
+		known[a.ID] = true
+	}
+
+	ids := make([]uuid.UUID, 0, len(authorIDs))
+	seen := make(map[uuid.UUID]bool, len(authorIDs))
+	for _, id := range authorIDs {
+		if !known[id] {
+			return fmt.Errorf("%w: %s", ErrUnknownAuthor, id)
+		}
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	return repo.SetContentAuthors(ctx, contentID, ids)
+}
+
+// checkAuthor normalizes the slug of author and ensures neither it nor its
+// user is used by another author of the site.
+func (svc *BaseService) checkAuthor(ctx context.Context, author *Author) error {
+	author.SlugField = NormalizeSlug(author.SlugField)
+	if author.SlugField == "" {
+		author.SlugField = NormalizeSlug(author.Name)
+	}
+
+	all, err := svc.getRepo(ctx).GetAllAuthors(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check author: %w", err)
+	}
+	for _, a := range all {
+		if a.ID == author.ID {
+			continue
+		}
+		if a.Slug() == author.SlugField {
+			return fmt.Errorf("%w: %s", ErrSlugTaken, author.SlugField)
+		}
+		if author.UserID != uuid.Nil && a.UserID == author.UserID {
+			return fmt.Errorf("%w: %s", ErrAuthorUserTaken, author.UserID)
+		}
+	}
+
+	return nil
+}
+
+// Content type related
+
+// CreateContentType validates contentType and stores it.
+func (svc *BaseService) CreateContentType(ctx context.Context, contentType ContentType) error {
+	if err := svc.checkContentType(ctx, &contentType); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateContentType(ctx, contentType)
+}
+
+func (svc *BaseService) GetContentType(ctx context.Context, id uuid.UUID) (ContentType, error) {
+	return svc.getRepo(ctx).GetContentType(ctx, id)
+}
+
+func (svc *BaseService) GetContentTypes(ctx context.Context) ([]ContentType, error) {
+	return svc.getRepo(ctx).GetContentTypes(ctx)
+}
+
+// UpdateContentType validates contentType and stores it. Values of fields
+// removed from the schema are kept but no longer typed.
+func (svc *BaseService) UpdateContentType(ctx context.Context, contentType ContentType) error {
+	if err := svc.checkContentType(ctx, &contentType); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).UpdateContentType(ctx, contentType)
+}
+
+// DeleteContentType removes a content type. Content of its kind is kept.
+func (svc *BaseService) DeleteContentType(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteContentType(ctx, id)
+}
+
+// GetFieldsForContent returns the custom field values of content by name.
+func (svc *BaseService) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) (map[string]string, error) {
+	fields, err := svc.getRepo(ctx).GetFieldsForContent(ctx, contentID)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string, len(fields))
+	for _, f := range fields {
+		values[f.Name] = f.Value
+	}
+	return values, nil
+}
+
+// SetContentFields replaces the custom field values of content after
+// checking them against the content type of its kind.
+func (svc *BaseService) SetContentFields(ctx context.Context, contentID uuid.UUID, fields map[string]string) error {
+	repo := svc.getRepo(ctx)
+	content, err := repo.GetContent(ctx, contentID)
+	if err != nil {
+		return fmt.Errorf("cannot get content: %w", err)
+	}
+
+	types, err := repo.GetContentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check content fields: %w", err)
+	}
+	ct, _ := FindContentType(types, content.Kind)
+	if err := ct.CheckFields(fields); err != nil {
+		return err
+	}
+
+	return repo.SetContentFields(ctx, contentID, ContentFieldList(contentID, fields))
+}
+
+// resolveContentTypes sets the custom fields and default layout of contents
+// from the content types of the site.
+func (svc *BaseService) resolveContentTypes(ctx context.Context, repo Repo, contents []Content) error {
+	types, err := repo.GetContentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get content types: %w", err)
+	}
+	fields, err := repo.GetContentFields(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get content fields: %w", err)
+	}
+	ResolveContentTypes(contents, types, fields)
+	return nil
+}
+
+// checkContentType normalizes contentType, validates its schema and
+// ensures no other content type of the site uses its name.
+func (svc *BaseService) checkContentType(ctx context.Context, contentType *ContentType) error {
+	contentType.Normalize()
+	if err := contentType.Validate(); err != nil {
+		return err
+	}
+
+	all, err := svc.getRepo(ctx).GetContentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check content type: %w", err)
+	}
+	for _, ct := range all {
+		if ct.ID != contentType.ID && ct.Name == contentType.Name {
+			return fmt.Errorf("%w: %s", ErrContentKindTaken, contentType.Name)
+		}
+	}
+
+	return nil
+}
+
+// checkContentKind ensures the kind of content is built in or defined by a
+// content type of the site, that the type allows its section and, when
+// content lists them, that its custom fields match the type schema.
+func (svc *BaseService) checkContentKind(ctx context.Context, content *Content) error {
+	types, err := svc.getRepo(ctx).GetContentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check content kind: %w", err)
+	}
+
+	ct, ok := FindContentType(types, content.Kind)
+	if !ok {
+		if content.Kind != "" && !IsBuiltinKind(content.Kind) {
+			return fmt.Errorf("%w: %s", ErrUnknownContentKind, content.Kind)
+		}
+	}
+	if content.SectionID != uuid.Nil && !ct.AllowsSection(content.SectionID) {
+		return fmt.Errorf("%w: %s", ErrSectionNotAllowed, content.Kind)
+	}
+	if content.Fields != nil {
+		return ct.CheckFields(content.Fields)
+	}
+
+	return nil
+}
+
+// Archetype related
+
+// CreateArchetype validates archetype and stores it.
+func (svc *BaseService) CreateArchetype(ctx context.Context, archetype Archetype) error {
+	if err := svc.checkArchetype(ctx, &archetype); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateArchetype(ctx, archetype)
+}
+
+func (svc *BaseService) GetArchetype(ctx context.Context, id uuid.UUID) (Archetype, error) {
+	return svc.getRepo(ctx).GetArchetype(ctx, id)
+}
+
+func (svc *BaseService) GetArchetypes(ctx context.Context) ([]Archetype, error) {
+	return svc.getRepo(ctx).GetArchetypes(ctx)
+}
+
+// UpdateArchetype validates archetype and stores it. Content already
+// created from it is not changed.
+func (svc *BaseService) UpdateArchetype(ctx context.Context, archetype Archetype) error {
+	if err := svc.checkArchetype(ctx, &archetype); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).UpdateArchetype(ctx, archetype)
+}
+
+func (svc *BaseService) DeleteArchetype(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteArchetype(ctx, id)
+}
+
+// ApplyArchetype fills the values content leaves unset with the defaults of
+// the archetype of the site named name. Content of another kind than the
+// archetype is rejected.
+func (svc *BaseService) ApplyArchetype(ctx context.Context, name string, content *Content) error {
+	archetypes, err := svc.getRepo(ctx).GetArchetypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot get archetypes: %w", err)
+	}
+
+	archetype, ok := FindArchetype(archetypes, name)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownArchetype, name)
+	}
+	if content.Kind != "" && !strings.EqualFold(content.Kind, archetype.Kind) {
+		return fmt.Errorf("%w: %s is for %s content", ErrInvalidArchetype, archetype.Name, archetype.Kind)
+	}
+
+	archetype.Apply(content)
+	return nil
+}
+
+// checkArchetype normalizes archetype, validates it against the content
+// types of the site and ensures no other archetype of the site uses its
+// name.
+func (svc *BaseService) checkArchetype(ctx context.Context, archetype *Archetype) error {
+	repo := svc.getRepo(ctx)
+	archetype.Normalize()
+
+	types, err := repo.GetContentTypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check archetype: %w", err)
+	}
+	if err := archetype.Validate(types); err != nil {
+		return err
+	}
+
+	all, err := repo.GetArchetypes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check archetype: %w", err)
+	}
+	for _, a := range all {
+		if a.ID != archetype.ID && a.Name == archetype.Name {
+			return fmt.Errorf("%w: %s", ErrArchetypeNameTaken, archetype.Name)
+		}
+	}
+
+	return nil
+}
+
+// Comment related
+
+// SubmitComment validates a comment left by a reader and stores it for
+// moderation. Draft content and content without comments enabled does not
+// take comments.
+func (svc *BaseService) SubmitComment(ctx context.Context, comment *Comment) error {
+	comment.Normalize()
+	if err := comment.Validate(); err != nil {
+		return err
+	}
+
+	siteID, err := RequireSiteID(ctx)
+	if err != nil {
+		return err
+	}
+
+	repo := svc.getRepo(ctx)
+	content, err := repo.GetContent(ctx, comment.ContentID)
+	if err != nil {
+		return fmt.Errorf("cannot get content: %w", err)
+	}
+	if content.Draft || !content.Meta.Comments {
+		return fmt.Errorf("%w: %s", ErrCommentsClosed, content.Slug())
+	}
+
+	comment.SiteID = siteID
+	comment.Status = CommentPending
+	comment.GenID()
+	comment.GenShortID()
+	comment.GenCreateValues()
+
+	return repo.CreateComment(ctx, *comment)
+}
+
+func (svc *BaseService) GetComment(ctx context.Context, id uuid.UUID) (Comment, error) {
+	return svc.getRepo(ctx).GetComment(ctx, id)
+}
+
+// GetComments returns the comments of the site with status, newest first.
+// An empty status returns all of them.
+func (svc *BaseService) GetComments(ctx context.Context, status string) ([]Comment, error) {
+	return svc.getRepo(ctx).GetComments(ctx, status)
+}
+
+// ModerateComment sets the status of a comment. When the comment enters or
+// leaves its page, the page is queued for the next comment rebuild.
+func (svc *BaseService) ModerateComment(ctx context.Context, id uuid.UUID, status string) error {
+	if !ValidCommentStatus(status) {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidComment, status)
+	}
+
+	repo := svc.getRepo(ctx)
+	comment, err := repo.GetComment(ctx, id)
+	if err != nil {
+		return fmt.Errorf("cannot get comment: %w", err)
+	}
+	if comment.Status == status {
+		return nil
+	}
+
+	wasApproved := comment.IsApproved()
+	comment.Status = status
+	comment.GenUpdateValues()
+	if err := repo.UpdateComment(ctx, comment); err != nil {
+		return err
+	}
+
+	if wasApproved || comment.IsApproved() {
+		return repo.AddCommentRebuild(ctx, comment.ContentID)
+	}
+	return nil
+}
+
+// DeleteComment removes a comment, queueing its page for the next comment
+// rebuild when it was shown there.
+func (svc *BaseService) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	repo := svc.getRepo(ctx)
+	comment, err := repo.GetComment(ctx, id)
+	if err != nil {
+		return fmt.Errorf("cannot get comment: %w", err)
+	}
+
+	if err := repo.DeleteComment(ctx, id); err != nil {
+		return err
+	}
+
+	if comment.IsApproved() {
+		return repo.AddCommentRebuild(ctx, comment.ContentID)
+	}
+	return nil
+}
+
+// GetCommentRebuilds returns the IDs of the content whose pages show
+// outdated comments.
+func (svc *BaseService) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	return svc.getRepo(ctx).GetCommentRebuilds(ctx)
+}
+
+// GenerateCommentPages regenerates only the HTML pages of the content whose
+// comments changed since the last build and returns how many were queued.
+// Indexes and assets are left as they are.
+func (svc *BaseService) GenerateCommentPages(ctx context.Context) (int, error) {
+	ids, err := svc.takeCommentRebuilds(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	only := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		only[id] = true
+	}
+	if err := svc.generateHTML(ctx, only); err != nil {
+		svc.requeueCommentRebuilds(ctx, ids)
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// takeCommentRebuilds returns the queued comment rebuilds and removes them
+// from the queue. They are taken before generating, so a page queued again
+// while the site is generated is kept for the next rebuild.
+func (svc *BaseService) takeCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	repo := svc.getRepo(ctx)
+	ids, err := repo.GetCommentRebuilds(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get comment rebuilds: %w", err)
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if err := repo.ClearCommentRebuilds(ctx, ids); err != nil {
+		return nil, fmt.Errorf("cannot clear comment rebuilds: %w", err)
+	}
+	return ids, nil
+}
+
+// requeueCommentRebuilds queues ids again after a failed generation.
+func (svc *BaseService) requeueCommentRebuilds(ctx context.Context, ids []uuid.UUID) {
+	repo := svc.getRepo(ctx)
+	for _, id := range ids {
+		if err := repo.AddCommentRebuild(ctx, id); err != nil {
+			svc.Log().Error("Cannot queue comment rebuild again", "content", id, "error", err)
+		}
+	}
+}
+
+// commentURL returns the address comment forms of the site post to, empty
+// when the site does not set one. The API address of the server is not used
+// as a default: published pages could not reach it.
+func (svc *BaseService) commentURL(ctx context.Context, siteSlug string, contentID uuid.UUID) string {
+	var base string
+	if svc.pm != nil {
+		base = svc.pm.Get(ctx, SSGKey.CommentsURL, "")
+	}
+	if base == "" {
+		return ""
+	}
+	q := url.Values{}
+	q.Set("site", siteSlug)
+	q.Set("content", contentID.String())
+	return base + "?" + q.Encode()
+}
+
+// Theme related
+
+// ListThemes returns the embedded theme and the installed ones.
+func (svc *BaseService) ListThemes(ctx context.Context) ([]ThemeManifest, error) {
+	return ListThemes(svc.assetsFS, svc.themesPath())
+}
+
+// InstallTheme installs the theme at src, a directory or zip file on the
+// server.
+func (svc *BaseService) InstallTheme(ctx context.Context, src string) (ThemeManifest, error) {
+	return InstallTheme(svc.themesPath(), src)
+}
+
+// RemoveTheme uninstalls theme name unless the site uses it.
+func (svc *BaseService) RemoveTheme(ctx context.Context, name string) error {
+	if svc.pm.Get(ctx, SSGKey.ThemeName, DefaultThemeName) == name {
+		return fmt.Errorf("%w: %s", ErrThemeInUse, name)
+	}
+	return RemoveTheme(svc.themesPath(), name)
+}
+
+// SelectTheme makes theme name, which must be installed, the theme of the
+// site.
+func (svc *BaseService) SelectTheme(ctx context.Context, name string) error {
+	themes, err := svc.ListThemes(ctx)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, t := range themes {
+		found = found || t.Name == name
+	}
+	if !found {
+		return fmt.Errorf("%w: %s", ErrThemeNotFound, name)
+	}
+
+	repo := svc.getRepo(ctx)
+	param, err := repo.GetParamByRefKey(ctx, SSGKey.ThemeName)
+	if err != nil || param.IsZero() {
+		param = NewParam("SSG Theme Name", name)
+		param.RefKey = SSGKey.ThemeName
+		param.System = 1
+		param.GenCreateValues()
+		return repo.CreateParam(ctx, &param)
+	}
+
+	param.Value = name
+	param.GenUpdateValues()
+	return repo.UpdateParam(ctx, &param)
+}
+
+// Data file related
+
+// ListDataFiles returns the data files of the site.
+func (svc *BaseService) ListDataFiles(ctx context.Context) ([]DataFile, error) {
+	dir, err := svc.siteDataPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ListDataFiles(dir)
+}
+
+// GetDataFile returns the data file name of the site with its content.
+func (svc *BaseService) GetDataFile(ctx context.Context, name string) (DataFile, error) {
+	dir, err := svc.siteDataPath(ctx)
+	if err != nil {
+		return DataFile{}, err
+	}
+	return ReadDataFile(dir, name)
+}
+
+// SaveDataFile validates and writes a data file of the site, creating it if
+// needed.
+func (svc *BaseService) SaveDataFile(ctx context.Context, file DataFile) error {
+	dir, err := svc.siteDataPath(ctx)
+	if err != nil {
+		return err
+	}
+	return WriteDataFile(dir, file)
+}
+
+// DeleteDataFile removes the data file name of the site.
+func (svc *BaseService) DeleteDataFile(ctx context.Context, name string) error {
+	dir, err := svc.siteDataPath(ctx)
+	if err != nil {
+		return err
+	}
+	return DeleteDataFile(dir, name)
+}
+
+// siteDataPath returns the data directory of the site in ctx.
+func (svc *BaseService) siteDataPath(ctx context.Context) (string, error) {
+	siteSlug, ok := GetSiteSlugFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("site slug not found in context")
+	}
+	sitesBasePath := svc.Cfg().StrValOrDef(SSGKey.SitesBasePath, "_workspace/sites")
+	return GetSiteDataPath(sitesBasePath, siteSlug), nil
+}
+
+// Menu related
+
+// CreateMenu validates menu and stores it.
+func (svc *BaseService) CreateMenu(ctx context.Context, menu Menu) error {
+	if err := svc.checkMenu(ctx, &menu); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).CreateMenu(ctx, menu)
+}
+
+// GetMenu returns a menu along with its items.
+func (svc *BaseService) GetMenu(ctx context.Context, id uuid.UUID) (Menu, error) {
+	repo := svc.getRepo(ctx)
+	menu, err := repo.GetMenu(ctx, id)
+	if err != nil {
+		return Menu{}, err
+	}
+
+	menu.Items, err = repo.GetMenuItems(ctx, menu.ID)
+	if err != nil {
+		return Menu{}, fmt.Errorf("cannot get menu items: %w", err)
+	}
+
+	return menu, nil
+}
+
+// GetMenus returns the menus of the site along with their items.
+func (svc *BaseService) GetMenus(ctx context.Context) ([]Menu, error) {
+	repo := svc.getRepo(ctx)
+	menus, err := repo.GetMenus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range menus {
+		menus[i].Items, err = repo.GetMenuItems(ctx, menus[i].ID)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get menu items: %w", err)
+		}
+	}
+
+	return menus, nil
+}
+
+// UpdateMenu validates menu and stores it.
+func (svc *BaseService) UpdateMenu(ctx context.Context, menu Menu) error {
+	if err := svc.checkMenu(ctx, &menu); err != nil {
+		return err
+	}
+	return svc.getRepo(ctx).UpdateMenu(ctx, menu)
+}
+
+// DeleteMenu removes a menu along with its items.
+func (svc *BaseService) DeleteMenu(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteMenu(ctx, id)
+}
+
+// CreateMenuItem validates item and stores it. Items without position are
+// placed after their siblings.
+func (svc *BaseService) CreateMenuItem(ctx context.Context, item MenuItem) error {
+	siblings, err := svc.checkMenuItem(ctx, &item)
+	if err != nil {
+		return err
+	}
+
+	if item.Position <= 0 {
+		item.Position = 1
+		for _, s := range siblings {
+			if s.Position >= item.Position {
+				item.Position = s.Position + 1
+			}
+		}
+	}
+
+	return svc.getRepo(ctx).CreateMenuItem(ctx, item)
+}
+
+func (svc *BaseService) GetMenuItem(ctx context.Context, id uuid.UUID) (MenuItem, error) {
+	return svc.getRepo(ctx).GetMenuItem(ctx, id)
+}
+
+// UpdateMenuItem validates item and stores it. Items moved below another
+// parent are placed after their new siblings.
+func (svc *BaseService) UpdateMenuItem(ctx context.Context, item MenuItem) error {
+	repo := svc.getRepo(ctx)
+	previous, err := repo.GetMenuItem(ctx, item.ID)
+	if err != nil {
+		return fmt.Errorf("cannot get menu item: %w", err)
+	}
+	item.MenuID = previous.MenuID
+
+	siblings, err := svc.checkMenuItem(ctx, &item)
+	if err != nil {
+		return err
+	}
+
+	if item.ParentID != previous.ParentID || item.Position <= 0 {
+		item.Position = 1
+		for _, s := range siblings {
+			if s.Position >= item.Position {
+				item.Position = s.Position + 1
+			}
+		}
+	}
+
+	return repo.UpdateMenuItem(ctx, item)
+}
+
+// DeleteMenuItem removes a menu item along with its children.
+func (svc *BaseService) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteMenuItem(ctx, id)
+}
+
+// MoveMenuItem swaps a menu item with its previous ("up") or next ("down")
+// sibling and renumbers the siblings from 1. Moving past either end leaves
+// the order unchanged.
+func (svc *BaseService) MoveMenuItem(ctx context.Context, id uuid.UUID, direction string) error {
+	if direction != "up" && direction != "down" {
+		return fmt.Errorf("%w: unknown direction %q", ErrInvalidMenuItem, direction)
+	}
+
+	repo := svc.getRepo(ctx)
+	item, err := repo.GetMenuItem(ctx, id)
+	if err != nil {
+		return fmt.Errorf("cannot get menu item: %w", err)
+	}
+
+	items, err := repo.GetMenuItems(ctx, item.MenuID)
+	if err != nil {
+		return fmt.Errorf("cannot get menu items: %w", err)
+	}
+
+	var siblings []MenuItem
+	pos := -1
+	for _, i := range items {
+		if i.ParentID != item.ParentID {
+			continue
+		}
+		if i.ID == item.ID {
+			pos = len(siblings)
+		}
+		siblings = append(siblings, i)
+	}
+
+	switch {
+	case direction == "up" && pos > 0:
+		siblings[pos-1], siblings[pos] = siblings[pos], siblings[pos-1]
+	case direction == "down" && pos >= 0 && pos < len(siblings)-1:
+		siblings[pos], siblings[pos+1] = siblings[pos+1], siblings[pos]
+	default:
+		return nil
+	}
+
+	for i := range siblings {
+		if siblings[i].Position == i+1 {
+			continue
+		}
+		siblings[i].Position = i + 1
+		if err := repo.UpdateMenuItem(ctx, siblings[i]); err != nil {
+			return fmt.Errorf("cannot update menu item position: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// checkMenu normalizes the location of menu and ensures no other menu of the
+// site uses it.
+func (svc *BaseService) checkMenu(ctx context.Context, menu *Menu) error {
+	menu.Location = strings.ToLower(strings.TrimSpace(menu.Location))
+	if !ValidMenuLocation(menu.Location) {
+		return fmt.Errorf("%w: %s", ErrInvalidMenuLocation, menu.Location)
+	}
+
+	menus, err := svc.getRepo(ctx).GetMenus(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot check menu location: %w", err)
+	}
+	for _, m := range menus {
+		if m.ID != menu.ID && m.Location == menu.Location {
+			return fmt.Errorf("%w: %s", ErrMenuLocationTaken, menu.Location)
+		}
+	}
+
+	return nil
+}
+
+// checkMenuItem validates the target and parent of item and returns its
+// siblings. Parents must be top level items of the same menu, and items
+// with children cannot be nested, so menus stay two levels deep.
+func (svc *BaseService) checkMenuItem(ctx context.Context, item *MenuItem) ([]MenuItem, error) {
+	item.TargetType = strings.ToLower(strings.TrimSpace(item.TargetType))
+	item.URL = strings.TrimSpace(item.URL)
+	if !ValidMenuTarget(item.TargetType) {
+		return nil, fmt.Errorf("%w: unknown target %q", ErrInvalidMenuItem, item.TargetType)
+	}
+	if item.TargetType == MenuTargetURL {
+		if item.URL == "" {
+			return nil, fmt.Errorf("%w: missing URL", ErrInvalidMenuItem)
+		}
+		item.TargetID = uuid.Nil
+	} else {
+		if item.TargetID == uuid.Nil {
+			return nil, fmt.Errorf("%w: missing %s", ErrInvalidMenuItem, item.TargetType)
+		}
+		item.URL = ""
+	}
+
+	repo := svc.getRepo(ctx)
+	if _, err := repo.GetMenu(ctx, item.MenuID); err != nil {
+		return nil, fmt.Errorf("cannot get menu: %w", err)
+	}
+
+	items, err := repo.GetMenuItems(ctx, item.MenuID)
+	if err != nil {
+		return nil, fmt.Errorf("cannot get menu items: %w", err)
+	}
+
+	if item.ParentID != uuid.Nil {
+		if item.ParentID == item.ID {
+			return nil, fmt.Errorf("%w: item cannot be its own parent", ErrInvalidMenuItem)
+		}
+		var parent *MenuItem
+		for i := range items {
+			if items[i].ID == item.ParentID {
+				parent = &items[i]
+			}
+			if item.ID != uuid.Nil && items[i].ParentID == item.ID {
+				return nil, fmt.Errorf("%w: item with children cannot be nested", ErrInvalidMenuItem)
+			}
+		}
+		if parent == nil || parent.ParentID != uuid.Nil {
+			return nil, fmt.Errorf("%w: parent must be a top level item of the menu", ErrInvalidMenuItem)
+		}
+	}
+
+	var siblings []MenuItem
+	for _, i := range items {
+		if i.ParentID == item.ParentID && i.ID != item.ID {
+			siblings = append(siblings, i)
+		}
+	}
+
+	return siblings, nil
+}
+
+// Param related
+func (svc *BaseService) CreateParam(ctx context.Context, param *Param) error {
+	return svc.getRepo(ctx).CreateParam(ctx, param)
+}
+
+func (svc *BaseService) GetParam(ctx context.Context, id uuid.UUID) (Param, error) {
+	return svc.getRepo(ctx).GetParam(ctx, id)
+}
+
+func (svc *BaseService) GetParamByName(ctx context.Context, name string) (Param, error) {
+	return svc.getRepo(ctx).GetParamByName(ctx, name)
+}
+
+func (svc *BaseService) GetParamByRefKey(ctx context.Context, refKey string) (Param, error) {
+	return svc.getRepo(ctx).GetParamByRefKey(ctx, refKey)
+}
+
+func (svc *BaseService) ListParams(ctx context.Context) ([]Param, error) {
+	return svc.getRepo(ctx).ListParams(ctx)
+}
+
+func (svc *BaseService) UpdateParam(ctx context.Context, param *Param) error {
+	return svc.getRepo(ctx).UpdateParam(ctx, param)
+}
+
+func (svc *BaseService) DeleteParam(ctx context.Context, id uuid.UUID) error {
+	return svc.getRepo(ctx).DeleteParam(ctx, id)
+}
+
+// Image related
+func (svc *BaseService) CreateImage(ctx context.Context, image *Image) error {
+	return svc.getRepo(ctx).CreateImage(ctx, image)
+}
+
+func (svc *BaseService) GetImage(ctx context.Context, id uuid.UUID) (Image, error) {
+	return svc.getRepo(ctx).GetImage(ctx, id)
+}
+
+func (svc *BaseService) GetImageByShortID(ctx context.Context, shortID string) (Image, error) {
+	return svc.getRepo(ctx).GetImageByShortID(ctx, shortID)
+}
+
+func (svc *BaseService) ListImages(ctx context.Context) ([]Image, error) {
+	return svc.getRepo(ctx).ListImages(ctx)
+}
 
 func (svc *BaseService) UpdateImage(ctx context.Context, image *Image) error {
 	return svc.getRepo(ctx).UpdateImage(ctx, image)
@@ -767,9 +2701,18 @@ func (svc *BaseService) UploadContentImage(ctx context.Context, contentID uuid.U
 
 	// Create Image record with accessibility metadata - always create new record
 	image := Image{
-		Title:    caption,
-		FilePath: result.RelativePath,
-		AltText:  altText,
+		Title:        caption,
+		FileName:     result.Filename,
+		FilePath:     result.RelativePath,
+		Width:        result.Width,
+		Height:       result.Height,
+		FilesizeByte: result.Size,
+		Mime:         result.Mime,
+		AltText:      altText,
+		Caption:      caption,
+	}
+	if result.Placeholder != nil {
+		image.DominantColor = result.Placeholder.DominantColor
 	}
 	image.GenCreateValues()
 
@@ -778,6 +2721,8 @@ func (svc *BaseService) UploadContentImage(ctx context.Context, contentID uuid.U
 		return nil, fmt.Errorf("failed to create image record: %w", err)
 	}
 
+	svc.createPlaceholderVariant(ctx, image.GetID(), result.Placeholder)
+
 	isHeader := imageType == ImageTypeHeader
 	contentImage := NewContentImage(contentID, image.GetID(), isHeader)
 
@@ -798,19 +2743,41 @@ func (svc *BaseService) UploadContentImage(ctx context.Context, contentID uuid.U
 	return result, nil
 }
 
+// createPlaceholderVariant stores the upload placeholder as an image variant.
+// A missing placeholder only degrades rendering, so errors are logged.
+func (svc *BaseService) createPlaceholderVariant(ctx context.Context, imageID uuid.UUID, placeholder *Placeholder) {
+	if placeholder == nil {
+		return
+	}
+
+	variant := placeholder.Variant(imageID)
+	variant.GenCreateValues()
+
+	if err := svc.repo.CreateImageVariant(ctx, &variant); err != nil {
+		svc.Log().Error("Failed to create placeholder variant", "imageID", imageID, "error", err)
+	}
+}
+
 // ImageWithMeta combines Image with ContentImage metadata for API responses
 type ImageWithMeta struct {
-	ID         uuid.UUID `json:"id"`
-	SiteID     uuid.UUID `json:"site_id"`
-	FileName   string    `json:"file_name"`
-	FilePath   string    `json:"file_path"`
-	Width      int       `json:"width"`
-	Height     int       `json:"height"`
-	Title      string    `json:"title"`
-	AltText    string    `json:"alt_text"`
-	IsHeader   bool      `json:"is_header"`
-	IsFeatured bool      `json:"is_featured"`
-	OrderNum   int       `json:"order_num"`
+	ID              uuid.UUID `json:"id"`
+	SiteID          uuid.UUID `json:"site_id"`
+	FileName        string    `json:"file_name"`
+	FilePath        string    `json:"file_path"`
+	Width           int       `json:"width"`
+	Height          int       `json:"height"`
+	Title           string    `json:"title"`
+	AltText         string    `json:"alt_text"`
+	AltLang         string    `json:"alt_lang"`
+	LongDescription string    `json:"long_description"`
+	Caption         string    `json:"caption"`
+	Decorative      bool      `json:"decorative"`
+	Credit          string    `json:"credit"`
+	License         string    `json:"license"`
+	SourceURL       string    `json:"source_url"`
+	IsHeader        bool      `json:"is_header"`
+	IsFeatured      bool      `json:"is_featured"`
+	OrderNum        int       `json:"order_num"`
 }
 
 // GetContentImages returns all images for a specific content via relationships
@@ -833,17 +2800,24 @@ func (svc *BaseService) GetContentImages(ctx context.Context, contentID uuid.UUI
 		}
 
 		imageWithMeta := ImageWithMeta{
-			ID:         image.ID,
-			SiteID:     image.SiteID,
-			FileName:   image.FileName,
-			FilePath:   image.FilePath,
-			Width:      image.Width,
-			Height:     image.Height,
-			Title:      image.Title,
-			AltText:    image.AltText,
-			IsHeader:   ci.IsHeader,
-			IsFeatured: ci.IsFeatured,
-			OrderNum:   ci.OrderNum,
+			ID:              image.ID,
+			SiteID:          image.SiteID,
+			FileName:        image.FileName,
+			FilePath:        image.FilePath,
+			Width:           image.Width,
+			Height:          image.Height,
+			Title:           image.Title,
+			AltText:         image.AltText,
+			AltLang:         image.AltLang,
+			LongDescription: image.LongDescription,
+			Caption:         image.Caption,
+			Decorative:      image.Decorative,
+			Credit:          image.Credit,
+			License:         image.License,
+			SourceURL:       image.SourceURL,
+			IsHeader:        ci.IsHeader,
+			IsFeatured:      ci.IsFeatured,
+			OrderNum:        ci.OrderNum,
 		}
 		images = append(images, imageWithMeta)
 	}
@@ -986,9 +2960,18 @@ func (svc *BaseService) UploadSectionImage(ctx context.Context, sectionID uuid.U
 
 	// Create Image record with accessibility metadata - always create new record
 	image := Image{
-		Title:    caption,
-		FilePath: result.RelativePath,
-		AltText:  altText,
+		Title:        caption,
+		FileName:     result.Filename,
+		FilePath:     result.RelativePath,
+		Width:        result.Width,
+		Height:       result.Height,
+		FilesizeByte: result.Size,
+		Mime:         result.Mime,
+		AltText:      altText,
+		Caption:      caption,
+	}
+	if result.Placeholder != nil {
+		image.DominantColor = result.Placeholder.DominantColor
 	}
 	image.GenCreateValues()
 
@@ -997,6 +2980,8 @@ func (svc *BaseService) UploadSectionImage(ctx context.Context, sectionID uuid.U
 		return nil, fmt.Errorf("failed to create image record: %w", err)
 	}
 
+	svc.createPlaceholderVariant(ctx, image.GetID(), result.Placeholder)
+
 	isHeader := imageType == ImageTypeSectionHeader || imageType == ImageTypeBlogHeader
 	sectionImage := NewSectionImage(sectionID, image.GetID(), isHeader)
 
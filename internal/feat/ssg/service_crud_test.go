@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"embed"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -27,6 +28,17 @@ type mockServiceRepo struct {
 	imageVariants   map[uuid.UUID]ImageVariant
 	contentImages   map[uuid.UUID][]ContentImage
 	sectionImages   map[uuid.UUID][]SectionImage
+	contentAliases  map[uuid.UUID]ContentAlias
+	series          map[uuid.UUID]Series
+	authors         map[uuid.UUID]Author
+	contentAuthors  map[uuid.UUID][]uuid.UUID
+	contentTypes    map[uuid.UUID]ContentType
+	contentFields   map[uuid.UUID][]ContentField
+	archetypes      map[uuid.UUID]Archetype
+	comments        map[uuid.UUID]Comment
+	rebuilds        map[uuid.UUID]bool
+	menus           map[uuid.UUID]Menu
+	menuItems       map[uuid.UUID]MenuItem
 	contentTags     map[uuid.UUID][]Tag
 	tagContent      map[uuid.UUID][]Content
 
@@ -97,6 +109,17 @@ func newMockServiceRepo() *mockServiceRepo {
 		imagesByShortID: make(map[string]Image),
 		imageVariants:   make(map[uuid.UUID]ImageVariant),
 		contentImages:   make(map[uuid.UUID][]ContentImage),
+		contentAliases:  make(map[uuid.UUID]ContentAlias),
+		series:          make(map[uuid.UUID]Series),
+		authors:         make(map[uuid.UUID]Author),
+		contentAuthors:  make(map[uuid.UUID][]uuid.UUID),
+		contentTypes:    make(map[uuid.UUID]ContentType),
+		contentFields:   make(map[uuid.UUID][]ContentField),
+		archetypes:      make(map[uuid.UUID]Archetype),
+		comments:        make(map[uuid.UUID]Comment),
+		rebuilds:        make(map[uuid.UUID]bool),
+		menus:           make(map[uuid.UUID]Menu),
+		menuItems:       make(map[uuid.UUID]MenuItem),
 		sectionImages:   make(map[uuid.UUID][]SectionImage),
 		contentTags:     make(map[uuid.UUID][]Tag),
 		tagContent:      make(map[uuid.UUID][]Content),
@@ -130,6 +153,15 @@ func (m *mockServiceRepo) GetContent(ctx context.Context, id uuid.UUID) (Content
 	return content, nil
 }
 
+func (m *mockServiceRepo) GetContentBySlug(ctx context.Context, slug string) (Content, error) {
+	for _, content := range m.contents {
+		if content.SlugField == slug {
+			return content, nil
+		}
+	}
+	return Content{}, sql.ErrNoRows
+}
+
 func (m *mockServiceRepo) UpdateContent(ctx context.Context, content *Content) error {
 	if m.updateContentErr != nil {
 		return m.updateContentErr
@@ -138,6 +170,20 @@ func (m *mockServiceRepo) UpdateContent(ctx context.Context, content *Content) e
 	return nil
 }
 
+func (m *mockServiceRepo) MoveContent(ctx context.Context, move ContentMove) error {
+	if m.updateContentErr != nil {
+		return m.updateContentErr
+	}
+	m.contents[move.Content.ID] = *move.Content
+	for _, alias := range move.Aliases {
+		m.CreateContentAlias(ctx, alias)
+	}
+	for _, id := range move.ClearedAliases {
+		delete(m.contentAliases, id)
+	}
+	return nil
+}
+
 func (m *mockServiceRepo) DeleteContent(ctx context.Context, id uuid.UUID) error {
 	if m.deleteContentErr != nil {
 		return m.deleteContentErr
@@ -217,6 +263,22 @@ func (m *mockServiceRepo) UpdateSection(ctx context.Context, section Section) er
 	return nil
 }
 
+func (m *mockServiceRepo) MoveSections(ctx context.Context, move SectionMove) error {
+	if m.updateSectionErr != nil {
+		return m.updateSectionErr
+	}
+	for _, section := range move.Sections {
+		m.sections[section.ID] = section
+	}
+	for _, alias := range move.Aliases {
+		m.CreateContentAlias(ctx, alias)
+	}
+	for _, id := range move.ClearedAliases {
+		delete(m.contentAliases, id)
+	}
+	return nil
+}
+
 func (m *mockServiceRepo) DeleteSection(ctx context.Context, id uuid.UUID) error {
 	if m.deleteSectionErr != nil {
 		return m.deleteSectionErr
@@ -546,6 +608,348 @@ func (m *mockServiceRepo) GetContentImagesByContentID(ctx context.Context, conte
 	return images, nil
 }
 
+func (m *mockServiceRepo) CreateSeries(ctx context.Context, series Series) error {
+	m.series[series.ID] = series
+	return nil
+}
+
+func (m *mockServiceRepo) GetSeries(ctx context.Context, id uuid.UUID) (Series, error) {
+	series, ok := m.series[id]
+	if !ok {
+		return Series{}, errors.New("series not found")
+	}
+	return series, nil
+}
+
+func (m *mockServiceRepo) GetAllSeries(ctx context.Context) ([]Series, error) {
+	result := make([]Series, 0, len(m.series))
+	for _, s := range m.series {
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateSeries(ctx context.Context, series Series) error {
+	m.series[series.ID] = series
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteSeries(ctx context.Context, id uuid.UUID) error {
+	for cid, c := range m.contents {
+		if c.SeriesID == id {
+			c.SeriesID, c.Series, c.SeriesOrder = uuid.Nil, "", 0
+			m.contents[cid] = c
+		}
+	}
+	delete(m.series, id)
+	return nil
+}
+
+func (m *mockServiceRepo) UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error {
+	for i, id := range contentIDs {
+		c := m.contents[id]
+		c.SeriesOrder = i + 1
+		m.contents[id] = c
+	}
+	return nil
+}
+
+func (m *mockServiceRepo) CreateAuthor(ctx context.Context, author Author) error {
+	m.authors[author.ID] = author
+	return nil
+}
+
+func (m *mockServiceRepo) GetAuthor(ctx context.Context, id uuid.UUID) (Author, error) {
+	author, ok := m.authors[id]
+	if !ok {
+		return Author{}, errors.New("author not found")
+	}
+	return author, nil
+}
+
+func (m *mockServiceRepo) GetAllAuthors(ctx context.Context) ([]Author, error) {
+	result := make([]Author, 0, len(m.authors))
+	for _, a := range m.authors {
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateAuthor(ctx context.Context, author Author) error {
+	m.authors[author.ID] = author
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteAuthor(ctx context.Context, id uuid.UUID) error {
+	delete(m.authors, id)
+	return nil
+}
+
+func (m *mockServiceRepo) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]Author, error) {
+	var result []Author
+	for _, id := range m.contentAuthors[contentID] {
+		result = append(result, m.authors[id])
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) GetContentAuthors(ctx context.Context) ([]ContentAuthor, error) {
+	var result []ContentAuthor
+	for cid, ids := range m.contentAuthors {
+		for i, id := range ids {
+			result = append(result, ContentAuthor{ContentID: cid, AuthorID: id, Position: i + 1})
+		}
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error {
+	m.contentAuthors[contentID] = authorIDs
+	return nil
+}
+
+func (m *mockServiceRepo) CreateContentType(ctx context.Context, contentType ContentType) error {
+	m.contentTypes[contentType.ID] = contentType
+	return nil
+}
+
+func (m *mockServiceRepo) GetContentType(ctx context.Context, id uuid.UUID) (ContentType, error) {
+	contentType, ok := m.contentTypes[id]
+	if !ok {
+		return ContentType{}, errors.New("content type not found")
+	}
+	return contentType, nil
+}
+
+func (m *mockServiceRepo) GetContentTypes(ctx context.Context) ([]ContentType, error) {
+	result := make([]ContentType, 0, len(m.contentTypes))
+	for _, ct := range m.contentTypes {
+		result = append(result, ct)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateContentType(ctx context.Context, contentType ContentType) error {
+	m.contentTypes[contentType.ID] = contentType
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteContentType(ctx context.Context, id uuid.UUID) error {
+	delete(m.contentTypes, id)
+	return nil
+}
+
+func (m *mockServiceRepo) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]ContentField, error) {
+	return m.contentFields[contentID], nil
+}
+
+func (m *mockServiceRepo) GetContentFields(ctx context.Context) ([]ContentField, error) {
+	var result []ContentField
+	for _, fields := range m.contentFields {
+		result = append(result, fields...)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) SetContentFields(ctx context.Context, contentID uuid.UUID, fields []ContentField) error {
+	m.contentFields[contentID] = fields
+	return nil
+}
+
+func (m *mockServiceRepo) CreateArchetype(ctx context.Context, archetype Archetype) error {
+	m.archetypes[archetype.ID] = archetype
+	return nil
+}
+
+func (m *mockServiceRepo) GetArchetype(ctx context.Context, id uuid.UUID) (Archetype, error) {
+	archetype, ok := m.archetypes[id]
+	if !ok {
+		return Archetype{}, errors.New("archetype not found")
+	}
+	return archetype, nil
+}
+
+func (m *mockServiceRepo) GetArchetypes(ctx context.Context) ([]Archetype, error) {
+	result := make([]Archetype, 0, len(m.archetypes))
+	for _, a := range m.archetypes {
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateArchetype(ctx context.Context, archetype Archetype) error {
+	m.archetypes[archetype.ID] = archetype
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteArchetype(ctx context.Context, id uuid.UUID) error {
+	delete(m.archetypes, id)
+	return nil
+}
+
+func (m *mockServiceRepo) CreateComment(ctx context.Context, comment Comment) error {
+	m.comments[comment.ID] = comment
+	return nil
+}
+
+func (m *mockServiceRepo) GetComment(ctx context.Context, id uuid.UUID) (Comment, error) {
+	comment, ok := m.comments[id]
+	if !ok {
+		return Comment{}, errors.New("comment not found")
+	}
+	return comment, nil
+}
+
+func (m *mockServiceRepo) GetComments(ctx context.Context, status string) ([]Comment, error) {
+	result := make([]Comment, 0, len(m.comments))
+	for _, c := range m.comments {
+		if status == "" || c.Status == status {
+			result = append(result, c)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateComment(ctx context.Context, comment Comment) error {
+	m.comments[comment.ID] = comment
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	delete(m.comments, id)
+	return nil
+}
+
+func (m *mockServiceRepo) AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error {
+	m.rebuilds[contentID] = true
+	return nil
+}
+
+func (m *mockServiceRepo) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(m.rebuilds))
+	for id := range m.rebuilds {
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func (m *mockServiceRepo) ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error {
+	if contentIDs == nil {
+		m.rebuilds = make(map[uuid.UUID]bool)
+	}
+	for _, id := range contentIDs {
+		delete(m.rebuilds, id)
+	}
+	return nil
+}
+
+func (m *mockServiceRepo) CreateMenu(ctx context.Context, menu Menu) error {
+	m.menus[menu.ID] = menu
+	return nil
+}
+
+func (m *mockServiceRepo) GetMenu(ctx context.Context, id uuid.UUID) (Menu, error) {
+	menu, ok := m.menus[id]
+	if !ok {
+		return Menu{}, errors.New("menu not found")
+	}
+	return menu, nil
+}
+
+func (m *mockServiceRepo) GetMenus(ctx context.Context) ([]Menu, error) {
+	result := make([]Menu, 0, len(m.menus))
+	for _, menu := range m.menus {
+		result = append(result, menu)
+	}
+	return result, nil
+}
+
+func (m *mockServiceRepo) UpdateMenu(ctx context.Context, menu Menu) error {
+	m.menus[menu.ID] = menu
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteMenu(ctx context.Context, id uuid.UUID) error {
+	for iid, item := range m.menuItems {
+		if item.MenuID == id {
+			delete(m.menuItems, iid)
+		}
+	}
+	delete(m.menus, id)
+	return nil
+}
+
+func (m *mockServiceRepo) CreateMenuItem(ctx context.Context, item MenuItem) error {
+	m.menuItems[item.ID] = item
+	return nil
+}
+
+func (m *mockServiceRepo) GetMenuItem(ctx context.Context, id uuid.UUID) (MenuItem, error) {
+	item, ok := m.menuItems[id]
+	if !ok {
+		return MenuItem{}, errors.New("menu item not found")
+	}
+	return item, nil
+}
+
+func (m *mockServiceRepo) GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]MenuItem, error) {
+	var result []MenuItem
+	for _, item := range m.menuItems {
+		if item.MenuID == menuID {
+			result = append(result, item)
+		}
+	}
+	return SortMenuItems(result), nil
+}
+
+func (m *mockServiceRepo) UpdateMenuItem(ctx context.Context, item MenuItem) error {
+	m.menuItems[item.ID] = item
+	return nil
+}
+
+func (m *mockServiceRepo) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
+	for iid, item := range m.menuItems {
+		if item.ParentID == id {
+			delete(m.menuItems, iid)
+		}
+	}
+	delete(m.menuItems, id)
+	return nil
+}
+
+func (m *mockServiceRepo) CreateContentAlias(ctx context.Context, alias *ContentAlias) error {
+	for id, existing := range m.contentAliases {
+		if existing.Path == alias.Path {
+			delete(m.contentAliases, id)
+		}
+	}
+	m.contentAliases[alias.ID] = *alias
+	return nil
+}
+
+func (m *mockServiceRepo) GetContentAliases(ctx context.Context) ([]ContentAlias, error) {
+	var aliases []ContentAlias
+	for _, alias := range m.contentAliases {
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+func (m *mockServiceRepo) GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]ContentAlias, error) {
+	var aliases []ContentAlias
+	for _, alias := range m.contentAliases {
+		if alias.ContentID == contentID {
+			aliases = append(aliases, alias)
+		}
+	}
+	return aliases, nil
+}
+
+func (m *mockServiceRepo) DeleteContentAlias(ctx context.Context, id uuid.UUID) error {
+	delete(m.contentAliases, id)
+	return nil
+}
+
 func (m *mockServiceRepo) CreateSectionImage(ctx context.Context, sectionImage *SectionImage) error {
 	if m.createSectionImageErr != nil {
 		return m.createSectionImageErr
@@ -678,6 +1082,67 @@ func TestServiceCreateContent(t *testing.T) {
 	}
 }
 
+func TestServiceContentSlugCollision(t *testing.T) {
+	existingID := uuid.New()
+
+	tests := []struct {
+		name     string
+		content  *Content
+		update   bool
+		wantErr  error
+		wantSlug string
+	}{
+		{
+			name:     "creates content with free slug",
+			content:  &Content{ID: uuid.New(), Heading: "New", SlugField: "fresh-slug"},
+			wantSlug: "fresh-slug",
+		},
+		{
+			name:    "rejects slug used by another content",
+			content: &Content{ID: uuid.New(), Heading: "New", SlugField: "taken"},
+			wantErr: ErrSlugTaken,
+		},
+		{
+			name:    "rejects slug colliding after normalization",
+			content: &Content{ID: uuid.New(), Heading: "New", SlugField: "Taken"},
+			wantErr: ErrSlugTaken,
+		},
+		{
+			name:     "keeps own slug on update",
+			content:  &Content{ID: existingID, Heading: "Renamed", SlugField: "taken"},
+			update:   true,
+			wantSlug: "taken",
+		},
+		{
+			name:     "allows empty slug",
+			content:  &Content{ID: uuid.New(), Heading: "New"},
+			wantSlug: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.contents[existingID] = Content{ID: existingID, Heading: "Existing", SlugField: "taken"}
+			svc := newTestService(repo)
+
+			var err error
+			if tt.update {
+				err = svc.UpdateContent(context.Background(), tt.content)
+			} else {
+				err = svc.CreateContent(context.Background(), tt.content)
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && tt.content.SlugField != tt.wantSlug {
+				t.Errorf("SlugField = %q, want %q", tt.content.SlugField, tt.wantSlug)
+			}
+		})
+	}
+}
+
 func TestServiceGetContent(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -929,6 +1394,12 @@ func TestServiceCreateSection(t *testing.T) {
 			section: Section{ID: uuid.New()},
 			wantErr: true,
 		},
+		{
+			name:    "returns error when parent does not exist",
+			setup:   func(m *mockServiceRepo) {},
+			section: Section{ID: uuid.New(), Path: "go", ParentID: uuid.New()},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -946,6 +1417,22 @@ func TestServiceCreateSection(t *testing.T) {
 	}
 }
 
+func TestServiceCreateSectionDerivesPath(t *testing.T) {
+	repo := newMockServiceRepo()
+	parent := Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	repo.sections[parent.ID] = parent
+	svc := newTestService(repo)
+
+	section := Section{ID: uuid.New(), Name: "Go", Path: "/go", ParentID: parent.ID}
+	if err := svc.CreateSection(context.Background(), section); err != nil {
+		t.Fatalf("CreateSection() error = %v", err)
+	}
+
+	if got := repo.sections[section.ID].Path; got != "/guides/go" {
+		t.Errorf("CreateSection() Path = %q, want %q", got, "/guides/go")
+	}
+}
+
 func TestServiceGetSection(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -1079,6 +1566,81 @@ func TestServiceUpdateSection(t *testing.T) {
 	}
 }
 
+func TestServiceUpdateSectionMovesSubtree(t *testing.T) {
+	repo := newMockServiceRepo()
+	guides := Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	docs := Section{ID: uuid.New(), Name: "Docs", Path: "/docs"}
+	golang := Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	modules := Section{ID: uuid.New(), Name: "Modules", Path: "/guides/go/modules", ParentID: golang.ID}
+	for _, s := range []Section{guides, docs, golang, modules} {
+		repo.sections[s.ID] = s
+	}
+	post := Content{ID: uuid.New(), Heading: "Workspaces", ShortID: "abc123", SectionID: modules.ID, SectionPath: modules.Path}
+	repo.contents[post.ID] = post
+	svc := newTestService(repo)
+
+	golang.ParentID = docs.ID
+	if err := svc.UpdateSection(context.Background(), golang); err != nil {
+		t.Fatalf("UpdateSection() error = %v", err)
+	}
+
+	aliases, _ := repo.GetContentAliasesByContentID(context.Background(), post.ID)
+	if len(aliases) != 1 || aliases[0].Path != "/guides/go/modules/workspaces-abc123/" {
+		t.Errorf("UpdateSection() aliases = %+v, want the previous content path", aliases)
+	}
+
+	if got := repo.sections[golang.ID].Path; got != "/docs/go" {
+		t.Errorf("UpdateSection() Path = %q, want %q", got, "/docs/go")
+	}
+	if got := repo.sections[modules.ID].Path; got != "/docs/go/modules" {
+		t.Errorf("UpdateSection() descendant Path = %q, want %q", got, "/docs/go/modules")
+	}
+
+	docs.ParentID = modules.ID
+	if err := svc.UpdateSection(context.Background(), docs); !errors.Is(err, ErrSectionCycle) {
+		t.Errorf("UpdateSection() error = %v, want %v", err, ErrSectionCycle)
+	}
+}
+
+func TestServiceUpdateSectionFailedMove(t *testing.T) {
+	repo := newMockServiceRepo()
+	guides := Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	golang := Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	for _, s := range []Section{guides, golang} {
+		repo.sections[s.ID] = s
+	}
+	post := Content{ID: uuid.New(), Heading: "Modules", ShortID: "abc123", SectionID: golang.ID, SectionPath: golang.Path}
+	repo.contents[post.ID] = post
+	repo.updateSectionErr = fmt.Errorf("UNIQUE constraint failed: section.site_id, section.path")
+	svc := newTestService(repo)
+
+	moved := guides
+	moved.Name = "Docs"
+	moved.Path = "/docs"
+	if err := svc.UpdateSection(context.Background(), moved); err == nil {
+		t.Fatal("UpdateSection() error = nil, want the move to fail")
+	}
+
+	if got := repo.sections[golang.ID].Path; got != "/guides/go" {
+		t.Errorf("descendant Path = %q, want it unchanged", got)
+	}
+	if len(repo.contentAliases) != 0 {
+		t.Errorf("recorded %d aliases for a failed move", len(repo.contentAliases))
+	}
+}
+
+func TestServiceDeleteSectionWithChildren(t *testing.T) {
+	repo := newMockServiceRepo()
+	parent := Section{ID: uuid.New(), Path: "/guides"}
+	repo.sections[parent.ID] = parent
+	repo.sections[uuid.New()] = Section{Path: "/guides/go", ParentID: parent.ID}
+	svc := newTestService(repo)
+
+	if err := svc.DeleteSection(context.Background(), parent.ID); !errors.Is(err, ErrSectionHasChildren) {
+		t.Errorf("DeleteSection() error = %v, want %v", err, ErrSectionHasChildren)
+	}
+}
+
 func TestServiceDeleteSection(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -2357,3 +2919,634 @@ func TestServiceDeleteImageVariant(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceUpdateContentRecordsAlias(t *testing.T) {
+	repo := newMockServiceRepo()
+	id := uuid.New()
+	repo.contents[id] = Content{ID: id, Heading: "Old Title", ShortID: "abc123", SectionPath: "blog"}
+	svc := newTestService(repo)
+
+	updated := &Content{ID: id, Heading: "New Title", ShortID: "abc123", SectionPath: "blog"}
+	if err := svc.UpdateContent(context.Background(), updated); err != nil {
+		t.Fatalf("UpdateContent() error = %v", err)
+	}
+
+	aliases, _ := repo.GetContentAliasesByContentID(context.Background(), id)
+	if len(aliases) != 1 {
+		t.Fatalf("got %d aliases, want 1", len(aliases))
+	}
+	if aliases[0].Path != "/blog/old-title-abc123/" {
+		t.Errorf("Path = %q, want %q", aliases[0].Path, "/blog/old-title-abc123/")
+	}
+	if aliases[0].Manual {
+		t.Error("recorded alias should not be manual")
+	}
+
+	// Moving back to the old path drops the alias pointing to it
+	updated.Heading = "Old Title"
+	if err := svc.UpdateContent(context.Background(), updated); err != nil {
+		t.Fatalf("UpdateContent() error = %v", err)
+	}
+
+	aliases, _ = repo.GetContentAliasesByContentID(context.Background(), id)
+	if len(aliases) != 1 || aliases[0].Path != "/blog/new-title-abc123/" {
+		t.Errorf("aliases = %+v, want only /blog/new-title-abc123/", aliases)
+	}
+}
+
+func TestServiceAddContentAlias(t *testing.T) {
+	contentID := uuid.New()
+	otherID := uuid.New()
+
+	tests := []struct {
+		name     string
+		path     string
+		wantErr  error
+		wantPath string
+	}{
+		{name: "adds normalized alias", path: "old/post", wantPath: "/old/post/"},
+		{name: "rejects root path", path: "/", wantErr: ErrInvalidAliasPath},
+		{name: "rejects live content path", path: "/blog/other-def456/", wantErr: ErrAliasTaken},
+		{name: "rejects alias of other content", path: "/taken/", wantErr: ErrAliasTaken},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.contents[contentID] = Content{ID: contentID, Heading: "Post", ShortID: "abc123", SectionPath: "blog"}
+			repo.contents[otherID] = Content{ID: otherID, Heading: "Other", ShortID: "def456", SectionPath: "blog"}
+			repo.contentAliases[uuid.New()] = ContentAlias{ContentID: otherID, Path: "/taken/"}
+			svc := newTestService(repo)
+
+			alias, err := svc.AddContentAlias(context.Background(), contentID, tt.path)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && alias.Path != tt.wantPath {
+				t.Errorf("Path = %q, want %q", alias.Path, tt.wantPath)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteContentAlias(t *testing.T) {
+	repo := newMockServiceRepo()
+	contentID := uuid.New()
+	alias := ContentAlias{ID: uuid.New(), ContentID: contentID, Path: "/old/"}
+	repo.contentAliases[alias.ID] = alias
+	svc := newTestService(repo)
+
+	if err := svc.DeleteContentAlias(context.Background(), uuid.New(), alias.ID); err == nil {
+		t.Error("expected error deleting alias of another content")
+	}
+	if err := svc.DeleteContentAlias(context.Background(), contentID, alias.ID); err != nil {
+		t.Fatalf("DeleteContentAlias() error = %v", err)
+	}
+	if _, ok := repo.contentAliases[alias.ID]; ok {
+		t.Error("alias should be deleted")
+	}
+}
+
+func TestServiceContentLocale(t *testing.T) {
+	existingID := uuid.New()
+
+	tests := []struct {
+		name       string
+		content    *Content
+		wantErr    error
+		wantLocale string
+		wantGroup  string
+	}{
+		{
+			name:       "normalizes locale and group",
+			content:    &Content{ID: uuid.New(), Heading: "Hola", Locale: "ES", TranslationGroup: "About Page"},
+			wantLocale: "es",
+			wantGroup:  "about-page",
+		},
+		{
+			name:    "rejects invalid locale",
+			content: &Content{ID: uuid.New(), Heading: "Hola", Locale: "spanish"},
+			wantErr: ErrInvalidLocale,
+		},
+		{
+			name:    "rejects second translation in same locale",
+			content: &Content{ID: uuid.New(), Heading: "Hello again", Locale: "en", TranslationGroup: "about-page"},
+			wantErr: ErrTranslationTaken,
+		},
+		{
+			name:    "empty locale is the site default",
+			content: &Content{ID: uuid.New(), Heading: "Hello again", TranslationGroup: "about-page"},
+			wantErr: ErrTranslationTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.contents[existingID] = Content{ID: existingID, Heading: "About", TranslationGroup: "about-page"}
+			svc := newTestService(repo)
+
+			err := svc.CreateContent(context.Background(), tt.content)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if tt.content.Locale != tt.wantLocale {
+				t.Errorf("Locale = %q, want %q", tt.content.Locale, tt.wantLocale)
+			}
+			if tt.content.TranslationGroup != tt.wantGroup {
+				t.Errorf("TranslationGroup = %q, want %q", tt.content.TranslationGroup, tt.wantGroup)
+			}
+		})
+	}
+}
+
+func TestServiceGetContentBacklinks(t *testing.T) {
+	repo := newMockServiceRepo()
+	targetID := uuid.New()
+	linkingID := uuid.New()
+	repo.contents[targetID] = Content{ID: targetID, Heading: "Target", ShortID: "aaa111", Body: "Self [[aaa111]]"}
+	repo.contents[linkingID] = Content{ID: linkingID, Heading: "Linking", ShortID: "bbb222", Body: "See [it](clio:aaa111)"}
+	repo.contents[uuid.New()] = Content{Heading: "Unrelated", ShortID: "ccc333", Body: "Nothing `[[aaa111]]`"}
+	svc := newTestService(repo)
+
+	backlinks, err := svc.GetContentBacklinks(context.Background(), targetID)
+	if err != nil {
+		t.Fatalf("GetContentBacklinks() error = %v", err)
+	}
+
+	if len(backlinks) != 1 || backlinks[0].ID != linkingID {
+		t.Errorf("GetContentBacklinks() = %+v, want only Linking", backlinks)
+	}
+
+	if _, err := svc.GetContentBacklinks(context.Background(), uuid.New()); err == nil {
+		t.Error("GetContentBacklinks() for missing content should fail")
+	}
+}
+
+func TestServiceContentSeriesAssignment(t *testing.T) {
+	seriesID := uuid.New()
+	otherID := uuid.New()
+	existingID := uuid.New()
+
+	tests := []struct {
+		name      string
+		content   *Content
+		update    bool
+		wantSlug  string
+		wantOrder int
+	}{
+		{
+			name:      "new part is appended last",
+			content:   &Content{ID: uuid.New(), Heading: "Part 3", SeriesID: seriesID},
+			wantSlug:  "learning-go",
+			wantOrder: 3,
+		},
+		{
+			name:      "part keeps its position on update",
+			content:   &Content{ID: existingID, Heading: "Part 1 renamed", SeriesID: seriesID},
+			update:    true,
+			wantSlug:  "learning-go",
+			wantOrder: 1,
+		},
+		{
+			name:      "part moved to another series is appended last",
+			content:   &Content{ID: existingID, Heading: "Part 1", SeriesID: otherID},
+			update:    true,
+			wantSlug:  "other",
+			wantOrder: 1,
+		},
+		{
+			name:      "content leaving a series loses its position",
+			content:   &Content{ID: existingID, Heading: "Part 1", Series: "learning-go", SeriesOrder: 1},
+			update:    true,
+			wantSlug:  "",
+			wantOrder: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.series[seriesID] = Series{ID: seriesID, Name: "Learning Go"}
+			repo.series[otherID] = Series{ID: otherID, Name: "Other"}
+			repo.contents[existingID] = Content{ID: existingID, Heading: "Part 1", SlugField: "part-1", SeriesID: seriesID, Series: "learning-go", SeriesOrder: 1}
+			secondID := uuid.New()
+			repo.contents[secondID] = Content{ID: secondID, Heading: "Part 2", SlugField: "part-2", SeriesID: seriesID, Series: "learning-go", SeriesOrder: 2}
+			svc := newTestService(repo)
+
+			var err error
+			if tt.update {
+				err = svc.UpdateContent(context.Background(), tt.content)
+			} else {
+				err = svc.CreateContent(context.Background(), tt.content)
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.content.Series != tt.wantSlug {
+				t.Errorf("Series = %q, want %q", tt.content.Series, tt.wantSlug)
+			}
+			if tt.content.SeriesOrder != tt.wantOrder {
+				t.Errorf("SeriesOrder = %d, want %d", tt.content.SeriesOrder, tt.wantOrder)
+			}
+		})
+	}
+}
+
+func TestServiceCreateSeries(t *testing.T) {
+	tests := []struct {
+		name       string
+		series     Series
+		wantErr    error
+		wantSlug   string
+		wantStatus string
+	}{
+		{
+			name:       "derives slug and default status",
+			series:     Series{ID: uuid.New(), Name: "Learning Go"},
+			wantSlug:   "learning-go",
+			wantStatus: SeriesStatusOngoing,
+		},
+		{
+			name:       "normalizes given slug and status",
+			series:     Series{ID: uuid.New(), Name: "Go", SlugField: "Go Basics", Status: " Complete "},
+			wantSlug:   "go-basics",
+			wantStatus: SeriesStatusComplete,
+		},
+		{
+			name:    "rejects unknown status",
+			series:  Series{ID: uuid.New(), Name: "Go", Status: "paused"},
+			wantErr: ErrInvalidSeriesStatus,
+		},
+		{
+			name:    "rejects slug used by another series",
+			series:  Series{ID: uuid.New(), Name: "Taken"},
+			wantErr: ErrSlugTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.series[existingID] = Series{ID: existingID, Name: "Taken", SlugField: "taken"}
+			svc := newTestService(repo)
+
+			err := svc.CreateSeries(context.Background(), tt.series)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			got := repo.series[tt.series.ID]
+			if got.SlugField != tt.wantSlug {
+				t.Errorf("SlugField = %q, want %q", got.SlugField, tt.wantSlug)
+			}
+			if got.Status != tt.wantStatus {
+				t.Errorf("Status = %q, want %q", got.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestServiceReorderSeries(t *testing.T) {
+	seriesID := uuid.New()
+	first, second, outsider := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		order     []uuid.UUID
+		wantErr   error
+		wantOrder []uuid.UUID
+	}{
+		{
+			name:      "reorders parts",
+			order:     []uuid.UUID{second, first},
+			wantOrder: []uuid.UUID{second, first},
+		},
+		{
+			name:    "rejects missing parts",
+			order:   []uuid.UUID{second},
+			wantErr: ErrInvalidSeriesOrder,
+		},
+		{
+			name:    "rejects content of another series",
+			order:   []uuid.UUID{second, outsider},
+			wantErr: ErrInvalidSeriesOrder,
+		},
+		{
+			name:    "rejects duplicated parts",
+			order:   []uuid.UUID{first, first},
+			wantErr: ErrInvalidSeriesOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.series[seriesID] = Series{ID: seriesID, Name: "Learning Go"}
+			repo.contents[first] = Content{ID: first, Heading: "First", SeriesID: seriesID, SeriesOrder: 1}
+			repo.contents[second] = Content{ID: second, Heading: "Second", SeriesID: seriesID, SeriesOrder: 2}
+			repo.contents[outsider] = Content{ID: outsider, Heading: "Outsider"}
+			svc := newTestService(repo)
+
+			err := svc.ReorderSeries(context.Background(), seriesID, tt.order)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			parts, err := svc.GetSeriesContents(context.Background(), seriesID)
+			if err != nil {
+				t.Fatalf("GetSeriesContents() error = %v", err)
+			}
+			if len(parts) != len(tt.wantOrder) {
+				t.Fatalf("got %d parts, want %d", len(parts), len(tt.wantOrder))
+			}
+			for i, id := range tt.wantOrder {
+				if parts[i].ID != id {
+					t.Errorf("part %d = %s, want %s", i, parts[i].Heading, id)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceCreateMenu(t *testing.T) {
+	tests := []struct {
+		name         string
+		menu         Menu
+		wantErr      error
+		wantLocation string
+	}{
+		{
+			name:         "normalizes location",
+			menu:         Menu{ID: uuid.New(), Name: "Footer", Location: " Footer "},
+			wantLocation: MenuLocationFooter,
+		},
+		{
+			name:    "rejects unknown location",
+			menu:    Menu{ID: uuid.New(), Name: "Sidebar", Location: "sidebar"},
+			wantErr: ErrInvalidMenuLocation,
+		},
+		{
+			name:    "rejects taken location",
+			menu:    Menu{ID: uuid.New(), Name: "Other", Location: MenuLocationMain},
+			wantErr: ErrMenuLocationTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.menus[existingID] = Menu{ID: existingID, Name: "Main", Location: MenuLocationMain}
+			svc := newTestService(repo)
+
+			err := svc.CreateMenu(context.Background(), tt.menu)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && repo.menus[tt.menu.ID].Location != tt.wantLocation {
+				t.Errorf("location = %q, want %q", repo.menus[tt.menu.ID].Location, tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestServiceCreateMenuItem(t *testing.T) {
+	menuID, otherMenuID := uuid.New(), uuid.New()
+	top, child, otherTop := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name         string
+		item         MenuItem
+		wantErr      error
+		wantPosition int
+	}{
+		{
+			name:         "appends top level item",
+			item:         MenuItem{ID: uuid.New(), MenuID: menuID, TargetType: MenuTargetURL, URL: "https://example.com"},
+			wantPosition: 2,
+		},
+		{
+			name:         "appends child item",
+			item:         MenuItem{ID: uuid.New(), MenuID: menuID, ParentID: top, TargetType: MenuTargetSection, TargetID: uuid.New()},
+			wantPosition: 2,
+		},
+		{
+			name:         "keeps given position",
+			item:         MenuItem{ID: uuid.New(), MenuID: menuID, TargetType: MenuTargetTag, TargetID: uuid.New(), Position: 7},
+			wantPosition: 7,
+		},
+		{
+			name:    "rejects unknown target",
+			item:    MenuItem{ID: uuid.New(), MenuID: menuID, TargetType: "page"},
+			wantErr: ErrInvalidMenuItem,
+		},
+		{
+			name:    "rejects URL item without URL",
+			item:    MenuItem{ID: uuid.New(), MenuID: menuID, TargetType: MenuTargetURL},
+			wantErr: ErrInvalidMenuItem,
+		},
+		{
+			name:    "rejects content item without content",
+			item:    MenuItem{ID: uuid.New(), MenuID: menuID, TargetType: MenuTargetContent},
+			wantErr: ErrInvalidMenuItem,
+		},
+		{
+			name:    "rejects third level",
+			item:    MenuItem{ID: uuid.New(), MenuID: menuID, ParentID: child, TargetType: MenuTargetURL, URL: "/x/"},
+			wantErr: ErrInvalidMenuItem,
+		},
+		{
+			name:    "rejects parent of another menu",
+			item:    MenuItem{ID: uuid.New(), MenuID: menuID, ParentID: otherTop, TargetType: MenuTargetURL, URL: "/x/"},
+			wantErr: ErrInvalidMenuItem,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.menus[menuID] = Menu{ID: menuID, Location: MenuLocationMain}
+			repo.menus[otherMenuID] = Menu{ID: otherMenuID, Location: MenuLocationFooter}
+			repo.menuItems[top] = MenuItem{ID: top, MenuID: menuID, TargetType: MenuTargetURL, URL: "/a/", Position: 1}
+			repo.menuItems[child] = MenuItem{ID: child, MenuID: menuID, ParentID: top, TargetType: MenuTargetURL, URL: "/b/", Position: 1}
+			repo.menuItems[otherTop] = MenuItem{ID: otherTop, MenuID: otherMenuID, TargetType: MenuTargetURL, URL: "/c/", Position: 1}
+			svc := newTestService(repo)
+
+			err := svc.CreateMenuItem(context.Background(), tt.item)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && repo.menuItems[tt.item.ID].Position != tt.wantPosition {
+				t.Errorf("position = %d, want %d", repo.menuItems[tt.item.ID].Position, tt.wantPosition)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateMenuItemRejectsNestingParent(t *testing.T) {
+	menuID, first, second, child := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	repo := newMockServiceRepo()
+	repo.menus[menuID] = Menu{ID: menuID, Location: MenuLocationMain}
+	repo.menuItems[first] = MenuItem{ID: first, MenuID: menuID, TargetType: MenuTargetURL, URL: "/a/", Position: 1}
+	repo.menuItems[second] = MenuItem{ID: second, MenuID: menuID, TargetType: MenuTargetURL, URL: "/b/", Position: 2}
+	repo.menuItems[child] = MenuItem{ID: child, MenuID: menuID, ParentID: first, TargetType: MenuTargetURL, URL: "/c/", Position: 1}
+	svc := newTestService(repo)
+
+	item := repo.menuItems[first]
+	item.ParentID = second
+	if err := svc.UpdateMenuItem(context.Background(), item); !errors.Is(err, ErrInvalidMenuItem) {
+		t.Errorf("nesting item with children error = %v, want %v", err, ErrInvalidMenuItem)
+	}
+
+	item = repo.menuItems[child]
+	item.ParentID = second
+	if err := svc.UpdateMenuItem(context.Background(), item); err != nil {
+		t.Fatalf("UpdateMenuItem() error = %v", err)
+	}
+	if got := repo.menuItems[child]; got.ParentID != second || got.Position != 1 {
+		t.Errorf("moved child = %+v, want first child of second", got)
+	}
+}
+
+func TestServiceMoveMenuItem(t *testing.T) {
+	menuID := uuid.New()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	tests := []struct {
+		name      string
+		id        uuid.UUID
+		direction string
+		wantErr   error
+		wantOrder []uuid.UUID
+	}{
+		{name: "moves up", id: second, direction: "up", wantOrder: []uuid.UUID{second, first, third}},
+		{name: "moves down", id: second, direction: "down", wantOrder: []uuid.UUID{first, third, second}},
+		{name: "keeps first on up", id: first, direction: "up", wantOrder: []uuid.UUID{first, second, third}},
+		{name: "keeps last on down", id: third, direction: "down", wantOrder: []uuid.UUID{first, second, third}},
+		{name: "rejects unknown direction", id: first, direction: "left", wantErr: ErrInvalidMenuItem},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			repo.menus[menuID] = Menu{ID: menuID, Location: MenuLocationMain}
+			// Gaps in positions are closed when moving.
+			for i, id := range []uuid.UUID{first, second, third} {
+				repo.menuItems[id] = MenuItem{ID: id, MenuID: menuID, TargetType: MenuTargetURL, URL: "/", Position: (i + 1) * 10}
+			}
+			svc := newTestService(repo)
+
+			err := svc.MoveMenuItem(context.Background(), tt.id, tt.direction)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+
+			menu, err := svc.GetMenu(context.Background(), menuID)
+			if err != nil {
+				t.Fatalf("GetMenu() error = %v", err)
+			}
+			for i, id := range tt.wantOrder {
+				if menu.Items[i].ID != id {
+					t.Errorf("item %d = %s, want %s", i, menu.Items[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceCreateAuthor(t *testing.T) {
+	userID := uuid.New()
+
+	tests := []struct {
+		name     string
+		author   Author
+		wantErr  error
+		wantSlug string
+	}{
+		{
+			name:     "derives slug from name",
+			author:   Author{ID: uuid.New(), Name: "Jane Doe"},
+			wantSlug: "jane-doe",
+		},
+		{
+			name:     "normalizes given slug",
+			author:   Author{ID: uuid.New(), Name: "Jane", SlugField: "Jane D"},
+			wantSlug: "jane-d",
+		},
+		{
+			name:    "rejects slug used by another author",
+			author:  Author{ID: uuid.New(), Name: "Taken"},
+			wantErr: ErrSlugTaken,
+		},
+		{
+			name:    "rejects user with a profile",
+			author:  Author{ID: uuid.New(), Name: "Other", UserID: userID},
+			wantErr: ErrAuthorUserTaken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMockServiceRepo()
+			existingID := uuid.New()
+			repo.authors[existingID] = Author{ID: existingID, Name: "Taken", SlugField: "taken", UserID: userID}
+			svc := newTestService(repo)
+
+			err := svc.CreateAuthor(context.Background(), tt.author)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := repo.authors[tt.author.ID].SlugField; got != tt.wantSlug {
+				t.Errorf("SlugField = %q, want %q", got, tt.wantSlug)
+			}
+		})
+	}
+}
+
+func TestServiceSetContentAuthors(t *testing.T) {
+	repo := newMockServiceRepo()
+	jane, bob := uuid.New(), uuid.New()
+	repo.authors[jane] = Author{ID: jane, Name: "Jane"}
+	repo.authors[bob] = Author{ID: bob, Name: "Bob"}
+	svc := newTestService(repo)
+	contentID := uuid.New()
+
+	err := svc.SetContentAuthors(context.Background(), contentID, []uuid.UUID{bob, jane, bob})
+	if err != nil {
+		t.Fatalf("SetContentAuthors() error = %v", err)
+	}
+	got := repo.contentAuthors[contentID]
+	if len(got) != 2 || got[0] != bob || got[1] != jane {
+		t.Errorf("authors = %v, want [bob jane]", got)
+	}
+
+	err = svc.SetContentAuthors(context.Background(), contentID, []uuid.UUID{uuid.New()})
+	if !errors.Is(err, ErrUnknownAuthor) {
+		t.Errorf("error = %v, want %v", err, ErrUnknownAuthor)
+	}
+}
@@ -3,9 +3,15 @@ package ssg
 import (
 	"context"
 	"embed"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/hermesgen/hm"
 )
 
@@ -78,3 +84,132 @@ func TestServiceGenerateHTMLFromContent(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceSiteValuesWithoutParamManager(t *testing.T) {
+	params := hm.XParams{Cfg: hm.NewConfig()}
+	svc := &BaseService{
+		Service: hm.NewService("test-service", params),
+		repo:    newMockServiceRepo(),
+	}
+	ctx := context.Background()
+
+	site := svc.siteInfo(ctx, "test-site")
+	if site.Name != "test-site" || site.BaseURL != "" {
+		t.Errorf("siteInfo() = %+v, want name test-site and no base URL", site)
+	}
+
+	data, err := svc.siteData(ctx, site, "structured", nil, nil)
+	if err != nil {
+		t.Fatalf("siteData() error = %v", err)
+	}
+	if data.Locale != DefaultLocale {
+		t.Errorf("siteData() locale = %q, want %q", data.Locale, DefaultLocale)
+	}
+}
+
+// generateTestSite generates the HTML of the site in repo with the embedded
+// theme and returns the directory it was written to.
+func generateTestSite(t *testing.T, repo *mockServiceRepo) string {
+	t.Helper()
+	htmlPath, err := tryGenerateTestSite(t, repo)
+	if err != nil {
+		t.Fatalf("GenerateHTMLFromContent() error = %v", err)
+	}
+	return htmlPath
+}
+
+// tryGenerateTestSite is generateTestSite returning the generation error.
+func tryGenerateTestSite(t *testing.T, repo *mockServiceRepo) (string, error) {
+	t.Helper()
+	cfg := hm.NewConfig()
+	sitesPath := t.TempDir()
+	cfg.Set(SSGKey.SitesBasePath, sitesPath)
+	params := hm.XParams{Cfg: cfg}
+
+	svc := NewService(os.DirFS("../../.."), repo, nil, &mockPublisher{}, NewParamManager(repo, params), nil, params)
+	ctx := NewContextWithSite("test-site", uuid.New())
+	err := svc.GenerateHTMLFromContent(ctx)
+
+	return GetSiteHTMLPath(sitesPath, "test-site"), err
+}
+
+// addTestParam sets a site param in repo.
+func addTestParam(repo *mockServiceRepo, refKey, value string) {
+	p := Param{ID: uuid.New(), Name: refKey, RefKey: refKey, Value: value}
+	repo.params[p.ID] = p
+	repo.paramsByName[p.Name] = p
+	repo.paramsByRef[p.RefKey] = p
+}
+
+func TestServiceGenerateHTMLWritesFeeds(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	repo := newMockServiceRepo()
+	post := Content{ID: uuid.New(), ShortID: "abc123", Heading: "First post", Kind: "blog", SectionPath: "/", Body: "Hello.", PublishedAt: &published}
+	repo.contents[post.ID] = post
+
+	htmlPath := generateTestSite(t, repo)
+	if _, err := os.Stat(filepath.Join(htmlPath, FeedFile)); !os.IsNotExist(err) {
+		t.Errorf("feed written without site base URL, stat error = %v", err)
+	}
+
+	addTestParam(repo, SSGKey.SiteBaseURL, "https://example.com")
+	htmlPath = generateTestSite(t, repo)
+
+	feed, err := os.ReadFile(filepath.Join(htmlPath, FeedFile))
+	if err != nil {
+		t.Fatalf("feed not written: %v", err)
+	}
+	if !strings.Contains(string(feed), "<title>First post</title>") {
+		t.Errorf("feed does not list the post:\n%s", feed)
+	}
+
+	home, err := os.ReadFile(filepath.Join(htmlPath, "index.html"))
+	if err != nil {
+		t.Fatalf("home page not written: %v", err)
+	}
+	if !strings.Contains(string(home), `type="application/atom+xml"`) || !strings.Contains(string(home), `href="https://example.com/feed.xml"`) {
+		t.Error("home page does not link the feed")
+	}
+}
+
+func TestServiceGenerateHTMLReportsUnresolvedRefs(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	repo := newMockServiceRepo()
+	post := Content{ID: uuid.New(), ShortID: "abc123", Heading: "First post", Kind: "blog", SectionPath: "/", Body: "See [[zzz999]].", PublishedAt: &published}
+	repo.contents[post.ID] = post
+
+	htmlPath, err := tryGenerateTestSite(t, repo)
+	if !errors.Is(err, ErrRefNotFound) {
+		t.Fatalf("GenerateHTMLFromContent() error = %v, want %v", err, ErrRefNotFound)
+	}
+	if !strings.Contains(err.Error(), "unresolved content references") {
+		t.Errorf("error = %q, want unresolved references reported", err)
+	}
+
+	page := GetContentFilePath(htmlPath, post, "structured")
+	if _, err := os.Stat(page); err != nil {
+		t.Errorf("page with unresolved reference not written: %v", err)
+	}
+}
+
+func TestServiceGenerateHTMLManualIndexPage(t *testing.T) {
+	published := time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	repo := newMockServiceRepo()
+	home := Content{ID: uuid.New(), ShortID: "home01", Heading: "Welcome", SlugField: "index", Kind: "page", SectionPath: "/", Body: "Manual home page.", PublishedAt: &published}
+	post := Content{ID: uuid.New(), ShortID: "abc123", Heading: "First post", Kind: "blog", SectionPath: "/", Body: "Hello.", PublishedAt: &published}
+	repo.contents[home.ID] = home
+	repo.contents[post.ID] = post
+
+	htmlPath := generateTestSite(t, repo)
+
+	page, err := os.ReadFile(filepath.Join(htmlPath, "index.html"))
+	if err != nil {
+		t.Fatalf("home page not written: %v", err)
+	}
+	if !strings.Contains(string(page), "Manual home page.") {
+		t.Error("home page is not the manual index page")
+	}
+	if _, err := os.Stat(filepath.Join(htmlPath, "index", "index.html")); !os.IsNotExist(err) {
+		t.Errorf("manual index page written under its slug, stat error = %v", err)
+	}
+}
@@ -0,0 +1,432 @@
+package ssg
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yuin/goldmark"
+	gmast "github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+const (
+	// ShortcodeDir holds the shortcode templates of a theme, one NAME.tmpl
+	// file per shortcode.
+	ShortcodeDir = "shortcode"
+	// ShortcodeLayoutPrefix marks the site layouts that define shortcodes.
+	// A layout named shortcode/NAME defines or overrides shortcode NAME.
+	ShortcodeLayoutPrefix = "shortcode/"
+)
+
+// ErrUnknownShortcode is returned when a content uses a shortcode that has
+// no template.
+var ErrUnknownShortcode = errors.New("unknown shortcode")
+
+// innerMarker stands for the inner content of a paired shortcode while its
+// template runs. Output is split at the marker around the rendered children.
+const innerMarker = "<!--clio:shortcode-inner-->"
+
+var (
+	shortcodeRe      = regexp.MustCompile(`^\s*\{\{<\s*(/?[A-Za-z][\w-]*)((?:\s+[^>]*?)?)\s*(/?)>\}\}\s*$`)
+	shortcodeParamRe = regexp.MustCompile(`([\w-]+)="([^"]*)"|([\w-]+)=(\S+)|"([^"]*)"|(\S+)`)
+)
+
+// ShortcodeError reports a shortcode that cannot be rendered and where it is.
+type ShortcodeError struct {
+	Name string
+	Line int
+	Err  error
+}
+
+func (e *ShortcodeError) Error() string {
+	return fmt.Sprintf("line %d: shortcode %q: %v", e.Line, e.Name, e.Err)
+}
+
+func (e *ShortcodeError) Unwrap() error {
+	return e.Err
+}
+
+// Shortcodes is the set of shortcode templates available to a site.
+type Shortcodes struct {
+	templates map[string]*template.Template
+	funcs     template.FuncMap
+}
+
+// NewShortcodes loads the shortcodes of the theme in fsys and then the
+// shortcodes defined by site layouts, which take precedence. Templates can
+// use funcs, usually the FuncMap of the site.
+func NewShortcodes(fsys fs.FS, layouts []Layout, funcs template.FuncMap) (*Shortcodes, error) {
+	sc := &Shortcodes{templates: make(map[string]*template.Template), funcs: funcs}
+
+	files, err := fs.Glob(fsys, ShortcodeDir+"/*.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("cannot list shortcodes: %w", err)
+	}
+	for _, file := range files {
+		code, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read shortcode %s: %w", file, err)
+		}
+		name := strings.TrimSuffix(path.Base(file), ".tmpl")
+		if err := sc.Add(name, string(code)); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, l := range layouts {
+		name, ok := strings.CutPrefix(l.Name, ShortcodeLayoutPrefix)
+		if !ok || name == "" {
+			continue
+		}
+		if err := sc.Add(name, l.Code); err != nil {
+			return nil, err
+		}
+	}
+
+	return sc, nil
+}
+
+// Add defines shortcode name, replacing any previous definition.
+func (sc *Shortcodes) Add(name, code string) error {
+	tmpl, err := template.New(name).Funcs(sc.funcs).Parse(code)
+	if err != nil {
+		return fmt.Errorf("cannot parse shortcode %s: %w", name, err)
+	}
+	sc.templates[name] = tmpl
+	return nil
+}
+
+// ShortcodeContext is the page a shortcode is rendered in.
+type ShortcodeContext struct {
+	Content Content
+	Images  []ImageWithMeta
+	// Contents are the contents the page can refer to, with their
+	// permalinks resolved.
+	Contents []Content
+	Mode     string
+	Site     SiteData
+}
+
+// ShortcodeImage is an image as seen by shortcode templates.
+type ShortcodeImage struct {
+	URL        string
+	Alt        string
+	Title      string
+	Caption    string
+	Width      int
+	Height     int
+	Decorative bool
+}
+
+// SeriesEntry is a content of a series as seen by shortcode templates.
+type SeriesEntry struct {
+	Heading string
+	URL     string
+	Current bool
+}
+
+// ShortcodeData is the data shortcode templates are executed with.
+type ShortcodeData struct {
+	Name    string
+	Params  map[string]string
+	Args    []string
+	Inner   template.HTML
+	Content Content
+	Site    SiteData
+	ctx     *ShortcodeContext
+}
+
+// Get returns the named parameter key, empty if not set.
+func (d ShortcodeData) Get(key string) string {
+	return d.Params[key]
+}
+
+// Arg returns the positional parameter i, empty if not set.
+func (d ShortcodeData) Arg(i int) string {
+	if i < 0 || i >= len(d.Args) {
+		return ""
+	}
+	return d.Args[i]
+}
+
+// Require returns the named parameter key or, when not set, the positional
+// parameter pos. It fails when neither is set.
+func (d ShortcodeData) Require(key string, pos int) (string, error) {
+	if v := d.Get(key); v != "" {
+		return v, nil
+	}
+	if v := d.Arg(pos); v != "" {
+		return v, nil
+	}
+	return "", fmt.Errorf("missing parameter %q", key)
+}
+
+// Image returns the image of the content whose ID, file name or file path
+// is id.
+func (d ShortcodeData) Image(id string) (ShortcodeImage, error) {
+	if id == "" {
+		return ShortcodeImage{}, errors.New("missing image id")
+	}
+	for _, img := range d.ctx.Images {
+		if img.ID.String() == id || img.FileName == id || img.FilePath == id {
+			return newShortcodeImage(img), nil
+		}
+	}
+	return ShortcodeImage{}, fmt.Errorf("image %q not found in content", id)
+}
+
+// Images returns the images of the content given in the content parameter.
+// Only the page content, content="self" and the default, is supported.
+func (d ShortcodeData) Images() ([]ShortcodeImage, error) {
+	if c := d.Get("content"); c != "" && c != "self" {
+		return nil, fmt.Errorf("unsupported content %q", c)
+	}
+	images := make([]ShortcodeImage, 0, len(d.ctx.Images))
+	for _, img := range d.ctx.Images {
+		if img.IsHeader {
+			continue
+		}
+		images = append(images, newShortcodeImage(img))
+	}
+	return images, nil
+}
+
+// Series returns the published contents of the series of the page, in
+// series order.
+func (d ShortcodeData) Series() []SeriesEntry {
+	current := d.ctx.Content
+	if current.Series == "" {
+		return nil
+	}
+
+	var series []Content
+	for _, c := range d.ctx.Contents {
+		if c.Series == current.Series && (!c.Draft || c.ID == current.ID) {
+			series = append(series, c)
+		}
+	}
+	sort.SliceStable(series, func(i, j int) bool {
+		return series[i].SeriesOrder < series[j].SeriesOrder
+	})
+
+	entries := make([]SeriesEntry, len(series))
+	for i, c := range series {
+		entries[i] = SeriesEntry{
+			Heading: c.Heading,
+			URL:     GetContentPath(c, d.ctx.Mode) + "/",
+			Current: c.ID == current.ID,
+		}
+	}
+	return entries
+}
+
+func newShortcodeImage(img ImageWithMeta) ShortcodeImage {
+	return ShortcodeImage{
+		URL:        "/static/images/" + strings.TrimPrefix(img.FilePath, "/"),
+		Alt:        img.AltText,
+		Title:      img.Title,
+		Caption:    img.Caption,
+		Width:      img.Width,
+		Height:     img.Height,
+		Decorative: img.Decorative,
+	}
+}
+
+// KindShortcode is the goldmark node kind of shortcodes.
+var KindShortcode = gmast.NewNodeKind("Shortcode")
+
+// ShortcodeNode is a shortcode in a Markdown body. Paired shortcodes hold
+// their inner content as children.
+type ShortcodeNode struct {
+	gmast.BaseBlock
+	Name   string
+	Params map[string]string
+	Args   []string
+	Paired bool
+	Line   int
+
+	depth  int
+	suffix string
+}
+
+// Kind implements gmast.Node.
+func (n *ShortcodeNode) Kind() gmast.NodeKind {
+	return KindShortcode
+}
+
+// Dump implements gmast.Node.
+func (n *ShortcodeNode) Dump(source []byte, level int) {
+	gmast.DumpHelper(n, source, level, map[string]string{
+		"Name":   n.Name,
+		"Paired": strconv.FormatBool(n.Paired),
+	}, nil)
+}
+
+// parseShortcode parses a line holding a single shortcode tag.
+func parseShortcode(line []byte) (name string, params map[string]string, args []string, selfClosing, ok bool) {
+	m := shortcodeRe.FindSubmatch(line)
+	if m == nil {
+		return "", nil, nil, false, false
+	}
+
+	params = make(map[string]string)
+	for _, p := range shortcodeParamRe.FindAllStringSubmatch(string(m[2]), -1) {
+		switch {
+		case p[1] != "":
+			params[p[1]] = p[2]
+		case p[3] != "":
+			params[p[3]] = p[4]
+		case p[5] != "" || strings.HasPrefix(p[0], `"`):
+			args = append(args, p[5])
+		default:
+			args = append(args, p[6])
+		}
+	}
+
+	return string(m[1]), params, args, len(m[3]) > 0, true
+}
+
+type shortcodeParser struct{}
+
+func (p *shortcodeParser) Trigger() []byte {
+	return []byte{'{'}
+}
+
+func (p *shortcodeParser) Open(parent gmast.Node, reader text.Reader, pc parser.Context) (gmast.Node, parser.State) {
+	line, segment := reader.PeekLine()
+	name, params, args, selfClosing, ok := parseShortcode(line)
+	if !ok {
+		return nil, parser.NoChildren
+	}
+	lineNum, _ := reader.Position()
+
+	node := &ShortcodeNode{Name: name, Params: params, Args: args, Line: lineNum + 1}
+	reader.AdvanceToEOL()
+
+	if !selfClosing && !strings.HasPrefix(name, "/") && hasClosingShortcode(reader.Source()[segment.Stop:], name) {
+		node.Paired = true
+		return node, parser.HasChildren
+	}
+	return node, parser.NoChildren
+}
+
+func (p *shortcodeParser) Continue(node gmast.Node, reader text.Reader, pc parser.Context) parser.State {
+	n := node.(*ShortcodeNode)
+	if !n.Paired {
+		return parser.Close
+	}
+
+	line, _ := reader.PeekLine()
+	if name, _, _, selfClosing, ok := parseShortcode(line); ok {
+		switch {
+		case name == "/"+n.Name && n.depth == 0:
+			reader.AdvanceToEOL()
+			return parser.Close
+		case name == "/"+n.Name:
+			n.depth--
+		case name == n.Name && !selfClosing:
+			n.depth++
+		}
+	}
+	return parser.Continue | parser.HasChildren
+}
+
+func (p *shortcodeParser) Close(node gmast.Node, reader text.Reader, pc parser.Context) {}
+
+func (p *shortcodeParser) CanInterruptParagraph() bool {
+	return true
+}
+
+func (p *shortcodeParser) CanAcceptIndentedLine() bool {
+	return false
+}
+
+func hasClosingShortcode(rest []byte, name string) bool {
+	closing := regexp.MustCompile(`(?m)^\s*\{\{<\s*/` + regexp.QuoteMeta(name) + `\s*>\}\}\s*$`)
+	return closing.Match(rest)
+}
+
+// shortcodePage holds the page being converted, shared by a processor and
+// its shortcode renderer so one parser serves every page of a build.
+type shortcodePage struct {
+	ctx *ShortcodeContext
+}
+
+type shortcodeRenderer struct {
+	shortcodes *Shortcodes
+	page       *shortcodePage
+}
+
+func (r *shortcodeRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindShortcode, r.renderShortcode)
+}
+
+func (r *shortcodeRenderer) renderShortcode(w util.BufWriter, source []byte, node gmast.Node, entering bool) (gmast.WalkStatus, error) {
+	n := node.(*ShortcodeNode)
+	if !entering {
+		_, _ = w.WriteString(n.suffix)
+		return gmast.WalkContinue, nil
+	}
+
+	if strings.HasPrefix(n.Name, "/") {
+		return gmast.WalkStop, &ShortcodeError{Name: n.Name, Line: n.Line, Err: errors.New("closing tag without opening tag")}
+	}
+
+	tmpl, ok := r.shortcodes.templates[n.Name]
+	if !ok {
+		return gmast.WalkStop, &ShortcodeError{Name: n.Name, Line: n.Line, Err: ErrUnknownShortcode}
+	}
+
+	data := ShortcodeData{
+		Name:    n.Name,
+		Params:  n.Params,
+		Args:    n.Args,
+		Content: r.page.ctx.Content,
+		Site:    r.page.ctx.Site,
+		ctx:     r.page.ctx,
+	}
+	if n.Paired {
+		data.Inner = innerMarker
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return gmast.WalkStop, &ShortcodeError{Name: n.Name, Line: n.Line, Err: err}
+	}
+
+	out := buf.String()
+	before, after, found := strings.Cut(out, innerMarker)
+	if !found {
+		_, _ = w.WriteString(out)
+		return gmast.WalkSkipChildren, nil
+	}
+	_, _ = w.WriteString(before)
+	n.suffix = after
+	return gmast.WalkContinue, nil
+}
+
+type shortcodeExtension struct {
+	shortcodes *Shortcodes
+	page       *shortcodePage
+}
+
+// Extend implements goldmark.Extender.
+func (e *shortcodeExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(parser.WithBlockParsers(
+		util.Prioritized(&shortcodeParser{}, 100),
+	))
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&shortcodeRenderer{shortcodes: e.shortcodes, page: e.page}, 100),
+	))
+}
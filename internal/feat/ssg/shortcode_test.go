@@ -0,0 +1,326 @@
+package ssg
+
+import (
+	"errors"
+	"html/template"
+	"os"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/google/uuid"
+)
+
+func TestParseShortcode(t *testing.T) {
+	tests := []struct {
+		name            string
+		line            string
+		wantName        string
+		wantParams      map[string]string
+		wantArgs        []string
+		wantSelfClosing bool
+		wantOK          bool
+	}{
+		{
+			name:       "named parameters",
+			line:       `{{< figure id="cover.png" caption="A cover" >}}`,
+			wantName:   "figure",
+			wantParams: map[string]string{"id": "cover.png", "caption": "A cover"},
+			wantOK:     true,
+		},
+		{
+			name:       "unquoted named parameter",
+			line:       `{{< callout type=warning >}}`,
+			wantName:   "callout",
+			wantParams: map[string]string{"type": "warning"},
+			wantOK:     true,
+		},
+		{
+			name:       "positional parameters",
+			line:       `{{< youtube dQw4w9WgXcQ "Some title" >}}`,
+			wantName:   "youtube",
+			wantParams: map[string]string{},
+			wantArgs:   []string{"dQw4w9WgXcQ", "Some title"},
+			wantOK:     true,
+		},
+		{
+			name:            "self closing",
+			line:            `{{< series-toc />}}`,
+			wantName:        "series-toc",
+			wantParams:      map[string]string{},
+			wantSelfClosing: true,
+			wantOK:          true,
+		},
+		{
+			name:       "closing tag",
+			line:       `  {{< /callout >}}  `,
+			wantName:   "/callout",
+			wantParams: map[string]string{},
+			wantOK:     true,
+		},
+		{name: "text around the tag", line: `See {{< youtube abc >}}`},
+		{name: "template action", line: `{{ .Title }}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, params, args, selfClosing, ok := parseShortcode([]byte(tt.line))
+
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !ok {
+				return
+			}
+			if name != tt.wantName {
+				t.Errorf("name = %q, want %q", name, tt.wantName)
+			}
+			if len(params) != len(tt.wantParams) {
+				t.Errorf("params = %v, want %v", params, tt.wantParams)
+			}
+			for k, v := range tt.wantParams {
+				if params[k] != v {
+					t.Errorf("params[%s] = %q, want %q", k, params[k], v)
+				}
+			}
+			if strings.Join(args, ",") != strings.Join(tt.wantArgs, ",") {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+			if selfClosing != tt.wantSelfClosing {
+				t.Errorf("selfClosing = %v, want %v", selfClosing, tt.wantSelfClosing)
+			}
+		})
+	}
+}
+
+func TestNewShortcodes(t *testing.T) {
+	fsys := fstest.MapFS{
+		ShortcodeDir + "/note.tmpl":  {Data: []byte(`<p class="embedded">{{ .Inner }}</p>`)},
+		ShortcodeDir + "/hello.tmpl": {Data: []byte(`<p>embedded hello</p>`)},
+	}
+	layouts := []Layout{
+		{Name: "shortcode/hello", Code: `<p>site hello</p>`},
+		{Name: "shortcode/badge", Code: `<span class="badge">{{ .Arg 0 }}</span>`},
+		{Name: "Main layout", Code: `{{ .Broken`},
+	}
+
+	sc, err := NewShortcodes(fsys, layouts, nil)
+	if err != nil {
+		t.Fatalf("NewShortcodes() error = %v", err)
+	}
+
+	for _, name := range []string{"note", "hello", "badge"} {
+		if _, ok := sc.templates[name]; !ok {
+			t.Errorf("shortcode %q not loaded", name)
+		}
+	}
+
+	p := NewMarkdownProcessor().WithShortcodes(sc)
+	got, err := p.ToHTML([]byte("{{< hello >}}"))
+	if err != nil {
+		t.Fatalf("ToHTML() error = %v", err)
+	}
+	if !strings.Contains(got, "site hello") {
+		t.Errorf("site layout did not override embedded shortcode: %q", got)
+	}
+
+	_, err = NewShortcodes(fsys, []Layout{{Name: "shortcode/bad", Code: `{{ .Broken`}}, nil)
+	if err == nil {
+		t.Error("NewShortcodes() with invalid site shortcode should fail")
+	}
+}
+
+func TestProcessorWithShortcodes(t *testing.T) {
+	sc := &Shortcodes{templates: map[string]*template.Template{}}
+	mustAdd := func(name, code string) {
+		if err := sc.Add(name, code); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mustAdd("box", `<div class="box">{{ .Inner }}</div>`)
+	mustAdd("badge", `<span class="badge">{{ .Arg 0 }}</span>`)
+	mustAdd("fail", `{{ .Require "id" 0 }}`)
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+		wantErr  error
+		wantLine int
+	}{
+		{
+			name:     "self closing shortcode",
+			markdown: "Intro\n\n{{< badge new >}}\n",
+			want:     "<p>Intro</p>\n<span class=\"badge\">new</span>",
+		},
+		{
+			name:     "paired shortcode renders markdown inside",
+			markdown: "{{< box >}}\nSome **bold** text\n{{< /box >}}\n\nAfter",
+			want:     "<div class=\"box\"><p>Some <strong>bold</strong> text</p>\n</div><p>After</p>\n",
+		},
+		{
+			name:     "nested shortcodes",
+			markdown: "{{< box >}}\n{{< box >}}\ninner\n{{< /box >}}\n{{< /box >}}",
+			want:     "<div class=\"box\"><div class=\"box\"><p>inner</p>\n</div></div>",
+		},
+		{
+			name:     "shortcode in code is not rendered",
+			markdown: "```\n{{< badge new >}}\n```",
+			want:     "<pre><code>{{&lt; badge new &gt;}}\n</code></pre>\n",
+		},
+		{
+			name:     "unknown shortcode",
+			markdown: "Intro\n\n{{< nope >}}",
+			wantErr:  ErrUnknownShortcode,
+			wantLine: 3,
+		},
+		{
+			name:     "template error",
+			markdown: "{{< fail >}}",
+			wantLine: 1,
+		},
+		{
+			name:     "closing tag without opening tag",
+			markdown: "Text\n\n{{< /box >}}",
+			wantLine: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMarkdownProcessor().WithShortcodes(sc)
+
+			got, err := p.ToHTML([]byte(tt.markdown))
+
+			if tt.wantLine != 0 {
+				var scErr *ShortcodeError
+				if !errors.As(err, &scErr) {
+					t.Fatalf("error = %v, want ShortcodeError", err)
+				}
+				if scErr.Line != tt.wantLine {
+					t.Errorf("Line = %d, want %d", scErr.Line, tt.wantLine)
+				}
+				if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+					t.Errorf("error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToHTML() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ToHTML() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDefaultShortcodes(t *testing.T) {
+	sc, err := NewShortcodes(os.DirFS("../../../assets/ssg"), nil, nil)
+	if err != nil {
+		t.Fatalf("NewShortcodes() error = %v", err)
+	}
+
+	imageID := uuid.New()
+	current := Content{ID: uuid.New(), Heading: "Part two", Series: "go", SeriesOrder: 2, Permalink: "/go/part-two/"}
+	ctx := &ShortcodeContext{
+		Content: current,
+		Images: []ImageWithMeta{
+			{ID: imageID, FileName: "cover.png", FilePath: "content/cover.png", AltText: "Cover"},
+			{FileName: "header.png", FilePath: "content/header.png", IsHeader: true},
+			{FileName: "diagram.png", FilePath: "content/diagram.png", AltText: "Diagram"},
+		},
+		Contents: []Content{
+			current,
+			{ID: uuid.New(), Heading: "Part one", Series: "go", SeriesOrder: 1, Permalink: "/go/part-one/"},
+			{ID: uuid.New(), Heading: "Part three", Series: "go", SeriesOrder: 3, Draft: true},
+			{ID: uuid.New(), Heading: "Other", Series: "rust"},
+		},
+		Mode: "structured",
+	}
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     []string
+		notWant  []string
+		wantErr  bool
+	}{
+		{
+			name:     "figure by id",
+			markdown: `{{< figure id="` + imageID.String() + `" >}}`,
+			want:     []string{`src="/static/images/content/cover.png"`, `alt="Cover"`},
+		},
+		{
+			name:     "figure by file name",
+			markdown: `{{< figure id="diagram.png" >}}`,
+			want:     []string{`src="/static/images/content/diagram.png"`},
+		},
+		{
+			name:     "figure of missing image",
+			markdown: `{{< figure id="missing.png" >}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "gallery of the content images",
+			markdown: `{{< gallery content="self" >}}`,
+			want:     []string{"shortcode-gallery", "content/cover.png", "content/diagram.png"},
+			notWant:  []string{"header.png"},
+		},
+		{
+			name:     "gallery of other content",
+			markdown: `{{< gallery content="abc123" >}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "youtube",
+			markdown: `{{< youtube dQw4w9WgXcQ >}}`,
+			want:     []string{`src="https://www.youtube-nocookie.com/embed/dQw4w9WgXcQ"`},
+		},
+		{
+			name:     "youtube without id",
+			markdown: `{{< youtube >}}`,
+			wantErr:  true,
+		},
+		{
+			name:     "callout",
+			markdown: "{{< callout type=\"warning\" >}}\nCareful\n{{< /callout >}}",
+			want:     []string{`class="callout callout-warning"`, "<p>Careful</p>"},
+		},
+		{
+			name:     "series table of contents",
+			markdown: `{{< series-toc >}}`,
+			want:     []string{`<a href="/go/part-one/">Part one</a>`, `<span aria-current="page">Part two</span>`},
+			notWant:  []string{"Part three", "Other"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewMarkdownProcessor().WithShortcodes(sc)
+			p.SetPage(ctx)
+
+			got, err := p.ToHTML([]byte(tt.markdown))
+
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ToHTML() = %q, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ToHTML() error = %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("ToHTML() = %q, want it to contain %q", got, w)
+				}
+			}
+			for _, w := range tt.notWant {
+				if strings.Contains(got, w) {
+					t.Errorf("ToHTML() = %q, should not contain %q", got, w)
+				}
+			}
+		})
+	}
+}
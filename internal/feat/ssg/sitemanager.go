@@ -111,6 +111,7 @@ func (sm *SiteManager) createSiteDirectories(slug string) error {
 		GetSiteMarkdownPath(sitesBasePath, slug),
 		GetSiteHTMLPath(sitesBasePath, slug),
 		GetSiteImagesPath(sitesBasePath, slug),
+		GetSiteDataPath(sitesBasePath, slug),
 	}
 
 	for _, dir := range dirs {
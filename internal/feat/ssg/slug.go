@@ -1,10 +1,14 @@
 package ssg
 
 import (
+	"errors"
 	"regexp"
 	"strings"
 )
 
+// ErrSlugTaken is returned when a content slug is already used in the site.
+var ErrSlugTaken = errors.New("slug already in use")
+
 // NormalizeSlug sanitizes a slug to ensure it's URL-safe:
 // - Lowercase
 // - Replace spaces with hyphens
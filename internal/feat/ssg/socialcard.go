@@ -0,0 +1,439 @@
+package ssg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	SocialCardWidth  = 1200
+	SocialCardHeight = 630
+	// SocialCardFile is the name of the card written next to each page.
+	SocialCardFile = "social-card.png"
+
+	socialCardPadding  = 80
+	socialCardMaxLines = 3
+)
+
+// CardTemplate describes the look of a generated social card.
+type CardTemplate struct {
+	Name       string
+	Background color.RGBA
+	Text       color.RGBA
+	Muted      color.RGBA
+	Accent     color.RGBA
+	// Overlay is the opacity of the background color laid over a
+	// background image so the text stays readable.
+	Overlay   uint8
+	TitleSize float64
+	MetaSize  float64
+}
+
+var cardTemplates = map[string]CardTemplate{
+	"default": {
+		Name:       "default",
+		Background: color.RGBA{0x1f, 0x29, 0x37, 0xff},
+		Text:       color.RGBA{0xff, 0xff, 0xff, 0xff},
+		Muted:      color.RGBA{0xd1, 0xd5, 0xdb, 0xff},
+		Accent:     color.RGBA{0xf5, 0x9e, 0x0b, 0xff},
+		Overlay:    0xb0,
+		TitleSize:  64,
+		MetaSize:   30,
+	},
+	"light": {
+		Name:       "light",
+		Background: color.RGBA{0xf9, 0xfa, 0xfb, 0xff},
+		Text:       color.RGBA{0x11, 0x18, 0x27, 0xff},
+		Muted:      color.RGBA{0x4b, 0x55, 0x63, 0xff},
+		Accent:     color.RGBA{0x25, 0x63, 0xeb, 0xff},
+		Overlay:    0xd8,
+		TitleSize:  64,
+		MetaSize:   30,
+	},
+	"bold": {
+		Name:       "bold",
+		Background: color.RGBA{0x00, 0x00, 0x00, 0xff},
+		Text:       color.RGBA{0xff, 0xff, 0xff, 0xff},
+		Muted:      color.RGBA{0xe5, 0xe7, 0xeb, 0xff},
+		Accent:     color.RGBA{0xef, 0x44, 0x44, 0xff},
+		Overlay:    0x90,
+		TitleSize:  80,
+		MetaSize:   32,
+	},
+}
+
+// CardTemplateByName returns the named built-in card template, or the
+// default one when the name is unknown. A valid hex accent replaces the
+// template accent.
+func CardTemplateByName(name, accent string) CardTemplate {
+	return SelectCardTemplate(cardTemplates, name, accent)
+}
+
+// SelectCardTemplate returns the named template of templates, or the
+// default one when the name is unknown. A valid hex accent replaces the
+// template accent.
+func SelectCardTemplate(templates map[string]CardTemplate, name, accent string) CardTemplate {
+	tpl, ok := templates[strings.ToLower(strings.TrimSpace(name))]
+	if !ok {
+		tpl, ok = templates["default"]
+	}
+	if !ok {
+		tpl = cardTemplates["default"]
+	}
+
+	if c, ok := parseHexColor(accent); ok {
+		tpl.Accent = c
+	}
+
+	return tpl
+}
+
+// cardTemplateSpec is a card template as written in the card templates file
+// of a theme. Unset values are taken from the built-in template of the same
+// name, or from the default one.
+type cardTemplateSpec struct {
+	Background string  `json:"background"`
+	Text       string  `json:"text"`
+	Muted      string  `json:"muted"`
+	Accent     string  `json:"accent"`
+	Overlay    *int    `json:"overlay"`
+	TitleSize  float64 `json:"title_size"`
+	MetaSize   float64 `json:"meta_size"`
+}
+
+// LoadCardTemplates returns the built-in card templates together with the
+// ones of the ThemeCardTemplatesFile in fsys, which add templates or
+// replace built-in ones of the same name. A missing file is not an error.
+func LoadCardTemplates(fsys fs.FS) (map[string]CardTemplate, error) {
+	templates := make(map[string]CardTemplate, len(cardTemplates))
+	for name, tpl := range cardTemplates {
+		templates[name] = tpl
+	}
+
+	data, err := fs.ReadFile(fsys, ThemeCardTemplatesFile)
+	if errors.Is(err, fs.ErrNotExist) {
+		return templates, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", ThemeCardTemplatesFile, err)
+	}
+
+	var specs map[string]cardTemplateSpec
+	if err := json.Unmarshal(data, &specs); err != nil {
+		return nil, fmt.Errorf("cannot parse %s: %w", ThemeCardTemplatesFile, err)
+	}
+
+	for name, spec := range specs {
+		name = strings.ToLower(strings.TrimSpace(name))
+		tpl, ok := templates[name]
+		if !ok {
+			tpl = cardTemplates["default"]
+		}
+		tpl.Name = name
+		if err := spec.apply(&tpl); err != nil {
+			return nil, fmt.Errorf("card template %s in %s: %w", name, ThemeCardTemplatesFile, err)
+		}
+		templates[name] = tpl
+	}
+
+	return templates, nil
+}
+
+// apply sets the values of the spec on tpl.
+func (s cardTemplateSpec) apply(tpl *CardTemplate) error {
+	colors := []struct {
+		field string
+		value string
+		dst   *color.RGBA
+	}{
+		{"background", s.Background, &tpl.Background},
+		{"text", s.Text, &tpl.Text},
+		{"muted", s.Muted, &tpl.Muted},
+		{"accent", s.Accent, &tpl.Accent},
+	}
+	for _, c := range colors {
+		if c.value == "" {
+			continue
+		}
+		v, ok := parseHexColor(c.value)
+		if !ok {
+			return fmt.Errorf("%s %q is not a hex color", c.field, c.value)
+		}
+		*c.dst = v
+	}
+
+	if s.Overlay != nil {
+		if *s.Overlay < 0 || *s.Overlay > 0xff {
+			return fmt.Errorf("overlay %d is not between 0 and 255", *s.Overlay)
+		}
+		tpl.Overlay = uint8(*s.Overlay)
+	}
+	if s.TitleSize < 0 || s.MetaSize < 0 {
+		return fmt.Errorf("font sizes cannot be negative")
+	}
+	if s.TitleSize > 0 {
+		tpl.TitleSize = s.TitleSize
+	}
+	if s.MetaSize > 0 {
+		tpl.MetaSize = s.MetaSize
+	}
+
+	return nil
+}
+
+// SocialCard holds what gets printed on a card.
+type SocialCard struct {
+	Heading    string
+	SiteName   string
+	Date       *time.Time
+	Locale     string // Locale of the date, see FormatDate
+	Background image.Image
+}
+
+// SocialCardRenderer draws social cards using the bundled Go fonts.
+type SocialCardRenderer struct {
+	bold    *opentype.Font
+	regular *opentype.Font
+}
+
+// NewSocialCardRenderer parses the bundled fonts.
+func NewSocialCardRenderer() (*SocialCardRenderer, error) {
+	bold, err := opentype.Parse(gobold.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse bold font: %w", err)
+	}
+
+	regular, err := opentype.Parse(goregular.TTF)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse regular font: %w", err)
+	}
+
+	return &SocialCardRenderer{bold: bold, regular: regular}, nil
+}
+
+// Render composes a card and returns it PNG encoded.
+func (r *SocialCardRenderer) Render(card SocialCard, tpl CardTemplate) ([]byte, error) {
+	canvas := image.NewRGBA(image.Rect(0, 0, SocialCardWidth, SocialCardHeight))
+	draw.Draw(canvas, canvas.Bounds(), image.NewUniform(tpl.Background), image.Point{}, draw.Src)
+
+	if card.Background != nil {
+		drawCover(canvas, card.Background)
+		overlay := tpl.Background
+		overlay.A = tpl.Overlay
+		draw.Draw(canvas, canvas.Bounds(), image.NewUniform(premultiply(overlay)), image.Point{}, draw.Over)
+	}
+
+	accent := image.Rect(socialCardPadding, socialCardPadding, socialCardPadding+96, socialCardPadding+10)
+	draw.Draw(canvas, accent, image.NewUniform(tpl.Accent), image.Point{}, draw.Src)
+
+	titleFace, err := opentype.NewFace(r.bold, &opentype.FaceOptions{Size: tpl.TitleSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create title face: %w", err)
+	}
+	defer titleFace.Close()
+
+	metaFace, err := opentype.NewFace(r.regular, &opentype.FaceOptions{Size: tpl.MetaSize, DPI: 72, Hinting: font.HintingFull})
+	if err != nil {
+		return nil, fmt.Errorf("cannot create meta face: %w", err)
+	}
+	defer metaFace.Close()
+
+	textWidth := SocialCardWidth - 2*socialCardPadding
+	lineHeight := int(tpl.TitleSize * 1.2)
+	y := socialCardPadding + 40 + int(tpl.TitleSize)
+	for _, line := range wrapText(titleFace, card.Heading, textWidth, socialCardMaxLines) {
+		drawText(canvas, titleFace, tpl.Text, socialCardPadding, y, line)
+		y += lineHeight
+	}
+
+	baseline := SocialCardHeight - socialCardPadding
+	drawText(canvas, metaFace, tpl.Muted, socialCardPadding, baseline, card.SiteName)
+
+	if card.Date != nil && !card.Date.IsZero() {
+		date := FormatDate("January 2, 2006", card.Locale, card.Date)
+		x := SocialCardWidth - socialCardPadding - font.MeasureString(metaFace, date).Round()
+		drawText(canvas, metaFace, tpl.Muted, x, baseline, date)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, fmt.Errorf("cannot encode social card: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// LoadCardBackground decodes the image at path to be used as card background.
+// Images over MaxImagePixels are rejected.
+func LoadCardBackground(path string) (image.Image, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open background: %w", err)
+	}
+
+	img, _, err := decodeImage(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot load background: %w", err)
+	}
+
+	return img, nil
+}
+
+// drawCover scales src to fill dst, cropping the excess around the center.
+func drawCover(dst *image.RGBA, src image.Image) {
+	sb := src.Bounds()
+	if sb.Dx() == 0 || sb.Dy() == 0 {
+		return
+	}
+
+	db := dst.Bounds()
+	crop := sb
+	if sb.Dx()*db.Dy() > sb.Dy()*db.Dx() {
+		w := sb.Dy() * db.Dx() / db.Dy()
+		crop.Min.X = sb.Min.X + (sb.Dx()-w)/2
+		crop.Max.X = crop.Min.X + w
+	} else {
+		h := sb.Dx() * db.Dy() / db.Dx()
+		crop.Min.Y = sb.Min.Y + (sb.Dy()-h)/2
+		crop.Max.Y = crop.Min.Y + h
+	}
+
+	draw.ApproxBiLinear.Scale(dst, db, src, crop, draw.Src, nil)
+}
+
+// wrapText splits text into lines that fit width. Text beyond maxLines
+// is cut and the last line gets an ellipsis.
+func wrapText(face font.Face, text string, width, maxLines int) []string {
+	var lines []string
+	var current string
+	for _, word := range strings.Fields(text) {
+		candidate := word
+		if current != "" {
+			candidate = current + " " + word
+		}
+		if current != "" && font.MeasureString(face, candidate).Round() > width {
+			lines = append(lines, current)
+			current = word
+			continue
+		}
+		current = candidate
+	}
+	if current != "" {
+		lines = append(lines, current)
+	}
+
+	if len(lines) <= maxLines {
+		return lines
+	}
+
+	lines = lines[:maxLines]
+	last := lines[maxLines-1]
+	for font.MeasureString(face, last+"…").Round() > width {
+		i := strings.LastIndex(last, " ")
+		if i < 0 {
+			break
+		}
+		last = last[:i]
+	}
+	lines[maxLines-1] = last + "…"
+
+	return lines
+}
+
+func drawText(dst *image.RGBA, face font.Face, c color.RGBA, x, y int, text string) {
+	d := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(c),
+		Face: face,
+		Dot:  fixed.P(x, y),
+	}
+	d.DrawString(text)
+}
+
+func premultiply(c color.RGBA) color.RGBA {
+	a := uint16(c.A)
+	return color.RGBA{
+		R: uint8(uint16(c.R) * a / 0xff),
+		G: uint8(uint16(c.G) * a / 0xff),
+		B: uint8(uint16(c.B) * a / 0xff),
+		A: c.A,
+	}
+}
+
+// parseHexColor parses #rgb and #rrggbb colors.
+func parseHexColor(s string) (color.RGBA, bool) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return color.RGBA{}, false
+	}
+
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return color.RGBA{}, false
+	}
+
+	return color.RGBA{R: uint8(v >> 16), G: uint8(v >> 8), B: uint8(v), A: 0xff}, true
+}
+
+// socialCards renders the cards of a site build with a shared setup.
+type socialCards struct {
+	renderer   *SocialCardRenderer
+	template   CardTemplate
+	siteName   string
+	background image.Image
+}
+
+// write renders the card of content next to its page and returns the card URL.
+// The content header image is used as background, then the site one.
+func (sc *socialCards) write(content Content, htmlPath, mode string) (string, error) {
+	card := SocialCard{
+		Heading:    content.Heading,
+		SiteName:   sc.siteName,
+		Date:       content.PublishedAt,
+		Locale:     content.Locale,
+		Background: sc.background,
+	}
+
+	if strings.HasPrefix(content.HeaderImageURL, "/") {
+		if bg, err := LoadCardBackground(filepath.Join(htmlPath, filepath.FromSlash(content.HeaderImageURL))); err == nil {
+			card.Background = bg
+		}
+	}
+
+	data, err := sc.renderer.Render(card, sc.template)
+	if err != nil {
+		return "", err
+	}
+
+	filePath := GetSocialCardFilePath(htmlPath, content, mode)
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return "", fmt.Errorf("cannot create social card directory: %w", err)
+	}
+
+	if err := os.WriteFile(filePath, data, 0644); err != nil {
+		return "", fmt.Errorf("cannot write social card: %w", err)
+	}
+
+	return GetSocialCardPath(content, mode), nil
+}
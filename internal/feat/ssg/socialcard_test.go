@@ -0,0 +1,235 @@
+package ssg
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"golang.org/x/image/font/basicfont"
+)
+
+func TestCardTemplateByName(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		accent     string
+		wantName   string
+		wantAccent color.RGBA
+	}{
+		{
+			name:       "known template",
+			template:   "Light",
+			wantName:   "light",
+			wantAccent: cardTemplates["light"].Accent,
+		},
+		{
+			name:       "unknown template falls back to default",
+			template:   "missing",
+			wantName:   "default",
+			wantAccent: cardTemplates["default"].Accent,
+		},
+		{
+			name:       "accent override",
+			template:   "bold",
+			accent:     "#0f0",
+			wantName:   "bold",
+			wantAccent: color.RGBA{0x00, 0xff, 0x00, 0xff},
+		},
+		{
+			name:       "invalid accent is ignored",
+			template:   "bold",
+			accent:     "green",
+			wantName:   "bold",
+			wantAccent: cardTemplates["bold"].Accent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CardTemplateByName(tt.template, tt.accent)
+
+			if got.Name != tt.wantName {
+				t.Errorf("Name = %q, want %q", got.Name, tt.wantName)
+			}
+			if got.Accent != tt.wantAccent {
+				t.Errorf("Accent = %v, want %v", got.Accent, tt.wantAccent)
+			}
+		})
+	}
+}
+
+func TestLoadCardTemplates(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		template string
+		want     CardTemplate
+		wantErr  bool
+	}{
+		{
+			name:     "no file keeps built-in templates",
+			template: "light",
+			want:     cardTemplates["light"],
+		},
+		{
+			name:     "adds template on top of default",
+			file:     `{"brand": {"background": "#102030", "accent": "#abc", "overlay": 0, "title_size": 72}}`,
+			template: "brand",
+			want: func() CardTemplate {
+				tpl := cardTemplates["default"]
+				tpl.Name = "brand"
+				tpl.Background = color.RGBA{0x10, 0x20, 0x30, 0xff}
+				tpl.Accent = color.RGBA{0xaa, 0xbb, 0xcc, 0xff}
+				tpl.Overlay = 0
+				tpl.TitleSize = 72
+				return tpl
+			}(),
+		},
+		{
+			name:     "replaces built-in template values",
+			file:     `{"Bold": {"text": "#ff0000"}}`,
+			template: "bold",
+			want: func() CardTemplate {
+				tpl := cardTemplates["bold"]
+				tpl.Text = color.RGBA{0xff, 0x00, 0x00, 0xff}
+				return tpl
+			}(),
+		},
+		{
+			name:    "rejects invalid color",
+			file:    `{"brand": {"background": "navy"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "rejects invalid json",
+			file:    `{"brand":`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fsys := fstest.MapFS{}
+			if tt.file != "" {
+				fsys[ThemeCardTemplatesFile] = &fstest.MapFile{Data: []byte(tt.file)}
+			}
+
+			templates, err := LoadCardTemplates(fsys)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadCardTemplates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := SelectCardTemplate(templates, tt.template, ""); got != tt.want {
+				t.Errorf("SelectCardTemplate() = %+v, want %+v", got, tt.want)
+			}
+			if len(cardTemplates) != 3 || cardTemplates["bold"].Text != (color.RGBA{0xff, 0xff, 0xff, 0xff}) {
+				t.Error("LoadCardTemplates() modified the built-in templates")
+			}
+		})
+	}
+}
+
+func TestSocialCardRendererRender(t *testing.T) {
+	renderer, err := NewSocialCardRenderer()
+	if err != nil {
+		t.Fatalf("NewSocialCardRenderer() error = %v", err)
+	}
+
+	date := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name string
+		card SocialCard
+	}{
+		{
+			name: "plain background",
+			card: SocialCard{Heading: "Hello World", SiteName: "My Site", Date: &date},
+		},
+		{
+			name: "image background",
+			card: SocialCard{
+				Heading:    strings.Repeat("A rather long heading ", 10),
+				SiteName:   "My Site",
+				Background: image.NewRGBA(image.Rect(0, 0, 300, 100)),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := renderer.Render(tt.card, CardTemplateByName("default", ""))
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			img, err := png.Decode(bytes.NewReader(data))
+			if err != nil {
+				t.Fatalf("Render() output is not a png: %v", err)
+			}
+			if b := img.Bounds(); b.Dx() != SocialCardWidth || b.Dy() != SocialCardHeight {
+				t.Errorf("card size = %dx%d, want %dx%d", b.Dx(), b.Dy(), SocialCardWidth, SocialCardHeight)
+			}
+		})
+	}
+}
+
+func TestWrapText(t *testing.T) {
+	face := basicfont.Face7x13
+
+	tests := []struct {
+		name      string
+		text      string
+		wantLines int
+		wantEllip bool
+	}{
+		{name: "short text", text: "one two", wantLines: 1},
+		{name: "wraps words", text: strings.Repeat("word ", 10), wantLines: 2},
+		{name: "cuts long text", text: strings.Repeat("word ", 200), wantLines: 3, wantEllip: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lines := wrapText(face, tt.text, 200, 3)
+
+			if len(lines) != tt.wantLines {
+				t.Fatalf("wrapText() got %d lines, want %d", len(lines), tt.wantLines)
+			}
+			last := lines[len(lines)-1]
+			if strings.HasSuffix(last, "…") != tt.wantEllip {
+				t.Errorf("last line = %q, ellipsis want %v", last, tt.wantEllip)
+			}
+		})
+	}
+}
+
+func TestSocialCardsWrite(t *testing.T) {
+	renderer, err := NewSocialCardRenderer()
+	if err != nil {
+		t.Fatalf("NewSocialCardRenderer() error = %v", err)
+	}
+
+	htmlPath := t.TempDir()
+	cards := &socialCards{renderer: renderer, template: CardTemplateByName("", ""), siteName: "Site"}
+	content := Content{Heading: "Card", ShortID: "abc123", SectionPath: "blog"}
+
+	url, err := cards.write(content, htmlPath, "structured")
+	if err != nil {
+		t.Fatalf("write() error = %v", err)
+	}
+
+	if url != "/blog/card-abc123/social-card.png" {
+		t.Errorf("write() url = %q", url)
+	}
+	if _, err := os.Stat(filepath.Join(htmlPath, "blog", "card-abc123", SocialCardFile)); err != nil {
+		t.Errorf("social card not written: %v", err)
+	}
+}
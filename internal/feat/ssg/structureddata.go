@@ -0,0 +1,250 @@
+package ssg
+
+import (
+	"encoding/json"
+	"html/template"
+	"path"
+	"strings"
+)
+
+// Breadcrumb is one step of the trail leading to a page.
+type Breadcrumb struct {
+	Name    string
+	URL     string // Root relative, as produced by the paths helpers
+	Current bool
+	// Label is set on the crumbs the generator names, whose Name is a UI
+	// string translated per locale. Section and content names are kept.
+	Label bool
+}
+
+// BuildContentBreadcrumbs returns the trail from the home page to content.
+// In structured mode it walks the section path and, for blog and series
+// content, the index the content is listed in.
+func BuildContentBreadcrumbs(content Content, sections []Section, mode string) []Breadcrumb {
+	crumbs := []Breadcrumb{{Name: "Home", URL: "/", Label: true}}
+
+	if mode != "blog" {
+		crumbs = append(crumbs, sectionBreadcrumbs(content.SectionPath, sections)...)
+
+		switch strings.ToLower(content.Kind) {
+		case "blog":
+			crumbs = append(crumbs, Breadcrumb{Name: "Blog", URL: GetIndexPath(content.SectionPath, "blog", mode), Label: true})
+		case "series":
+			if content.Series != "" {
+				crumbs = append(crumbs, Breadcrumb{Name: SeriesTitle(content), URL: seriesIndexPath(content.SectionPath, content.Series)})
+			}
+		}
+	}
+
+	crumbs = append(crumbs, Breadcrumb{Name: content.Heading, URL: GetContentPath(content, mode) + "/", Current: true})
+
+	return crumbs
+}
+
+// BuildIndexBreadcrumbs returns the trail from the home page to an index.
+func BuildIndexBreadcrumbs(index *Index, title string, sections []Section, mode string) []Breadcrumb {
+	crumbs := []Breadcrumb{{Name: "Home", URL: "/", Label: true}}
+	if index.Type == "archive" {
+		return append(crumbs, archiveBreadcrumbs(index, title)...)
+	}
+	if index.Type == "tag" || index.Type == "author" {
+		return append(crumbs, Breadcrumb{Name: title, URL: index.Path, Current: true})
+	}
+	if index.Path == "/" || mode == "blog" {
+		crumbs[0].Current = true
+		return crumbs
+	}
+
+	crumbs = append(crumbs, sectionBreadcrumbs(index.Path, sections)...)
+
+	last := &crumbs[len(crumbs)-1]
+	if last.URL == GetIndexPath(index.Path, "", mode) && index.Type == "section" {
+		last.Current = true
+		return crumbs
+	}
+
+	return append(crumbs, Breadcrumb{Name: title, URL: GetIndexPath(index.Path, "", mode), Current: true})
+}
+
+// sectionBreadcrumbs returns a crumb for every section along sectionPath,
+// root excluded. Segments without a section are skipped.
+func sectionBreadcrumbs(sectionPath string, sections []Section) []Breadcrumb {
+	byPath := make(map[string]Section, len(sections))
+	for _, s := range sections {
+		byPath[strings.Trim(s.Path, "/")] = s
+	}
+
+	var crumbs []Breadcrumb
+	var current string
+	for _, segment := range strings.Split(strings.Trim(sectionPath, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		current = path.Join(current, segment)
+		if s, ok := byPath[current]; ok {
+			crumbs = append(crumbs, Breadcrumb{Name: s.Name, URL: "/" + current + "/"})
+		}
+	}
+
+	return crumbs
+}
+
+func seriesIndexPath(sectionPath, series string) string {
+	return path.Join("/", sectionPath, series) + "/"
+}
+
+// ContentStructuredData returns the schema.org JSON-LD graph of a content page.
+func ContentStructuredData(content Content, seo SEO, crumbs []Breadcrumb, site SiteInfo) template.JS {
+	page := map[string]any{
+		"@type":            contentSchemaType(content.Kind),
+		"headline":         content.Heading,
+		"url":              seo.URL,
+		"mainEntityOfPage": seo.Canonical,
+	}
+	setIf(page, "description", seo.Description)
+	setIf(page, "image", seo.Image)
+	setIf(page, "datePublished", seo.PublishedTime)
+	setIf(page, "dateModified", seo.ModifiedTime)
+	setIf(page, "keywords", seo.Keywords)
+
+	if site.Name != "" {
+		page["publisher"] = map[string]any{"@type": "Organization", "name": site.Name}
+	}
+
+	if len(content.Authors) > 0 {
+		authors := make([]map[string]any, 0, len(content.Authors))
+		for i := range content.Authors {
+			authors = append(authors, authorPerson(&content.Authors[i], site))
+		}
+		page["author"] = authors
+	}
+
+	if strings.EqualFold(content.Kind, "series") && content.Series != "" {
+		page["isPartOf"] = map[string]any{
+			"@type": "CreativeWorkSeries",
+			"name":  SeriesTitle(content),
+			"url":   site.URL(seriesIndexPath(content.SectionPath, content.Series)),
+		}
+		if content.SeriesOrder > 0 {
+			page["position"] = content.SeriesOrder
+		}
+	}
+
+	return jsonLD(page, breadcrumbList(crumbs, site))
+}
+
+// IndexStructuredData returns the schema.org JSON-LD graph of an index page.
+// The root index also describes the site itself.
+func IndexStructuredData(index *Index, contents []Content, seo SEO, crumbs []Breadcrumb, site SiteInfo, mode string) template.JS {
+	items := make([]map[string]any, 0, len(contents))
+	for i, c := range contents {
+		items = append(items, map[string]any{
+			"@type":    "ListItem",
+			"position": i + 1,
+			"url":      site.URL(GetContentPath(c, mode) + "/"),
+			"name":     c.Heading,
+		})
+	}
+
+	page := map[string]any{
+		"@type": "CollectionPage",
+		"name":  seo.Title,
+		"url":   seo.URL,
+		"mainEntity": map[string]any{
+			"@type":           "ItemList",
+			"itemListElement": items,
+		},
+	}
+	setIf(page, "description", seo.Description)
+	if author := IndexAuthor(index); author != nil {
+		page["about"] = authorPerson(author, site)
+	}
+
+	nodes := []map[string]any{page}
+	if index.Path == "/" {
+		website := map[string]any{
+			"@type": "WebSite",
+			"url":   site.URL("/"),
+		}
+		setIf(website, "name", site.Name)
+		nodes = append([]map[string]any{website}, nodes...)
+	}
+
+	return jsonLD(append(nodes, breadcrumbList(crumbs, site))...)
+}
+
+func contentSchemaType(kind string) string {
+	switch strings.ToLower(kind) {
+	case "blog":
+		return "BlogPosting"
+	case "article", "series":
+		return "Article"
+	default:
+		return "WebPage"
+	}
+}
+
+// authorPerson returns the schema.org Person describing author, linking to
+// the author page and profiles.
+func authorPerson(author *Author, site SiteInfo) map[string]any {
+	authorURL := author.URL
+	if authorURL == "" {
+		authorURL = author.Path()
+	}
+
+	person := map[string]any{
+		"@type": "Person",
+		"name":  author.Name,
+		"url":   site.URL(authorURL),
+	}
+	setIf(person, "description", seoDescription(author.Bio))
+	if author.Avatar != "" {
+		person["image"] = site.URL(author.Avatar)
+	}
+
+	var sameAs []string
+	for _, link := range author.LinkList() {
+		sameAs = append(sameAs, link.URL)
+	}
+	if len(sameAs) > 0 {
+		person["sameAs"] = sameAs
+	}
+
+	return person
+}
+
+func breadcrumbList(crumbs []Breadcrumb, site SiteInfo) map[string]any {
+	items := make([]map[string]any, 0, len(crumbs))
+	for i, c := range crumbs {
+		items = append(items, map[string]any{
+			"@type":    "ListItem",
+			"position": i + 1,
+			"name":     c.Name,
+			"item":     site.URL(c.URL),
+		})
+	}
+
+	return map[string]any{
+		"@type":           "BreadcrumbList",
+		"itemListElement": items,
+	}
+}
+
+// jsonLD encodes nodes as a single schema.org graph. encoding/json escapes
+// <, > and &, so the result is safe to embed in a script element.
+func jsonLD(nodes ...map[string]any) template.JS {
+	data, err := json.Marshal(map[string]any{
+		"@context": "https://schema.org",
+		"@graph":   nodes,
+	})
+	if err != nil {
+		return ""
+	}
+	return template.JS(data)
+}
+
+func setIf(m map[string]any, key, value string) {
+	if value != "" {
+		m[key] = value
+	}
+}
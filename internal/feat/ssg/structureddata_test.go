@@ -0,0 +1,290 @@
+package ssg
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBuildContentBreadcrumbs(t *testing.T) {
+	sections := []Section{
+		{Name: "root", Path: "/"},
+		{Name: "Docs", Path: "/docs"},
+		{Name: "Tutorials", Path: "/docs/tutorials"},
+	}
+
+	tests := []struct {
+		name    string
+		content Content
+		mode    string
+		want    []Breadcrumb
+	}{
+		{
+			name:    "nested sections",
+			content: Content{Heading: "Guide", ShortID: "abc", Kind: "article", SectionPath: "/docs/tutorials"},
+			mode:    "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Docs", URL: "/docs/"},
+				{Name: "Tutorials", URL: "/docs/tutorials/"},
+				{Name: "Guide", URL: "/docs/tutorials/guide-abc/", Current: true},
+			},
+		},
+		{
+			name:    "blog post",
+			content: Content{Heading: "Post", ShortID: "abc", Kind: "blog", SectionPath: "/docs"},
+			mode:    "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Docs", URL: "/docs/"},
+				{Name: "Blog", URL: "/docs/blog/", Label: true},
+				{Name: "Post", URL: "/docs/post-abc/", Current: true},
+			},
+		},
+		{
+			name:    "series part",
+			content: Content{Heading: "Part", ShortID: "abc", Kind: "series", Series: "go-basics", SectionPath: "/docs"},
+			mode:    "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Docs", URL: "/docs/"},
+				{Name: "Go basics", URL: "/docs/go-basics/"},
+				{Name: "Part", URL: "/docs/part-abc/", Current: true},
+			},
+		},
+		{
+			name:    "named series part",
+			content: Content{Heading: "Part", ShortID: "abc", Kind: "series", Series: "go-basics", SeriesName: "Go: The Basics", SectionPath: "/docs"},
+			mode:    "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Docs", URL: "/docs/"},
+				{Name: "Go: The Basics", URL: "/docs/go-basics/"},
+				{Name: "Part", URL: "/docs/part-abc/", Current: true},
+			},
+		},
+		{
+			name:    "blog mode",
+			content: Content{Heading: "Post", ShortID: "abc", Kind: "blog", SectionPath: "/docs"},
+			mode:    "blog",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Post", URL: "/post-abc/", Current: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildContentBreadcrumbs(tt.content, sections, tt.mode)
+			assertBreadcrumbs(t, got, tt.want)
+		})
+	}
+}
+
+func TestBuildIndexBreadcrumbs(t *testing.T) {
+	sections := []Section{
+		{Name: "root", Path: "/"},
+		{Name: "Tech", Path: "/tech"},
+	}
+
+	tests := []struct {
+		name  string
+		index *Index
+		title string
+		mode  string
+		want  []Breadcrumb
+	}{
+		{
+			name:  "root index",
+			index: &Index{Path: "/", Type: "section"},
+			mode:  "structured",
+			want:  []Breadcrumb{{Name: "Home", URL: "/", Current: true, Label: true}},
+		},
+		{
+			name:  "section index",
+			index: &Index{Path: "/tech/", Type: "section"},
+			title: "Tech",
+			mode:  "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Tech", URL: "/tech/", Current: true},
+			},
+		},
+		{
+			name:  "blog index",
+			index: &Index{Path: "/tech/blog/", Type: "blog"},
+			title: "Tech Blog",
+			mode:  "structured",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Tech", URL: "/tech/"},
+				{Name: "Tech Blog", URL: "/tech/blog/", Current: true},
+			},
+		},
+		{
+			name:  "month archive index in blog mode",
+			index: &Index{Path: "/2024/03/", Type: "archive"},
+			title: "March 2024",
+			mode:  "blog",
+			want: []Breadcrumb{
+				{Name: "Home", URL: "/", Label: true},
+				{Name: "Archive", URL: "/archive/", Label: true},
+				{Name: "2024", URL: "/2024/"},
+				{Name: "March 2024", URL: "/2024/03/", Current: true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildIndexBreadcrumbs(tt.index, tt.title, sections, tt.mode)
+			assertBreadcrumbs(t, got, tt.want)
+		})
+	}
+}
+
+func assertBreadcrumbs(t *testing.T, got, want []Breadcrumb) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got %d breadcrumbs %v, want %d %v", len(got), got, len(want), want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("breadcrumb %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+type testGraph struct {
+	Context string           `json:"@context"`
+	Graph   []map[string]any `json:"@graph"`
+}
+
+func decodeGraph(t *testing.T, data string) testGraph {
+	t.Helper()
+	var g testGraph
+	if err := json.Unmarshal([]byte(data), &g); err != nil {
+		t.Fatalf("invalid JSON-LD: %v", err)
+	}
+	if g.Context != "https://schema.org" {
+		t.Errorf("@context = %q", g.Context)
+	}
+	return g
+}
+
+func graphTypes(g testGraph) []string {
+	var types []string
+	for _, n := range g.Graph {
+		types = append(types, n["@type"].(string))
+	}
+	return types
+}
+
+func TestContentStructuredData(t *testing.T) {
+	site := SiteInfo{Name: "Site", BaseURL: "https://example.com"}
+
+	tests := []struct {
+		name     string
+		content  Content
+		wantType string
+	}{
+		{name: "blog posting", content: Content{Heading: "Post", ShortID: "a", Kind: "blog"}, wantType: "BlogPosting"},
+		{name: "article", content: Content{Heading: "Article", ShortID: "a", Kind: "article"}, wantType: "Article"},
+		{name: "page", content: Content{Heading: "About", ShortID: "a", Kind: "page"}, wantType: "WebPage"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seo := NewContentSEO(tt.content, site, "blog", "")
+			crumbs := BuildContentBreadcrumbs(tt.content, nil, "blog")
+
+			g := decodeGraph(t, string(ContentStructuredData(tt.content, seo, crumbs, site)))
+
+			types := graphTypes(g)
+			if len(types) != 2 || types[0] != tt.wantType || types[1] != "BreadcrumbList" {
+				t.Errorf("types = %v, want [%s BreadcrumbList]", types, tt.wantType)
+			}
+			if g.Graph[0]["headline"] != tt.content.Heading {
+				t.Errorf("headline = %v, want %q", g.Graph[0]["headline"], tt.content.Heading)
+			}
+		})
+	}
+}
+
+func TestContentStructuredDataAuthors(t *testing.T) {
+	site := SiteInfo{Name: "Site", BaseURL: "https://example.com"}
+	content := Content{Heading: "Post", ShortID: "a", Kind: "blog", Authors: []Author{
+		{Name: "Jane Doe", URL: "/es/authors/jane-doe/", Links: "https://example.social/@jane"},
+		{Name: "Bob"},
+	}}
+
+	seo := NewContentSEO(content, site, "blog", "")
+	g := decodeGraph(t, string(ContentStructuredData(content, seo, nil, site)))
+
+	authors, ok := g.Graph[0]["author"].([]any)
+	if !ok || len(authors) != 2 {
+		t.Fatalf("author = %v, want two people", g.Graph[0]["author"])
+	}
+	jane := authors[0].(map[string]any)
+	if jane["url"] != "https://example.com/es/authors/jane-doe/" || jane["sameAs"] == nil {
+		t.Errorf("author[0] = %v", jane)
+	}
+	if bob := authors[1].(map[string]any); bob["url"] != "https://example.com/authors/bob/" {
+		t.Errorf("author[1] url = %v", bob["url"])
+	}
+}
+
+func TestContentStructuredDataSeries(t *testing.T) {
+	site := SiteInfo{Name: "Site", BaseURL: "https://example.com"}
+	content := Content{Heading: "Part", ShortID: "a", Kind: "series", Series: "go-basics", SeriesName: "Go: The Basics", SeriesOrder: 2, SectionPath: "/docs"}
+
+	seo := NewContentSEO(content, site, "structured", "")
+	g := decodeGraph(t, string(ContentStructuredData(content, seo, nil, site)))
+
+	series, ok := g.Graph[0]["isPartOf"].(map[string]any)
+	if !ok {
+		t.Fatalf("isPartOf = %v, want a series", g.Graph[0]["isPartOf"])
+	}
+	if series["name"] != "Go: The Basics" || series["url"] != "https://example.com/docs/go-basics/" {
+		t.Errorf("isPartOf = %v", series)
+	}
+}
+
+func TestIndexStructuredData(t *testing.T) {
+	site := SiteInfo{Name: "Site", BaseURL: "https://example.com"}
+	contents := []Content{{Heading: "One", ShortID: "a"}, {Heading: "Two", ShortID: "b"}}
+
+	tests := []struct {
+		name      string
+		index     *Index
+		wantTypes []string
+	}{
+		{name: "root index describes the site", index: &Index{Path: "/"}, wantTypes: []string{"WebSite", "CollectionPage", "BreadcrumbList"}},
+		{name: "section index", index: &Index{Path: "/tech/"}, wantTypes: []string{"CollectionPage", "BreadcrumbList"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			seo := NewIndexSEO(tt.index, nil, site, "structured", "en", 1)
+			crumbs := BuildIndexBreadcrumbs(tt.index, seo.Title, nil, "structured")
+
+			g := decodeGraph(t, string(IndexStructuredData(tt.index, contents, seo, crumbs, site, "structured")))
+
+			types := graphTypes(g)
+			if len(types) != len(tt.wantTypes) {
+				t.Fatalf("types = %v, want %v", types, tt.wantTypes)
+			}
+			for i := range types {
+				if types[i] != tt.wantTypes[i] {
+					t.Errorf("types = %v, want %v", types, tt.wantTypes)
+				}
+			}
+
+			page := g.Graph[len(g.Graph)-2]
+			list := page["mainEntity"].(map[string]any)["itemListElement"].([]any)
+			if len(list) != len(contents) {
+				t.Errorf("item list has %d items, want %d", len(list), len(contents))
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package ssg
 
 import (
+	"path"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -157,3 +160,66 @@ func (t *Tag) Ref() string {
 func (t *Tag) SetRef(ref string) {
 	t.ref = ref
 }
+
+// TagIndexPath returns the path of the index listing the content tagged with
+// the tag of slug, e.g. /tags/go/.
+func TagIndexPath(slug string) string {
+	return path.Join("/tags", slug) + "/"
+}
+
+// BuildTagIndexes returns one index per tag of the published content of
+// allContent that is listed on other indexes, newest first.
+func BuildTagIndexes(allContent []Content) []*Index {
+	indexes := make(map[string]*Index)
+
+	for _, content := range allContent {
+		kind := strings.ToLower(content.Kind)
+		if content.Draft || (kind != "article" && kind != "blog" && kind != "series") {
+			continue
+		}
+		for _, tag := range content.Tags {
+			tagPath := TagIndexPath(tag.Slug())
+			if _, ok := indexes[tagPath]; !ok {
+				indexes[tagPath] = &Index{Path: tagPath, Type: "tag", Content: []Content{}}
+			}
+			indexes[tagPath].Content = append(indexes[tagPath].Content, content)
+		}
+	}
+
+	result := make([]*Index, 0, len(indexes))
+	for _, index := range indexes {
+		sort.SliceStable(index.Content, func(i, j int) bool {
+			a, b := index.Content[i].PublishedAt, index.Content[j].PublishedAt
+			if a == nil || b == nil {
+				return a != nil
+			}
+			return a.After(*b)
+		})
+		result = append(result, index)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Path < result[j].Path
+	})
+
+	return result
+}
+
+// BuildLocaleTagIndexes builds the tag indexes of every locale in allContent,
+// prefixed like the ones of BuildLocaleIndexes.
+func BuildLocaleTagIndexes(allContent []Content, defaultLocale string) []*Index {
+	return buildLocaleIndexes(allContent, defaultLocale, BuildTagIndexes)
+}
+
+// tagTitle returns the name of the tag listed by a tag index.
+func tagTitle(index *Index) string {
+	slug := path.Base(strings.TrimSuffix(index.Path, "/"))
+	for _, c := range index.Content {
+		for _, t := range c.Tags {
+			if t.Slug() == slug {
+				return t.Name
+			}
+		}
+	}
+	return humanize(slug)
+}
@@ -525,3 +525,54 @@ func TestTagSetRef(t *testing.T) {
 		t.Errorf("SetRef() set %v, want %v", tag.ref, ref)
 	}
 }
+
+func TestBuildLocaleTagIndexes(t *testing.T) {
+	date := func(day int) *time.Time {
+		d := time.Date(2024, time.March, day, 10, 0, 0, 0, time.UTC)
+		return &d
+	}
+	golang := Tag{ShortID: "a1b2c3", Name: "Go Lang"}
+	rust := Tag{ShortID: "d4e5f6", Name: "Rust"}
+	contents := []Content{
+		{Heading: "Older", Kind: "article", Tags: []Tag{golang}, PublishedAt: date(1)},
+		{Heading: "Newer", Kind: "blog", Tags: []Tag{golang, rust}, PublishedAt: date(2)},
+		{Heading: "Draft", Kind: "blog", Tags: []Tag{golang}, Draft: true},
+		{Heading: "About", Kind: "page", Tags: []Tag{rust}},
+		{Heading: "Nuevo", Kind: "blog", Tags: []Tag{golang}, Locale: "es"},
+	}
+
+	indexes := BuildLocaleTagIndexes(contents, "en")
+
+	want := map[string][]string{
+		"/tags/go-lang-a1b2c3/":    {"Newer", "Older"},
+		"/tags/rust-d4e5f6/":       {"Newer"},
+		"/es/tags/go-lang-a1b2c3/": {"Nuevo"},
+	}
+	if len(indexes) != len(want) {
+		t.Fatalf("got %d indexes, want %d", len(indexes), len(want))
+	}
+	for _, idx := range indexes {
+		headings, ok := want[idx.Path]
+		if !ok {
+			t.Errorf("unexpected index %s", idx.Path)
+			continue
+		}
+		if idx.Type != "tag" {
+			t.Errorf("index %s type = %q, want tag", idx.Path, idx.Type)
+		}
+		if len(idx.Content) != len(headings) {
+			t.Errorf("index %s has %d items, want %d", idx.Path, len(idx.Content), len(headings))
+			continue
+		}
+		for i, heading := range headings {
+			if idx.Content[i].Heading != heading {
+				t.Errorf("index %s item %d = %q, want %q", idx.Path, i, idx.Content[i].Heading, heading)
+			}
+		}
+		if idx.Path == "/tags/go-lang-a1b2c3/" {
+			if got := indexTitle(idx, nil, SiteInfo{}, "en"); got != "Go Lang" {
+				t.Errorf("indexTitle() = %q, want Go Lang", got)
+			}
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package ssg
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const (
+	// DefaultThemeName names the theme embedded in the binary.
+	DefaultThemeName = "default"
+	// EmbeddedThemeDir is the root of the embedded theme in the assets.
+	EmbeddedThemeDir = "assets/ssg"
+	// ThemeManifestFile describes a theme, at its root.
+	ThemeManifestFile = "theme.json"
+	// ThemeLayoutFile is the main layout of a theme.
+	ThemeLayoutFile = "layout/layout.html"
+	// ThemePartialsPattern matches the partials of a theme.
+	ThemePartialsPattern = "partial/*.tmpl"
+	// ThemeStaticDir holds the static assets a theme copies to the site.
+	ThemeStaticDir = "static"
+	// ThemeCardTemplatesFile holds the social card templates of a theme.
+	ThemeCardTemplatesFile = "social-cards.json"
+)
+
+// ErrInvalidTheme is returned when a theme has no valid manifest.
+var ErrInvalidTheme = errors.New("invalid theme")
+
+// ErrThemeNotFound is returned when a site selects a theme that is not
+// installed.
+var ErrThemeNotFound = errors.New("theme not found")
+
+// ErrThemeInUse is returned when removing the theme the site uses.
+var ErrThemeInUse = errors.New("theme in use")
+
+// ThemeManifest describes a theme. Params holds the default values of the
+// params the theme templates read; sites override them with params of the
+// same ref key.
+type ThemeManifest struct {
+	Name        string            `json:"name"`
+	Version     string            `json:"version"`
+	Description string            `json:"description"`
+	Author      string            `json:"author"`
+	Params      map[string]string `json:"params"`
+}
+
+// Theme is the presentation of a generated site: its layout, partials,
+// shortcodes and static assets. Files are looked up in the site overrides
+// first, then in the theme and last in the embedded theme, so themes and
+// overrides only need to provide the files they change.
+type Theme struct {
+	Manifest ThemeManifest
+	FS       fs.FS
+}
+
+// ThemeInfo is the theme as seen by site templates.
+type ThemeInfo struct {
+	Name    string
+	Version string
+	Params  map[string]string
+}
+
+// ResolveTheme returns the theme name for a site. The embedded theme, rooted
+// at EmbeddedThemeDir in assetsFS, is used for DefaultThemeName or an empty
+// name; other themes are read from themesPath. Files in overridesPath, when
+// it exists, take precedence over the theme ones.
+func ResolveTheme(assetsFS fs.FS, themesPath, name, overridesPath string) (*Theme, error) {
+	embedded, err := fs.Sub(assetsFS, EmbeddedThemeDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open embedded theme: %w", err)
+	}
+
+	var layers layeredFS
+	if overridesPath != "" {
+		if info, err := os.Stat(overridesPath); err == nil && info.IsDir() {
+			layers = append(layers, os.DirFS(overridesPath))
+		}
+	}
+
+	manifestFS := embedded
+	if name != "" && name != DefaultThemeName {
+		dir := filepath.Join(themesPath, name)
+		if info, err := os.Stat(dir); err != nil || !info.IsDir() {
+			return nil, fmt.Errorf("%w: %s", ErrThemeNotFound, name)
+		}
+		manifestFS = os.DirFS(dir)
+		layers = append(layers, manifestFS)
+	}
+	layers = append(layers, embedded)
+
+	manifest, err := ReadThemeManifest(manifestFS)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Theme{Manifest: manifest, FS: layers}, nil
+}
+
+// ReadThemeManifest reads and validates the manifest at the root of fsys.
+func ReadThemeManifest(fsys fs.FS) (ThemeManifest, error) {
+	data, err := fs.ReadFile(fsys, ThemeManifestFile)
+	if err != nil {
+		return ThemeManifest{}, fmt.Errorf("%w: cannot read %s: %v", ErrInvalidTheme, ThemeManifestFile, err)
+	}
+
+	var m ThemeManifest
+	if err := json.Unmarshal(data, &m); err != nil {
+		return ThemeManifest{}, fmt.Errorf("%w: cannot parse %s: %v", ErrInvalidTheme, ThemeManifestFile, err)
+	}
+	if m.Name == "" || NormalizeSlug(m.Name) != m.Name {
+		return ThemeManifest{}, fmt.Errorf("%w: name %q must only contain lowercase letters, numbers and hyphens", ErrInvalidTheme, m.Name)
+	}
+
+	return m, nil
+}
+
+// Info returns the theme as seen by templates, with the manifest params
+// replaced by the values of get, which looks up the site params.
+func (t *Theme) Info(get func(key, def string) string) ThemeInfo {
+	params := make(map[string]string, len(t.Manifest.Params))
+	for key, def := range t.Manifest.Params {
+		params[key] = get(key, def)
+	}
+	return ThemeInfo{Name: t.Manifest.Name, Version: t.Manifest.Version, Params: params}
+}
+
+// ListThemes returns the manifests of the embedded theme and of the themes
+// installed in themesPath, sorted by name after the embedded one. Directories
+// without a valid manifest are skipped.
+func ListThemes(assetsFS fs.FS, themesPath string) ([]ThemeManifest, error) {
+	embedded, err := fs.Sub(assetsFS, EmbeddedThemeDir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open embedded theme: %w", err)
+	}
+	def, err := ReadThemeManifest(embedded)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(themesPath)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("cannot list themes: %w", err)
+	}
+
+	var installed []ThemeManifest
+	for _, e := range entries {
+		if !e.IsDir() || e.Name() == DefaultThemeName {
+			continue
+		}
+		m, err := ReadThemeManifest(os.DirFS(filepath.Join(themesPath, e.Name())))
+		if err != nil || m.Name != e.Name() {
+			continue
+		}
+		installed = append(installed, m)
+	}
+	sort.Slice(installed, func(i, j int) bool {
+		return installed[i].Name < installed[j].Name
+	})
+
+	return append([]ThemeManifest{def}, installed...), nil
+}
+
+// InstallTheme installs the theme at src, a directory or a zip file, into
+// themesPath under the name of its manifest, replacing a previous version.
+// The manifest may be at the root of src or of its single top directory.
+func InstallTheme(themesPath, src string) (ThemeManifest, error) {
+	info, err := os.Stat(src)
+	if err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot open theme: %w", err)
+	}
+
+	var fsys fs.FS
+	if info.IsDir() {
+		fsys = os.DirFS(src)
+	} else {
+		zr, err := zip.OpenReader(src)
+		if err != nil {
+			return ThemeManifest{}, fmt.Errorf("%w: cannot open zip: %v", ErrInvalidTheme, err)
+		}
+		defer zr.Close()
+		fsys = zr
+	}
+
+	fsys, err = themeRoot(fsys)
+	if err != nil {
+		return ThemeManifest{}, err
+	}
+	m, err := ReadThemeManifest(fsys)
+	if err != nil {
+		return ThemeManifest{}, err
+	}
+	if m.Name == DefaultThemeName {
+		return ThemeManifest{}, fmt.Errorf("%w: %s is reserved for the embedded theme", ErrInvalidTheme, DefaultThemeName)
+	}
+
+	if err := os.MkdirAll(themesPath, 0755); err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot create themes directory: %w", err)
+	}
+	tmp, err := os.MkdirTemp(themesPath, ".install-")
+	if err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot create install directory: %w", err)
+	}
+	defer os.RemoveAll(tmp)
+	if err := os.Chmod(tmp, 0755); err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot prepare install directory: %w", err)
+	}
+
+	if err := copyTree(fsys, tmp); err != nil {
+		return ThemeManifest{}, err
+	}
+
+	dest := filepath.Join(themesPath, m.Name)
+	if err := os.RemoveAll(dest); err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot remove previous theme: %w", err)
+	}
+	if err := os.Rename(tmp, dest); err != nil {
+		return ThemeManifest{}, fmt.Errorf("cannot install theme: %w", err)
+	}
+
+	return m, nil
+}
+
+// RemoveTheme deletes the installed theme name from themesPath.
+func RemoveTheme(themesPath, name string) error {
+	if name == "" || name == DefaultThemeName || NormalizeSlug(name) != name {
+		return fmt.Errorf("%w: %s", ErrThemeNotFound, name)
+	}
+	dir := filepath.Join(themesPath, name)
+	if _, err := os.Stat(dir); err != nil {
+		return fmt.Errorf("%w: %s", ErrThemeNotFound, name)
+	}
+	return os.RemoveAll(dir)
+}
+
+// themeRoot returns fsys, or its single top directory when the manifest is
+// there, as zips made by compressing a directory are.
+func themeRoot(fsys fs.FS) (fs.FS, error) {
+	if _, err := fs.Stat(fsys, ThemeManifestFile); err == nil {
+		return fsys, nil
+	}
+
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("%w: cannot read theme: %v", ErrInvalidTheme, err)
+	}
+	var dirs []string
+	for _, e := range entries {
+		if e.IsDir() {
+			dirs = append(dirs, e.Name())
+		}
+	}
+	if len(dirs) != 1 {
+		return nil, fmt.Errorf("%w: %s not found", ErrInvalidTheme, ThemeManifestFile)
+	}
+	return fs.Sub(fsys, dirs[0])
+}
+
+// copyTree copies the files of fsys into dir.
+func copyTree(fsys fs.FS, dir string) error {
+	return fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("error walking theme: %w", err)
+		}
+
+		dest := filepath.Join(dir, filepath.FromSlash(p))
+		if d.IsDir() {
+			if err := os.MkdirAll(dest, 0755); err != nil {
+				return fmt.Errorf("cannot create directory: %w", err)
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		return copyFile(fsys, p, dest)
+	})
+}
+
+// layeredFS looks files up in each of its layers in turn. Directory listings
+// merge the entries of every layer, the first one winning.
+type layeredFS []fs.FS
+
+func (l layeredFS) Open(name string) (fs.File, error) {
+	for _, layer := range l {
+		f, err := layer.Open(name)
+		if err == nil {
+			return f, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+}
+
+func (l layeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	seen := make(map[string]bool)
+	var entries []fs.DirEntry
+	found := false
+	for _, layer := range l {
+		layerEntries, err := fs.ReadDir(layer, name)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		found = true
+		for _, e := range layerEntries {
+			if !seen[e.Name()] {
+				seen[e.Name()] = true
+				entries = append(entries, e)
+			}
+		}
+	}
+	if !found {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrNotExist}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	return entries, nil
+}
@@ -0,0 +1,260 @@
+package ssg_test
+
+import (
+	"archive/zip"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func testAssetsFS() fstest.MapFS {
+	return fstest.MapFS{
+		"assets/ssg/theme.json":             {Data: []byte(`{"name":"default","version":"1.0.0"}`)},
+		"assets/ssg/layout/layout.html":     {Data: []byte("default layout")},
+		"assets/ssg/partial/header.tmpl":    {Data: []byte("default header")},
+		"assets/ssg/partial/footer.tmpl":    {Data: []byte("default footer")},
+		"assets/ssg/static/css/main.css":    {Data: []byte("default css")},
+		"assets/ssg/shortcode/youtube.tmpl": {Data: []byte("default youtube")},
+	}
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func readFile(t *testing.T, fsys fs.FS, name string) string {
+	t.Helper()
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		t.Fatalf("ReadFile(%s) error = %v", name, err)
+	}
+	return string(data)
+}
+
+func TestResolveTheme(t *testing.T) {
+	themesPath := t.TempDir()
+	writeFiles(t, filepath.Join(themesPath, "dark"), map[string]string{
+		"theme.json":          `{"name":"dark","version":"2.0.0","params":{"ssg.theme.accent":"black"}}`,
+		"layout/layout.html":  "dark layout",
+		"partial/header.tmpl": "dark header",
+		"partial/nav.tmpl":    "dark nav",
+	})
+	overrides := t.TempDir()
+	writeFiles(t, overrides, map[string]string{
+		"partial/header.tmpl": "site header",
+	})
+
+	theme, err := ssg.ResolveTheme(testAssetsFS(), themesPath, "dark", overrides)
+	if err != nil {
+		t.Fatalf("ResolveTheme() error = %v", err)
+	}
+	if theme.Manifest.Name != "dark" || theme.Manifest.Version != "2.0.0" {
+		t.Errorf("Manifest = %+v", theme.Manifest)
+	}
+
+	want := map[string]string{
+		"layout/layout.html":     "dark layout",
+		"partial/header.tmpl":    "site header",
+		"partial/footer.tmpl":    "default footer",
+		"static/css/main.css":    "default css",
+		"shortcode/youtube.tmpl": "default youtube",
+	}
+	for name, data := range want {
+		if got := readFile(t, theme.FS, name); got != data {
+			t.Errorf("%s = %q, want %q", name, got, data)
+		}
+	}
+
+	partials, err := fs.Glob(theme.FS, ssg.ThemePartialsPattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPartials := []string{"partial/footer.tmpl", "partial/header.tmpl", "partial/nav.tmpl"}
+	if !reflect.DeepEqual(partials, wantPartials) {
+		t.Errorf("partials = %v, want %v", partials, wantPartials)
+	}
+
+	info := theme.Info(func(key, def string) string {
+		if key == "ssg.theme.accent" {
+			return "navy"
+		}
+		return def
+	})
+	if info.Name != "dark" || info.Params["ssg.theme.accent"] != "navy" {
+		t.Errorf("Info() = %+v", info)
+	}
+}
+
+func TestResolveThemeDefault(t *testing.T) {
+	for _, name := range []string{"", ssg.DefaultThemeName} {
+		theme, err := ssg.ResolveTheme(testAssetsFS(), t.TempDir(), name, filepath.Join(t.TempDir(), "missing"))
+		if err != nil {
+			t.Fatalf("ResolveTheme(%q) error = %v", name, err)
+		}
+		if theme.Manifest.Name != ssg.DefaultThemeName {
+			t.Errorf("ResolveTheme(%q) name = %s", name, theme.Manifest.Name)
+		}
+		if got := readFile(t, theme.FS, "partial/header.tmpl"); got != "default header" {
+			t.Errorf("header = %q", got)
+		}
+	}
+
+	_, err := ssg.ResolveTheme(testAssetsFS(), t.TempDir(), "missing", "")
+	if !errors.Is(err, ssg.ErrThemeNotFound) {
+		t.Errorf("ResolveTheme(missing) error = %v, want ErrThemeNotFound", err)
+	}
+}
+
+func TestInstallTheme(t *testing.T) {
+	themeFiles := map[string]string{
+		"theme.json":          `{"name":"dark","version":"1.0.0"}`,
+		"partial/header.tmpl": "dark header",
+	}
+
+	tests := []struct {
+		name    string
+		src     func(t *testing.T) string
+		wantErr error
+	}{
+		{
+			name: "from directory",
+			src: func(t *testing.T) string {
+				dir := t.TempDir()
+				writeFiles(t, dir, themeFiles)
+				return dir
+			},
+		},
+		{
+			name: "from zip",
+			src: func(t *testing.T) string {
+				return writeZip(t, "", themeFiles)
+			},
+		},
+		{
+			name: "from zip with a top directory",
+			src: func(t *testing.T) string {
+				return writeZip(t, "dark-1.0.0/", themeFiles)
+			},
+		},
+		{
+			name: "without manifest",
+			src: func(t *testing.T) string {
+				return writeZip(t, "", map[string]string{"partial/header.tmpl": "x"})
+			},
+			wantErr: ssg.ErrInvalidTheme,
+		},
+		{
+			name: "with an invalid name",
+			src: func(t *testing.T) string {
+				return writeZip(t, "", map[string]string{"theme.json": `{"name":"../dark"}`})
+			},
+			wantErr: ssg.ErrInvalidTheme,
+		},
+		{
+			name: "with the reserved name",
+			src: func(t *testing.T) string {
+				return writeZip(t, "", map[string]string{"theme.json": `{"name":"default"}`})
+			},
+			wantErr: ssg.ErrInvalidTheme,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			themesPath := filepath.Join(t.TempDir(), "themes")
+
+			m, err := ssg.InstallTheme(themesPath, tt.src(t))
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("InstallTheme() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("InstallTheme() error = %v", err)
+			}
+			if m.Name != "dark" {
+				t.Errorf("Name = %s, want dark", m.Name)
+			}
+
+			got := readFile(t, os.DirFS(filepath.Join(themesPath, "dark")), "partial/header.tmpl")
+			if got != "dark header" {
+				t.Errorf("installed header = %q", got)
+			}
+		})
+	}
+}
+
+func TestListAndRemoveThemes(t *testing.T) {
+	themesPath := t.TempDir()
+	writeFiles(t, themesPath, map[string]string{
+		"zen/theme.json":     `{"name":"zen"}`,
+		"dark/theme.json":    `{"name":"dark"}`,
+		"broken/theme.json":  `{`,
+		"renamed/theme.json": `{"name":"other"}`,
+	})
+
+	themes, err := ssg.ListThemes(testAssetsFS(), themesPath)
+	if err != nil {
+		t.Fatalf("ListThemes() error = %v", err)
+	}
+	var names []string
+	for _, m := range themes {
+		names = append(names, m.Name)
+	}
+	if want := []string{"default", "dark", "zen"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("ListThemes() = %v, want %v", names, want)
+	}
+
+	if err := ssg.RemoveTheme(themesPath, "dark"); err != nil {
+		t.Fatalf("RemoveTheme() error = %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(themesPath, "dark")); !os.IsNotExist(err) {
+		t.Errorf("theme directory still exists: %v", err)
+	}
+	for _, name := range []string{"dark", ssg.DefaultThemeName, "../zen"} {
+		if err := ssg.RemoveTheme(themesPath, name); !errors.Is(err, ssg.ErrThemeNotFound) {
+			t.Errorf("RemoveTheme(%s) error = %v, want ErrThemeNotFound", name, err)
+		}
+	}
+}
+
+func writeZip(t *testing.T, prefix string, files map[string]string) string {
+	t.Helper()
+	p := filepath.Join(t.TempDir(), "theme.zip")
+	f, err := os.Create(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	zw := zip.NewWriter(f)
+	for name, data := range files {
+		w, err := zw.Create(prefix + name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(data)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
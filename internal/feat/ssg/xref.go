@@ -0,0 +1,171 @@
+package ssg
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// RefScheme is the link scheme of cross references, as in [text](clio:SHORTID).
+const RefScheme = "clio:"
+
+var (
+	// ErrRefNotFound is reported when a cross reference targets no content.
+	ErrRefNotFound = errors.New("referenced content not found")
+	// ErrRefDraft is reported when a cross reference targets a draft.
+	ErrRefDraft = errors.New("referenced content is a draft")
+)
+
+var (
+	refLinkRe = regexp.MustCompile(`\[([^\]]*)\]\(clio:([A-Za-z0-9]+)(#[^)\s]*)?\)`)
+	refWikiRe = regexp.MustCompile(`\[\[([A-Za-z0-9]+)(?:\|([^\]]+))?\]\]`)
+)
+
+// ContentRefs resolves cross references between contents to their current
+// paths, so links survive slug and permalink changes.
+type ContentRefs struct {
+	contents map[string]Content
+	mode     string
+}
+
+// NewContentRefs indexes contents by short ID. Contents must have their
+// permalinks resolved.
+func NewContentRefs(contents []Content, mode string) *ContentRefs {
+	refs := &ContentRefs{
+		contents: make(map[string]Content, len(contents)),
+		mode:     mode,
+	}
+	for _, c := range contents {
+		if c.ShortID != "" {
+			refs.contents[c.ShortID] = c
+		}
+	}
+	return refs
+}
+
+// Reference returns the wiki style reference to content, e.g. [[1a2b3c4d5e6f]].
+func Reference(content Content) string {
+	return "[[" + content.ShortID + "]]"
+}
+
+// Resolve rewrites the cross references in markdown as regular links to the
+// target paths. [[SHORTID]] uses the target heading as link text and
+// [[SHORTID|text]] the given one. References to drafts or missing content
+// are rendered as plain text and reported in the returned errors. References
+// inside code are left untouched.
+func (r *ContentRefs) Resolve(markdown []byte) ([]byte, []error) {
+	var errs []error
+
+	resolve := func(line string) string {
+		line = refLinkRe.ReplaceAllStringFunc(line, func(match string) string {
+			m := refLinkRe.FindStringSubmatch(match)
+			path, err := r.path(m[2])
+			if err != nil {
+				errs = append(errs, err)
+				return m[1]
+			}
+			return fmt.Sprintf("[%s](%s%s)", m[1], path, m[3])
+		})
+
+		return refWikiRe.ReplaceAllStringFunc(line, func(match string) string {
+			m := refWikiRe.FindStringSubmatch(match)
+			text := m[2]
+			path, err := r.path(m[1])
+			if err != nil {
+				errs = append(errs, err)
+				if text == "" {
+					return m[1]
+				}
+				return text
+			}
+			if text == "" {
+				text = r.contents[m[1]].Heading
+			}
+			return fmt.Sprintf("[%s](%s)", text, path)
+		})
+	}
+
+	out := mapOutsideCode(string(markdown), resolve)
+	return []byte(out), errs
+}
+
+// Text rewrites the cross references in markdown as the text a reader sees:
+// the link text of [text](clio:SHORTID) and [[SHORTID|text]], and the target
+// heading for [[SHORTID]]. References to missing content leave no text.
+func (r *ContentRefs) Text(markdown string) string {
+	return mapOutsideCode(markdown, func(line string) string {
+		line = refLinkRe.ReplaceAllString(line, "$1")
+		return refWikiRe.ReplaceAllStringFunc(line, func(match string) string {
+			m := refWikiRe.FindStringSubmatch(match)
+			if m[2] != "" {
+				return m[2]
+			}
+			if r == nil {
+				return ""
+			}
+			return r.contents[m[1]].Heading
+		})
+	})
+}
+
+func (r *ContentRefs) path(shortID string) (string, error) {
+	c, ok := r.contents[shortID]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ErrRefNotFound, shortID)
+	}
+	if c.Draft {
+		return "", fmt.Errorf("%w: %s", ErrRefDraft, shortID)
+	}
+	return GetContentPath(c, r.mode) + "/", nil
+}
+
+// ExtractRefs returns the short IDs referenced in markdown, without
+// duplicates and sorted.
+func ExtractRefs(markdown string) []string {
+	seen := make(map[string]bool)
+	mapOutsideCode(markdown, func(line string) string {
+		for _, m := range refLinkRe.FindAllStringSubmatch(line, -1) {
+			seen[m[2]] = true
+		}
+		for _, m := range refWikiRe.FindAllStringSubmatch(line, -1) {
+			seen[m[1]] = true
+		}
+		return line
+	})
+
+	refs := make([]string, 0, len(seen))
+	for id := range seen {
+		refs = append(refs, id)
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// mapOutsideCode applies fn to the text of markdown that is not inside a
+// fenced code block or an inline code span.
+func mapOutsideCode(markdown string, fn func(string) string) string {
+	lines := strings.Split(markdown, "\n")
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+
+		parts := strings.Split(line, "`")
+		for j := 0; j < len(parts); j += 2 {
+			parts[j] = fn(parts[j])
+		}
+		lines[i] = strings.Join(parts, "`")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,116 @@
+package ssg
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestContentRefsResolve(t *testing.T) {
+	contents := []Content{
+		{Heading: "First Post", ShortID: "aaa111", SectionPath: "blog", Permalink: "/blog/first/"},
+		{Heading: "Draft Post", ShortID: "bbb222", SectionPath: "blog", Draft: true},
+	}
+	refs := NewContentRefs(contents, "structured")
+
+	tests := []struct {
+		name     string
+		markdown string
+		want     string
+		wantErrs []error
+	}{
+		{
+			name:     "resolves link reference",
+			markdown: "See [the first](clio:aaa111).",
+			want:     "See [the first](/blog/first/).",
+		},
+		{
+			name:     "keeps fragment",
+			markdown: "[setup](clio:aaa111#setup)",
+			want:     "[setup](/blog/first/#setup)",
+		},
+		{
+			name:     "resolves wiki reference with heading",
+			markdown: "Read [[aaa111]] first.",
+			want:     "Read [First Post](/blog/first/) first.",
+		},
+		{
+			name:     "resolves wiki reference with text",
+			markdown: "Read [[aaa111|this]] first.",
+			want:     "Read [this](/blog/first/) first.",
+		},
+		{
+			name:     "renders draft reference as text",
+			markdown: "Soon [coming](clio:bbb222) and [[bbb222|later]].",
+			want:     "Soon coming and later.",
+			wantErrs: []error{ErrRefDraft, ErrRefDraft},
+		},
+		{
+			name:     "renders missing reference as text",
+			markdown: "Gone [[ccc333]].",
+			want:     "Gone ccc333.",
+			wantErrs: []error{ErrRefNotFound},
+		},
+		{
+			name:     "skips inline code",
+			markdown: "Write `[[aaa111]]` to get [[aaa111]].",
+			want:     "Write `[[aaa111]]` to get [First Post](/blog/first/).",
+		},
+		{
+			name:     "skips fenced code",
+			markdown: "```\n[[aaa111]]\n```\n[[aaa111]]",
+			want:     "```\n[[aaa111]]\n```\n[First Post](/blog/first/)",
+		},
+		{
+			name:     "leaves regular links",
+			markdown: "[site](https://example.com)",
+			want:     "[site](https://example.com)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := refs.Resolve([]byte(tt.markdown))
+
+			if string(got) != tt.want {
+				t.Errorf("Resolve() = %q, want %q", got, tt.want)
+			}
+			if len(errs) != len(tt.wantErrs) {
+				t.Fatalf("Resolve() errors = %v, want %v", errs, tt.wantErrs)
+			}
+			for i := range errs {
+				if !errors.Is(errs[i], tt.wantErrs[i]) {
+					t.Errorf("error[%d] = %v, want %v", i, errs[i], tt.wantErrs[i])
+				}
+			}
+		})
+	}
+}
+
+func TestContentRefsText(t *testing.T) {
+	refs := NewContentRefs([]Content{{Heading: "First Post", ShortID: "aaa111"}}, "structured")
+
+	markdown := "[a](clio:aaa111#x) [[aaa111]] [[aaa111|this]] [[zzz999]]\n`[[aaa111]]`"
+
+	want := "a First Post this \n`[[aaa111]]`"
+	if got := refs.Text(markdown); got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractRefs(t *testing.T) {
+	markdown := "[a](clio:bbb222) [[aaa111]] [[bbb222|again]]\n`[[ccc333]]`\n[b](https://example.com)"
+
+	got := ExtractRefs(markdown)
+
+	want := []string{"aaa111", "bbb222"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractRefs() = %v, want %v", got, want)
+	}
+}
+
+func TestReference(t *testing.T) {
+	if got := Reference(Content{ShortID: "aaa111"}); got != "[[aaa111]]" {
+		t.Errorf("Reference() = %q, want %q", got, "[[aaa111]]")
+	}
+}
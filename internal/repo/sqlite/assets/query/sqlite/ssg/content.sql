@@ -3,25 +3,31 @@
 
 -- Create
 INSERT INTO content (
-    id, site_id, short_id, user_id, section_id, kind, heading, summary, body, draft, featured, series, series_order, published_at, created_by, updated_by, created_at, updated_at
+    id, site_id, short_id, user_id, section_id, kind, heading, slug, locale, translation_group, summary, body, draft, featured, series_id, series, series_order, published_at, created_by, updated_by, created_at, updated_at
 ) VALUES (
-    :id, :site_id, :short_id, :user_id, :section_id, :kind, :heading, :summary, :body, :draft, :featured, :series, :series_order, :published_at, :created_by, :updated_by, :created_at, :updated_at
+    :id, :site_id, :short_id, :user_id, :section_id, :kind, :heading, :slug, :locale, :translation_group, :summary, :body, :draft, :featured, :series_id, :series, :series_order, :published_at, :created_by, :updated_by, :created_at, :updated_at
 );
 
 -- GetAll
-SELECT id, site_id, user_id, section_id, heading, body, draft, featured, published_at, short_id, created_by, updated_by, created_at, updated_at FROM content;
+SELECT id, site_id, user_id, section_id, heading, slug, locale, translation_group, body, draft, featured, published_at, short_id, created_by, updated_by, created_at, updated_at FROM content;
 
 -- Get
-SELECT id, site_id, user_id, section_id, heading, body, draft, featured, published_at, short_id, created_by, updated_by, created_at, updated_at FROM content WHERE id = :id;
+SELECT id, site_id, user_id, section_id, heading, slug, locale, translation_group, body, draft, featured, published_at, short_id, created_by, updated_by, created_at, updated_at FROM content WHERE id = :id;
 
 -- Update
 UPDATE content SET
     user_id = :user_id,
     section_id = :section_id,
     heading = :heading,
+    slug = :slug,
+    locale = :locale,
+    translation_group = :translation_group,
     body = :body,
     draft = :draft,
     featured = :featured,
+    series_id = :series_id,
+    series = :series,
+    series_order = :series_order,
     published_at = :published_at,
     updated_by = :updated_by,
     updated_at = :updated_at
@@ -32,15 +38,18 @@ DELETE FROM content WHERE id = :id;
 
 -- GetAllContentWithMeta
 SELECT
-    c.id, c.site_id, c.user_id, c.section_id, c.kind, c.heading, c.body, c.draft, c.featured, c.published_at, c.short_id,
+    c.id, c.site_id, c.user_id, c.section_id, c.kind, c.heading, c.slug, c.locale, c.translation_group, c.body, c.draft, c.featured, c.published_at, c.short_id,
+    COALESCE(c.series_id, '') AS series_id, COALESCE(c.series, '') AS series, COALESCE(c.series_order, 0) AS series_order,
     c.created_by, c.updated_by, c.created_at, c.updated_at,
     COALESCE(s.path, '') AS section_path, COALESCE(s.name, '') AS section_name,
     COALESCE(m.id, '') AS meta_id, COALESCE(m.description, '') AS description, COALESCE(m.keywords, '') AS keywords,
     COALESCE(m.robots, '') AS robots, COALESCE(m.canonical_url, '') AS canonical_url, COALESCE(m.sitemap, '') AS sitemap,
     COALESCE(m.table_of_contents, 0) AS table_of_contents, COALESCE(m.share, 0) AS share, COALESCE(m.comments, 0) AS comments,
+    COALESCE(c.summary, '') AS summary, COALESCE(m.summary, '') AS meta_summary, COALESCE(m.excerpt, '') AS meta_excerpt,
     COALESCE(t.id, '') AS tag_id, COALESCE(t.short_id, '') AS tag_short_id, COALESCE(t.name, '') AS tag_name, COALESCE(t.slug, '') AS tag_slug,
     COALESCE(ci.image_id, '') AS content_image_id, COALESCE(ci.is_header, 0) AS is_header,
-    COALESCE(i.file_path, '') AS image_file_path, COALESCE(i.alt_text, '') AS image_alt_text
+    COALESCE(i.file_path, '') AS image_file_path, COALESCE(i.alt_text, '') AS image_alt_text,
+    COALESCE(i.dominant_color, '') AS image_dominant_color, COALESCE(iv.blob_ref, '') AS image_placeholder
 FROM
     content c
 LEFT JOIN
@@ -55,6 +64,8 @@ LEFT JOIN
     content_images ci ON c.id = ci.content_id AND ci.is_header = 1
 LEFT JOIN
     image i ON ci.image_id = i.id
+LEFT JOIN
+    image_variant iv ON i.id = iv.image_id AND iv.kind = 'placeholder'
 WHERE
     c.site_id = ?
 ORDER BY
@@ -62,7 +73,8 @@ ORDER BY
 
 -- GetContentWithPaginationAndSearch
 SELECT
-    c.id, c.site_id, c.user_id, c.section_id, c.kind, c.heading, c.body, c.draft, c.featured, c.published_at, c.short_id,
+    c.id, c.site_id, c.user_id, c.section_id, c.kind, c.heading, c.slug, c.locale, c.translation_group, c.body, c.draft, c.featured, c.published_at, c.short_id,
+    COALESCE(c.series_id, '') AS series_id, COALESCE(c.series, '') AS series, COALESCE(c.series_order, 0) AS series_order,
     c.created_by, c.updated_by, c.created_at, c.updated_at,
     COALESCE(s.path, '') AS section_path, COALESCE(s.name, '') AS section_name,
     COALESCE(m.id, '') AS meta_id, COALESCE(m.description, '') AS description, COALESCE(m.keywords, '') AS keywords,
@@ -70,7 +82,8 @@ SELECT
     COALESCE(m.table_of_contents, 0) AS table_of_contents, COALESCE(m.share, 0) AS share, COALESCE(m.comments, 0) AS comments,
     COALESCE(t.id, '') AS tag_id, COALESCE(t.short_id, '') AS tag_short_id, COALESCE(t.name, '') AS tag_name, COALESCE(t.slug, '') AS tag_slug,
     COALESCE(ci.image_id, '') AS content_image_id, COALESCE(ci.is_header, 0) AS is_header,
-    COALESCE(i.file_path, '') AS image_file_path, COALESCE(i.alt_text, '') AS image_alt_text
+    COALESCE(i.file_path, '') AS image_file_path, COALESCE(i.alt_text, '') AS image_alt_text,
+    COALESCE(i.dominant_color, '') AS image_dominant_color, COALESCE(iv.blob_ref, '') AS image_placeholder
 FROM
     content c
 LEFT JOIN
@@ -85,6 +98,8 @@ LEFT JOIN
     content_images ci ON c.id = ci.content_id AND ci.is_header = 1
 LEFT JOIN
     image i ON ci.image_id = i.id
+LEFT JOIN
+    image_variant iv ON i.id = iv.image_id AND iv.kind = 'placeholder'
 WHERE
     c.site_id = ?
     AND (? = '' OR c.heading LIKE '%' || ? || '%')
@@ -99,3 +114,6 @@ LEFT JOIN section s ON c.section_id = s.id
 WHERE
     c.site_id = ?
     AND (? = '' OR c.heading LIKE '%' || ? || '%');
+
+-- GetContentBySlug
+SELECT id, site_id, user_id, section_id, heading, slug, locale, translation_group, body, draft, featured, published_at, short_id, created_by, updated_by, created_at, updated_at FROM content WHERE site_id = ? AND slug = ?;
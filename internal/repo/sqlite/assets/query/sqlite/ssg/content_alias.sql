@@ -0,0 +1,28 @@
+-- Res: ContentAlias
+-- Table: content_alias
+
+-- Create
+INSERT INTO content_alias (
+    id, site_id, content_id, path, manual, created_at
+) VALUES (
+    ?, ?, ?, ?, ?, ?
+)
+ON CONFLICT(site_id, path) DO UPDATE SET
+    content_id = excluded.content_id,
+    manual = excluded.manual,
+    created_at = excluded.created_at;
+
+-- GetAll
+SELECT id, site_id, content_id, path, manual, created_at
+FROM content_alias
+WHERE site_id = ?
+ORDER BY path;
+
+-- GetByContentID
+SELECT id, site_id, content_id, path, manual, created_at
+FROM content_alias
+WHERE content_id = ?
+ORDER BY path;
+
+-- Delete
+DELETE FROM content_alias WHERE id = ?;
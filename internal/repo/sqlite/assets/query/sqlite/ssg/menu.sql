@@ -0,0 +1,35 @@
+-- Res: Menu
+-- Table: menu
+
+-- Create
+INSERT INTO menu (
+    id, site_id, short_id, name, location, created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :name, :location, :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    id, site_id, COALESCE(short_id, '') AS short_id, name, location,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM menu
+WHERE id = ?;
+
+-- GetAll
+SELECT
+    id, site_id, COALESCE(short_id, '') AS short_id, name, location,
+    COALESCE(created_by, '') AS created_by, COALESCE(updated_by, '') AS updated_by, created_at, updated_at
+FROM menu
+WHERE site_id = ?
+ORDER BY location;
+
+-- Update
+UPDATE menu SET
+    name = :name,
+    location = :location,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM menu WHERE id = ?;
@@ -1,7 +1,7 @@
 
 -- Create
-INSERT INTO section (id, site_id, short_id, name, description, path, layout_id, layout_name, created_by, updated_by, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+INSERT INTO section (id, site_id, short_id, name, description, path, layout_id, layout_name, parent_id, roll_up, created_by, updated_by, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
 
 -- Update
 UPDATE section SET
@@ -9,6 +9,8 @@ UPDATE section SET
     description = :description,
     path = :path,
     layout_id = :layout_id,
+    parent_id = :parent_id,
+    roll_up = :roll_up,
     updated_by = :updated_by,
     updated_at = :updated_at
 WHERE id = :id;
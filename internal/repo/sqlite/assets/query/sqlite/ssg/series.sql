@@ -0,0 +1,57 @@
+-- Res: Series
+-- Table: series
+
+-- Create
+INSERT INTO series (
+    id, site_id, short_id, section_id, name, slug, description, cover_image, status, created_by, updated_by, created_at, updated_at
+) VALUES (
+    :id, :site_id, :short_id, :section_id, :name, :slug, :description, :cover_image, :status, :created_by, :updated_by, :created_at, :updated_at
+);
+
+-- Get
+SELECT
+    sr.id, sr.site_id, COALESCE(sr.short_id, '') AS short_id, COALESCE(sr.section_id, '') AS section_id,
+    sr.name, sr.slug, sr.description, sr.cover_image, sr.status,
+    COALESCE(s.path, '') AS section_path, COALESCE(s.name, '') AS section_name,
+    COALESCE(sr.created_by, '') AS created_by, COALESCE(sr.updated_by, '') AS updated_by, sr.created_at, sr.updated_at
+FROM series sr
+LEFT JOIN section s ON sr.section_id = s.id
+WHERE sr.id = ?;
+
+-- GetAll
+SELECT
+    sr.id, sr.site_id, COALESCE(sr.short_id, '') AS short_id, COALESCE(sr.section_id, '') AS section_id,
+    sr.name, sr.slug, sr.description, sr.cover_image, sr.status,
+    COALESCE(s.path, '') AS section_path, COALESCE(s.name, '') AS section_name,
+    COALESCE(sr.created_by, '') AS created_by, COALESCE(sr.updated_by, '') AS updated_by, sr.created_at, sr.updated_at
+FROM series sr
+LEFT JOIN section s ON sr.section_id = s.id
+WHERE sr.site_id = ?
+ORDER BY sr.name;
+
+-- Update
+UPDATE series SET
+    section_id = :section_id,
+    name = :name,
+    slug = :slug,
+    description = :description,
+    cover_image = :cover_image,
+    status = :status,
+    updated_by = :updated_by,
+    updated_at = :updated_at
+WHERE id = :id;
+
+-- Delete
+DELETE FROM series WHERE id = ?;
+
+-- Res: SeriesPart
+-- Table: content
+
+-- RenameParts
+UPDATE content SET series = ? WHERE series_id = ?;
+
+-- DetachParts
+UPDATE content SET series_id = '', series = '', series_order = 0 WHERE series_id = ?;
+
+-- UpdatePartOrder
+UPDATE content SET series_order = ? WHERE id = ? AND series_id = ?;
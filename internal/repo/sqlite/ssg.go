@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/jmoiron/sqlx"
 )
 
 var (
@@ -23,6 +24,15 @@ var (
 	resParam        = "param"
 	resImage        = "image"
 	resImageVariant = "image_variant"
+	resSeries       = "series"
+	resMenu         = "menu"
+	resMenuItem     = "menu_item"
+	resAuthor       = "author"
+	resContentType  = "content_type"
+	resContentField = "content_field"
+	resArchetype    = "archetype"
+	resContentAlias = "content_alias"
+	resComment      = "comment"
 )
 
 // sanitizeURLPath sanitizes a file path for safe use in URLs
@@ -104,6 +114,31 @@ func (repo *ClioRepo) GetContent(ctx context.Context, id uuid.UUID) (ssg.Content
 	return ssg.Content{}, errors.New("content not found")
 }
 
+// GetContentBySlug returns the content of the current site using slug.
+// sql.ErrNoRows is returned when there is none.
+func (repo *ClioRepo) GetContentBySlug(ctx context.Context, slug string) (ssg.Content, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return ssg.Content{}, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContent, "GetContentBySlug")
+	if err != nil {
+		return ssg.Content{}, fmt.Errorf("cannot get content by slug query: %w", err)
+	}
+
+	var c ssg.Content
+	err = repo.db.GetContext(ctx, &c, query, siteID, slug)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Content{}, err
+		}
+		return ssg.Content{}, fmt.Errorf("cannot get content by slug: %w", err)
+	}
+
+	return c, nil
+}
+
 func (repo *ClioRepo) UpdateContent(ctx context.Context, c *ssg.Content) (err error) {
 	tx, err := repo.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -119,6 +154,33 @@ func (repo *ClioRepo) UpdateContent(ctx context.Context, c *ssg.Content) (err er
 		err = tx.Commit()
 	}()
 
+	return repo.updateContent(ctx, tx, c)
+}
+
+// MoveContent updates the content of move and records and drops its aliases
+// in one transaction.
+func (repo *ClioRepo) MoveContent(ctx context.Context, move ssg.ContentMove) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if err = repo.updateContent(ctx, tx, move.Content); err != nil {
+		return err
+	}
+	return repo.moveContentAliases(ctx, tx, move.Aliases, move.ClearedAliases)
+}
+
+func (repo *ClioRepo) updateContent(ctx context.Context, tx *sqlx.Tx, c *ssg.Content) error {
 	// Update Content
 	contentQuery, err := repo.BaseRepo.Query().Get(featSSG, resContent, "Update")
 	if err != nil {
@@ -140,6 +202,33 @@ func (repo *ClioRepo) UpdateContent(ctx context.Context, c *ssg.Content) (err er
 	return nil
 }
 
+// moveContentAliases records aliases and drops the cleared ones within tx.
+func (repo *ClioRepo) moveContentAliases(ctx context.Context, tx *sqlx.Tx, aliases []*ssg.ContentAlias, cleared []uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentAlias, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create content alias query: %w", err)
+	}
+	for _, alias := range aliases {
+		if _, err := tx.ExecContext(ctx, query,
+			alias.ID, alias.SiteID, alias.ContentID, alias.Path, alias.Manual, alias.CreatedAt,
+		); err != nil {
+			return fmt.Errorf("cannot record content alias: %w", err)
+		}
+	}
+
+	query, err = repo.BaseRepo.Query().Get(featSSG, resContentAlias, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete content alias query: %w", err)
+	}
+	for _, id := range cleared {
+		if _, err := tx.ExecContext(ctx, query, id); err != nil {
+			return fmt.Errorf("cannot delete content alias: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (repo *ClioRepo) DeleteContent(ctx context.Context, id uuid.UUID) error {
 	query, err := repo.BaseRepo.Query().Get(featSSG, resContent, "Delete")
 	if err != nil {
@@ -180,18 +269,23 @@ func (repo *ClioRepo) GetAllContentWithMeta(ctx context.Context) ([]ssg.Content,
 		var metaID sql.NullString
 		var description, keywords, robots, canonicalURL, sitemap sql.NullString
 		var tableOfContents, share, comments sql.NullBool
+		var summary, metaSummary, metaExcerpt sql.NullString
 
 		var tagID, tagShortID, tagName, tagSlug sql.NullString
 		var contentImageID, imageFilePath, imageAltText sql.NullString
+		var imageDominantColor, imagePlaceholder sql.NullString
 		var isHeader sql.NullBool
 
 		err := rows.Scan(
-			&c.ID, &c.SiteID, &c.UserID, &c.SectionID, &c.Kind, &c.Heading, &c.Body, &c.Draft, &c.Featured, &publishedAt, &c.ShortID,
+			&c.ID, &c.SiteID, &c.UserID, &c.SectionID, &c.Kind, &c.Heading, &c.SlugField, &c.Locale, &c.TranslationGroup, &c.Body, &c.Draft, &c.Featured, &publishedAt, &c.ShortID,
+			&c.SeriesID, &c.Series, &c.SeriesOrder,
 			&c.CreatedBy, &c.UpdatedBy, &c.CreatedAt, &c.UpdatedAt,
 			&sectionPath, &sectionName,
 			&metaID, &description, &keywords, &robots, &canonicalURL, &sitemap, &tableOfContents, &share, &comments,
+			&summary, &metaSummary, &metaExcerpt,
 			&tagID, &tagShortID, &tagName, &tagSlug,
 			&contentImageID, &isHeader, &imageFilePath, &imageAltText,
+			&imageDominantColor, &imagePlaceholder,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("error scanning row: %w", err)
@@ -200,6 +294,7 @@ func (repo *ClioRepo) GetAllContentWithMeta(ctx context.Context) ([]ssg.Content,
 		if _, ok := contentMap[c.ID]; !ok {
 			c.SectionPath = sectionPath.String
 			c.SectionName = sectionName.String
+			c.Summary = summary.String
 			if publishedAt.Valid {
 				c.PublishedAt = &publishedAt.Time
 			}
@@ -208,6 +303,8 @@ func (repo *ClioRepo) GetAllContentWithMeta(ctx context.Context) ([]ssg.Content,
 				m.ID, _ = uuid.Parse(metaID.String)
 				m.ContentID = c.ID
 				m.Description = description.String
+				m.Summary = metaSummary.String
+				m.Excerpt = metaExcerpt.String
 				m.Keywords = keywords.String
 				m.Robots = robots.String
 				m.CanonicalURL = canonicalURL.String
@@ -228,6 +325,8 @@ func (repo *ClioRepo) GetAllContentWithMeta(ctx context.Context) ([]ssg.Content,
 			if isHeader.Valid && isHeader.Bool {
 				contentMap[c.ID].HeaderImageURL = "/static/images/" + sanitizedPath
 				contentMap[c.ID].HeaderImageAlt = imageAltText.String
+				contentMap[c.ID].HeaderImageColor = imageDominantColor.String
+				contentMap[c.ID].HeaderImagePlaceholder = imagePlaceholder.String
 			}
 		}
 
@@ -293,15 +392,18 @@ func (repo *ClioRepo) GetContentWithPaginationAndSearch(ctx context.Context, off
 
 		var tagID, tagShortID, tagName, tagSlug sql.NullString
 		var contentImageID, imageFilePath, imageAltText sql.NullString
+		var imageDominantColor, imagePlaceholder sql.NullString
 		var isHeader sql.NullBool
 
 		err := rows.Scan(
-			&c.ID, &c.SiteID, &c.UserID, &c.SectionID, &c.Kind, &c.Heading, &c.Body, &c.Draft, &c.Featured, &publishedAt, &c.ShortID,
+			&c.ID, &c.SiteID, &c.UserID, &c.SectionID, &c.Kind, &c.Heading, &c.SlugField, &c.Locale, &c.TranslationGroup, &c.Body, &c.Draft, &c.Featured, &publishedAt, &c.ShortID,
+			&c.SeriesID, &c.Series, &c.SeriesOrder,
 			&c.CreatedBy, &c.UpdatedBy, &c.CreatedAt, &c.UpdatedAt,
 			&sectionPath, &sectionName,
 			&metaID, &description, &keywords, &robots, &canonicalURL, &sitemap, &tableOfContents, &share, &comments,
 			&tagID, &tagShortID, &tagName, &tagSlug,
 			&contentImageID, &isHeader, &imageFilePath, &imageAltText,
+			&imageDominantColor, &imagePlaceholder,
 		)
 		if err != nil {
 			return nil, 0, fmt.Errorf("error scanning row: %w", err)
@@ -338,6 +440,8 @@ func (repo *ClioRepo) GetContentWithPaginationAndSearch(ctx context.Context, off
 			if isHeader.Valid && isHeader.Bool {
 				contentMap[c.ID].HeaderImageURL = imageURL
 				contentMap[c.ID].HeaderImageAlt = imageAltText.String
+				contentMap[c.ID].HeaderImageColor = imageDominantColor.String
+				contentMap[c.ID].HeaderImagePlaceholder = imagePlaceholder.String
 			}
 		}
 
@@ -375,6 +479,8 @@ func (repo *ClioRepo) CreateSection(ctx context.Context, section ssg.Section) er
 		section.Path,
 		section.LayoutID,
 		section.LayoutName,
+		section.ParentID,
+		section.RollUp,
 		section.GetCreatedBy(),
 		section.GetUpdatedBy(),
 		section.GetCreatedAt(),
@@ -401,6 +507,7 @@ func (repo *ClioRepo) GetSections(ctx context.Context) ([]ssg.Section, error) {
 		err := rows.Scan(
 			&s.ID, &s.SiteID, &s.ShortID, &s.Name, &s.Description, &s.Path, &s.LayoutID, &layoutNameTable,
 			&s.CreatedBy, &s.UpdatedBy, &s.CreatedAt, &s.UpdatedAt,
+			&s.ParentID, &s.RollUp,
 			&layoutNameJoin,
 		)
 		if err != nil {
@@ -432,13 +539,15 @@ func (repo *ClioRepo) GetSection(ctx context.Context, id uuid.UUID) (ssg.Section
 		updatedBy       uuid.UUID
 		createdAt       time.Time
 		updatedAt       time.Time
+		parentID        uuid.UUID
+		rollUp          bool
 		layoutNameTable sql.NullString
 		layoutNameJoin  sql.NullString
 	)
 
 	err = row.Scan(
 		&sectionID, &siteID, &shortID, &name, &description, &path, &layoutID, &layoutNameTable,
-		&createdBy, &updatedBy, &createdAt, &updatedAt, &layoutNameJoin,
+		&createdBy, &updatedBy, &createdAt, &updatedAt, &parentID, &rollUp, &layoutNameJoin,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -451,6 +560,8 @@ func (repo *ClioRepo) GetSection(ctx context.Context, id uuid.UUID) (ssg.Section
 	section.SetID(sectionID)
 	section.SiteID = siteID
 	section.LayoutName = layoutNameJoin.String
+	section.ParentID = parentID
+	section.RollUp = rollUp
 	section.SetShortID(shortID)
 	section.SetCreatedBy(createdBy)
 	section.SetUpdatedBy(updatedBy)
@@ -470,6 +581,38 @@ func (repo *ClioRepo) UpdateSection(ctx context.Context, section ssg.Section) er
 	return err
 }
 
+// MoveSections stores the new paths of the moved sections and the alias
+// changes of their content in one transaction, so a failed move leaves the
+// tree and its redirects as they were.
+func (repo *ClioRepo) MoveSections(ctx context.Context, move ssg.SectionMove) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSection, "Update")
+	if err != nil {
+		return err
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	for _, section := range move.Sections {
+		if _, err = tx.NamedExecContext(ctx, query, section); err != nil {
+			return fmt.Errorf("cannot update section %s: %w", section.Path, err)
+		}
+	}
+
+	return repo.moveContentAliases(ctx, tx, move.Aliases, move.ClearedAliases)
+}
+
 func (repo *ClioRepo) DeleteSection(ctx context.Context, id uuid.UUID) error {
 	query, err := repo.BaseRepo.Query().Get(featSSG, resSection, "Delete")
 	if err != nil {
@@ -682,106 +825,97 @@ func (repo *ClioRepo) DeleteTag(ctx context.Context, id uuid.UUID) error {
 	return err
 }
 
-// Param related
+// Series related
 
-func (repo *ClioRepo) CreateParam(ctx context.Context, p *ssg.Param) (err error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Create")
+func (repo *ClioRepo) CreateSeries(ctx context.Context, series ssg.Series) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "Create")
 	if err != nil {
-		return fmt.Errorf("cannot get create param query: %w", err)
-	}
-	if _, err = repo.db.NamedExecContext(ctx, query, p); err != nil {
-		return fmt.Errorf("cannot create param: %w", err)
+		return err
 	}
-	return nil
+
+	_, err = repo.db.NamedExecContext(ctx, query, series)
+	return err
 }
 
-func (repo *ClioRepo) GetParam(ctx context.Context, id uuid.UUID) (ssg.Param, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Get")
+func (repo *ClioRepo) GetSeries(ctx context.Context, id uuid.UUID) (ssg.Series, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "Get")
 	if err != nil {
-		return ssg.Param{}, fmt.Errorf("cannot get get param query: %w", err)
+		return ssg.Series{}, err
 	}
-	var param ssg.Param
-	err = repo.db.GetContext(ctx, &param, query, id)
+
+	var series ssg.Series
+	err = repo.db.GetContext(ctx, &series, query, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Param{}, errors.New("param not found")
-		}
-		return ssg.Param{}, fmt.Errorf("cannot get param: %w", err)
+		return ssg.Series{}, err
 	}
-	return param, nil
+
+	return series, nil
 }
 
-func (repo *ClioRepo) GetParamByName(ctx context.Context, name string) (ssg.Param, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "GetByName")
-	if err != nil {
-		return ssg.Param{}, fmt.Errorf("cannot get get param by name query: %w", err)
+func (repo *ClioRepo) GetAllSeries(ctx context.Context) ([]ssg.Series, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
 	}
-	var param ssg.Param
-	err = repo.db.GetContext(ctx, &param, query, name)
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "GetAll")
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Param{}, errors.New("param not found")
-		}
-		return ssg.Param{}, fmt.Errorf("cannot get param by name: %w", err)
+		return nil, err
 	}
-	return param, nil
+
+	var series []ssg.Series
+	err = repo.db.SelectContext(ctx, &series, query, siteID)
+	return series, err
 }
 
-func (repo *ClioRepo) GetParamByRefKey(ctx context.Context, refKey string) (ssg.Param, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "GetByRefKey")
+// UpdateSeries stores series and renames the series of its parts to the
+// current slug.
+func (repo *ClioRepo) UpdateSeries(ctx context.Context, series ssg.Series) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "Update")
 	if err != nil {
-		return ssg.Param{}, fmt.Errorf("cannot get get param by ref key query: %w", err)
+		return fmt.Errorf("cannot get update series query: %w", err)
 	}
-	var param ssg.Param
-	err = repo.db.GetContext(ctx, &param, query, refKey)
+	partsQuery, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "RenameParts")
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Param{}, errors.New("param not found")
-		}
-		return ssg.Param{}, fmt.Errorf("cannot get param by ref key: %w", err)
+		return fmt.Errorf("cannot get rename series parts query: %w", err)
 	}
-	return param, nil
-}
 
-func (repo *ClioRepo) ListParams(ctx context.Context) ([]ssg.Param, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "List")
-	if err != nil {
-		return nil, fmt.Errorf("cannot get list params query: %w", err)
-	}
-	var params []ssg.Param
-	err = repo.db.SelectContext(ctx, &params, query)
+	tx, err := repo.db.BeginTxx(ctx, nil)
 	if err != nil {
-		return nil, fmt.Errorf("cannot list params: %w", err)
+		return fmt.Errorf("cannot begin transaction: %w", err)
 	}
-	return params, nil
-}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
 
-func (repo *ClioRepo) UpdateParam(ctx context.Context, p *ssg.Param) (err error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Update")
-	if err != nil {
-		return fmt.Errorf("cannot get update param query: %w", err)
+	if _, err = tx.NamedExecContext(ctx, query, series); err != nil {
+		return fmt.Errorf("cannot update series: %w", err)
 	}
-	if _, err = repo.db.NamedExecContext(ctx, query, p); err != nil {
-		return fmt.Errorf("cannot update param: %w", err)
+
+	if _, err = tx.ExecContext(ctx, partsQuery, series.Slug(), series.ID); err != nil {
+		return fmt.Errorf("cannot update series parts: %w", err)
 	}
+
 	return nil
 }
 
-func (repo *ClioRepo) DeleteParam(ctx context.Context, id uuid.UUID) error {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Delete")
+// DeleteSeries removes a series. Its parts are detached, not deleted.
+func (repo *ClioRepo) DeleteSeries(ctx context.Context, id uuid.UUID) (err error) {
+	partsQuery, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "DetachParts")
 	if err != nil {
-		return fmt.Errorf("cannot get delete param query: %w", err)
+		return fmt.Errorf("cannot get detach series parts query: %w", err)
 	}
-	_, err = repo.db.ExecContext(ctx, query, id)
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "Delete")
 	if err != nil {
-		return fmt.Errorf("cannot delete param: %w", err)
+		return fmt.Errorf("cannot get delete series query: %w", err)
 	}
-	return nil
-}
-
-// Image related
 
-func (repo *ClioRepo) CreateImage(ctx context.Context, img *ssg.Image) (err error) {
 	tx, err := repo.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("cannot begin transaction: %w", err)
@@ -796,87 +930,112 @@ func (repo *ClioRepo) CreateImage(ctx context.Context, img *ssg.Image) (err erro
 		err = tx.Commit()
 	}()
 
-	imageQuery, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Create")
-	if err != nil {
-		return fmt.Errorf("cannot get create image query: %w", err)
+	if _, err = tx.ExecContext(ctx, partsQuery, id); err != nil {
+		return fmt.Errorf("cannot detach series parts: %w", err)
 	}
-	if _, err = tx.NamedExecContext(ctx, imageQuery, img); err != nil {
-		return fmt.Errorf("cannot create image: %w", err)
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete series: %w", err)
 	}
 
 	return nil
 }
 
-func (repo *ClioRepo) GetImage(ctx context.Context, id uuid.UUID) (ssg.Image, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Get")
+// UpdateSeriesOrder numbers the parts of a series from 1 following the order
+// of contentIDs.
+func (repo *ClioRepo) UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resSeries, "UpdatePartOrder")
 	if err != nil {
-		return ssg.Image{}, fmt.Errorf("cannot get image query: %w", err)
+		return fmt.Errorf("cannot get update series order query: %w", err)
 	}
 
-	var img ssg.Image
-	err = repo.db.GetContext(ctx, &img, query, id)
+	tx, err := repo.db.BeginTxx(ctx, nil)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Image{}, errors.New("image not found")
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	for i, id := range contentIDs {
+		if _, err = tx.ExecContext(ctx, query, i+1, id, seriesID); err != nil {
+			return fmt.Errorf("cannot update series order: %w", err)
 		}
-		return ssg.Image{}, fmt.Errorf("cannot get image: %w", err)
 	}
 
-	return img, nil
+	return nil
 }
 
-func (repo *ClioRepo) GetImageByShortID(ctx context.Context, shortID string) (ssg.Image, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "GetImageByShortID")
-	if err != nil {
-		return ssg.Image{}, fmt.Errorf("cannot get image by short ID query: %w", err)
-	}
+// Menu related
 
-	var img ssg.Image
-	err = repo.db.GetContext(ctx, &img, query, shortID)
+func (repo *ClioRepo) CreateMenu(ctx context.Context, menu ssg.Menu) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenu, "Create")
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Image{}, errors.New("image not found")
-		}
-		return ssg.Image{}, fmt.Errorf("cannot get image by short ID: %w", err)
+		return err
 	}
 
-	return img, nil
+	_, err = repo.db.NamedExecContext(ctx, query, menu)
+	return err
 }
 
-func (repo *ClioRepo) GetImageByContentHash(ctx context.Context, contentHash string) (ssg.Image, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "GetImageByContentHash")
+func (repo *ClioRepo) GetMenu(ctx context.Context, id uuid.UUID) (ssg.Menu, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenu, "Get")
 	if err != nil {
-		return ssg.Image{}, fmt.Errorf("cannot get image by content hash query: %w", err)
+		return ssg.Menu{}, err
 	}
 
-	var img ssg.Image
-	err = repo.db.GetContext(ctx, &img, query, contentHash)
+	var menu ssg.Menu
+	err = repo.db.GetContext(ctx, &menu, query, id)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return ssg.Image{}, errors.New("image not found")
-		}
-		return ssg.Image{}, fmt.Errorf("cannot get image by content hash: %w", err)
+		return ssg.Menu{}, err
 	}
 
-	return img, nil
+	return menu, nil
 }
 
-func (repo *ClioRepo) ListImages(ctx context.Context) ([]ssg.Image, error) {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "List")
+func (repo *ClioRepo) GetMenus(ctx context.Context) ([]ssg.Menu, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenu, "GetAll")
 	if err != nil {
-		return nil, fmt.Errorf("cannot get list images query: %w", err)
+		return nil, err
 	}
 
-	var images []ssg.Image
-	err = repo.db.SelectContext(ctx, &images, query)
+	var menus []ssg.Menu
+	err = repo.db.SelectContext(ctx, &menus, query, siteID)
+	return menus, err
+}
+
+func (repo *ClioRepo) UpdateMenu(ctx context.Context, menu ssg.Menu) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenu, "Update")
 	if err != nil {
-		return nil, fmt.Errorf("cannot list images: %w", err)
+		return err
 	}
 
-	return images, nil
+	_, err = repo.db.NamedExecContext(ctx, query, menu)
+	return err
 }
 
-func (repo *ClioRepo) UpdateImage(ctx context.Context, img *ssg.Image) (err error) {
+// DeleteMenu removes a menu along with its items.
+func (repo *ClioRepo) DeleteMenu(ctx context.Context, id uuid.UUID) (err error) {
+	itemsQuery, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "DeleteByMenu")
+	if err != nil {
+		return fmt.Errorf("cannot get delete menu items query: %w", err)
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenu, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete menu query: %w", err)
+	}
+
 	tx, err := repo.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("cannot begin transaction: %w", err)
@@ -891,30 +1050,964 @@ func (repo *ClioRepo) UpdateImage(ctx context.Context, img *ssg.Image) (err erro
 		err = tx.Commit()
 	}()
 
-	imageQuery, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Update")
-	if err != nil {
-		return fmt.Errorf("cannot get update image query: %w", err)
+	if _, err = tx.ExecContext(ctx, itemsQuery, id); err != nil {
+		return fmt.Errorf("cannot delete menu items: %w", err)
 	}
-	if _, err = tx.NamedExecContext(ctx, imageQuery, img); err != nil {
-		return fmt.Errorf("cannot update image: %w", err)
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete menu: %w", err)
 	}
 
 	return nil
 }
 
-func (repo *ClioRepo) DeleteImage(ctx context.Context, id uuid.UUID) error {
-	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Delete")
+func (repo *ClioRepo) CreateMenuItem(ctx context.Context, item ssg.MenuItem) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "Create")
 	if err != nil {
-		return fmt.Errorf("cannot get delete image query: %w", err)
+		return err
 	}
-	_, err = repo.db.ExecContext(ctx, query, id)
+
+	_, err = repo.db.NamedExecContext(ctx, query, item)
+	return err
+}
+
+func (repo *ClioRepo) GetMenuItem(ctx context.Context, id uuid.UUID) (ssg.MenuItem, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "Get")
 	if err != nil {
-		return fmt.Errorf("cannot delete image: %w", err)
+		return ssg.MenuItem{}, err
 	}
-	return nil
-}
 
-// ImageVariant related
+	var item ssg.MenuItem
+	err = repo.db.GetContext(ctx, &item, query, id)
+	if err != nil {
+		return ssg.MenuItem{}, err
+	}
+
+	return item, nil
+}
+
+// GetMenuItems returns the items of a menu, each top level item followed by
+// its children.
+func (repo *ClioRepo) GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]ssg.MenuItem, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "GetByMenu")
+	if err != nil {
+		return nil, err
+	}
+
+	var items []ssg.MenuItem
+	if err := repo.db.SelectContext(ctx, &items, query, menuID); err != nil {
+		return nil, err
+	}
+
+	return ssg.SortMenuItems(items), nil
+}
+
+func (repo *ClioRepo) UpdateMenuItem(ctx context.Context, item ssg.MenuItem) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "Update")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.NamedExecContext(ctx, query, item)
+	return err
+}
+
+// DeleteMenuItem removes a menu item along with its children.
+func (repo *ClioRepo) DeleteMenuItem(ctx context.Context, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resMenuItem, "Delete")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.ExecContext(ctx, query, id, id)
+	return err
+}
+
+// Author related
+
+func (repo *ClioRepo) CreateAuthor(ctx context.Context, author ssg.Author) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "Create")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.NamedExecContext(ctx, query, author)
+	return err
+}
+
+func (repo *ClioRepo) GetAuthor(ctx context.Context, id uuid.UUID) (ssg.Author, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "Get")
+	if err != nil {
+		return ssg.Author{}, err
+	}
+
+	var author ssg.Author
+	err = repo.db.GetContext(ctx, &author, query, id)
+	if err != nil {
+		return ssg.Author{}, err
+	}
+
+	return author, nil
+}
+
+func (repo *ClioRepo) GetAllAuthors(ctx context.Context) ([]ssg.Author, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	var authors []ssg.Author
+	err = repo.db.SelectContext(ctx, &authors, query, siteID)
+	return authors, err
+}
+
+func (repo *ClioRepo) UpdateAuthor(ctx context.Context, author ssg.Author) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "Update")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.NamedExecContext(ctx, query, author)
+	return err
+}
+
+// DeleteAuthor removes an author along with its attributions.
+func (repo *ClioRepo) DeleteAuthor(ctx context.Context, id uuid.UUID) (err error) {
+	attributionsQuery, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "DeleteAuthorAttributions")
+	if err != nil {
+		return fmt.Errorf("cannot get delete author attributions query: %w", err)
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete author query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.ExecContext(ctx, attributionsQuery, id); err != nil {
+		return fmt.Errorf("cannot delete author attributions: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete author: %w", err)
+	}
+
+	return nil
+}
+
+// GetAuthorsForContent returns the authors content is attributed to, in
+// their position order.
+func (repo *ClioRepo) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]ssg.Author, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "GetAuthorsForContent")
+	if err != nil {
+		return nil, err
+	}
+
+	var authors []ssg.Author
+	err = repo.db.SelectContext(ctx, &authors, query, contentID)
+	return authors, err
+}
+
+// GetContentAuthors returns the attributions of the content of the site.
+func (repo *ClioRepo) GetContentAuthors(ctx context.Context) ([]ssg.ContentAuthor, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "GetContentAuthors")
+	if err != nil {
+		return nil, err
+	}
+
+	var links []ssg.ContentAuthor
+	err = repo.db.SelectContext(ctx, &links, query, siteID)
+	return links, err
+}
+
+// SetContentAuthors attributes content to the authors of authorIDs,
+// positioned from 1 in that order, replacing its previous authors.
+func (repo *ClioRepo) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) (err error) {
+	clearQuery, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "ClearContentAuthors")
+	if err != nil {
+		return fmt.Errorf("cannot get clear content authors query: %w", err)
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resAuthor, "AddAuthorToContent")
+	if err != nil {
+		return fmt.Errorf("cannot get add content author query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.ExecContext(ctx, clearQuery, contentID); err != nil {
+		return fmt.Errorf("cannot clear content authors: %w", err)
+	}
+
+	for i, id := range authorIDs {
+		if _, err = tx.ExecContext(ctx, query, contentID, id, i+1); err != nil {
+			return fmt.Errorf("cannot add content author: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Content type related
+
+// CreateContentType stores a content type along with its fields and
+// allowed sections.
+func (repo *ClioRepo) CreateContentType(ctx context.Context, contentType ssg.ContentType) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create content type query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.NamedExecContext(ctx, query, contentType); err != nil {
+		return fmt.Errorf("cannot create content type: %w", err)
+	}
+
+	return repo.insertContentTypeSchema(ctx, tx, contentType)
+}
+
+func (repo *ClioRepo) GetContentType(ctx context.Context, id uuid.UUID) (ssg.ContentType, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "Get")
+	if err != nil {
+		return ssg.ContentType{}, err
+	}
+
+	var contentType ssg.ContentType
+	err = repo.db.GetContext(ctx, &contentType, query, id)
+	if err != nil {
+		return ssg.ContentType{}, err
+	}
+
+	types := []ssg.ContentType{contentType}
+	if err := repo.loadContentTypeSchemas(ctx, types); err != nil {
+		return ssg.ContentType{}, err
+	}
+	return types[0], nil
+}
+
+// GetContentTypes returns the content types of the site with their fields
+// and allowed sections.
+func (repo *ClioRepo) GetContentTypes(ctx context.Context) ([]ssg.ContentType, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	var types []ssg.ContentType
+	if err := repo.db.SelectContext(ctx, &types, query, siteID); err != nil {
+		return nil, err
+	}
+
+	if err := repo.loadContentTypeSchemas(ctx, types); err != nil {
+		return nil, err
+	}
+	return types, nil
+}
+
+// UpdateContentType stores a content type, replacing its fields and allowed
+// sections.
+func (repo *ClioRepo) UpdateContentType(ctx context.Context, contentType ssg.ContentType) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "Update")
+	if err != nil {
+		return fmt.Errorf("cannot get update content type query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.NamedExecContext(ctx, query, contentType); err != nil {
+		return fmt.Errorf("cannot update content type: %w", err)
+	}
+
+	if err = repo.deleteContentTypeSchema(ctx, tx, contentType.ID); err != nil {
+		return err
+	}
+	return repo.insertContentTypeSchema(ctx, tx, contentType)
+}
+
+// DeleteContentType removes a content type along with its fields and
+// allowed sections. The field values of its content are kept.
+func (repo *ClioRepo) DeleteContentType(ctx context.Context, id uuid.UUID) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete content type query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if err = repo.deleteContentTypeSchema(ctx, tx, id); err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete content type: %w", err)
+	}
+
+	return nil
+}
+
+// loadContentTypeSchemas sets the fields, in their position order, and the
+// allowed sections of types.
+func (repo *ClioRepo) loadContentTypeSchemas(ctx context.Context, types []ssg.ContentType) error {
+	if len(types) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(types))
+	byID := make(map[uuid.UUID]*ssg.ContentType, len(types))
+	for i := range types {
+		ids[i] = types[i].ID
+		byID[types[i].ID] = &types[i]
+		types[i].Fields = []ssg.FieldDef{}
+		types[i].SectionIDs = []uuid.UUID{}
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "GetFields")
+	if err != nil {
+		return fmt.Errorf("cannot get content type fields query: %w", err)
+	}
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return fmt.Errorf("cannot expand content type fields query: %w", err)
+	}
+	var fields []ssg.FieldDef
+	if err := repo.db.SelectContext(ctx, &fields, repo.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("cannot get content type fields: %w", err)
+	}
+	for _, f := range fields {
+		ct := byID[f.ContentTypeID]
+		ct.Fields = append(ct.Fields, f)
+	}
+
+	query, err = repo.BaseRepo.Query().Get(featSSG, resContentType, "GetSections")
+	if err != nil {
+		return fmt.Errorf("cannot get content type sections query: %w", err)
+	}
+	query, args, err = sqlx.In(query, ids)
+	if err != nil {
+		return fmt.Errorf("cannot expand content type sections query: %w", err)
+	}
+	var sections []ssg.ContentTypeSection
+	if err := repo.db.SelectContext(ctx, &sections, repo.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("cannot get content type sections: %w", err)
+	}
+	for _, s := range sections {
+		ct := byID[s.ContentTypeID]
+		ct.SectionIDs = append(ct.SectionIDs, s.SectionID)
+	}
+
+	return nil
+}
+
+func (repo *ClioRepo) insertContentTypeSchema(ctx context.Context, tx *sqlx.Tx, contentType ssg.ContentType) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "AddField")
+	if err != nil {
+		return fmt.Errorf("cannot get add content type field query: %w", err)
+	}
+	for _, f := range contentType.Fields {
+		if _, err := tx.ExecContext(ctx, query, contentType.ID, f.Name, f.Label, f.Type, f.Required, f.Position); err != nil {
+			return fmt.Errorf("cannot add content type field: %w", err)
+		}
+	}
+
+	query, err = repo.BaseRepo.Query().Get(featSSG, resContentType, "AddSection")
+	if err != nil {
+		return fmt.Errorf("cannot get add content type section query: %w", err)
+	}
+	for _, id := range contentType.SectionIDs {
+		if _, err := tx.ExecContext(ctx, query, contentType.ID, id); err != nil {
+			return fmt.Errorf("cannot add content type section: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (repo *ClioRepo) deleteContentTypeSchema(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentType, "ClearFields")
+	if err != nil {
+		return fmt.Errorf("cannot get clear content type fields query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot clear content type fields: %w", err)
+	}
+
+	query, err = repo.BaseRepo.Query().Get(featSSG, resContentType, "ClearSections")
+	if err != nil {
+		return fmt.Errorf("cannot get clear content type sections query: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot clear content type sections: %w", err)
+	}
+	return nil
+}
+
+// GetFieldsForContent returns the custom field values of content.
+func (repo *ClioRepo) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentField, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentField, "GetFieldsForContent")
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []ssg.ContentField
+	err = repo.db.SelectContext(ctx, &fields, query, contentID)
+	return fields, err
+}
+
+// GetContentFields returns the custom field values of the content of the
+// site.
+func (repo *ClioRepo) GetContentFields(ctx context.Context) ([]ssg.ContentField, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentField, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []ssg.ContentField
+	err = repo.db.SelectContext(ctx, &fields, query, siteID)
+	return fields, err
+}
+
+// SetContentFields replaces the custom field values of content with fields.
+func (repo *ClioRepo) SetContentFields(ctx context.Context, contentID uuid.UUID, fields []ssg.ContentField) (err error) {
+	clearQuery, err := repo.BaseRepo.Query().Get(featSSG, resContentField, "DeleteForContent")
+	if err != nil {
+		return fmt.Errorf("cannot get clear content fields query: %w", err)
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentField, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get add content field query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.ExecContext(ctx, clearQuery, contentID); err != nil {
+		return fmt.Errorf("cannot clear content fields: %w", err)
+	}
+
+	for _, f := range fields {
+		if _, err = tx.ExecContext(ctx, query, contentID, f.Name, f.Value); err != nil {
+			return fmt.Errorf("cannot add content field: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Archetype related
+
+// CreateArchetype stores an archetype along with its default field values.
+func (repo *ClioRepo) CreateArchetype(ctx context.Context, archetype ssg.Archetype) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create archetype query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.NamedExecContext(ctx, query, archetype); err != nil {
+		return fmt.Errorf("cannot create archetype: %w", err)
+	}
+
+	return repo.insertArchetypeFields(ctx, tx, archetype)
+}
+
+func (repo *ClioRepo) GetArchetype(ctx context.Context, id uuid.UUID) (ssg.Archetype, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "Get")
+	if err != nil {
+		return ssg.Archetype{}, err
+	}
+
+	var archetype ssg.Archetype
+	err = repo.db.GetContext(ctx, &archetype, query, id)
+	if err != nil {
+		return ssg.Archetype{}, err
+	}
+
+	archetypes := []ssg.Archetype{archetype}
+	if err := repo.loadArchetypeFields(ctx, archetypes); err != nil {
+		return ssg.Archetype{}, err
+	}
+	return archetypes[0], nil
+}
+
+// GetArchetypes returns the archetypes of the site with their default field
+// values.
+func (repo *ClioRepo) GetArchetypes(ctx context.Context) ([]ssg.Archetype, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	var archetypes []ssg.Archetype
+	if err := repo.db.SelectContext(ctx, &archetypes, query, siteID); err != nil {
+		return nil, err
+	}
+
+	if err := repo.loadArchetypeFields(ctx, archetypes); err != nil {
+		return nil, err
+	}
+	return archetypes, nil
+}
+
+// UpdateArchetype stores an archetype, replacing its default field values.
+func (repo *ClioRepo) UpdateArchetype(ctx context.Context, archetype ssg.Archetype) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "Update")
+	if err != nil {
+		return fmt.Errorf("cannot get update archetype query: %w", err)
+	}
+	clearQuery, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "ClearFields")
+	if err != nil {
+		return fmt.Errorf("cannot get clear archetype fields query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.NamedExecContext(ctx, query, archetype); err != nil {
+		return fmt.Errorf("cannot update archetype: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, clearQuery, archetype.ID); err != nil {
+		return fmt.Errorf("cannot clear archetype fields: %w", err)
+	}
+	return repo.insertArchetypeFields(ctx, tx, archetype)
+}
+
+// DeleteArchetype removes an archetype along with its default field values.
+// Content created from it is kept.
+func (repo *ClioRepo) DeleteArchetype(ctx context.Context, id uuid.UUID) (err error) {
+	clearQuery, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "ClearFields")
+	if err != nil {
+		return fmt.Errorf("cannot get clear archetype fields query: %w", err)
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete archetype query: %w", err)
+	}
+
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	if _, err = tx.ExecContext(ctx, clearQuery, id); err != nil {
+		return fmt.Errorf("cannot clear archetype fields: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete archetype: %w", err)
+	}
+
+	return nil
+}
+
+// loadArchetypeFields sets the default field values of archetypes.
+func (repo *ClioRepo) loadArchetypeFields(ctx context.Context, archetypes []ssg.Archetype) error {
+	if len(archetypes) == 0 {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(archetypes))
+	byID := make(map[uuid.UUID]*ssg.Archetype, len(archetypes))
+	for i := range archetypes {
+		ids[i] = archetypes[i].ID
+		byID[archetypes[i].ID] = &archetypes[i]
+		archetypes[i].Fields = map[string]string{}
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "GetFields")
+	if err != nil {
+		return fmt.Errorf("cannot get archetype fields query: %w", err)
+	}
+	query, args, err := sqlx.In(query, ids)
+	if err != nil {
+		return fmt.Errorf("cannot expand archetype fields query: %w", err)
+	}
+	var fields []ssg.ArchetypeField
+	if err := repo.db.SelectContext(ctx, &fields, repo.db.Rebind(query), args...); err != nil {
+		return fmt.Errorf("cannot get archetype fields: %w", err)
+	}
+	for _, f := range fields {
+		byID[f.ArchetypeID].Fields[f.Name] = f.Value
+	}
+
+	return nil
+}
+
+func (repo *ClioRepo) insertArchetypeFields(ctx context.Context, tx *sqlx.Tx, archetype ssg.Archetype) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resArchetype, "AddField")
+	if err != nil {
+		return fmt.Errorf("cannot get add archetype field query: %w", err)
+	}
+	for _, f := range ssg.ArchetypeFieldList(archetype.ID, archetype.Fields) {
+		if _, err := tx.ExecContext(ctx, query, f.ArchetypeID, f.Name, f.Value); err != nil {
+			return fmt.Errorf("cannot add archetype field: %w", err)
+		}
+	}
+	return nil
+}
+
+// Param related
+
+func (repo *ClioRepo) CreateParam(ctx context.Context, p *ssg.Param) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create param query: %w", err)
+	}
+	if _, err = repo.db.NamedExecContext(ctx, query, p); err != nil {
+		return fmt.Errorf("cannot create param: %w", err)
+	}
+	return nil
+}
+
+func (repo *ClioRepo) GetParam(ctx context.Context, id uuid.UUID) (ssg.Param, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Get")
+	if err != nil {
+		return ssg.Param{}, fmt.Errorf("cannot get get param query: %w", err)
+	}
+	var param ssg.Param
+	err = repo.db.GetContext(ctx, &param, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Param{}, errors.New("param not found")
+		}
+		return ssg.Param{}, fmt.Errorf("cannot get param: %w", err)
+	}
+	return param, nil
+}
+
+func (repo *ClioRepo) GetParamByName(ctx context.Context, name string) (ssg.Param, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "GetByName")
+	if err != nil {
+		return ssg.Param{}, fmt.Errorf("cannot get get param by name query: %w", err)
+	}
+	var param ssg.Param
+	err = repo.db.GetContext(ctx, &param, query, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Param{}, errors.New("param not found")
+		}
+		return ssg.Param{}, fmt.Errorf("cannot get param by name: %w", err)
+	}
+	return param, nil
+}
+
+func (repo *ClioRepo) GetParamByRefKey(ctx context.Context, refKey string) (ssg.Param, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "GetByRefKey")
+	if err != nil {
+		return ssg.Param{}, fmt.Errorf("cannot get get param by ref key query: %w", err)
+	}
+	var param ssg.Param
+	err = repo.db.GetContext(ctx, &param, query, refKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Param{}, errors.New("param not found")
+		}
+		return ssg.Param{}, fmt.Errorf("cannot get param by ref key: %w", err)
+	}
+	return param, nil
+}
+
+func (repo *ClioRepo) ListParams(ctx context.Context) ([]ssg.Param, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "List")
+	if err != nil {
+		return nil, fmt.Errorf("cannot get list params query: %w", err)
+	}
+	var params []ssg.Param
+	err = repo.db.SelectContext(ctx, &params, query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list params: %w", err)
+	}
+	return params, nil
+}
+
+func (repo *ClioRepo) UpdateParam(ctx context.Context, p *ssg.Param) (err error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Update")
+	if err != nil {
+		return fmt.Errorf("cannot get update param query: %w", err)
+	}
+	if _, err = repo.db.NamedExecContext(ctx, query, p); err != nil {
+		return fmt.Errorf("cannot update param: %w", err)
+	}
+	return nil
+}
+
+func (repo *ClioRepo) DeleteParam(ctx context.Context, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resParam, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete param query: %w", err)
+	}
+	_, err = repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("cannot delete param: %w", err)
+	}
+	return nil
+}
+
+// Image related
+
+func (repo *ClioRepo) CreateImage(ctx context.Context, img *ssg.Image) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	imageQuery, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create image query: %w", err)
+	}
+	if _, err = tx.NamedExecContext(ctx, imageQuery, img); err != nil {
+		return fmt.Errorf("cannot create image: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ClioRepo) GetImage(ctx context.Context, id uuid.UUID) (ssg.Image, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Get")
+	if err != nil {
+		return ssg.Image{}, fmt.Errorf("cannot get image query: %w", err)
+	}
+
+	var img ssg.Image
+	err = repo.db.GetContext(ctx, &img, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Image{}, errors.New("image not found")
+		}
+		return ssg.Image{}, fmt.Errorf("cannot get image: %w", err)
+	}
+
+	return img, nil
+}
+
+func (repo *ClioRepo) GetImageByShortID(ctx context.Context, shortID string) (ssg.Image, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "GetImageByShortID")
+	if err != nil {
+		return ssg.Image{}, fmt.Errorf("cannot get image by short ID query: %w", err)
+	}
+
+	var img ssg.Image
+	err = repo.db.GetContext(ctx, &img, query, shortID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Image{}, errors.New("image not found")
+		}
+		return ssg.Image{}, fmt.Errorf("cannot get image by short ID: %w", err)
+	}
+
+	return img, nil
+}
+
+func (repo *ClioRepo) GetImageByContentHash(ctx context.Context, contentHash string) (ssg.Image, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "GetImageByContentHash")
+	if err != nil {
+		return ssg.Image{}, fmt.Errorf("cannot get image by content hash query: %w", err)
+	}
+
+	var img ssg.Image
+	err = repo.db.GetContext(ctx, &img, query, contentHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ssg.Image{}, errors.New("image not found")
+		}
+		return ssg.Image{}, fmt.Errorf("cannot get image by content hash: %w", err)
+	}
+
+	return img, nil
+}
+
+func (repo *ClioRepo) ListImages(ctx context.Context) ([]ssg.Image, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "List")
+	if err != nil {
+		return nil, fmt.Errorf("cannot get list images query: %w", err)
+	}
+
+	var images []ssg.Image
+	err = repo.db.SelectContext(ctx, &images, query)
+	if err != nil {
+		return nil, fmt.Errorf("cannot list images: %w", err)
+	}
+
+	return images, nil
+}
+
+func (repo *ClioRepo) UpdateImage(ctx context.Context, img *ssg.Image) (err error) {
+	tx, err := repo.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("cannot begin transaction: %w", err)
+	}
+	defer func() {
+		if err != nil {
+			if rbErr := tx.Rollback(); rbErr != nil {
+				err = fmt.Errorf("cannot rollback transaction: %v (original error: %w)", rbErr, err)
+			}
+			return
+		}
+		err = tx.Commit()
+	}()
+
+	imageQuery, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Update")
+	if err != nil {
+		return fmt.Errorf("cannot get update image query: %w", err)
+	}
+	if _, err = tx.NamedExecContext(ctx, imageQuery, img); err != nil {
+		return fmt.Errorf("cannot update image: %w", err)
+	}
+
+	return nil
+}
+
+func (repo *ClioRepo) DeleteImage(ctx context.Context, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resImage, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete image query: %w", err)
+	}
+	_, err = repo.db.ExecContext(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("cannot delete image: %w", err)
+	}
+	return nil
+}
+
+// ImageVariant related
 
 func (repo *ClioRepo) CreateImageVariant(ctx context.Context, variant *ssg.ImageVariant) (err error) {
 	tx, err := repo.db.BeginTxx(ctx, nil)
@@ -1102,6 +2195,64 @@ func (repo *ClioRepo) GetContentImagesByContentID(ctx context.Context, contentID
 	return contentImages, err
 }
 
+// ContentAlias methods
+
+// CreateContentAlias stores alias. A path already used in the site is moved
+// to the new content, so the latest move wins.
+func (repo *ClioRepo) CreateContentAlias(ctx context.Context, alias *ssg.ContentAlias) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentAlias, "Create")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.ExecContext(ctx, query,
+		alias.ID,
+		alias.SiteID,
+		alias.ContentID,
+		alias.Path,
+		alias.Manual,
+		alias.CreatedAt,
+	)
+	return err
+}
+
+func (repo *ClioRepo) GetContentAliases(ctx context.Context) ([]ssg.ContentAlias, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentAlias, "GetAll")
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases []ssg.ContentAlias
+	err = repo.db.SelectContext(ctx, &aliases, query, siteID)
+	return aliases, err
+}
+
+func (repo *ClioRepo) GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]ssg.ContentAlias, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentAlias, "GetByContentID")
+	if err != nil {
+		return nil, err
+	}
+
+	var aliases []ssg.ContentAlias
+	err = repo.db.SelectContext(ctx, &aliases, query, contentID)
+	return aliases, err
+}
+
+func (repo *ClioRepo) DeleteContentAlias(ctx context.Context, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resContentAlias, "Delete")
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.db.ExecContext(ctx, query, id)
+	return err
+}
+
 // SectionImage relationship methods
 
 func (repo *ClioRepo) CreateSectionImage(ctx context.Context, sectionImage *ssg.SectionImage) error {
@@ -1155,3 +2306,149 @@ func (repo *ClioRepo) GetSiteBySlug(ctx context.Context, slug string) (ssg.Site,
 
 	return site, nil
 }
+
+// Comment related
+
+func (repo *ClioRepo) CreateComment(ctx context.Context, comment ssg.Comment) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "Create")
+	if err != nil {
+		return fmt.Errorf("cannot get create comment query: %w", err)
+	}
+
+	if _, err := repo.db.NamedExecContext(ctx, query, comment); err != nil {
+		return fmt.Errorf("cannot create comment: %w", err)
+	}
+	return nil
+}
+
+func (repo *ClioRepo) GetComment(ctx context.Context, id uuid.UUID) (ssg.Comment, error) {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "Get")
+	if err != nil {
+		return ssg.Comment{}, err
+	}
+
+	var comment ssg.Comment
+	if err := repo.db.GetContext(ctx, &comment, query, id); err != nil {
+		return ssg.Comment{}, err
+	}
+	return comment, nil
+}
+
+// GetComments returns the comments of the site with status, or all of them
+// when status is empty. Approved comments, rendered into pages, are listed
+// oldest first; the others newest first, as a moderation queue.
+func (repo *ClioRepo) GetComments(ctx context.Context, status string) ([]ssg.Comment, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	name := "GetAll"
+	if status == ssg.CommentApproved {
+		name = "GetAllOldestFirst"
+	}
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, name)
+	if err != nil {
+		return nil, err
+	}
+
+	var comments []ssg.Comment
+	if err := repo.db.SelectContext(ctx, &comments, query, siteID, status, status); err != nil {
+		return nil, err
+	}
+	return comments, nil
+}
+
+func (repo *ClioRepo) UpdateComment(ctx context.Context, comment ssg.Comment) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "Update")
+	if err != nil {
+		return fmt.Errorf("cannot get update comment query: %w", err)
+	}
+
+	if _, err := repo.db.NamedExecContext(ctx, query, comment); err != nil {
+		return fmt.Errorf("cannot update comment: %w", err)
+	}
+	return nil
+}
+
+func (repo *ClioRepo) DeleteComment(ctx context.Context, id uuid.UUID) error {
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "Delete")
+	if err != nil {
+		return fmt.Errorf("cannot get delete comment query: %w", err)
+	}
+
+	if _, err := repo.db.ExecContext(ctx, query, id); err != nil {
+		return fmt.Errorf("cannot delete comment: %w", err)
+	}
+	return nil
+}
+
+// AddCommentRebuild queues the page of the content for the next comment
+// rebuild of the site.
+func (repo *ClioRepo) AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "AddRebuild")
+	if err != nil {
+		return fmt.Errorf("cannot get add comment rebuild query: %w", err)
+	}
+
+	if _, err := repo.db.ExecContext(ctx, query, siteID, contentID); err != nil {
+		return fmt.Errorf("cannot add comment rebuild: %w", err)
+	}
+	return nil
+}
+
+// GetCommentRebuilds returns the IDs of the content queued for the next
+// comment rebuild of the site.
+func (repo *ClioRepo) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("no site ID in context")
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "GetRebuilds")
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []uuid.UUID{}
+	if err := repo.db.SelectContext(ctx, &ids, query, siteID); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ClearCommentRebuilds removes contentIDs from the comment rebuild queue of
+// the site. A nil contentIDs clears the whole queue.
+func (repo *ClioRepo) ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error {
+	siteID, ok := ssg.GetSiteIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("no site ID in context")
+	}
+
+	if contentIDs == nil {
+		query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "ClearRebuilds")
+		if err != nil {
+			return fmt.Errorf("cannot get clear comment rebuilds query: %w", err)
+		}
+		if _, err := repo.db.ExecContext(ctx, query, siteID); err != nil {
+			return fmt.Errorf("cannot clear comment rebuilds: %w", err)
+		}
+		return nil
+	}
+
+	query, err := repo.BaseRepo.Query().Get(featSSG, resComment, "ClearRebuild")
+	if err != nil {
+		return fmt.Errorf("cannot get clear comment rebuild query: %w", err)
+	}
+	for _, id := range contentIDs {
+		if _, err := repo.db.ExecContext(ctx, query, siteID, id); err != nil {
+			return fmt.Errorf("cannot clear comment rebuild: %w", err)
+		}
+	}
+	return nil
+}
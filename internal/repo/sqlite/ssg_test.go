@@ -2,7 +2,10 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/hermesgen/clio/internal/feat/ssg"
@@ -42,10 +45,14 @@ func setupTestSsgRepo(t *testing.T) (*ClioRepo, uuid.UUID) {
 			section_id TEXT,
 			kind TEXT,
 			heading TEXT NOT NULL,
+			slug TEXT NOT NULL DEFAULT '',
+			locale TEXT NOT NULL DEFAULT '',
+			translation_group TEXT NOT NULL DEFAULT '',
 			summary TEXT,
 			body TEXT,
 			draft INTEGER DEFAULT 0,
 			featured INTEGER DEFAULT 0,
+			series_id TEXT NOT NULL DEFAULT '',
 			series TEXT,
 			series_order INTEGER,
 			published_at TIMESTAMP,
@@ -55,6 +62,8 @@ func setupTestSsgRepo(t *testing.T) (*ClioRepo, uuid.UUID) {
 			updated_at TIMESTAMP
 		);
 
+		CREATE UNIQUE INDEX IF NOT EXISTS idx_content_site_slug ON content(site_id, slug) WHERE slug != '';
+
 		CREATE TABLE IF NOT EXISTS section (
 			id TEXT PRIMARY KEY,
 			site_id TEXT NOT NULL,
@@ -67,7 +76,10 @@ func setupTestSsgRepo(t *testing.T) (*ClioRepo, uuid.UUID) {
 			created_by TEXT,
 			updated_by TEXT,
 			created_at TIMESTAMP,
-			updated_at TIMESTAMP
+			updated_at TIMESTAMP,
+			parent_id TEXT NOT NULL DEFAULT '',
+			roll_up INTEGER NOT NULL DEFAULT 0,
+			UNIQUE(site_id, path)
 		);
 
 		CREATE TABLE IF NOT EXISTS tag (
@@ -132,6 +144,174 @@ func setupTestSsgRepo(t *testing.T) (*ClioRepo, uuid.UUID) {
 			updated_at TIMESTAMP
 		);
 
+		CREATE TABLE IF NOT EXISTS series (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			section_id TEXT,
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			cover_image TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL DEFAULT 'ongoing',
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE(site_id, slug)
+		);
+
+		CREATE TABLE IF NOT EXISTS author (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			user_id TEXT NOT NULL DEFAULT '',
+			name TEXT NOT NULL,
+			slug TEXT NOT NULL,
+			bio TEXT NOT NULL DEFAULT '',
+			avatar TEXT NOT NULL DEFAULT '',
+			links TEXT NOT NULL DEFAULT '',
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE(site_id, slug)
+		);
+
+		CREATE TABLE IF NOT EXISTS content_author (
+			content_id TEXT NOT NULL,
+			author_id TEXT NOT NULL,
+			position INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (content_id, author_id)
+		);
+
+		CREATE TABLE IF NOT EXISTS content_type (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			name TEXT NOT NULL,
+			label TEXT NOT NULL DEFAULT '',
+			layout_id TEXT NOT NULL DEFAULT '',
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE(site_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS content_type_field (
+			content_type_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			label TEXT NOT NULL DEFAULT '',
+			type TEXT NOT NULL,
+			required INTEGER NOT NULL DEFAULT 0,
+			position INTEGER NOT NULL DEFAULT 0,
+			PRIMARY KEY (content_type_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS content_type_section (
+			content_type_id TEXT NOT NULL,
+			section_id TEXT NOT NULL,
+			PRIMARY KEY (content_type_id, section_id)
+		);
+
+		CREATE TABLE IF NOT EXISTS content_field (
+			content_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value TEXT NOT NULL DEFAULT '',
+			PRIMARY KEY (content_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS archetype (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			name TEXT NOT NULL,
+			description TEXT NOT NULL DEFAULT '',
+			kind TEXT NOT NULL,
+			section_id TEXT NOT NULL DEFAULT '',
+			body TEXT NOT NULL DEFAULT '',
+			tags TEXT NOT NULL DEFAULT '',
+			robots TEXT NOT NULL DEFAULT '',
+			keywords TEXT NOT NULL DEFAULT '',
+			sitemap TEXT NOT NULL DEFAULT '',
+			table_of_contents INTEGER NOT NULL DEFAULT 0,
+			share INTEGER NOT NULL DEFAULT 0,
+			comments INTEGER NOT NULL DEFAULT 0,
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE(site_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS archetype_field (
+			archetype_id TEXT NOT NULL,
+			name TEXT NOT NULL,
+			value TEXT NOT NULL DEFAULT '',
+			PRIMARY KEY (archetype_id, name)
+		);
+
+		CREATE TABLE IF NOT EXISTS comment (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			content_id TEXT NOT NULL,
+			author_name TEXT NOT NULL,
+			author_email TEXT NOT NULL DEFAULT '',
+			body TEXT NOT NULL,
+			status TEXT NOT NULL DEFAULT 'pending',
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS comment_rebuild (
+			site_id TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			PRIMARY KEY (site_id, content_id)
+		);
+
+		CREATE TABLE IF NOT EXISTS menu (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			short_id TEXT,
+			name TEXT NOT NULL,
+			location TEXT NOT NULL,
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP,
+			UNIQUE(site_id, location)
+		);
+
+		CREATE TABLE IF NOT EXISTS menu_item (
+			id TEXT PRIMARY KEY,
+			menu_id TEXT NOT NULL,
+			short_id TEXT,
+			parent_id TEXT NOT NULL DEFAULT '',
+			label TEXT NOT NULL DEFAULT '',
+			target_type TEXT NOT NULL,
+			target_id TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL DEFAULT '',
+			position INTEGER NOT NULL DEFAULT 0,
+			created_by TEXT,
+			updated_by TEXT,
+			created_at TIMESTAMP,
+			updated_at TIMESTAMP
+		);
+
+		CREATE TABLE IF NOT EXISTS content_alias (
+			id TEXT PRIMARY KEY,
+			site_id TEXT NOT NULL,
+			content_id TEXT NOT NULL,
+			path TEXT NOT NULL,
+			manual INTEGER NOT NULL DEFAULT 0,
+			created_at TIMESTAMP,
+			UNIQUE(site_id, path)
+		);
+
 		CREATE TABLE IF NOT EXISTS content_tag (
 			id TEXT PRIMARY KEY,
 			content_id TEXT NOT NULL,
@@ -146,9 +326,19 @@ func setupTestSsgRepo(t *testing.T) (*ClioRepo, uuid.UUID) {
 			file_name TEXT NOT NULL,
 			file_path TEXT NOT NULL,
 			alt_text TEXT,
+			alt_lang TEXT NOT NULL DEFAULT '',
+			long_description TEXT NOT NULL DEFAULT '',
+			caption TEXT NOT NULL DEFAULT '',
+			decorative INTEGER NOT NULL DEFAULT 0,
+			credit TEXT NOT NULL DEFAULT '',
+			license TEXT NOT NULL DEFAULT '',
+			source_url TEXT NOT NULL DEFAULT '',
 			title TEXT,
 			width INTEGER,
 			height INTEGER,
+			filesize_bytes INTEGER NOT NULL DEFAULT 0,
+			mime TEXT NOT NULL DEFAULT '',
+			dominant_color TEXT NOT NULL DEFAULT '',
 			created_by TEXT,
 			updated_by TEXT,
 			created_at TIMESTAMP,
@@ -297,6 +487,45 @@ func TestClioRepoGetContent(t *testing.T) {
 	}
 }
 
+func TestClioRepoGetContentBySlug(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	content := &ssg.Content{
+		ID:        uuid.New(),
+		SiteID:    siteID,
+		Heading:   "Test Content",
+		SlugField: "test-content",
+	}
+	if err := repo.CreateContent(ctx, content); err != nil {
+		t.Fatalf("CreateContent() error = %v", err)
+	}
+
+	got, err := repo.GetContentBySlug(ctx, "test-content")
+	if err != nil {
+		t.Fatalf("GetContentBySlug() error = %v", err)
+	}
+	if got.ID != content.ID {
+		t.Errorf("GetContentBySlug() got ID = %v, want %v", got.ID, content.ID)
+	}
+
+	_, err = repo.GetContentBySlug(ctx, "missing")
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("GetContentBySlug() error = %v, want sql.ErrNoRows", err)
+	}
+
+	duplicate := &ssg.Content{
+		ID:        uuid.New(),
+		SiteID:    siteID,
+		Heading:   "Other Content",
+		SlugField: "test-content",
+	}
+	if err := repo.CreateContent(ctx, duplicate); err == nil {
+		t.Error("CreateContent() with duplicated slug succeeded, want error")
+	}
+}
+
 func TestClioRepoUpdateContent(t *testing.T) {
 	repo, siteID := setupTestSsgRepo(t)
 	defer repo.db.Close()
@@ -356,6 +585,39 @@ func TestClioRepoUpdateContent(t *testing.T) {
 	}
 }
 
+func TestClioRepoMoveContent(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	content := &ssg.Content{ID: uuid.New(), SiteID: siteID, Heading: "Old Title"}
+	if err := repo.CreateContent(ctx, content); err != nil {
+		t.Fatalf("CreateContent() error = %v", err)
+	}
+	stale := ssg.NewContentAlias(siteID, content.ID, "/new-title/", false)
+	if err := repo.CreateContentAlias(ctx, stale); err != nil {
+		t.Fatalf("CreateContentAlias() error = %v", err)
+	}
+
+	move := ssg.ContentMove{
+		Content:        &ssg.Content{ID: content.ID, SiteID: siteID, Heading: "New Title"},
+		Aliases:        []*ssg.ContentAlias{ssg.NewContentAlias(siteID, content.ID, "/old-title/", false)},
+		ClearedAliases: []uuid.UUID{stale.ID},
+	}
+	if err := repo.MoveContent(ctx, move); err != nil {
+		t.Fatalf("MoveContent() error = %v", err)
+	}
+
+	updated, _ := repo.GetContent(ctx, content.ID)
+	if updated.Heading != "New Title" {
+		t.Errorf("GetContent() Heading = %q, want New Title", updated.Heading)
+	}
+	aliases, _ := repo.GetContentAliasesByContentID(ctx, content.ID)
+	if len(aliases) != 1 || aliases[0].Path != "/old-title/" {
+		t.Errorf("GetContentAliasesByContentID() = %+v, want only /old-title/", aliases)
+	}
+}
+
 func TestClioRepoDeleteContent(t *testing.T) {
 	repo, siteID := setupTestSsgRepo(t)
 	defer repo.db.Close()
@@ -473,6 +735,110 @@ func TestClioRepoGetSection(t *testing.T) {
 	}
 }
 
+func TestClioRepoSectionParent(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	parent := ssg.Section{ID: uuid.New(), SiteID: siteID, Name: "Guides", Path: "/guides"}
+	child := ssg.Section{ID: uuid.New(), SiteID: siteID, Name: "Go", Path: "/guides/go", ParentID: parent.ID}
+	for _, s := range []ssg.Section{parent, child} {
+		if err := repo.CreateSection(ctx, s); err != nil {
+			t.Fatalf("CreateSection() error = %v", err)
+		}
+	}
+
+	got, err := repo.GetSection(ctx, child.ID)
+	if err != nil {
+		t.Fatalf("GetSection() error = %v", err)
+	}
+	if got.ParentID != parent.ID {
+		t.Errorf("GetSection() ParentID = %v, want %v", got.ParentID, parent.ID)
+	}
+
+	parent.RollUp = true
+	if err := repo.UpdateSection(ctx, parent); err != nil {
+		t.Fatalf("UpdateSection() error = %v", err)
+	}
+
+	sections, err := repo.GetSections(ctx)
+	if err != nil {
+		t.Fatalf("GetSections() error = %v", err)
+	}
+	for _, s := range sections {
+		switch s.ID {
+		case parent.ID:
+			if !s.RollUp || s.ParentID != uuid.Nil {
+				t.Errorf("GetSections() parent RollUp, ParentID = %v, %v", s.RollUp, s.ParentID)
+			}
+		case child.ID:
+			if s.RollUp || s.ParentID != parent.ID {
+				t.Errorf("GetSections() child RollUp, ParentID = %v, %v", s.RollUp, s.ParentID)
+			}
+		}
+	}
+}
+
+func TestClioRepoMoveSections(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	guides := ssg.Section{ID: uuid.New(), SiteID: siteID, Name: "Guides", Path: "/guides"}
+	golang := ssg.Section{ID: uuid.New(), SiteID: siteID, Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	taken := ssg.Section{ID: uuid.New(), SiteID: siteID, Name: "Go", Path: "/docs/go"}
+	for _, s := range []ssg.Section{guides, golang, taken} {
+		if err := repo.CreateSection(ctx, s); err != nil {
+			t.Fatalf("CreateSection() error = %v", err)
+		}
+	}
+	contentID := uuid.New()
+
+	move := func(base string) ssg.SectionMove {
+		parent, child := guides, golang
+		parent.Path = base
+		child.Path = base + "/go"
+		return ssg.SectionMove{
+			Sections: []ssg.Section{parent, child},
+			Aliases:  []*ssg.ContentAlias{ssg.NewContentAlias(siteID, contentID, "/guides/go/post/", false)},
+		}
+	}
+
+	// The descendant clashes with an existing path, nothing is moved
+	if err := repo.MoveSections(ctx, move("/docs")); err == nil {
+		t.Fatal("MoveSections() error = nil, want path conflict")
+	}
+	got, err := repo.GetSection(ctx, guides.ID)
+	if err != nil {
+		t.Fatalf("GetSection() error = %v", err)
+	}
+	if got.Path != "/guides" {
+		t.Errorf("GetSection() Path = %q after failed move, want /guides", got.Path)
+	}
+	if aliases, _ := repo.GetContentAliases(ctx); len(aliases) != 0 {
+		t.Errorf("GetContentAliases() got %d aliases after failed move, want 0", len(aliases))
+	}
+
+	if err := repo.MoveSections(ctx, move("/manuals")); err != nil {
+		t.Fatalf("MoveSections() error = %v", err)
+	}
+	got, _ = repo.GetSection(ctx, golang.ID)
+	if got.Path != "/manuals/go" {
+		t.Errorf("GetSection() Path = %q, want /manuals/go", got.Path)
+	}
+	aliases, _ := repo.GetContentAliasesByContentID(ctx, contentID)
+	if len(aliases) != 1 || aliases[0].Path != "/guides/go/post/" {
+		t.Errorf("GetContentAliasesByContentID() = %+v, want /guides/go/post/", aliases)
+	}
+
+	if err := repo.MoveSections(ctx, ssg.SectionMove{ClearedAliases: []uuid.UUID{aliases[0].ID}}); err != nil {
+		t.Fatalf("MoveSections() error = %v", err)
+	}
+	if aliases, _ := repo.GetContentAliasesByContentID(ctx, contentID); len(aliases) != 0 {
+		t.Errorf("GetContentAliasesByContentID() got %d aliases after clearing, want 0", len(aliases))
+	}
+}
+
 func TestClioRepoGetSections(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1913,6 +2279,54 @@ func TestClioRepoGetAllContentWithMeta(t *testing.T) {
 	}
 }
 
+func TestClioRepoGetAllContentWithMetaHeaderPlaceholder(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	content := &ssg.Content{ID: uuid.New(), SiteID: siteID, Heading: "With Header"}
+	if err := repo.CreateContent(ctx, content); err != nil {
+		t.Fatalf("CreateContent() error = %v", err)
+	}
+
+	image := &ssg.Image{
+		ID:            uuid.New(),
+		SiteID:        siteID,
+		FileName:      "header.jpg",
+		FilePath:      "with-header/header.jpg",
+		DominantColor: "#336699",
+	}
+	if err := repo.CreateImage(ctx, image); err != nil {
+		t.Fatalf("CreateImage() error = %v", err)
+	}
+
+	if err := repo.CreateContentImage(ctx, ssg.NewContentImage(content.ID, image.ID, true)); err != nil {
+		t.Fatalf("CreateContentImage() error = %v", err)
+	}
+
+	placeholder := ssg.Placeholder{DataURI: "data:image/jpeg;base64,AAAA", Width: 16, Height: 9}
+	variant := placeholder.Variant(image.ID)
+	if err := repo.CreateImageVariant(ctx, &variant); err != nil {
+		t.Fatalf("CreateImageVariant() error = %v", err)
+	}
+
+	contents, err := repo.GetAllContentWithMeta(ctx)
+	if err != nil {
+		t.Fatalf("GetAllContentWithMeta() error = %v", err)
+	}
+	if len(contents) != 1 {
+		t.Fatalf("GetAllContentWithMeta() got %d contents, want 1", len(contents))
+	}
+
+	got := contents[0]
+	if got.HeaderImageColor != "#336699" {
+		t.Errorf("HeaderImageColor = %q, want %q", got.HeaderImageColor, "#336699")
+	}
+	if got.HeaderImagePlaceholder != placeholder.DataURI {
+		t.Errorf("HeaderImagePlaceholder = %q, want %q", got.HeaderImagePlaceholder, placeholder.DataURI)
+	}
+}
+
 func TestClioRepoGetSiteBySlug(t *testing.T) {
 	repo, _ := setupTestSsgRepo(t)
 	defer repo.db.Close()
@@ -2765,3 +3179,548 @@ func TestSanitizeURLPath(t *testing.T) {
 		})
 	}
 }
+
+func TestClioRepoContentAliases(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	first := uuid.New()
+	second := uuid.New()
+
+	if err := repo.CreateContentAlias(ctx, ssg.NewContentAlias(siteID, first, "/old/", false)); err != nil {
+		t.Fatalf("CreateContentAlias() error = %v", err)
+	}
+	if err := repo.CreateContentAlias(ctx, ssg.NewContentAlias(siteID, first, "/older/", true)); err != nil {
+		t.Fatalf("CreateContentAlias() error = %v", err)
+	}
+
+	// Same path moves to the latest content
+	if err := repo.CreateContentAlias(ctx, ssg.NewContentAlias(siteID, second, "/old/", false)); err != nil {
+		t.Fatalf("CreateContentAlias() error = %v", err)
+	}
+
+	all, err := repo.GetContentAliases(ctx)
+	if err != nil {
+		t.Fatalf("GetContentAliases() error = %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("GetContentAliases() got %d aliases, want 2", len(all))
+	}
+
+	aliases, err := repo.GetContentAliasesByContentID(ctx, first)
+	if err != nil {
+		t.Fatalf("GetContentAliasesByContentID() error = %v", err)
+	}
+	if len(aliases) != 1 || aliases[0].Path != "/older/" || !aliases[0].Manual {
+		t.Fatalf("GetContentAliasesByContentID() = %+v, want manual /older/", aliases)
+	}
+
+	if err := repo.DeleteContentAlias(ctx, aliases[0].ID); err != nil {
+		t.Fatalf("DeleteContentAlias() error = %v", err)
+	}
+	aliases, _ = repo.GetContentAliasesByContentID(ctx, first)
+	if len(aliases) != 0 {
+		t.Errorf("expected no aliases after delete, got %d", len(aliases))
+	}
+}
+
+func TestClioRepoSeries(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	section := ssg.NewSection("Guides", "", "/guides", uuid.Nil)
+	section.GenCreateValues()
+	section.SiteID = siteID
+	if err := repo.CreateSection(ctx, section); err != nil {
+		t.Fatalf("CreateSection() error = %v", err)
+	}
+
+	series := ssg.NewSeries("Go Basics", "Learn Go", section.ID)
+	series.SlugField = "go-basics"
+	series.SiteID = siteID
+	series.GenCreateValues()
+	if err := repo.CreateSeries(ctx, series); err != nil {
+		t.Fatalf("CreateSeries() error = %v", err)
+	}
+
+	var parts []uuid.UUID
+	for i, heading := range []string{"Part one", "Part two"} {
+		c := &ssg.Content{ID: uuid.New(), SiteID: siteID, SectionID: section.ID, Heading: heading,
+			SeriesID: series.ID, Series: series.Slug(), SeriesOrder: i + 1}
+		if err := repo.CreateContent(ctx, c); err != nil {
+			t.Fatalf("CreateContent() error = %v", err)
+		}
+		parts = append(parts, c.ID)
+	}
+
+	got, err := repo.GetSeries(ctx, series.ID)
+	if err != nil {
+		t.Fatalf("GetSeries() error = %v", err)
+	}
+	if got.Name != "Go Basics" || got.Status != ssg.SeriesStatusOngoing || got.SectionPath != "/guides" {
+		t.Errorf("GetSeries() = %+v", got)
+	}
+
+	series.Name = "Go Fundamentals"
+	series.SlugField = "go-fundamentals"
+	series.Status = ssg.SeriesStatusComplete
+	if err := repo.UpdateSeries(ctx, series); err != nil {
+		t.Fatalf("UpdateSeries() error = %v", err)
+	}
+	if err := repo.UpdateSeriesOrder(ctx, series.ID, []uuid.UUID{parts[1], parts[0]}); err != nil {
+		t.Fatalf("UpdateSeriesOrder() error = %v", err)
+	}
+
+	all, err := repo.GetAllSeries(ctx)
+	if err != nil {
+		t.Fatalf("GetAllSeries() error = %v", err)
+	}
+	if len(all) != 1 || all[0].Slug() != "go-fundamentals" || all[0].Status != ssg.SeriesStatusComplete {
+		t.Fatalf("GetAllSeries() = %+v", all)
+	}
+
+	contents, err := repo.GetAllContentWithMeta(ctx)
+	if err != nil {
+		t.Fatalf("GetAllContentWithMeta() error = %v", err)
+	}
+	order := map[uuid.UUID]int{}
+	for _, c := range contents {
+		if c.SeriesID != series.ID || c.Series != "go-fundamentals" {
+			t.Errorf("content %q series = %s %q, want %s go-fundamentals", c.Heading, c.SeriesID, c.Series, series.ID)
+		}
+		order[c.ID] = c.SeriesOrder
+	}
+	if order[parts[0]] != 2 || order[parts[1]] != 1 {
+		t.Errorf("series order = %v, want second part first", order)
+	}
+
+	if err := repo.DeleteSeries(ctx, series.ID); err != nil {
+		t.Fatalf("DeleteSeries() error = %v", err)
+	}
+	contents, _ = repo.GetAllContentWithMeta(ctx)
+	if len(contents) != 2 {
+		t.Fatalf("got %d contents after series delete, want 2", len(contents))
+	}
+	for _, c := range contents {
+		if c.SeriesID != uuid.Nil || c.Series != "" || c.SeriesOrder != 0 {
+			t.Errorf("content %q still in series: %+v", c.Heading, c)
+		}
+	}
+}
+
+func TestClioRepoMenus(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	menu := ssg.NewMenu("Main", ssg.MenuLocationMain)
+	menu.GenID()
+	menu.SiteID = siteID
+	menu.GenCreateValues()
+	if err := repo.CreateMenu(ctx, menu); err != nil {
+		t.Fatalf("CreateMenu() error = %v", err)
+	}
+
+	other := ssg.NewMenu("Other main", ssg.MenuLocationMain)
+	other.GenID()
+	other.SiteID = siteID
+	if err := repo.CreateMenu(ctx, other); err == nil {
+		t.Errorf("CreateMenu() with taken location error = nil, want error")
+	}
+
+	newItem := func(label string, parentID uuid.UUID, position int) ssg.MenuItem {
+		item := ssg.NewMenuItem(menu.ID, label, ssg.MenuTargetURL)
+		item.GenID()
+		item.URL = "https://example.com/" + label
+		item.ParentID = parentID
+		item.Position = position
+		item.GenCreateValues()
+		if err := repo.CreateMenuItem(ctx, item); err != nil {
+			t.Fatalf("CreateMenuItem() error = %v", err)
+		}
+		return item
+	}
+	docs := newItem("docs", uuid.Nil, 2)
+	newItem("about", uuid.Nil, 1)
+	newItem("api", docs.ID, 1)
+
+	items, err := repo.GetMenuItems(ctx, menu.ID)
+	if err != nil {
+		t.Fatalf("GetMenuItems() error = %v", err)
+	}
+	var labels []string
+	for _, item := range items {
+		labels = append(labels, item.Label)
+	}
+	if len(labels) != 3 || labels[0] != "about" || labels[1] != "docs" || labels[2] != "api" {
+		t.Errorf("GetMenuItems() labels = %v, want [about docs api]", labels)
+	}
+
+	docs.Label = "guides"
+	docs.Position = 3
+	if err := repo.UpdateMenuItem(ctx, docs); err != nil {
+		t.Fatalf("UpdateMenuItem() error = %v", err)
+	}
+	got, err := repo.GetMenuItem(ctx, docs.ID)
+	if err != nil {
+		t.Fatalf("GetMenuItem() error = %v", err)
+	}
+	if got.Label != "guides" || got.Position != 3 || got.ParentID != uuid.Nil {
+		t.Errorf("GetMenuItem() = %+v", got)
+	}
+
+	if err := repo.DeleteMenuItem(ctx, docs.ID); err != nil {
+		t.Fatalf("DeleteMenuItem() error = %v", err)
+	}
+	items, _ = repo.GetMenuItems(ctx, menu.ID)
+	if len(items) != 1 {
+		t.Errorf("expected 1 item after deleting parent, got %d", len(items))
+	}
+
+	menu.Location = ssg.MenuLocationFooter
+	if err := repo.UpdateMenu(ctx, menu); err != nil {
+		t.Fatalf("UpdateMenu() error = %v", err)
+	}
+	menus, err := repo.GetMenus(ctx)
+	if err != nil {
+		t.Fatalf("GetMenus() error = %v", err)
+	}
+	if len(menus) != 1 || menus[0].Location != ssg.MenuLocationFooter {
+		t.Fatalf("GetMenus() = %+v", menus)
+	}
+
+	if err := repo.DeleteMenu(ctx, menu.ID); err != nil {
+		t.Fatalf("DeleteMenu() error = %v", err)
+	}
+	if _, err := repo.GetMenu(ctx, menu.ID); err == nil {
+		t.Errorf("GetMenu() after delete error = nil, want error")
+	}
+	items, _ = repo.GetMenuItems(ctx, menu.ID)
+	if len(items) != 0 {
+		t.Errorf("expected no items after deleting menu, got %d", len(items))
+	}
+}
+
+func TestClioRepoAuthors(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	newAuthor := func(name string) ssg.Author {
+		author := ssg.NewAuthor(name, uuid.New())
+		author.GenID()
+		author.SiteID = siteID
+		author.SlugField = ssg.NormalizeSlug(name)
+		author.GenCreateValues()
+		if err := repo.CreateAuthor(ctx, author); err != nil {
+			t.Fatalf("CreateAuthor() error = %v", err)
+		}
+		return author
+	}
+	jane := newAuthor("Jane Doe")
+	bob := newAuthor("Bob")
+
+	jane.Bio = "Writes about Go"
+	if err := repo.UpdateAuthor(ctx, jane); err != nil {
+		t.Fatalf("UpdateAuthor() error = %v", err)
+	}
+	got, err := repo.GetAuthor(ctx, jane.ID)
+	if err != nil {
+		t.Fatalf("GetAuthor() error = %v", err)
+	}
+	if got.Bio != "Writes about Go" || got.UserID != jane.UserID || got.Slug() != "jane-doe" {
+		t.Errorf("GetAuthor() = %+v", got)
+	}
+
+	contentID := uuid.New()
+	if err := repo.SetContentAuthors(ctx, contentID, []uuid.UUID{jane.ID, bob.ID}); err != nil {
+		t.Fatalf("SetContentAuthors() error = %v", err)
+	}
+	if err := repo.SetContentAuthors(ctx, contentID, []uuid.UUID{bob.ID, jane.ID}); err != nil {
+		t.Fatalf("SetContentAuthors() error = %v", err)
+	}
+	authors, err := repo.GetAuthorsForContent(ctx, contentID)
+	if err != nil {
+		t.Fatalf("GetAuthorsForContent() error = %v", err)
+	}
+	if len(authors) != 2 || authors[0].ID != bob.ID || authors[1].ID != jane.ID {
+		t.Errorf("GetAuthorsForContent() = %+v, want Bob, Jane", authors)
+	}
+
+	if err := repo.DeleteAuthor(ctx, bob.ID); err != nil {
+		t.Fatalf("DeleteAuthor() error = %v", err)
+	}
+	links, err := repo.GetContentAuthors(ctx)
+	if err != nil {
+		t.Fatalf("GetContentAuthors() error = %v", err)
+	}
+	if len(links) != 1 || links[0].AuthorID != jane.ID || links[0].Position != 2 {
+		t.Errorf("GetContentAuthors() = %+v, want Jane only", links)
+	}
+	all, err := repo.GetAllAuthors(ctx)
+	if err != nil {
+		t.Fatalf("GetAllAuthors() error = %v", err)
+	}
+	if len(all) != 1 || all[0].ID != jane.ID {
+		t.Errorf("GetAllAuthors() = %+v, want Jane", all)
+	}
+}
+
+func TestClioRepoContentTypes(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	sectionID := uuid.New()
+	recipe := ssg.NewContentType("recipe", "Recipe")
+	recipe.GenID()
+	recipe.SiteID = siteID
+	recipe.SectionIDs = []uuid.UUID{sectionID}
+	recipe.Fields = []ssg.FieldDef{
+		{Name: "servings", Type: ssg.FieldTypeNumber, Required: true, Position: 1},
+		{Name: "cooked_at", Type: ssg.FieldTypeDate, Position: 2},
+	}
+	recipe.GenCreateValues()
+	if err := repo.CreateContentType(ctx, recipe); err != nil {
+		t.Fatalf("CreateContentType() error = %v", err)
+	}
+
+	got, err := repo.GetContentType(ctx, recipe.ID)
+	if err != nil {
+		t.Fatalf("GetContentType() error = %v", err)
+	}
+	if got.Name != "recipe" || len(got.SectionIDs) != 1 || got.SectionIDs[0] != sectionID {
+		t.Errorf("GetContentType() = %+v", got)
+	}
+	if len(got.Fields) != 2 || got.Fields[0].Name != "servings" || !got.Fields[0].Required || got.Fields[1].Type != ssg.FieldTypeDate {
+		t.Errorf("GetContentType() fields = %+v", got.Fields)
+	}
+
+	recipe.Fields = recipe.Fields[:1]
+	recipe.SectionIDs = nil
+	if err := repo.UpdateContentType(ctx, recipe); err != nil {
+		t.Fatalf("UpdateContentType() error = %v", err)
+	}
+	all, err := repo.GetContentTypes(ctx)
+	if err != nil {
+		t.Fatalf("GetContentTypes() error = %v", err)
+	}
+	if len(all) != 1 || len(all[0].Fields) != 1 || len(all[0].SectionIDs) != 0 {
+		t.Errorf("GetContentTypes() = %+v, want recipe with one field and no sections", all)
+	}
+
+	content := &ssg.Content{ID: uuid.New(), SiteID: siteID, Heading: "Pasta", Kind: "recipe"}
+	if err := repo.CreateContent(ctx, content); err != nil {
+		t.Fatalf("CreateContent() error = %v", err)
+	}
+	fields := []ssg.ContentField{
+		{ContentID: content.ID, Name: "servings", Value: "4"},
+		{ContentID: content.ID, Name: "cooked_at", Value: "2025-03-01"},
+	}
+	if err := repo.SetContentFields(ctx, content.ID, fields); err != nil {
+		t.Fatalf("SetContentFields() error = %v", err)
+	}
+	if err := repo.SetContentFields(ctx, content.ID, fields[:1]); err != nil {
+		t.Fatalf("SetContentFields() error = %v", err)
+	}
+	stored, err := repo.GetFieldsForContent(ctx, content.ID)
+	if err != nil {
+		t.Fatalf("GetFieldsForContent() error = %v", err)
+	}
+	if len(stored) != 1 || stored[0].Name != "servings" || stored[0].Value != "4" {
+		t.Errorf("GetFieldsForContent() = %+v, want servings only", stored)
+	}
+
+	if err := repo.DeleteContentType(ctx, recipe.ID); err != nil {
+		t.Fatalf("DeleteContentType() error = %v", err)
+	}
+	all, err = repo.GetContentTypes(ctx)
+	if err != nil {
+		t.Fatalf("GetContentTypes() error = %v", err)
+	}
+	if len(all) != 0 {
+		t.Errorf("GetContentTypes() = %+v, want none", all)
+	}
+	siteFields, err := repo.GetContentFields(ctx)
+	if err != nil {
+		t.Fatalf("GetContentFields() error = %v", err)
+	}
+	if len(siteFields) != 1 {
+		t.Errorf("GetContentFields() = %+v, want the kept value", siteFields)
+	}
+}
+
+func TestClioRepoArchetypes(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	section := ssg.NewSection("Recipes", "", "/recipes", uuid.Nil)
+	section.GenCreateValues()
+	section.SiteID = siteID
+	if err := repo.CreateSection(ctx, section); err != nil {
+		t.Fatalf("CreateSection() error = %v", err)
+	}
+
+	quick := ssg.NewArchetype("quick", "recipe")
+	quick.GenID()
+	quick.SiteID = siteID
+	quick.SectionID = section.ID
+	quick.Body = "## Ingredients\n\n## Steps\n"
+	quick.Tags = "food, quick"
+	quick.Robots = "noindex"
+	quick.TableOfContents = true
+	quick.Fields = map[string]string{"servings": "2", "vegan": ""}
+	quick.GenCreateValues()
+	if err := repo.CreateArchetype(ctx, quick); err != nil {
+		t.Fatalf("CreateArchetype() error = %v", err)
+	}
+
+	got, err := repo.GetArchetype(ctx, quick.ID)
+	if err != nil {
+		t.Fatalf("GetArchetype() error = %v", err)
+	}
+	if got.Name != "quick" || got.Kind != "recipe" || got.SectionID != section.ID || got.SectionName != "Recipes" {
+		t.Errorf("GetArchetype() = %+v", got)
+	}
+	if got.Body != quick.Body || got.Tags != "food, quick" || got.Robots != "noindex" || !got.TableOfContents {
+		t.Errorf("GetArchetype() defaults = %+v", got)
+	}
+	if len(got.Fields) != 1 || got.Fields["servings"] != "2" {
+		t.Errorf("GetArchetype() fields = %v, want servings only", got.Fields)
+	}
+
+	quick.SectionID = uuid.Nil
+	quick.Fields = map[string]string{"vegan": "true"}
+	if err := repo.UpdateArchetype(ctx, quick); err != nil {
+		t.Fatalf("UpdateArchetype() error = %v", err)
+	}
+	all, err := repo.GetArchetypes(ctx)
+	if err != nil {
+		t.Fatalf("GetArchetypes() error = %v", err)
+	}
+	if len(all) != 1 || all[0].SectionID != uuid.Nil || len(all[0].Fields) != 1 || all[0].Fields["vegan"] != "true" {
+		t.Errorf("GetArchetypes() = %+v, want quick without section and with vegan only", all)
+	}
+
+	if err := repo.DeleteArchetype(ctx, quick.ID); err != nil {
+		t.Fatalf("DeleteArchetype() error = %v", err)
+	}
+	all, err = repo.GetArchetypes(ctx)
+	if err != nil {
+		t.Fatalf("GetArchetypes() error = %v", err)
+	}
+	if len(all) != 0 {
+		t.Errorf("GetArchetypes() = %+v, want none", all)
+	}
+	var stored int
+	if err := repo.db.Get(&stored, `SELECT COUNT(*) FROM archetype_field`); err != nil {
+		t.Fatal(err)
+	}
+	if stored != 0 {
+		t.Errorf("archetype fields = %d, want none", stored)
+	}
+}
+
+func TestClioRepoComments(t *testing.T) {
+	repo, siteID := setupTestSsgRepo(t)
+	defer repo.db.Close()
+	ctx := ssg.NewContextWithSite("test-site", siteID)
+
+	content := ssg.NewContent("Pasta", "Body")
+	content.GenID()
+	content.SiteID = siteID
+	if _, err := repo.db.Exec(`INSERT INTO content (id, site_id, heading) VALUES (?, ?, ?)`, content.ID, siteID, content.Heading); err != nil {
+		t.Fatal(err)
+	}
+
+	newComment := func(name string, createdAt time.Time) ssg.Comment {
+		c := ssg.NewComment(content.ID, name, "", "Comment by "+name)
+		c.GenID()
+		c.GenShortID()
+		c.SiteID = siteID
+		c.GenCreateValues()
+		c.CreatedAt = createdAt
+		if err := repo.CreateComment(ctx, c); err != nil {
+			t.Fatalf("CreateComment() error = %v", err)
+		}
+		return c
+	}
+	day := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	first := newComment("Ana", day)
+	second := newComment("Bo", day.Add(time.Hour))
+	newComment("Cy", day.Add(2*time.Hour))
+
+	got, err := repo.GetComment(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("GetComment() error = %v", err)
+	}
+	if got.AuthorName != "Ana" || got.Status != ssg.CommentPending || got.ContentHeading != "Pasta" {
+		t.Errorf("GetComment() = %+v", got)
+	}
+
+	pending, err := repo.GetComments(ctx, ssg.CommentPending)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(pending) != 3 || pending[0].AuthorName != "Cy" {
+		t.Errorf("GetComments(pending) = %+v, want 3 newest first", pending)
+	}
+
+	for _, c := range []ssg.Comment{first, second} {
+		c.Status = ssg.CommentApproved
+		if err := repo.UpdateComment(ctx, c); err != nil {
+			t.Fatalf("UpdateComment() error = %v", err)
+		}
+	}
+	approved, err := repo.GetComments(ctx, ssg.CommentApproved)
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(approved) != 2 || approved[0].AuthorName != "Ana" || approved[1].AuthorName != "Bo" {
+		t.Errorf("GetComments(approved) = %+v, want Ana and Bo oldest first", approved)
+	}
+
+	if err := repo.DeleteComment(ctx, second.ID); err != nil {
+		t.Fatalf("DeleteComment() error = %v", err)
+	}
+	all, err := repo.GetComments(ctx, "")
+	if err != nil {
+		t.Fatalf("GetComments() error = %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("GetComments() = %d comments, want 2", len(all))
+	}
+
+	other := uuid.New()
+	for _, id := range []uuid.UUID{content.ID, content.ID, other} {
+		if err := repo.AddCommentRebuild(ctx, id); err != nil {
+			t.Fatalf("AddCommentRebuild() error = %v", err)
+		}
+	}
+	rebuilds, err := repo.GetCommentRebuilds(ctx)
+	if err != nil {
+		t.Fatalf("GetCommentRebuilds() error = %v", err)
+	}
+	if len(rebuilds) != 2 {
+		t.Errorf("GetCommentRebuilds() = %v, want 2 content IDs", rebuilds)
+	}
+
+	if err := repo.ClearCommentRebuilds(ctx, []uuid.UUID{other}); err != nil {
+		t.Fatalf("ClearCommentRebuilds() error = %v", err)
+	}
+	rebuilds, _ = repo.GetCommentRebuilds(ctx)
+	if len(rebuilds) != 1 || rebuilds[0] != content.ID {
+		t.Errorf("GetCommentRebuilds() = %v, want only %s", rebuilds, content.ID)
+	}
+
+	if err := repo.ClearCommentRebuilds(ctx, nil); err != nil {
+		t.Fatalf("ClearCommentRebuilds() error = %v", err)
+	}
+	rebuilds, _ = repo.GetCommentRebuilds(ctx)
+	if len(rebuilds) != 0 {
+		t.Errorf("GetCommentRebuilds() = %v, want none", rebuilds)
+	}
+}
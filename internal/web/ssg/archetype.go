@@ -0,0 +1,137 @@
+package ssg
+
+import (
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	archetypeType = "archetype"
+)
+
+// Archetype model for the web layer.
+type Archetype struct {
+	ID              uuid.UUID         `json:"id"`
+	ShortID         string            `json:"-"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Kind            string            `json:"kind"`
+	SectionID       uuid.UUID         `json:"section_id"`
+	SectionName     string            `json:"section_name"`
+	Body            string            `json:"body"`
+	Tags            string            `json:"tags"`
+	Robots          string            `json:"robots"`
+	Keywords        string            `json:"keywords"`
+	Sitemap         string            `json:"sitemap"`
+	TableOfContents bool              `json:"table_of_contents"`
+	Share           bool              `json:"share"`
+	Comments        bool              `json:"comments"`
+	Fields          map[string]string `json:"fields"`
+}
+
+// NewArchetype creates a new Archetype for the web layer.
+func NewArchetype(name string) Archetype {
+	return Archetype{
+		Name: name,
+	}
+}
+
+// Type returns the type of the entity.
+func (a *Archetype) Type() string {
+	return hm.DefaultType(archetypeType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (a *Archetype) GetID() uuid.UUID {
+	return a.ID
+}
+
+// GenID delegates to the functional helper.
+func (a *Archetype) GenID() {
+	hm.GenID(a)
+}
+
+// SetID sets the unique identifier of the entity.
+func (a *Archetype) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		a.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (a *Archetype) GetShortID() string {
+	return a.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (a *Archetype) GenShortID() {
+	hm.GenShortID(a)
+}
+
+// SetShortID sets the short ID of the entity.
+func (a *Archetype) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ShortID == "" || shouldForce {
+		a.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (a *Archetype) TypeID() string {
+	return hm.Normalize(a.Type()) + "-" + a.GetShortID()
+}
+
+// IsZero returns true if the Archetype is uninitialized.
+func (a *Archetype) IsZero() bool {
+	return a.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (a *Archetype) Slug() string {
+	return a.Name
+}
+
+func (a *Archetype) OptValue() string {
+	return a.Name
+}
+
+// OptLabel returns the name of the archetype along with the kind of the
+// content it creates.
+func (a *Archetype) OptLabel() string {
+	return a.Name + " (" + a.Kind + ")"
+}
+
+// ToWebArchetype converts a feat.Archetype model to a web.Archetype model.
+func ToWebArchetype(featArchetype feat.Archetype) Archetype {
+	return Archetype{
+		ID:              featArchetype.ID,
+		ShortID:         featArchetype.ShortID,
+		Name:            featArchetype.Name,
+		Description:     featArchetype.Description,
+		Kind:            featArchetype.Kind,
+		SectionID:       featArchetype.SectionID,
+		SectionName:     featArchetype.SectionName,
+		Body:            featArchetype.Body,
+		Tags:            featArchetype.Tags,
+		Robots:          featArchetype.Robots,
+		Keywords:        featArchetype.Keywords,
+		Sitemap:         featArchetype.Sitemap,
+		TableOfContents: featArchetype.TableOfContents,
+		Share:           featArchetype.Share,
+		Comments:        featArchetype.Comments,
+		Fields:          featArchetype.Fields,
+	}
+}
+
+// ToWebArchetypes converts a slice of feat.Archetype models to a slice of
+// web.Archetype models.
+func ToWebArchetypes(featArchetypes []feat.Archetype) []Archetype {
+	webArchetypes := make([]Archetype, len(featArchetypes))
+	for i, a := range featArchetypes {
+		webArchetypes[i] = ToWebArchetype(a)
+	}
+	return webArchetypes
+}
@@ -0,0 +1,127 @@
+package ssg
+
+import (
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	authorType = "author"
+)
+
+// Author model for the web layer.
+type Author struct {
+	ID        uuid.UUID `json:"id"`
+	ShortID   string    `json:"-"`
+	UserID    uuid.UUID `json:"user_id"`
+	Name      string    `json:"name"`
+	SlugField string    `json:"slug"`
+	Bio       string    `json:"bio"`
+	Avatar    string    `json:"avatar"`
+	Links     string    `json:"links"`
+}
+
+// NewAuthor creates a new Author for the web layer.
+func NewAuthor(name string) Author {
+	return Author{
+		Name: name,
+	}
+}
+
+// Type returns the type of the entity.
+func (a *Author) Type() string {
+	return hm.DefaultType(authorType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (a *Author) GetID() uuid.UUID {
+	return a.ID
+}
+
+// GenID delegates to the functional helper.
+func (a *Author) GenID() {
+	hm.GenID(a)
+}
+
+// SetID sets the unique identifier of the entity.
+func (a *Author) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		a.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (a *Author) GetShortID() string {
+	return a.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (a *Author) GenShortID() {
+	hm.GenShortID(a)
+}
+
+// SetShortID sets the short ID of the entity.
+func (a *Author) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if a.ShortID == "" || shouldForce {
+		a.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (a *Author) TypeID() string {
+	return hm.Normalize(a.Type()) + "-" + a.GetShortID()
+}
+
+// IsZero returns true if the Author is uninitialized.
+func (a *Author) IsZero() bool {
+	return a.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (a *Author) Slug() string {
+	if a.SlugField != "" {
+		return a.SlugField
+	}
+	return feat.NormalizeSlug(a.Name)
+}
+
+// Path returns the path of the author page in the generated site.
+func (a *Author) Path() string {
+	return feat.AuthorIndexPath(a.Slug())
+}
+
+func (a *Author) OptValue() string {
+	return a.GetID().String()
+}
+
+func (a *Author) OptLabel() string {
+	return a.Name
+}
+
+// ToWebAuthor converts a feat.Author model to a web.Author model.
+func ToWebAuthor(featAuthor feat.Author) Author {
+	return Author{
+		ID:        featAuthor.ID,
+		ShortID:   featAuthor.ShortID,
+		UserID:    featAuthor.UserID,
+		Name:      featAuthor.Name,
+		SlugField: featAuthor.Slug(),
+		Bio:       featAuthor.Bio,
+		Avatar:    featAuthor.Avatar,
+		Links:     featAuthor.Links,
+	}
+}
+
+// ToWebAuthors converts a slice of feat.Author models to a slice of
+// web.Author models.
+func ToWebAuthors(featAuthors []feat.Author) []Author {
+	webAuthors := make([]Author, len(featAuthors))
+	for i, a := range featAuthors {
+		webAuthors[i] = ToWebAuthor(a)
+	}
+	return webAuthors
+}
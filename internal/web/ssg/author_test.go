@@ -0,0 +1,49 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestAuthorPath(t *testing.T) {
+	tests := []struct {
+		name   string
+		author Author
+		want   string
+	}{
+		{name: "uses slug field", author: Author{Name: "Jane Doe", SlugField: "jane"}, want: "/authors/jane/"},
+		{name: "derives slug from name", author: Author{Name: "Jane Doe"}, want: "/authors/jane-doe/"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.author.Path(); got != tt.want {
+				t.Errorf("Path() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToWebAuthor(t *testing.T) {
+	featAuthor := feat.Author{
+		ID:     uuid.New(),
+		UserID: uuid.New(),
+		Name:   "Jane Doe",
+		Bio:    "Writes about Go",
+		Avatar: "/static/jane.png",
+	}
+
+	webAuthor := ToWebAuthor(featAuthor)
+
+	if webAuthor.ID != featAuthor.ID || webAuthor.UserID != featAuthor.UserID {
+		t.Errorf("ToWebAuthor() IDs = %v, %v", webAuthor.ID, webAuthor.UserID)
+	}
+	if webAuthor.SlugField != "jane-doe" || webAuthor.Bio != "Writes about Go" {
+		t.Errorf("ToWebAuthor() SlugField, Bio = %q, %q", webAuthor.SlugField, webAuthor.Bio)
+	}
+	if len(ToWebAuthors([]feat.Author{featAuthor, featAuthor})) != 2 {
+		t.Error("ToWebAuthors() should convert every author")
+	}
+}
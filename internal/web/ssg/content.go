@@ -21,6 +21,8 @@ type Content struct {
 	SectionID   uuid.UUID  `json:"section_id"`
 	Kind        string     `json:"kind"`
 	Heading     string     `json:"heading"`
+	SlugField   string     `json:"slug"`
+	Locale      string     `json:"locale"`
 	Body        string     `json:"body"`
 	Image       string     `json:"image"`
 	Draft       bool       `json:"draft"`
@@ -30,6 +32,8 @@ type Content struct {
 	Meta        feat.Meta  `json:"meta"`
 	SectionPath string     `json:"section_path,omitempty"`
 	SectionName string     `json:"section_name,omitempty"`
+
+	TranslationGroup string `json:"translation_group"`
 }
 
 // NewContent creates a new Content.
@@ -115,6 +119,8 @@ func ToWebContent(featContent feat.Content) Content {
 		SectionID:   featContent.SectionID,
 		Kind:        featContent.Kind,
 		Heading:     featContent.Heading,
+		SlugField:   featContent.SlugField,
+		Locale:      featContent.Locale,
 		Body:        featContent.Body,
 		Image:       "",
 		Draft:       featContent.Draft,
@@ -124,6 +130,8 @@ func ToWebContent(featContent feat.Content) Content {
 		Meta:        featContent.Meta,
 		SectionPath: featContent.SectionPath,
 		SectionName: featContent.SectionName,
+
+		TranslationGroup: featContent.TranslationGroup,
 	}
 }
 
@@ -0,0 +1,135 @@
+package ssg
+
+import (
+	"strings"
+
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	contentTypeType = "content-type"
+)
+
+// ContentType model for the web layer.
+type ContentType struct {
+	ID         uuid.UUID       `json:"id"`
+	ShortID    string          `json:"-"`
+	Name       string          `json:"name"`
+	Label      string          `json:"label"`
+	LayoutID   uuid.UUID       `json:"layout_id"`
+	LayoutName string          `json:"layout_name"`
+	SectionIDs []uuid.UUID     `json:"section_ids"`
+	Fields     []feat.FieldDef `json:"fields"`
+}
+
+// NewContentType creates a new ContentType for the web layer.
+func NewContentType(name string) ContentType {
+	return ContentType{
+		Name: name,
+	}
+}
+
+// Type returns the type of the entity.
+func (ct *ContentType) Type() string {
+	return hm.DefaultType(contentTypeType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (ct *ContentType) GetID() uuid.UUID {
+	return ct.ID
+}
+
+// GenID delegates to the functional helper.
+func (ct *ContentType) GenID() {
+	hm.GenID(ct)
+}
+
+// SetID sets the unique identifier of the entity.
+func (ct *ContentType) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if ct.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		ct.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (ct *ContentType) GetShortID() string {
+	return ct.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (ct *ContentType) GenShortID() {
+	hm.GenShortID(ct)
+}
+
+// SetShortID sets the short ID of the entity.
+func (ct *ContentType) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if ct.ShortID == "" || shouldForce {
+		ct.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (ct *ContentType) TypeID() string {
+	return hm.Normalize(ct.Type()) + "-" + ct.GetShortID()
+}
+
+// IsZero returns true if the ContentType is uninitialized.
+func (ct *ContentType) IsZero() bool {
+	return ct.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (ct *ContentType) Slug() string {
+	return ct.Name
+}
+
+// FieldNames returns the names of the custom fields of the type, comma
+// separated.
+func (ct *ContentType) FieldNames() string {
+	names := make([]string, len(ct.Fields))
+	for i, f := range ct.Fields {
+		names[i] = f.Name
+	}
+	return strings.Join(names, ", ")
+}
+
+func (ct *ContentType) OptValue() string {
+	return ct.Name
+}
+
+func (ct *ContentType) OptLabel() string {
+	if ct.Label != "" {
+		return ct.Label
+	}
+	return ct.Name
+}
+
+// ToWebContentType converts a feat.ContentType model to a web.ContentType
+// model.
+func ToWebContentType(featContentType feat.ContentType) ContentType {
+	return ContentType{
+		ID:         featContentType.ID,
+		ShortID:    featContentType.ShortID,
+		Name:       featContentType.Name,
+		Label:      featContentType.Label,
+		LayoutID:   featContentType.LayoutID,
+		LayoutName: featContentType.LayoutName,
+		SectionIDs: featContentType.SectionIDs,
+		Fields:     featContentType.Fields,
+	}
+}
+
+// ToWebContentTypes converts a slice of feat.ContentType models to a slice of
+// web.ContentType models.
+func ToWebContentTypes(featContentTypes []feat.ContentType) []ContentType {
+	webContentTypes := make([]ContentType, len(featContentTypes))
+	for i, ct := range featContentTypes {
+		webContentTypes[i] = ToWebContentType(ct)
+	}
+	return webContentTypes
+}
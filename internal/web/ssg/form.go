@@ -21,8 +21,11 @@ type ContentForm struct {
 	ID          string `json:"id"`
 	UserID      string `json:"user_id"`
 	SectionID   string `json:"section_id"`
+	SeriesID    string `json:"series_id"`
 	Kind        string `json:"kind"`
 	Heading     string `json:"heading"`
+	Slug        string `json:"slug"`
+	Locale      string `json:"locale"`
 	Body        string `json:"body"`
 	Image       string `json:"image"`
 	Draft       bool   `json:"draft"`
@@ -30,6 +33,22 @@ type ContentForm struct {
 	PublishedAt string `json:"published_at"`
 	Tags        string `json:"tags"`
 
+	// AuthorIDs lists the authors of the content in byline order.
+	AuthorIDs []string `json:"author_ids"`
+
+	// Fields holds the custom field values of the content, submitted as
+	// field.<kind>.<name> so only the inputs of the selected kind count.
+	Fields map[string]string `json:"fields"`
+
+	// ContentTypes lists the content types of the site, used to render and
+	// validate the custom field inputs.
+	ContentTypes []feat.ContentType `json:"-"`
+
+	// Archetype is the name of the archetype new content starts from.
+	Archetype string `json:"-"`
+
+	TranslationGroup string `json:"translation_group"`
+
 	// Meta fields
 	Description     string `json:"description"`
 	Keywords        string `json:"keywords"`
@@ -58,11 +77,17 @@ func ContentFormFromRequest(r *http.Request) (ContentForm, error) {
 	form.ID = r.Form.Get("id")
 	form.UserID = r.Form.Get("user_id")
 	form.SectionID = r.Form.Get("section_id")
+	form.SeriesID = r.Form.Get("series_id")
 	form.Kind = r.Form.Get("kind")
 	form.Heading = r.Form.Get("heading")
+	form.Slug = strings.TrimSpace(r.Form.Get("slug"))
+	form.Locale = strings.TrimSpace(r.Form.Get("locale"))
+	form.TranslationGroup = strings.TrimSpace(r.Form.Get("translation_group"))
 	form.Body = r.Form.Get("body")
 	form.Image = r.Form.Get("image")
 	form.Tags = r.Form.Get("tags")
+	form.AuthorIDs = r.Form["author_ids"]
+	form.Fields = parseFieldInputs(r, form.Kind)
 	form.Draft, _ = strconv.ParseBool(r.Form.Get("draft"))
 	form.Featured, _ = strconv.ParseBool(r.Form.Get("featured"))
 	form.PublishedAt = r.Form.Get("published_at")
@@ -105,7 +130,17 @@ func ToFeatContent(form ContentForm) feat.Content {
 		}
 	}
 
+	if form.SeriesID != "" {
+		seriesID, err := uuid.Parse(form.SeriesID)
+		if err == nil {
+			content.SeriesID = seriesID
+		}
+	}
+
 	content.Kind = form.Kind
+	content.SlugField = form.Slug
+	content.Locale = form.Locale
+	content.TranslationGroup = form.TranslationGroup
 	// TODO: Handle image via relationship
 	content.Draft = form.Draft
 	content.Featured = form.Featured
@@ -142,6 +177,17 @@ func ToFeatContent(form ContentForm) feat.Content {
 		}
 	}
 
+	// The form always lists the authors, so an empty selection clears them.
+	content.Authors = []feat.Author{}
+	for _, idStr := range form.AuthorIDs {
+		id, err := uuid.Parse(idStr)
+		if err == nil {
+			content.Authors = append(content.Authors, feat.Author{ID: id})
+		}
+	}
+
+	content.Fields = form.Fields
+
 	// Meta
 	meta := feat.NewMeta(content.ID)
 	meta.Description = form.Description
@@ -163,8 +209,14 @@ func ToContentForm(r *http.Request, content feat.Content) ContentForm {
 	form.ID = content.GetID().String()
 	form.UserID = content.UserID.String()
 	form.SectionID = content.SectionID.String()
+	if content.SeriesID != uuid.Nil {
+		form.SeriesID = content.SeriesID.String()
+	}
 	form.Kind = content.Kind
 	form.Heading = content.Heading
+	form.Slug = content.SlugField
+	form.Locale = content.Locale
+	form.TranslationGroup = content.TranslationGroup
 	form.Body = content.Body
 	form.Image = "" // TODO: Get image via relationship
 	form.Draft = content.Draft
@@ -180,6 +232,11 @@ func ToContentForm(r *http.Request, content feat.Content) ContentForm {
 	}
 	form.Tags = strings.Join(tagNames, ",")
 
+	for _, author := range content.Authors {
+		form.AuthorIDs = append(form.AuthorIDs, author.ID.String())
+	}
+	form.Fields = content.Fields
+
 	// Meta
 	form.Description = content.Meta.Description
 	form.Keywords = content.Meta.Keywords
@@ -193,12 +250,66 @@ func ToContentForm(r *http.Request, content feat.Content) ContentForm {
 	return form
 }
 
+// HasAuthor reports whether the author of id is selected.
+func (f *ContentForm) HasAuthor(id string) bool {
+	for _, authorID := range f.AuthorIDs {
+		if authorID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// FieldInput returns the name of the input of the custom field name of
+// kind.
+func (f *ContentForm) FieldInput(kind, name string) string {
+	return fieldInputPrefix(kind) + name
+}
+
+// FieldValue returns the value of the custom field name when kind is the
+// kind of the content, empty otherwise.
+func (f *ContentForm) FieldValue(kind, name string) string {
+	if kind != f.Kind {
+		return ""
+	}
+	return f.Fields[name]
+}
+
+// fieldInputPrefix returns the prefix of the custom field inputs of kind.
+func fieldInputPrefix(kind string) string {
+	return "field." + kind + "."
+}
+
+// parseFieldInputs returns the values of the custom field inputs of kind in
+// the parsed form of r by field name.
+func parseFieldInputs(r *http.Request, kind string) map[string]string {
+	fields := make(map[string]string)
+	prefix := fieldInputPrefix(kind)
+	for key, values := range r.Form {
+		if name, ok := strings.CutPrefix(key, prefix); ok && name != "" {
+			fields[name] = strings.TrimSpace(values[0])
+		}
+	}
+	return fields
+}
+
 // Validate validates the ContentForm.
 func (f *ContentForm) Validate() {
 	validation := f.Validation()
 	if f.Heading == "" {
 		validation.AddFieldError("heading", f.Heading, "Heading cannot be empty")
 	}
+	if f.Slug != "" && feat.NormalizeSlug(f.Slug) != f.Slug {
+		validation.AddFieldError("slug", f.Slug, "Slug can only contain lowercase letters, numbers and hyphens")
+	}
+	if f.Locale != "" && !feat.ValidLocale(feat.NormalizeLocale(f.Locale)) {
+		validation.AddFieldError("locale", f.Locale, "Locale must be a language code such as en or pt-BR")
+	}
+	if ct, ok := feat.FindContentType(f.ContentTypes, f.Kind); ok {
+		if err := ct.CheckFields(f.Fields); err != nil {
+			validation.AddFieldError("fields", "", err.Error())
+		}
+	}
 	f.SetValidation(validation)
 }
 
@@ -276,6 +387,8 @@ type SectionForm struct {
 	Description string `json:"description"`
 	Path        string `json:"path"`
 	LayoutID    string `json:"layout_id"`
+	ParentID    string `json:"parent_id"`
+	RollUp      bool   `json:"roll_up"`
 	Header      string `json:"header"`
 	BlogHeader  string `json:"blog_header"`
 }
@@ -299,6 +412,8 @@ func SectionFormFromRequest(r *http.Request) (SectionForm, error) {
 	form.Description = r.Form.Get("description")
 	form.Path = r.Form.Get("path")
 	form.LayoutID = r.Form.Get("layout_id")
+	form.ParentID = r.Form.Get("parent_id")
+	form.RollUp, _ = strconv.ParseBool(r.Form.Get("roll_up"))
 	form.Header = r.Form.Get("header")
 	form.BlogHeader = r.Form.Get("blog_header")
 
@@ -309,6 +424,8 @@ func SectionFormFromRequest(r *http.Request) (SectionForm, error) {
 func ToFeatSection(form SectionForm) feat.Section {
 	layoutID, _ := uuid.Parse(form.LayoutID)
 	section := feat.NewSection(form.Name, form.Description, form.Path, layoutID)
+	section.ParentID, _ = uuid.Parse(form.ParentID)
+	section.RollUp = form.RollUp
 	// TODO: Handle header and blog header via relationships
 	if form.ID != "" {
 		id, err := uuid.Parse(form.ID)
@@ -328,6 +445,10 @@ func ToSectionForm(r *http.Request, section feat.Section) SectionForm {
 	form.Description = section.Description
 	form.Path = section.Path
 	form.LayoutID = section.LayoutID.String()
+	if section.ParentID != uuid.Nil {
+		form.ParentID = section.ParentID.String()
+	}
+	form.RollUp = section.RollUp
 	form.Header = ""     // TODO: Get header via relationship
 	form.BlogHeader = "" // TODO: Get blog header via relationship
 	return form
@@ -406,6 +527,355 @@ func (f *TagForm) Validate() {
 	f.SetValidation(validation)
 }
 
+// SeriesForm represents the form data for a series.
+type SeriesForm struct {
+	*hm.BaseForm
+	ID          string `json:"id"`
+	SectionID   string `json:"section_id"`
+	Name        string `json:"name"`
+	Slug        string `json:"slug"`
+	Description string `json:"description"`
+	CoverImage  string `json:"cover_image"`
+	Status      string `json:"status"`
+}
+
+// NewSeriesForm creates a new SeriesForm from a request.
+func NewSeriesForm(r *http.Request) SeriesForm {
+	return SeriesForm{
+		BaseForm: hm.NewBaseForm(r),
+		Status:   feat.SeriesStatusOngoing,
+	}
+}
+
+// SeriesFormFromRequest creates a SeriesForm from an HTTP request.
+func SeriesFormFromRequest(r *http.Request) (SeriesForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return SeriesForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewSeriesForm(r)
+	form.ID = r.Form.Get("id")
+	form.SectionID = r.Form.Get("section_id")
+	form.Name = r.Form.Get("name")
+	form.Slug = strings.TrimSpace(r.Form.Get("slug"))
+	form.Description = r.Form.Get("description")
+	form.CoverImage = strings.TrimSpace(r.Form.Get("cover_image"))
+	form.Status = r.Form.Get("status")
+
+	return form, nil
+}
+
+// ToFeatSeries converts a SeriesForm to a feat.Series model.
+func ToFeatSeries(form SeriesForm) feat.Series {
+	sectionID, _ := uuid.Parse(form.SectionID)
+	series := feat.NewSeries(form.Name, form.Description, sectionID)
+	series.SlugField = form.Slug
+	series.CoverImage = form.CoverImage
+	series.Status = form.Status
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			series.ID = id
+		}
+	}
+	return series
+}
+
+// ToSeriesForm converts a feat.Series model to a SeriesForm.
+func ToSeriesForm(r *http.Request, featSeries feat.Series) SeriesForm {
+	form := NewSeriesForm(r)
+	form.ID = featSeries.GetID().String()
+	form.SectionID = featSeries.SectionID.String()
+	form.Name = featSeries.Name
+	form.Slug = featSeries.SlugField
+	form.Description = featSeries.Description
+	form.CoverImage = featSeries.CoverImage
+	form.Status = featSeries.Status
+	return form
+}
+
+// Validate validates the SeriesForm.
+func (f *SeriesForm) Validate() {
+	validation := f.Validation()
+	if f.Name == "" {
+		validation.AddFieldError("name", f.Name, "Name cannot be empty")
+	}
+	if f.Slug != "" && feat.NormalizeSlug(f.Slug) != f.Slug {
+		validation.AddFieldError("slug", f.Slug, "Slug can only contain lowercase letters, numbers and hyphens")
+	}
+	if !feat.ValidSeriesStatus(f.Status) {
+		validation.AddFieldError("status", f.Status, "Status must be ongoing or complete")
+	}
+	f.SetValidation(validation)
+}
+
+// AuthorForm represents the form data for an author.
+type AuthorForm struct {
+	*hm.BaseForm
+	ID     string `json:"id"`
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+	Slug   string `json:"slug"`
+	Bio    string `json:"bio"`
+	Avatar string `json:"avatar"`
+	Links  string `json:"links"`
+}
+
+// NewAuthorForm creates a new AuthorForm from a request.
+func NewAuthorForm(r *http.Request) AuthorForm {
+	return AuthorForm{
+		BaseForm: hm.NewBaseForm(r),
+	}
+}
+
+// AuthorFormFromRequest creates an AuthorForm from an HTTP request.
+func AuthorFormFromRequest(r *http.Request) (AuthorForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return AuthorForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewAuthorForm(r)
+	form.ID = r.Form.Get("id")
+	form.UserID = r.Form.Get("user_id")
+	form.Name = strings.TrimSpace(r.Form.Get("name"))
+	form.Slug = strings.TrimSpace(r.Form.Get("slug"))
+	form.Bio = r.Form.Get("bio")
+	form.Avatar = strings.TrimSpace(r.Form.Get("avatar"))
+	form.Links = r.Form.Get("links")
+
+	return form, nil
+}
+
+// ToFeatAuthor converts an AuthorForm to a feat.Author model.
+func ToFeatAuthor(form AuthorForm) feat.Author {
+	userID, _ := uuid.Parse(form.UserID)
+	author := feat.NewAuthor(form.Name, userID)
+	author.SlugField = form.Slug
+	author.Bio = form.Bio
+	author.Avatar = form.Avatar
+	author.Links = form.Links
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			author.ID = id
+		}
+	}
+	return author
+}
+
+// ToAuthorForm converts a feat.Author model to an AuthorForm.
+func ToAuthorForm(r *http.Request, featAuthor feat.Author) AuthorForm {
+	form := NewAuthorForm(r)
+	form.ID = featAuthor.GetID().String()
+	if featAuthor.UserID != uuid.Nil {
+		form.UserID = featAuthor.UserID.String()
+	}
+	form.Name = featAuthor.Name
+	form.Slug = featAuthor.SlugField
+	form.Bio = featAuthor.Bio
+	form.Avatar = featAuthor.Avatar
+	form.Links = featAuthor.Links
+	return form
+}
+
+// Validate validates the AuthorForm.
+func (f *AuthorForm) Validate() {
+	validation := f.Validation()
+	if f.Name == "" {
+		validation.AddFieldError("name", f.Name, "Name cannot be empty")
+	}
+	if f.Slug != "" && feat.NormalizeSlug(f.Slug) != f.Slug {
+		validation.AddFieldError("slug", f.Slug, "Slug can only contain lowercase letters, numbers and hyphens")
+	}
+	if f.UserID != "" {
+		if _, err := uuid.Parse(f.UserID); err != nil {
+			validation.AddFieldError("user_id", f.UserID, "User is not valid")
+		}
+	}
+	f.SetValidation(validation)
+}
+
+// MenuForm represents the form data for a menu.
+type MenuForm struct {
+	*hm.BaseForm
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Location string `json:"location"`
+}
+
+// NewMenuForm creates a new MenuForm from a request.
+func NewMenuForm(r *http.Request) MenuForm {
+	return MenuForm{
+		BaseForm: hm.NewBaseForm(r),
+		Location: feat.MenuLocationMain,
+	}
+}
+
+// MenuFormFromRequest creates a MenuForm from an HTTP request.
+func MenuFormFromRequest(r *http.Request) (MenuForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return MenuForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewMenuForm(r)
+	form.ID = r.Form.Get("id")
+	form.Name = r.Form.Get("name")
+	form.Location = r.Form.Get("location")
+
+	return form, nil
+}
+
+// ToFeatMenu converts a MenuForm to a feat.Menu model.
+func ToFeatMenu(form MenuForm) feat.Menu {
+	menu := feat.NewMenu(form.Name, form.Location)
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			menu.ID = id
+		}
+	}
+	return menu
+}
+
+// ToMenuForm converts a feat.Menu model to a MenuForm.
+func ToMenuForm(r *http.Request, featMenu feat.Menu) MenuForm {
+	form := NewMenuForm(r)
+	form.ID = featMenu.GetID().String()
+	form.Name = featMenu.Name
+	form.Location = featMenu.Location
+	return form
+}
+
+// Validate validates the MenuForm.
+func (f *MenuForm) Validate() {
+	validation := f.Validation()
+	if f.Name == "" {
+		validation.AddFieldError("name", f.Name, "Name cannot be empty")
+	}
+	if !feat.ValidMenuLocation(f.Location) {
+		validation.AddFieldError("location", f.Location, "Location must be main, footer or submenu")
+	}
+	f.SetValidation(validation)
+}
+
+// MenuItemForm represents the form data for a menu item. The target is
+// picked from the select matching the target type.
+type MenuItemForm struct {
+	*hm.BaseForm
+	ID         string `json:"id"`
+	MenuID     string `json:"menu_id"`
+	ParentID   string `json:"parent_id"`
+	Label      string `json:"label"`
+	TargetType string `json:"target_type"`
+	SectionID  string `json:"section_id"`
+	ContentID  string `json:"content_id"`
+	TagID      string `json:"tag_id"`
+	URL        string `json:"url"`
+}
+
+// NewMenuItemForm creates a new MenuItemForm from a request.
+func NewMenuItemForm(r *http.Request) MenuItemForm {
+	return MenuItemForm{
+		BaseForm:   hm.NewBaseForm(r),
+		TargetType: feat.MenuTargetSection,
+	}
+}
+
+// MenuItemFormFromRequest creates a MenuItemForm from an HTTP request.
+func MenuItemFormFromRequest(r *http.Request) (MenuItemForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return MenuItemForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewMenuItemForm(r)
+	form.ID = r.Form.Get("id")
+	form.MenuID = r.Form.Get("menu_id")
+	form.ParentID = r.Form.Get("parent_id")
+	form.Label = strings.TrimSpace(r.Form.Get("label"))
+	form.TargetType = r.Form.Get("target_type")
+	form.SectionID = r.Form.Get("section_id")
+	form.ContentID = r.Form.Get("content_id")
+	form.TagID = r.Form.Get("tag_id")
+	form.URL = strings.TrimSpace(r.Form.Get("url"))
+
+	return form, nil
+}
+
+// targetID returns the ID selected for the target type of the form.
+func (f *MenuItemForm) targetID() string {
+	switch f.TargetType {
+	case feat.MenuTargetSection:
+		return f.SectionID
+	case feat.MenuTargetContent:
+		return f.ContentID
+	case feat.MenuTargetTag:
+		return f.TagID
+	}
+	return ""
+}
+
+// ToFeatMenuItem converts a MenuItemForm to a feat.MenuItem model.
+func ToFeatMenuItem(form MenuItemForm) feat.MenuItem {
+	menuID, _ := uuid.Parse(form.MenuID)
+	item := feat.NewMenuItem(menuID, form.Label, form.TargetType)
+	item.ParentID, _ = uuid.Parse(form.ParentID)
+	item.TargetID, _ = uuid.Parse(form.targetID())
+	if form.TargetType == feat.MenuTargetURL {
+		item.URL = form.URL
+	}
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			item.ID = id
+		}
+	}
+	return item
+}
+
+// ToMenuItemForm converts a feat.MenuItem model to a MenuItemForm.
+func ToMenuItemForm(r *http.Request, featItem feat.MenuItem) MenuItemForm {
+	form := NewMenuItemForm(r)
+	form.ID = featItem.GetID().String()
+	form.MenuID = featItem.MenuID.String()
+	if featItem.ParentID != uuid.Nil {
+		form.ParentID = featItem.ParentID.String()
+	}
+	form.Label = featItem.Label
+	form.TargetType = featItem.TargetType
+	switch featItem.TargetType {
+	case feat.MenuTargetSection:
+		form.SectionID = featItem.TargetID.String()
+	case feat.MenuTargetContent:
+		form.ContentID = featItem.TargetID.String()
+	case feat.MenuTargetTag:
+		form.TagID = featItem.TargetID.String()
+	}
+	form.URL = featItem.URL
+	return form
+}
+
+// Validate validates the MenuItemForm.
+func (f *MenuItemForm) Validate() {
+	validation := f.Validation()
+	if _, err := uuid.Parse(f.MenuID); err != nil {
+		validation.AddFieldError("menu_id", f.MenuID, "Menu is not valid")
+	}
+	switch f.TargetType {
+	case feat.MenuTargetURL:
+		if f.URL == "" {
+			validation.AddFieldError("url", f.URL, "URL cannot be empty")
+		}
+	case feat.MenuTargetSection, feat.MenuTargetContent, feat.MenuTargetTag:
+		if _, err := uuid.Parse(f.targetID()); err != nil {
+			field := f.TargetType + "_id"
+			validation.AddFieldError(field, f.targetID(), "Pick the "+f.TargetType+" to link to")
+		}
+	default:
+		validation.AddFieldError("target_type", f.TargetType, "Target must be a section, content, tag or URL")
+	}
+	f.SetValidation(validation)
+}
+
 // ParamForm represents the form data for a param.
 type ParamForm struct {
 	*hm.BaseForm
@@ -475,3 +945,342 @@ func (f *ParamForm) Validate() {
 	}
 	f.SetValidation(validation)
 }
+
+// DataFileForm represents the form data for a site data file.
+type DataFileForm struct {
+	*hm.BaseForm
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+// NewDataFileForm creates a new DataFileForm from a request.
+func NewDataFileForm(r *http.Request) DataFileForm {
+	return DataFileForm{
+		BaseForm: hm.NewBaseForm(r),
+	}
+}
+
+// DataFileFormFromRequest creates a DataFileForm from an HTTP request.
+func DataFileFormFromRequest(r *http.Request) (DataFileForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return DataFileForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewDataFileForm(r)
+	form.Name = strings.TrimSpace(r.Form.Get("name"))
+	form.Content = r.Form.Get("content")
+
+	return form, nil
+}
+
+// ToFeatDataFile converts a DataFileForm to a feat.DataFile model.
+func ToFeatDataFile(form DataFileForm) feat.DataFile {
+	return feat.DataFile{
+		Name:    form.Name,
+		Format:  feat.DataFileFormat(form.Name),
+		Content: form.Content,
+	}
+}
+
+// ToDataFileForm converts a feat.DataFile model to a DataFileForm.
+func ToDataFileForm(r *http.Request, file feat.DataFile) DataFileForm {
+	form := NewDataFileForm(r)
+	form.Name = file.Name
+	form.Content = file.Content
+	return form
+}
+
+// Validate validates the DataFileForm. Content that cannot be parsed is
+// reported with its line.
+func (f *DataFileForm) Validate() {
+	validation := f.Validation()
+	if !feat.ValidDataFileName(f.Name) {
+		validation.AddFieldError("name", f.Name, "Name can only contain letters, numbers, hyphens and underscores, with a .yaml, .yml, .json, .toml or .csv extension")
+	} else if _, err := feat.ParseDataFile(f.Name, []byte(f.Content)); err != nil {
+		validation.AddFieldError("content", "", err.Error())
+	}
+	f.SetValidation(validation)
+}
+
+// contentTypeBlankRows is the number of empty field rows the content type
+// form offers to add fields.
+const contentTypeBlankRows = 3
+
+// ContentTypeForm represents the form data for a content type. Fields are
+// submitted as rows of field_name, field_label, field_type and
+// field_required, the latter valued with the row index.
+type ContentTypeForm struct {
+	*hm.BaseForm
+	ID         string          `json:"id"`
+	Name       string          `json:"name"`
+	Label      string          `json:"label"`
+	LayoutID   string          `json:"layout_id"`
+	SectionIDs []string        `json:"section_ids"`
+	Fields     []feat.FieldDef `json:"fields"`
+}
+
+// NewContentTypeForm creates a new ContentTypeForm from a request.
+func NewContentTypeForm(r *http.Request) ContentTypeForm {
+	return ContentTypeForm{
+		BaseForm: hm.NewBaseForm(r),
+	}
+}
+
+// ContentTypeFormFromRequest creates a ContentTypeForm from an HTTP request.
+// Rows without a field name are left out.
+func ContentTypeFormFromRequest(r *http.Request) (ContentTypeForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return ContentTypeForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewContentTypeForm(r)
+	form.ID = r.Form.Get("id")
+	form.Name = strings.TrimSpace(r.Form.Get("name"))
+	form.Label = strings.TrimSpace(r.Form.Get("label"))
+	form.LayoutID = r.Form.Get("layout_id")
+	form.SectionIDs = r.Form["section_ids"]
+
+	required := make(map[string]bool)
+	for _, i := range r.Form["field_required"] {
+		required[i] = true
+	}
+	labels := r.Form["field_label"]
+	types := r.Form["field_type"]
+	for i, name := range r.Form["field_name"] {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		f := feat.FieldDef{Name: name, Required: required[strconv.Itoa(i)]}
+		if i < len(labels) {
+			f.Label = strings.TrimSpace(labels[i])
+		}
+		if i < len(types) {
+			f.Type = types[i]
+		}
+		form.Fields = append(form.Fields, f)
+	}
+
+	return form, nil
+}
+
+// ToFeatContentType converts a ContentTypeForm to a feat.ContentType model.
+func ToFeatContentType(form ContentTypeForm) feat.ContentType {
+	contentType := feat.NewContentType(form.Name, form.Label)
+	contentType.Fields = append([]feat.FieldDef(nil), form.Fields...)
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			contentType.ID = id
+		}
+	}
+	if form.LayoutID != "" {
+		layoutID, err := uuid.Parse(form.LayoutID)
+		if err == nil {
+			contentType.LayoutID = layoutID
+		}
+	}
+	for _, idStr := range form.SectionIDs {
+		id, err := uuid.Parse(idStr)
+		if err == nil {
+			contentType.SectionIDs = append(contentType.SectionIDs, id)
+		}
+	}
+	return contentType
+}
+
+// ToContentTypeForm converts a feat.ContentType model to a ContentTypeForm.
+func ToContentTypeForm(r *http.Request, featContentType feat.ContentType) ContentTypeForm {
+	form := NewContentTypeForm(r)
+	form.ID = featContentType.GetID().String()
+	form.Name = featContentType.Name
+	form.Label = featContentType.Label
+	if featContentType.LayoutID != uuid.Nil {
+		form.LayoutID = featContentType.LayoutID.String()
+	}
+	for _, id := range featContentType.SectionIDs {
+		form.SectionIDs = append(form.SectionIDs, id.String())
+	}
+	form.Fields = featContentType.Fields
+	return form
+}
+
+// Rows returns the field rows to render: the fields of the type followed by
+// blank rows to add new ones.
+func (f *ContentTypeForm) Rows() []feat.FieldDef {
+	rows := make([]feat.FieldDef, 0, len(f.Fields)+contentTypeBlankRows)
+	rows = append(rows, f.Fields...)
+	for i := 0; i < contentTypeBlankRows; i++ {
+		rows = append(rows, feat.FieldDef{Type: feat.FieldTypeString})
+	}
+	return rows
+}
+
+// HasSection reports whether the section of id is allowed.
+func (f *ContentTypeForm) HasSection(id string) bool {
+	for _, sectionID := range f.SectionIDs {
+		if sectionID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate validates the ContentTypeForm. Problems in the field schema are
+// reported as they are found.
+func (f *ContentTypeForm) Validate() {
+	validation := f.Validation()
+	if f.Name == "" {
+		validation.AddFieldError("name", f.Name, "Name cannot be empty")
+	} else if feat.NormalizeSlug(f.Name) != f.Name {
+		validation.AddFieldError("name", f.Name, "Name can only contain lowercase letters, numbers and hyphens")
+	} else {
+		contentType := ToFeatContentType(*f)
+		contentType.Normalize()
+		if err := contentType.Validate(); err != nil {
+			validation.AddFieldError("fields", "", err.Error())
+		}
+	}
+	f.SetValidation(validation)
+}
+
+// ArchetypeForm represents the form data for an archetype. Default custom
+// field values are submitted as field.<kind>.<name>, like in ContentForm.
+type ArchetypeForm struct {
+	*hm.BaseForm
+	ID              string            `json:"id"`
+	Name            string            `json:"name"`
+	Description     string            `json:"description"`
+	Kind            string            `json:"kind"`
+	SectionID       string            `json:"section_id"`
+	Body            string            `json:"body"`
+	Tags            string            `json:"tags"`
+	Robots          string            `json:"robots"`
+	Keywords        string            `json:"keywords"`
+	Sitemap         string            `json:"sitemap"`
+	TableOfContents bool              `json:"table_of_contents"`
+	Share           bool              `json:"share"`
+	Comments        bool              `json:"comments"`
+	Fields          map[string]string `json:"fields"`
+
+	// ContentTypes lists the content types of the site, used to render and
+	// validate the custom field inputs.
+	ContentTypes []feat.ContentType `json:"-"`
+}
+
+// NewArchetypeForm creates a new ArchetypeForm from a request.
+func NewArchetypeForm(r *http.Request) ArchetypeForm {
+	return ArchetypeForm{
+		BaseForm: hm.NewBaseForm(r),
+	}
+}
+
+// ArchetypeFormFromRequest creates an ArchetypeForm from an HTTP request.
+func ArchetypeFormFromRequest(r *http.Request) (ArchetypeForm, error) {
+	if err := r.ParseForm(); err != nil {
+		return ArchetypeForm{}, fmt.Errorf("error parsing form: %w", err)
+	}
+
+	form := NewArchetypeForm(r)
+	form.ID = r.Form.Get("id")
+	form.Name = strings.TrimSpace(r.Form.Get("name"))
+	form.Description = strings.TrimSpace(r.Form.Get("description"))
+	form.Kind = r.Form.Get("kind")
+	form.SectionID = r.Form.Get("section_id")
+	form.Body = r.Form.Get("body")
+	form.Tags = r.Form.Get("tags")
+	form.Robots = r.Form.Get("robots")
+	form.Keywords = r.Form.Get("keywords")
+	form.Sitemap = r.Form.Get("sitemap")
+	form.TableOfContents, _ = strconv.ParseBool(r.Form.Get("table_of_contents"))
+	form.Share, _ = strconv.ParseBool(r.Form.Get("share"))
+	form.Comments, _ = strconv.ParseBool(r.Form.Get("comments"))
+	form.Fields = parseFieldInputs(r, form.Kind)
+
+	return form, nil
+}
+
+// ToFeatArchetype converts an ArchetypeForm to a feat.Archetype model.
+func ToFeatArchetype(form ArchetypeForm) feat.Archetype {
+	archetype := feat.NewArchetype(form.Name, form.Kind)
+	if form.ID != "" {
+		id, err := uuid.Parse(form.ID)
+		if err == nil {
+			archetype.ID = id
+		}
+	}
+	if form.SectionID != "" {
+		sectionID, err := uuid.Parse(form.SectionID)
+		if err == nil {
+			archetype.SectionID = sectionID
+		}
+	}
+	archetype.Description = form.Description
+	archetype.Body = form.Body
+	archetype.Tags = form.Tags
+	archetype.Robots = form.Robots
+	archetype.Keywords = form.Keywords
+	archetype.Sitemap = form.Sitemap
+	archetype.TableOfContents = form.TableOfContents
+	archetype.Share = form.Share
+	archetype.Comments = form.Comments
+	archetype.Fields = form.Fields
+	return archetype
+}
+
+// ToArchetypeForm converts a feat.Archetype model to an ArchetypeForm.
+func ToArchetypeForm(r *http.Request, featArchetype feat.Archetype) ArchetypeForm {
+	form := NewArchetypeForm(r)
+	form.ID = featArchetype.GetID().String()
+	form.Name = featArchetype.Name
+	form.Description = featArchetype.Description
+	form.Kind = featArchetype.Kind
+	if featArchetype.SectionID != uuid.Nil {
+		form.SectionID = featArchetype.SectionID.String()
+	}
+	form.Body = featArchetype.Body
+	form.Tags = featArchetype.Tags
+	form.Robots = featArchetype.Robots
+	form.Keywords = featArchetype.Keywords
+	form.Sitemap = featArchetype.Sitemap
+	form.TableOfContents = featArchetype.TableOfContents
+	form.Share = featArchetype.Share
+	form.Comments = featArchetype.Comments
+	form.Fields = featArchetype.Fields
+	return form
+}
+
+// FieldInput returns the name of the input of the custom field name of
+// kind.
+func (f *ArchetypeForm) FieldInput(kind, name string) string {
+	return fieldInputPrefix(kind) + name
+}
+
+// FieldValue returns the default value of the custom field name when kind is
+// the kind of the archetype, empty otherwise.
+func (f *ArchetypeForm) FieldValue(kind, name string) string {
+	if kind != f.Kind {
+		return ""
+	}
+	return f.Fields[name]
+}
+
+// Validate validates the ArchetypeForm. Required custom fields may be left
+// empty, content created from the archetype must set them.
+func (f *ArchetypeForm) Validate() {
+	validation := f.Validation()
+	if f.Name == "" {
+		validation.AddFieldError("name", f.Name, "Name cannot be empty")
+	} else if feat.NormalizeSlug(f.Name) != f.Name {
+		validation.AddFieldError("name", f.Name, "Name can only contain lowercase letters, numbers and hyphens")
+	}
+	if f.Kind == "" {
+		validation.AddFieldError("kind", f.Kind, "Kind cannot be empty")
+	}
+	if ct, ok := feat.FindContentType(f.ContentTypes, f.Kind); ok {
+		if err := ct.CheckFieldValues(f.Fields); err != nil {
+			validation.AddFieldError("fields", "", err.Error())
+		}
+	}
+	f.SetValidation(validation)
+}
@@ -458,6 +458,17 @@ func TestToFeatSection(t *testing.T) {
 	if section.LayoutID != layoutID {
 		t.Errorf("LayoutID = %v, want %v", section.LayoutID, layoutID)
 	}
+	if section.ParentID != uuid.Nil || section.RollUp {
+		t.Errorf("ParentID, RollUp = %v, %v, want none", section.ParentID, section.RollUp)
+	}
+
+	parentID := uuid.New()
+	form.ParentID = parentID.String()
+	form.RollUp = true
+	section = ToFeatSection(form)
+	if section.ParentID != parentID || !section.RollUp {
+		t.Errorf("ParentID, RollUp = %v, %v, want %v, true", section.ParentID, section.RollUp, parentID)
+	}
 }
 
 func TestToSectionForm(t *testing.T) {
@@ -614,6 +625,305 @@ func TestTagFormValidate(t *testing.T) {
 	}
 }
 
+func TestSeriesFormFromRequest(t *testing.T) {
+	sectionID := uuid.New()
+	formData := url.Values{
+		"name":        {"Learning Go"},
+		"slug":        {" learning-go "},
+		"section_id":  {sectionID.String()},
+		"status":      {"complete"},
+		"cover_image": {"/static/go.png"},
+	}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form, err := SeriesFormFromRequest(req)
+	if err != nil {
+		t.Fatalf("SeriesFormFromRequest() error = %v", err)
+	}
+
+	series := ToFeatSeries(form)
+	if series.Name != "Learning Go" || series.SlugField != "learning-go" {
+		t.Errorf("Name, SlugField = %q, %q", series.Name, series.SlugField)
+	}
+	if series.SectionID != sectionID {
+		t.Errorf("SectionID = %v, want %v", series.SectionID, sectionID)
+	}
+	if series.Status != feat.SeriesStatusComplete || series.CoverImage != "/static/go.png" {
+		t.Errorf("Status, CoverImage = %q, %q", series.Status, series.CoverImage)
+	}
+}
+
+func TestToSeriesForm(t *testing.T) {
+	series := feat.Series{ID: uuid.New(), SectionID: uuid.New(), Name: "Learning Go", Status: feat.SeriesStatusOngoing}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	form := ToSeriesForm(req, series)
+
+	if form.ID != series.ID.String() || form.SectionID != series.SectionID.String() {
+		t.Errorf("ID, SectionID = %q, %q", form.ID, form.SectionID)
+	}
+	if form.Name != "Learning Go" || form.Status != feat.SeriesStatusOngoing {
+		t.Errorf("Name, Status = %q, %q", form.Name, form.Status)
+	}
+}
+
+func TestSeriesFormValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      SeriesForm
+		wantValid bool
+	}{
+		{
+			name:      "valid form",
+			form:      SeriesForm{Name: "Learning Go", Status: feat.SeriesStatusOngoing},
+			wantValid: true,
+		},
+		{
+			name:      "empty name",
+			form:      SeriesForm{Status: feat.SeriesStatusOngoing},
+			wantValid: false,
+		},
+		{
+			name:      "invalid slug",
+			form:      SeriesForm{Name: "Learning Go", Slug: "Learning Go", Status: feat.SeriesStatusOngoing},
+			wantValid: false,
+		},
+		{
+			name:      "unknown status",
+			form:      SeriesForm{Name: "Learning Go", Status: "paused"},
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewSeriesForm(req).BaseForm
+			tt.form.Validate()
+			isValid := tt.form.Validation().IsValid()
+			if isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestAuthorFormFromRequest(t *testing.T) {
+	userID := uuid.New()
+	formData := url.Values{
+		"name":    {" Jane Doe "},
+		"slug":    {" jane "},
+		"user_id": {userID.String()},
+		"bio":     {"Writes about Go"},
+		"links":   {"Mastodon https://example.social/@jane"},
+	}
+
+	req := httptest.NewRequest("POST", "/", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form, err := AuthorFormFromRequest(req)
+	if err != nil {
+		t.Fatalf("AuthorFormFromRequest() error = %v", err)
+	}
+
+	author := ToFeatAuthor(form)
+	if author.Name != "Jane Doe" || author.SlugField != "jane" || author.UserID != userID {
+		t.Errorf("Name, SlugField, UserID = %q, %q, %v", author.Name, author.SlugField, author.UserID)
+	}
+	if author.Bio != "Writes about Go" || len(author.LinkList()) != 1 {
+		t.Errorf("Bio, Links = %q, %q", author.Bio, author.Links)
+	}
+}
+
+func TestToAuthorForm(t *testing.T) {
+	author := feat.Author{ID: uuid.New(), Name: "Jane Doe", SlugField: "jane"}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	form := ToAuthorForm(req, author)
+
+	if form.ID != author.ID.String() || form.Name != "Jane Doe" || form.Slug != "jane" {
+		t.Errorf("ID, Name, Slug = %q, %q, %q", form.ID, form.Name, form.Slug)
+	}
+	if form.UserID != "" {
+		t.Errorf("UserID = %q, want empty for a guest author", form.UserID)
+	}
+}
+
+func TestAuthorFormValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      AuthorForm
+		wantValid bool
+	}{
+		{
+			name:      "valid form",
+			form:      AuthorForm{Name: "Jane Doe"},
+			wantValid: true,
+		},
+		{
+			name:      "empty name",
+			form:      AuthorForm{},
+			wantValid: false,
+		},
+		{
+			name:      "invalid slug",
+			form:      AuthorForm{Name: "Jane Doe", Slug: "Jane Doe"},
+			wantValid: false,
+		},
+		{
+			name:      "invalid user",
+			form:      AuthorForm{Name: "Jane Doe", UserID: "jane"},
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewAuthorForm(req).BaseForm
+			tt.form.Validate()
+			isValid := tt.form.Validation().IsValid()
+			if isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestContentFormAuthors(t *testing.T) {
+	jane, bob := uuid.New(), uuid.New()
+
+	form := ContentForm{AuthorIDs: []string{jane.String(), "invalid", bob.String()}}
+	content := ToFeatContent(form)
+	if len(content.Authors) != 2 || content.Authors[0].ID != jane || content.Authors[1].ID != bob {
+		t.Errorf("ToFeatContent() Authors = %+v, want Jane, Bob", content.Authors)
+	}
+
+	if authors := ToFeatContent(ContentForm{}).Authors; authors == nil || len(authors) != 0 {
+		t.Errorf("ToFeatContent() without authors = %v, want empty list", authors)
+	}
+
+	req := httptest.NewRequest("GET", "/", nil)
+	back := ToContentForm(req, content)
+	if !back.HasAuthor(bob.String()) || back.HasAuthor(uuid.New().String()) {
+		t.Errorf("ToContentForm() AuthorIDs = %v", back.AuthorIDs)
+	}
+}
+
+func TestMenuFormValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      MenuForm
+		wantValid bool
+	}{
+		{
+			name:      "valid form",
+			form:      MenuForm{Name: "Main", Location: feat.MenuLocationMain},
+			wantValid: true,
+		},
+		{
+			name:      "empty name",
+			form:      MenuForm{Location: feat.MenuLocationFooter},
+			wantValid: false,
+		},
+		{
+			name:      "unknown location",
+			form:      MenuForm{Name: "Main", Location: "sidebar"},
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewMenuForm(req).BaseForm
+			tt.form.Validate()
+			isValid := tt.form.Validation().IsValid()
+			if isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestMenuItemFormValidate(t *testing.T) {
+	menuID := uuid.New().String()
+	tests := []struct {
+		name      string
+		form      MenuItemForm
+		wantValid bool
+	}{
+		{
+			name:      "valid section item",
+			form:      MenuItemForm{MenuID: menuID, TargetType: feat.MenuTargetSection, SectionID: uuid.New().String()},
+			wantValid: true,
+		},
+		{
+			name:      "valid URL item",
+			form:      MenuItemForm{MenuID: menuID, TargetType: feat.MenuTargetURL, URL: "/about/"},
+			wantValid: true,
+		},
+		{
+			name:      "missing menu",
+			form:      MenuItemForm{TargetType: feat.MenuTargetURL, URL: "/about/"},
+			wantValid: false,
+		},
+		{
+			name:      "target picked for another type",
+			form:      MenuItemForm{MenuID: menuID, TargetType: feat.MenuTargetTag, SectionID: uuid.New().String()},
+			wantValid: false,
+		},
+		{
+			name:      "empty URL",
+			form:      MenuItemForm{MenuID: menuID, TargetType: feat.MenuTargetURL},
+			wantValid: false,
+		},
+		{
+			name:      "unknown target type",
+			form:      MenuItemForm{MenuID: menuID, TargetType: "page"},
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewMenuItemForm(req).BaseForm
+			tt.form.Validate()
+			isValid := tt.form.Validation().IsValid()
+			if isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestToFeatMenuItem(t *testing.T) {
+	menuID, contentID := uuid.New(), uuid.New()
+	form := MenuItemForm{
+		MenuID:     menuID.String(),
+		Label:      "About",
+		TargetType: feat.MenuTargetContent,
+		SectionID:  uuid.New().String(),
+		ContentID:  contentID.String(),
+		URL:        "/ignored/",
+	}
+
+	item := ToFeatMenuItem(form)
+
+	if item.MenuID != menuID || item.Label != "About" {
+		t.Errorf("ToFeatMenuItem() = %+v", item)
+	}
+	if item.TargetID != contentID {
+		t.Errorf("ToFeatMenuItem() TargetID = %v, want %v", item.TargetID, contentID)
+	}
+	if item.URL != "" || item.ParentID != uuid.Nil {
+		t.Errorf("ToFeatMenuItem() URL, ParentID = %q, %v", item.URL, item.ParentID)
+	}
+}
+
 func TestParamFormFromRequest(t *testing.T) {
 	formData := url.Values{
 		"name":        {"test_param"},
@@ -722,3 +1032,215 @@ func TestParamFormValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestDataFileFormValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      DataFileForm
+		wantValid bool
+		wantMsg   string
+	}{
+		{
+			name: "valid form",
+			form: DataFileForm{
+				Name:    "team.yaml",
+				Content: "- name: Ada\n",
+			},
+			wantValid: true,
+		},
+		{
+			name: "invalid name",
+			form: DataFileForm{
+				Name:    "../team.yaml",
+				Content: "- name: Ada\n",
+			},
+			wantValid: false,
+		},
+		{
+			name: "invalid content reports the line",
+			form: DataFileForm{
+				Name:    "team.json",
+				Content: "[\n  {\"name\": \"Ada\"},\n  {\"name\": }\n]",
+			},
+			wantValid: false,
+			wantMsg:   "team.json: line 3:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewDataFileForm(req).BaseForm
+			tt.form.Validate()
+			isValid := tt.form.Validation().IsValid()
+			if isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+			if tt.wantMsg != "" {
+				msg := tt.form.Validation().FieldMsg("content")
+				if !strings.Contains(msg, tt.wantMsg) {
+					t.Errorf("content error = %v, want it to contain %q", msg, tt.wantMsg)
+				}
+			}
+		})
+	}
+}
+
+func TestContentTypeFormFromRequest(t *testing.T) {
+	formData := url.Values{
+		"name":           {"recipe"},
+		"label":          {" Recipe "},
+		"field_name":     {"servings", "", "cooked_at"},
+		"field_label":    {"Servings", "", ""},
+		"field_type":     {"number", "string", "date"},
+		"field_required": {"2"},
+	}
+	req := httptest.NewRequest("POST", "/", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form, err := ContentTypeFormFromRequest(req)
+	if err != nil {
+		t.Fatalf("ContentTypeFormFromRequest() error = %v", err)
+	}
+
+	want := []feat.FieldDef{
+		{Name: "servings", Label: "Servings", Type: feat.FieldTypeNumber},
+		{Name: "cooked_at", Type: feat.FieldTypeDate, Required: true},
+	}
+	if form.Label != "Recipe" || len(form.Fields) != len(want) {
+		t.Fatalf("ContentTypeFormFromRequest() = %+v", form)
+	}
+	for i := range want {
+		if form.Fields[i] != want[i] {
+			t.Errorf("Fields[%d] = %+v, want %+v", i, form.Fields[i], want[i])
+		}
+	}
+	if rows := form.Rows(); len(rows) != len(want)+contentTypeBlankRows {
+		t.Errorf("Rows() = %d rows, want %d", len(rows), len(want)+contentTypeBlankRows)
+	}
+}
+
+func TestContentTypeFormValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		form      ContentTypeForm
+		wantValid bool
+	}{
+		{
+			name:      "valid form",
+			form:      ContentTypeForm{Name: "recipe", Fields: []feat.FieldDef{{Name: "servings", Type: feat.FieldTypeNumber}}},
+			wantValid: true,
+		},
+		{
+			name:      "empty name",
+			form:      ContentTypeForm{},
+			wantValid: false,
+		},
+		{
+			name:      "invalid name",
+			form:      ContentTypeForm{Name: "Book Review"},
+			wantValid: false,
+		},
+		{
+			name:      "invalid field name",
+			form:      ContentTypeForm{Name: "recipe", Fields: []feat.FieldDef{{Name: "cooked-at", Type: feat.FieldTypeDate}}},
+			wantValid: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			tt.form.BaseForm = NewContentTypeForm(req).BaseForm
+			tt.form.Validate()
+			if isValid := tt.form.Validation().IsValid(); isValid != tt.wantValid {
+				t.Errorf("Validate() isValid = %v, want %v", isValid, tt.wantValid)
+			}
+		})
+	}
+}
+
+func TestContentFormFields(t *testing.T) {
+	formData := url.Values{
+		"heading":                 {"Pasta"},
+		"kind":                    {"recipe"},
+		"field.recipe.servings":   {" 4 "},
+		"field.event.starts_at":   {"2025-03-01"},
+		"field.recipe.unexpected": {""},
+	}
+	req := httptest.NewRequest("POST", "/", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form, err := ContentFormFromRequest(req)
+	if err != nil {
+		t.Fatalf("ContentFormFromRequest() error = %v", err)
+	}
+	if len(form.Fields) != 2 || form.Fields["servings"] != "4" {
+		t.Errorf("Fields = %v, want the recipe fields only", form.Fields)
+	}
+	if got := form.FieldValue("event", "starts_at"); got != "" {
+		t.Errorf("FieldValue() of another kind = %q, want empty", got)
+	}
+	if content := ToFeatContent(form); content.Fields["servings"] != "4" {
+		t.Errorf("ToFeatContent() Fields = %v", content.Fields)
+	}
+
+	form.ContentTypes = []feat.ContentType{{Name: "recipe", Fields: []feat.FieldDef{
+		{Name: "servings", Type: feat.FieldTypeNumber, Required: true},
+		{Name: "unexpected", Type: feat.FieldTypeString},
+	}}}
+	form.Validate()
+	if !form.Validation().IsValid() {
+		t.Errorf("Validate() = %q, want valid", form.Validation().FieldMsg("fields"))
+	}
+
+	form.Fields["servings"] = "four"
+	form.Validate()
+	if form.Validation().IsValid() {
+		t.Error("Validate() with invalid number is valid, want invalid")
+	}
+}
+
+func TestArchetypeForm(t *testing.T) {
+	sectionID := uuid.New()
+	formData := url.Values{
+		"name":                  {" quick "},
+		"kind":                  {"recipe"},
+		"section_id":            {sectionID.String()},
+		"body":                  {"## Steps"},
+		"tags":                  {"food, quick"},
+		"table_of_contents":     {"true"},
+		"field.recipe.servings": {" 2 "},
+		"field.event.starts_at": {"2025-03-01"},
+	}
+	req := httptest.NewRequest("POST", "/", strings.NewReader(formData.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	form, err := ArchetypeFormFromRequest(req)
+	if err != nil {
+		t.Fatalf("ArchetypeFormFromRequest() error = %v", err)
+	}
+	if form.Name != "quick" || len(form.Fields) != 1 || form.Fields["servings"] != "2" {
+		t.Errorf("ArchetypeFormFromRequest() = %+v, want quick with the recipe fields only", form)
+	}
+
+	archetype := ToFeatArchetype(form)
+	if archetype.SectionID != sectionID || archetype.Body != "## Steps" || !archetype.TableOfContents || archetype.Fields["servings"] != "2" {
+		t.Errorf("ToFeatArchetype() = %+v", archetype)
+	}
+
+	form.ContentTypes = []feat.ContentType{{Name: "recipe", Fields: []feat.FieldDef{
+		{Name: "servings", Type: feat.FieldTypeNumber},
+		{Name: "vegan", Type: feat.FieldTypeBool, Required: true},
+	}}}
+	form.Validate()
+	if !form.Validation().IsValid() {
+		t.Errorf("Validate() = %q, want valid with required field unset", form.Validation().FieldMsg("fields"))
+	}
+
+	form.Fields["servings"] = "two"
+	form.Validate()
+	if form.Validation().IsValid() {
+		t.Error("Validate() with invalid number is valid, want invalid")
+	}
+}
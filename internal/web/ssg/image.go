@@ -23,6 +23,12 @@ type Image struct {
 	Path        string    `json:"path"`        // From ImageVariant
 	URL         string    `json:"url"`         // From ImageVariant
 	AltText     string    `json:"altText"`
+	AltLang     string    `json:"altLang"`
+	Caption     string    `json:"caption"`
+	Decorative  bool      `json:"decorative"`
+	Credit      string    `json:"credit"`
+	License     string    `json:"license"`
+	SourceURL   string    `json:"sourceURL"`
 	MimeType    string    `json:"mimeType"`
 	Size        int64     `json:"size"`
 	Width       int       `json:"width"`
@@ -117,14 +123,23 @@ func (i *Image) StringID() string {
 func ToWebImage(featImage feat.Image) Image {
 	url := "/static/images/" + featImage.FilePath
 	return Image{
-		ID:      featImage.ID,
-		ShortID: featImage.ShortID,
-		Name:    featImage.Title,
-		Path:    featImage.FilePath,
-		URL:     url,
-		AltText: featImage.AltText,
-		Width:   featImage.Width,
-		Height:  featImage.Height,
+		ID:          featImage.ID,
+		ShortID:     featImage.ShortID,
+		Name:        featImage.Title,
+		Description: featImage.LongDescription,
+		Path:        featImage.FilePath,
+		URL:         url,
+		AltText:     featImage.AltText,
+		AltLang:     featImage.AltLang,
+		Caption:     featImage.Caption,
+		Decorative:  featImage.Decorative,
+		Credit:      featImage.Credit,
+		License:     featImage.License,
+		SourceURL:   featImage.SourceURL,
+		MimeType:    featImage.Mime,
+		Size:        featImage.FilesizeByte,
+		Width:       featImage.Width,
+		Height:      featImage.Height,
 	}
 }
 
@@ -144,6 +159,12 @@ type ImageForm struct {
 	Name         string                `json:"name"`
 	Description  string                `json:"description"`
 	AltText      string                `json:"altText"`
+	AltLang      string                `json:"altLang"`
+	Caption      string                `json:"caption"`
+	Decorative   bool                  `json:"decorative"`
+	Credit       string                `json:"credit"`
+	License      string                `json:"license"`
+	SourceURL    string                `json:"sourceURL"`
 	File         *multipart.FileHeader `json:"file"` // For file upload
 }
 
@@ -158,13 +179,33 @@ func NewImageForm(r *http.Request) ImageForm {
 func ToFeatImage(form ImageForm) feat.Image {
 	id, _ := uuid.Parse(form.ID)
 	return feat.Image{
-		ID:      id,
-		Title:   form.Name, // Map Name to Title
-		AltText: form.AltText,
+		ID:              id,
+		Title:           form.Name, // Map Name to Title
+		AltText:         form.AltText,
+		AltLang:         form.AltLang,
+		LongDescription: form.Description,
+		Caption:         form.Caption,
+		Decorative:      form.Decorative,
+		Credit:          form.Credit,
+		License:         form.License,
+		SourceURL:       form.SourceURL,
 		// Path, URL, MimeType, Size, Width, Height are set in webhandlerimage.go from file upload
 	}
 }
 
+// readImageFormValues populates the metadata fields of an ImageForm from the request.
+func readImageFormValues(r *http.Request, form *ImageForm) {
+	form.Name = r.FormValue("name")
+	form.Description = r.FormValue("description")
+	form.AltText = r.FormValue("altText")
+	form.AltLang = r.FormValue("altLang")
+	form.Caption = r.FormValue("caption")
+	form.Decorative = r.FormValue("decorative") == "on" || r.FormValue("decorative") == "true"
+	form.Credit = r.FormValue("credit")
+	form.License = r.FormValue("license")
+	form.SourceURL = r.FormValue("sourceURL")
+}
+
 // ToImageForm converts a web.Image to an ImageForm.
 func ToImageForm(r *http.Request, image Image) ImageForm {
 	form := NewImageForm(r)
@@ -172,6 +213,12 @@ func ToImageForm(r *http.Request, image Image) ImageForm {
 	form.Name = image.Name
 	form.Description = image.Description
 	form.AltText = image.AltText
+	form.AltLang = image.AltLang
+	form.Caption = image.Caption
+	form.Decorative = image.Decorative
+	form.Credit = image.Credit
+	form.License = image.License
+	form.SourceURL = image.SourceURL
 	return form
 }
 
@@ -186,7 +233,8 @@ func (f *ImageForm) Validate() {
 		validation.AddFieldError("name", f.Name, "Name cannot be empty")
 	}
 
-	if f.File == nil || f.File.Size == 0 {
+	// The file can only be provided on creation
+	if f.ID == "" && (f.File == nil || f.File.Size == 0) {
 		validation.AddFieldError("file", "", "Image file is required")
 	}
 }
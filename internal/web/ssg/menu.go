@@ -0,0 +1,236 @@
+package ssg
+
+import (
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	menuType     = "menu"
+	menuItemType = "menu-item"
+)
+
+// Menu model for the web layer.
+type Menu struct {
+	ID       uuid.UUID  `json:"id"`
+	ShortID  string     `json:"-"`
+	Name     string     `json:"name"`
+	Location string     `json:"location"`
+	Items    []MenuItem `json:"items"`
+}
+
+// MenuItem model for the web layer. TargetName is the name of the section,
+// content or tag the item points to, when known.
+type MenuItem struct {
+	ID         uuid.UUID `json:"id"`
+	ShortID    string    `json:"-"`
+	MenuID     uuid.UUID `json:"menu_id"`
+	ParentID   uuid.UUID `json:"parent_id"`
+	Label      string    `json:"label"`
+	TargetType string    `json:"target_type"`
+	TargetID   uuid.UUID `json:"target_id"`
+	TargetName string    `json:"-"`
+	URL        string    `json:"url"`
+	Position   int       `json:"position"`
+}
+
+// NewMenu creates a new Menu for the web layer.
+func NewMenu(name string) Menu {
+	return Menu{
+		Name:     name,
+		Location: feat.MenuLocationMain,
+	}
+}
+
+// Type returns the type of the entity.
+func (m *Menu) Type() string {
+	return hm.DefaultType(menuType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (m *Menu) GetID() uuid.UUID {
+	return m.ID
+}
+
+// GenID delegates to the functional helper.
+func (m *Menu) GenID() {
+	hm.GenID(m)
+}
+
+// SetID sets the unique identifier of the entity.
+func (m *Menu) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if m.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		m.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (m *Menu) GetShortID() string {
+	return m.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (m *Menu) GenShortID() {
+	hm.GenShortID(m)
+}
+
+// SetShortID sets the short ID of the entity.
+func (m *Menu) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if m.ShortID == "" || shouldForce {
+		m.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (m *Menu) TypeID() string {
+	return hm.Normalize(m.Type()) + "-" + m.GetShortID()
+}
+
+// IsZero returns true if the Menu is uninitialized.
+func (m *Menu) IsZero() bool {
+	return m.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (m *Menu) Slug() string {
+	return hm.Normalize(m.Name) + "-" + m.GetShortID()
+}
+
+func (m *Menu) OptValue() string {
+	return m.GetID().String()
+}
+
+func (m *Menu) OptLabel() string {
+	return m.Name
+}
+
+// Type returns the type of the entity.
+func (i *MenuItem) Type() string {
+	return hm.DefaultType(menuItemType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (i *MenuItem) GetID() uuid.UUID {
+	return i.ID
+}
+
+// GenID delegates to the functional helper.
+func (i *MenuItem) GenID() {
+	hm.GenID(i)
+}
+
+// SetID sets the unique identifier of the entity.
+func (i *MenuItem) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if i.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		i.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (i *MenuItem) GetShortID() string {
+	return i.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (i *MenuItem) GenShortID() {
+	hm.GenShortID(i)
+}
+
+// SetShortID sets the short ID of the entity.
+func (i *MenuItem) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if i.ShortID == "" || shouldForce {
+		i.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (i *MenuItem) TypeID() string {
+	return hm.Normalize(i.Type()) + "-" + i.GetShortID()
+}
+
+// IsZero returns true if the MenuItem is uninitialized.
+func (i *MenuItem) IsZero() bool {
+	return i.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (i *MenuItem) Slug() string {
+	return hm.Normalize(i.DisplayLabel()) + "-" + i.GetShortID()
+}
+
+// IsChild reports whether the item is shown below another one.
+func (i MenuItem) IsChild() bool {
+	return i.ParentID != uuid.Nil
+}
+
+// DisplayLabel returns the label of the item, or the name of its target when
+// it has none.
+func (i MenuItem) DisplayLabel() string {
+	switch {
+	case i.Label != "":
+		return i.Label
+	case i.TargetName != "":
+		return i.TargetName
+	}
+	return i.URL
+}
+
+func (i *MenuItem) OptValue() string {
+	return i.GetID().String()
+}
+
+func (i *MenuItem) OptLabel() string {
+	return i.DisplayLabel()
+}
+
+// ToWebMenu converts a feat.Menu model to a web.Menu model.
+func ToWebMenu(featMenu feat.Menu) Menu {
+	return Menu{
+		ID:       featMenu.ID,
+		ShortID:  featMenu.ShortID,
+		Name:     featMenu.Name,
+		Location: featMenu.Location,
+		Items:    ToWebMenuItems(featMenu.Items),
+	}
+}
+
+// ToWebMenus converts a slice of feat.Menu models to a slice of web.Menu
+// models.
+func ToWebMenus(featMenus []feat.Menu) []Menu {
+	webMenus := make([]Menu, len(featMenus))
+	for i, m := range featMenus {
+		webMenus[i] = ToWebMenu(m)
+	}
+	return webMenus
+}
+
+// ToWebMenuItem converts a feat.MenuItem model to a web.MenuItem model.
+func ToWebMenuItem(featItem feat.MenuItem) MenuItem {
+	return MenuItem{
+		ID:         featItem.ID,
+		ShortID:    featItem.ShortID,
+		MenuID:     featItem.MenuID,
+		ParentID:   featItem.ParentID,
+		Label:      featItem.Label,
+		TargetType: featItem.TargetType,
+		TargetID:   featItem.TargetID,
+		URL:        featItem.URL,
+		Position:   featItem.Position,
+	}
+}
+
+// ToWebMenuItems converts a slice of feat.MenuItem models to a slice of
+// web.MenuItem models.
+func ToWebMenuItems(featItems []feat.MenuItem) []MenuItem {
+	webItems := make([]MenuItem, len(featItems))
+	for i, item := range featItems {
+		webItems[i] = ToWebMenuItem(item)
+	}
+	return webItems
+}
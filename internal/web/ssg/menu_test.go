@@ -0,0 +1,73 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestMenuItemDisplayLabel(t *testing.T) {
+	tests := []struct {
+		name string
+		item MenuItem
+		want string
+	}{
+		{name: "uses label", item: MenuItem{Label: "Start", TargetName: "Home", URL: "/"}, want: "Start"},
+		{name: "falls back to target name", item: MenuItem{TargetName: "Blog"}, want: "Blog"},
+		{name: "falls back to URL", item: MenuItem{URL: "https://example.com"}, want: "https://example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.item.DisplayLabel(); got != tt.want {
+				t.Errorf("DisplayLabel() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToWebMenu(t *testing.T) {
+	menuID, parentID := uuid.New(), uuid.New()
+	featMenu := feat.Menu{
+		ID:       menuID,
+		Name:     "Main",
+		Location: feat.MenuLocationMain,
+		Items: []feat.MenuItem{
+			{ID: parentID, MenuID: menuID, Label: "Docs", TargetType: feat.MenuTargetSection, Position: 1},
+			{ID: uuid.New(), MenuID: menuID, ParentID: parentID, TargetType: feat.MenuTargetURL, URL: "/docs/faq/", Position: 1},
+		},
+	}
+
+	webMenu := ToWebMenu(featMenu)
+
+	if webMenu.ID != menuID || webMenu.Name != "Main" || webMenu.Location != feat.MenuLocationMain {
+		t.Errorf("ToWebMenu() = %+v", webMenu)
+	}
+	if len(webMenu.Items) != 2 {
+		t.Fatalf("ToWebMenu() items = %d, want 2", len(webMenu.Items))
+	}
+	if webMenu.Items[0].IsChild() || !webMenu.Items[1].IsChild() {
+		t.Error("ToWebMenu() should keep the item parents")
+	}
+	if len(ToWebMenus([]feat.Menu{featMenu, featMenu})) != 2 {
+		t.Error("ToWebMenus() should convert every menu")
+	}
+}
+
+func TestMenuParents(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	items := []MenuItem{
+		{ID: first},
+		{ID: uuid.New(), ParentID: first},
+		{ID: second},
+	}
+
+	if got := menuParents(items, uuid.Nil); len(got) != 2 {
+		t.Errorf("menuParents() = %d items, want 2", len(got))
+	}
+	got := menuParents(items, second)
+	if len(got) != 1 || got[0].ID != first {
+		t.Errorf("menuParents() should skip the edited item, got %v", got)
+	}
+}
@@ -22,6 +22,8 @@ type Section struct {
 	Header      string    `json:"header"`
 	BlogHeader  string    `json:"blog_header"`
 	LayoutName  string    `json:"layout_name"`
+	ParentID    uuid.UUID `json:"parent_id"`
+	RollUp      bool      `json:"roll_up"`
 }
 
 // NewSection creates a new Section.
@@ -113,6 +115,8 @@ func ToWebSection(featSection feat.Section) Section {
 		Header:      "", // TODO: Get header via relationship
 		BlogHeader:  "", // TODO: Get blog header via relationship
 		LayoutName:  featSection.LayoutName,
+		ParentID:    featSection.ParentID,
+		RollUp:      featSection.RollUp,
 	}
 }
 
@@ -0,0 +1,127 @@
+package ssg
+
+import (
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	seriesType = "series"
+)
+
+// Series model for the web layer.
+type Series struct {
+	ID          uuid.UUID `json:"id"`
+	ShortID     string    `json:"-"`
+	SectionID   uuid.UUID `json:"section_id"`
+	Name        string    `json:"name"`
+	SlugField   string    `json:"slug"`
+	Description string    `json:"description"`
+	CoverImage  string    `json:"cover_image"`
+	Status      string    `json:"status"`
+	SectionName string    `json:"section_name"`
+	SectionPath string    `json:"section_path"`
+}
+
+// NewSeries creates a new Series for the web layer.
+func NewSeries(name string) Series {
+	return Series{
+		Name:   name,
+		Status: feat.SeriesStatusOngoing,
+	}
+}
+
+// Type returns the type of the entity.
+func (s *Series) Type() string {
+	return hm.DefaultType(seriesType)
+}
+
+// GetID returns the unique identifier of the entity.
+func (s *Series) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GenID delegates to the functional helper.
+func (s *Series) GenID() {
+	hm.GenID(s)
+}
+
+// SetID sets the unique identifier of the entity.
+func (s *Series) SetID(id uuid.UUID, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if s.ID == uuid.Nil || (shouldForce && id != uuid.Nil) {
+		s.ID = id
+	}
+}
+
+// ShortID returns the short ID portion of the slug.
+func (s *Series) GetShortID() string {
+	return s.ShortID
+}
+
+// GenShortID delegates to the functional helper.
+func (s *Series) GenShortID() {
+	hm.GenShortID(s)
+}
+
+// SetShortID sets the short ID of the entity.
+func (s *Series) SetShortID(shortID string, force ...bool) {
+	shouldForce := len(force) > 0 && force[0]
+	if s.ShortID == "" || shouldForce {
+		s.ShortID = shortID
+	}
+}
+
+// TypeID returns a universal identifier for a specific model instance.
+func (s *Series) TypeID() string {
+	return hm.Normalize(s.Type()) + "-" + s.GetShortID()
+}
+
+// IsZero returns true if the Series is uninitialized.
+func (s *Series) IsZero() bool {
+	return s.ID == uuid.Nil
+}
+
+// Slug returns a human-readable, URL-friendly string identifier for the entity.
+func (s *Series) Slug() string {
+	if s.SlugField != "" {
+		return s.SlugField
+	}
+	return feat.NormalizeSlug(s.Name)
+}
+
+func (s *Series) OptValue() string {
+	return s.GetID().String()
+}
+
+func (s *Series) OptLabel() string {
+	return s.Name
+}
+
+// ToWebSeries converts a feat.Series model to a web.Series model.
+func ToWebSeries(featSeries feat.Series) Series {
+	return Series{
+		ID:          featSeries.ID,
+		ShortID:     featSeries.ShortID,
+		SectionID:   featSeries.SectionID,
+		Name:        featSeries.Name,
+		SlugField:   featSeries.Slug(),
+		Description: featSeries.Description,
+		CoverImage:  featSeries.CoverImage,
+		Status:      featSeries.Status,
+		SectionName: featSeries.SectionName,
+		SectionPath: featSeries.SectionPath,
+	}
+}
+
+// ToWebSeriesList converts a slice of feat.Series models to a slice of
+// web.Series models.
+func ToWebSeriesList(featSeries []feat.Series) []Series {
+	webSeries := make([]Series, len(featSeries))
+	for i, s := range featSeries {
+		webSeries[i] = ToWebSeries(s)
+	}
+	return webSeries
+}
@@ -0,0 +1,54 @@
+package ssg
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestSeriesSlug(t *testing.T) {
+	tests := []struct {
+		name   string
+		series Series
+		want   string
+	}{
+		{name: "uses slug field", series: Series{Name: "Learning Go", SlugField: "go"}, want: "go"},
+		{name: "derives slug from name", series: Series{Name: "Learning Go"}, want: "learning-go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.series.Slug(); got != tt.want {
+				t.Errorf("Slug() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestToWebSeries(t *testing.T) {
+	featSeries := feat.Series{
+		ID:          uuid.New(),
+		SectionID:   uuid.New(),
+		Name:        "Learning Go",
+		Description: "Go from zero",
+		CoverImage:  "/static/go.png",
+		Status:      feat.SeriesStatusComplete,
+		SectionName: "Guides",
+	}
+
+	webSeries := ToWebSeries(featSeries)
+
+	if webSeries.ID != featSeries.ID || webSeries.SectionID != featSeries.SectionID {
+		t.Errorf("ToWebSeries() IDs = %v, %v", webSeries.ID, webSeries.SectionID)
+	}
+	if webSeries.SlugField != "learning-go" {
+		t.Errorf("ToWebSeries() SlugField = %q, want learning-go", webSeries.SlugField)
+	}
+	if webSeries.Status != feat.SeriesStatusComplete || webSeries.SectionName != "Guides" {
+		t.Errorf("ToWebSeries() Status, SectionName = %q, %q", webSeries.Status, webSeries.SectionName)
+	}
+	if len(ToWebSeriesList([]feat.Series{featSeries, featSeries})) != 2 {
+		t.Error("ToWebSeriesList() should convert every series")
+	}
+}
@@ -69,7 +69,11 @@ func (r *testRepo) CreateContent(ctx context.Context, content *feat.Content) err
 func (r *testRepo) GetContent(ctx context.Context, id uuid.UUID) (feat.Content, error) {
 	return feat.Content{}, nil
 }
+func (r *testRepo) GetContentBySlug(ctx context.Context, slug string) (feat.Content, error) {
+	return feat.Content{}, nil
+}
 func (r *testRepo) UpdateContent(ctx context.Context, content *feat.Content) error                       { return nil }
+func (r *testRepo) MoveContent(ctx context.Context, move feat.ContentMove) error                          { return nil }
 func (r *testRepo) DeleteContent(ctx context.Context, id uuid.UUID) error                                 { return nil }
 func (r *testRepo) GetAllContentWithMeta(ctx context.Context) ([]feat.Content, error)                     { return nil, nil }
 func (r *testRepo) GetContentWithPaginationAndSearch(ctx context.Context, offset, limit int, searchQuery string) ([]feat.Content, int, error) {
@@ -81,6 +85,7 @@ func (r *testRepo) GetSection(ctx context.Context, id uuid.UUID) (feat.Section,
 }
 func (r *testRepo) GetSections(ctx context.Context) ([]feat.Section, error) { return nil, nil }
 func (r *testRepo) UpdateSection(ctx context.Context, section feat.Section) error { return nil }
+func (r *testRepo) MoveSections(ctx context.Context, move feat.SectionMove) error { return nil }
 func (r *testRepo) DeleteSection(ctx context.Context, id uuid.UUID) error          { return nil }
 func (r *testRepo) CreateLayout(ctx context.Context, layout feat.Layout) error     { return nil }
 func (r *testRepo) GetLayout(ctx context.Context, id uuid.UUID) (feat.Layout, error) {
@@ -95,6 +100,81 @@ func (r *testRepo) GetTagByName(ctx context.Context, name string) (feat.Tag, err
 func (r *testRepo) GetAllTags(ctx context.Context) ([]feat.Tag, error)                  { return nil, nil }
 func (r *testRepo) UpdateTag(ctx context.Context, tag feat.Tag) error                   { return nil }
 func (r *testRepo) DeleteTag(ctx context.Context, id uuid.UUID) error                   { return nil }
+func (r *testRepo) CreateSeries(ctx context.Context, series feat.Series) error          { return nil }
+func (r *testRepo) GetSeries(ctx context.Context, id uuid.UUID) (feat.Series, error)    { return feat.Series{}, nil }
+func (r *testRepo) GetAllSeries(ctx context.Context) ([]feat.Series, error)             { return nil, nil }
+func (r *testRepo) UpdateSeries(ctx context.Context, series feat.Series) error          { return nil }
+func (r *testRepo) DeleteSeries(ctx context.Context, id uuid.UUID) error                { return nil }
+func (r *testRepo) UpdateSeriesOrder(ctx context.Context, seriesID uuid.UUID, contentIDs []uuid.UUID) error {
+	return nil
+}
+func (r *testRepo) CreateAuthor(ctx context.Context, author feat.Author) error { return nil }
+func (r *testRepo) GetAuthor(ctx context.Context, id uuid.UUID) (feat.Author, error) {
+	return feat.Author{}, nil
+}
+func (r *testRepo) GetAllAuthors(ctx context.Context) ([]feat.Author, error)   { return nil, nil }
+func (r *testRepo) UpdateAuthor(ctx context.Context, author feat.Author) error { return nil }
+func (r *testRepo) DeleteAuthor(ctx context.Context, id uuid.UUID) error       { return nil }
+func (r *testRepo) GetAuthorsForContent(ctx context.Context, contentID uuid.UUID) ([]feat.Author, error) {
+	return nil, nil
+}
+func (r *testRepo) GetContentAuthors(ctx context.Context) ([]feat.ContentAuthor, error) {
+	return nil, nil
+}
+func (r *testRepo) SetContentAuthors(ctx context.Context, contentID uuid.UUID, authorIDs []uuid.UUID) error {
+	return nil
+}
+func (r *testRepo) CreateContentType(ctx context.Context, contentType feat.ContentType) error {
+	return nil
+}
+func (r *testRepo) GetContentType(ctx context.Context, id uuid.UUID) (feat.ContentType, error) {
+	return feat.ContentType{}, nil
+}
+func (r *testRepo) GetContentTypes(ctx context.Context) ([]feat.ContentType, error) { return nil, nil }
+func (r *testRepo) UpdateContentType(ctx context.Context, contentType feat.ContentType) error {
+	return nil
+}
+func (r *testRepo) DeleteContentType(ctx context.Context, id uuid.UUID) error { return nil }
+func (r *testRepo) GetFieldsForContent(ctx context.Context, contentID uuid.UUID) ([]feat.ContentField, error) {
+	return nil, nil
+}
+func (r *testRepo) GetContentFields(ctx context.Context) ([]feat.ContentField, error) { return nil, nil }
+func (r *testRepo) SetContentFields(ctx context.Context, contentID uuid.UUID, fields []feat.ContentField) error {
+	return nil
+}
+func (r *testRepo) CreateArchetype(ctx context.Context, archetype feat.Archetype) error { return nil }
+func (r *testRepo) GetArchetype(ctx context.Context, id uuid.UUID) (feat.Archetype, error) {
+	return feat.Archetype{}, nil
+}
+func (r *testRepo) GetArchetypes(ctx context.Context) ([]feat.Archetype, error)         { return nil, nil }
+func (r *testRepo) UpdateArchetype(ctx context.Context, archetype feat.Archetype) error { return nil }
+func (r *testRepo) DeleteArchetype(ctx context.Context, id uuid.UUID) error             { return nil }
+func (r *testRepo) CreateComment(ctx context.Context, comment feat.Comment) error       { return nil }
+func (r *testRepo) GetComment(ctx context.Context, id uuid.UUID) (feat.Comment, error) {
+	return feat.Comment{}, nil
+}
+func (r *testRepo) GetComments(ctx context.Context, status string) ([]feat.Comment, error) {
+	return nil, nil
+}
+func (r *testRepo) UpdateComment(ctx context.Context, comment feat.Comment) error    { return nil }
+func (r *testRepo) DeleteComment(ctx context.Context, id uuid.UUID) error            { return nil }
+func (r *testRepo) AddCommentRebuild(ctx context.Context, contentID uuid.UUID) error { return nil }
+func (r *testRepo) GetCommentRebuilds(ctx context.Context) ([]uuid.UUID, error) {
+	return nil, nil
+}
+func (r *testRepo) ClearCommentRebuilds(ctx context.Context, contentIDs []uuid.UUID) error {
+	return nil
+}
+func (r *testRepo) CreateMenu(ctx context.Context, menu feat.Menu) error                        { return nil }
+func (r *testRepo) GetMenu(ctx context.Context, id uuid.UUID) (feat.Menu, error)                { return feat.Menu{}, nil }
+func (r *testRepo) GetMenus(ctx context.Context) ([]feat.Menu, error)                           { return nil, nil }
+func (r *testRepo) UpdateMenu(ctx context.Context, menu feat.Menu) error                        { return nil }
+func (r *testRepo) DeleteMenu(ctx context.Context, id uuid.UUID) error                          { return nil }
+func (r *testRepo) CreateMenuItem(ctx context.Context, item feat.MenuItem) error                { return nil }
+func (r *testRepo) GetMenuItem(ctx context.Context, id uuid.UUID) (feat.MenuItem, error)        { return feat.MenuItem{}, nil }
+func (r *testRepo) GetMenuItems(ctx context.Context, menuID uuid.UUID) ([]feat.MenuItem, error) { return nil, nil }
+func (r *testRepo) UpdateMenuItem(ctx context.Context, item feat.MenuItem) error                { return nil }
+func (r *testRepo) DeleteMenuItem(ctx context.Context, id uuid.UUID) error                      { return nil }
 func (r *testRepo) CreateParam(ctx context.Context, param *feat.Param) error            { return nil }
 func (r *testRepo) GetParam(ctx context.Context, id uuid.UUID) (feat.Param, error)      { return feat.Param{}, nil }
 func (r *testRepo) GetParamByName(ctx context.Context, name string) (feat.Param, error) {
@@ -133,6 +213,14 @@ func (r *testRepo) DeleteContentImage(ctx context.Context, id uuid.UUID) error {
 func (r *testRepo) GetContentImagesByContentID(ctx context.Context, contentID uuid.UUID) ([]feat.ContentImage, error) {
 	return nil, nil
 }
+func (r *testRepo) CreateContentAlias(ctx context.Context, alias *feat.ContentAlias) error { return nil }
+func (r *testRepo) GetContentAliases(ctx context.Context) ([]feat.ContentAlias, error) {
+	return nil, nil
+}
+func (r *testRepo) GetContentAliasesByContentID(ctx context.Context, contentID uuid.UUID) ([]feat.ContentAlias, error) {
+	return nil, nil
+}
+func (r *testRepo) DeleteContentAlias(ctx context.Context, id uuid.UUID) error { return nil }
 func (r *testRepo) CreateSectionImage(ctx context.Context, sectionImage *feat.SectionImage) error {
 	return nil
 }
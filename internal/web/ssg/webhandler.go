@@ -5,6 +5,8 @@ import (
 	"html/template"
 	"net/http"
 	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -41,6 +43,31 @@ func (wh *WebHandler) addSiteSlugHeader(r *http.Request) *http.Request {
 	return r
 }
 
+// browserAPIURL returns the base URL of the API for scripts running in the
+// browser, from the configured API server address.
+func (wh *WebHandler) browserAPIURL() string {
+	return "http://" + wh.Cfg().APIAddr() + "/api/v1"
+}
+
+// apiStatusPattern matches the status the APIClient reports in the error of
+// a failed response. The client returns no typed error, so this is the only
+// place that depends on its message.
+var apiStatusPattern = regexp.MustCompile(`failed with status (\d{3})\b`)
+
+// apiErrorStatus returns the HTTP status of the failed API response err
+// reports, or 0 when err is not one.
+func apiErrorStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+	m := apiStatusPattern.FindStringSubmatch(err.Error())
+	if m == nil {
+		return 0
+	}
+	status, _ := strconv.Atoi(m[1])
+	return status
+}
+
 func (wh *WebHandler) ServeStaticImage(w http.ResponseWriter, r *http.Request) {
 	siteSlug, ok := feat.GetSiteSlugFromContext(r.Context())
 	if !ok || siteSlug == "" {
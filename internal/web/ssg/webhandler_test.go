@@ -1,6 +1,8 @@
 package ssg
 
 import (
+	"errors"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -46,3 +48,31 @@ func TestWebHandlerServeStaticImage(t *testing.T) {
 		})
 	}
 }
+
+func TestAPIErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "slug already in use", http.StatusConflict)
+	}))
+	defer server.Close()
+
+	client := hm.NewAPIClient("test-client", func() string { return "" }, server.URL, hm.XParams{Cfg: hm.NewConfig()})
+	clientErr := client.Get(httptest.NewRequest(http.MethodGet, "/", nil), "/ssg/contents", nil)
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "api client error", err: clientErr, want: http.StatusConflict},
+		{name: "other error", err: errors.New("status 409 of something else"), want: 0},
+		{name: "no error", err: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := apiErrorStatus(tt.err); got != tt.want {
+				t.Errorf("apiErrorStatus(%v) = %d, want %d", tt.err, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+func (h *WebHandler) NewArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New archetype form")
+	form := NewArchetypeForm(r)
+	h.renderArchetypeForm(w, r, form, NewArchetype(""), "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create archetype")
+	form, err := ArchetypeFormFromRequest(r)
+	if err != nil {
+		h.renderArchetypeForm(w, r, form, NewArchetype(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.ContentTypes, err = h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		archetype := ToFeatArchetype(form)
+		h.renderArchetypeForm(w, r, form, ToWebArchetype(archetype), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featArchetype := ToFeatArchetype(form)
+	var response struct {
+		Archetype feat.Archetype `json:"archetype"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/archetypes", featArchetype, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create archetype via API", http.StatusInternalServerError)
+		return
+	}
+
+	createdArchetype := ToWebArchetype(response.Archetype)
+	h.FlashInfo(w, r, "Archetype created")
+	h.Redir(w, r, hm.EditPath(&Archetype{}, createdArchetype.GetID()), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit archetype")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing archetype ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		Archetype feat.Archetype `json:"archetype"`
+	}
+	path := fmt.Sprintf("/ssg/archetypes/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get archetype from API", http.StatusInternalServerError)
+		return
+	}
+
+	archetype := response.Archetype
+	form := ToArchetypeForm(r, archetype)
+	h.renderArchetypeForm(w, r, form, ToWebArchetype(archetype), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update archetype")
+	form, err := ArchetypeFormFromRequest(r)
+	if err != nil {
+		h.renderArchetypeForm(w, r, form, NewArchetype(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.ContentTypes, err = h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		archetype := ToFeatArchetype(form)
+		h.renderArchetypeForm(w, r, form, ToWebArchetype(archetype), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featArchetype := ToFeatArchetype(form)
+	path := fmt.Sprintf("/ssg/archetypes/%s", featArchetype.GetID())
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featArchetype, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update archetype via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Archetype updated successfully")
+	h.Redir(w, r, hm.ListPath(&Archetype{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListArchetypes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List archetypes")
+	archetypes, err := h.getArchetypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get archetypes from API", http.StatusInternalServerError)
+		return
+	}
+
+	page := hm.NewPage(r, ToWebArchetypes(archetypes))
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-archetypes")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+func (h *WebHandler) DeleteArchetype(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete archetype")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing archetype ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/archetypes/%s", idStr)
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete archetype via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Archetype deleted successfully")
+	h.Redir(w, r, hm.ListPath(&Archetype{}), http.StatusSeeOther)
+}
+
+// getArchetypes returns the archetypes of the site with their default field
+// values.
+func (h *WebHandler) getArchetypes(r *http.Request) ([]feat.Archetype, error) {
+	var response struct {
+		Archetypes []feat.Archetype `json:"archetypes"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/archetypes", &response)
+	return response.Archetypes, err
+}
+
+func (h *WebHandler) renderArchetypeForm(w http.ResponseWriter, r *http.Request, form ArchetypeForm, archetype Archetype, errorMessage string, statusCode int) {
+	var sectionsResponse struct {
+		Sections []Section `json:"sections"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/sections", &sectionsResponse)
+	if err != nil {
+		h.Err(w, err, "Cannot get sections from API", http.StatusInternalServerError)
+		return
+	}
+
+	contentTypes, err := h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+	form.ContentTypes = contentTypes
+
+	page := hm.NewPage(r, archetype)
+	page.SetForm(&form)
+	page.AddSelect("kinds", kindOptions(contentTypes))
+	page.AddSelect("sections", hm.ToSelectOpt(hm.ToPtrSlice(sectionsResponse.Sections)))
+
+	if archetype.IsZero() {
+		page.Name = "New Archetype"
+		page.IsNew = true
+		page.Form.SetAction(hm.CreatePath(&Archetype{}))
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Archetype"
+		page.IsNew = false
+		page.Form.SetAction(hm.UpdatePath(&Archetype{}))
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-archetype")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
@@ -0,0 +1,198 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateArchetype(t *testing.T) {
+	createdID := uuid.New()
+
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postErr        error
+		wantStatusCode int
+		wantLocation   string
+	}{
+		{
+			name: "creates archetype successfully",
+			formData: url.Values{
+				"name":                  []string{"quick"},
+				"kind":                  []string{"recipe"},
+				"body":                  []string{"## Steps"},
+				"field.recipe.servings": []string{"2"},
+			},
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "/ssg/edit-archetype?id=" + createdID.String(),
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"name": []string{"quick"},
+				"kind": []string{"recipe"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postResp := map[string]interface{}{
+				"archetype": feat.Archetype{ID: createdID, Name: "quick", Kind: "recipe"},
+			}
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-archetype", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateArchetype(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateArchetype() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantLocation != "" && w.Header().Get("Location") != tt.wantLocation {
+				t.Errorf("CreateArchetype() location = %q, want %q", w.Header().Get("Location"), tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestWebHandlerUpdateArchetype(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+	}{
+		{
+			name:           "updates archetype successfully",
+			formData:       url.Values{"id": []string{uuid.New().String()}, "name": []string{"quick"}, "kind": []string{"article"}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{uuid.New().String()}, "name": []string{"quick"}, "kind": []string{"article"}},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/update-archetype", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.UpdateArchetype(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("UpdateArchetype() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteArchetype(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes archetype successfully",
+			formData:       url.Values{"id": []string{uuid.New().String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{uuid.New().String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-archetype", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteArchetype(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteArchetype() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerNewContentFromArchetype(t *testing.T) {
+	tests := []struct {
+		name           string
+		getErr         error
+		wantStatusCode int
+	}{
+		{
+			name:           "fails with unknown archetype",
+			wantStatusCode: http.StatusNotFound,
+		},
+		{
+			name:           "fails when API returns error",
+			getErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			getResp := map[string]interface{}{
+				"archetypes": []feat.Archetype{{ID: uuid.New(), Name: "quick", Kind: "recipe"}},
+			}
+			handler, server := newTestWebHandlerWithMockAPI(getResp, tt.getErr, nil, nil, nil, nil)
+			defer server.Close()
+
+			req := httptest.NewRequest(http.MethodGet, "/ssg/new-content?template=slow", nil)
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.NewContent(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("NewContent() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/hermesgen/clio/internal/feat/auth"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+func (h *WebHandler) NewAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New author form")
+	form := NewAuthorForm(r)
+	h.renderAuthorForm(w, r, form, NewAuthor(""), "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create author")
+	form, err := AuthorFormFromRequest(r)
+	if err != nil {
+		h.renderAuthorForm(w, r, form, NewAuthor(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		author := ToFeatAuthor(form)
+		h.renderAuthorForm(w, r, form, ToWebAuthor(author), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featAuthor := ToFeatAuthor(form)
+	var response struct {
+		Author feat.Author `json:"author"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/authors", featAuthor, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create author via API", http.StatusInternalServerError)
+		return
+	}
+
+	createdAuthor := ToWebAuthor(response.Author)
+	h.FlashInfo(w, r, "Author created")
+	h.Redir(w, r, hm.EditPath(&Author{}, createdAuthor.GetID()), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit author")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing author ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		Author feat.Author `json:"author"`
+	}
+	path := fmt.Sprintf("/ssg/authors/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get author from API", http.StatusInternalServerError)
+		return
+	}
+
+	author := response.Author
+	form := ToAuthorForm(r, author)
+	h.renderAuthorForm(w, r, form, ToWebAuthor(author), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update author")
+	form, err := AuthorFormFromRequest(r)
+	if err != nil {
+		h.renderAuthorForm(w, r, form, NewAuthor(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		author := ToFeatAuthor(form)
+		h.renderAuthorForm(w, r, form, ToWebAuthor(author), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featAuthor := ToFeatAuthor(form)
+	path := fmt.Sprintf("/ssg/authors/%s", featAuthor.GetID())
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featAuthor, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update author via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Author updated successfully")
+	h.Redir(w, r, hm.ListPath(&Author{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListAuthors(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List authors")
+	var response struct {
+		Authors []feat.Author `json:"authors"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/authors", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get authors from API", http.StatusInternalServerError)
+		return
+	}
+
+	authors := ToWebAuthors(response.Authors)
+	page := hm.NewPage(r, authors)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-authors")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+func (h *WebHandler) DeleteAuthor(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete author")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing author ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/authors/%s", idStr)
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete author via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Author deleted successfully")
+	h.Redir(w, r, hm.ListPath(&Author{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) renderAuthorForm(w http.ResponseWriter, r *http.Request, form AuthorForm, author Author, errorMessage string, statusCode int) {
+	var response struct {
+		Users []auth.User `json:"users"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/auth/users", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get users from API", http.StatusInternalServerError)
+		return
+	}
+	users := response.Users
+
+	page := hm.NewPage(r, author)
+	page.SetForm(&form)
+	page.AddSelect("users", hm.ToSelectOpt(hm.ToPtrSlice(users)))
+
+	if author.IsZero() {
+		page.Name = "New Author"
+		page.IsNew = true
+		page.Form.SetAction(hm.CreatePath(&Author{}))
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Author"
+		page.IsNew = false
+		page.Form.SetAction(hm.UpdatePath(&Author{}))
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-author")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
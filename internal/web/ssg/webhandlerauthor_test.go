@@ -0,0 +1,112 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateAuthor(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postResp       interface{}
+		postErr        error
+		wantStatusCode int
+	}{
+		{
+			name: "creates author successfully",
+			formData: url.Values{
+				"name": []string{"Jane Doe"},
+			},
+			postResp: map[string]interface{}{
+				"author": map[string]interface{}{
+					"id":   uuid.New().String(),
+					"name": "Jane Doe",
+				},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"name": []string{"Jane Doe"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, tt.postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-author", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateAuthor(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateAuthor() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteAuthor(t *testing.T) {
+	authorID := uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes author successfully",
+			formData:       url.Values{"id": []string{authorID.String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{authorID.String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-author", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteAuthor(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteAuthor() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/google/uuid"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const listCommentsPath = ssgPath + "/list-comments"
+
+// CommentList is the data of the comment moderation page.
+type CommentList struct {
+	Comments []feat.Comment
+	Status   string
+	// Rebuilds is the number of pages to rebuild for moderated comments to
+	// show up in the generated site.
+	Rebuilds int
+}
+
+func (h *WebHandler) ListComments(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List comments")
+	status := r.URL.Query().Get("status")
+	if status == "" {
+		status = feat.CommentPending
+	}
+	if !feat.ValidCommentStatus(status) {
+		h.Err(w, nil, "Invalid comment status", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		Comments []feat.Comment `json:"comments"`
+	}
+	path := "/ssg/comments?status=" + url.QueryEscape(status)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get comments from API", http.StatusInternalServerError)
+		return
+	}
+
+	var rebuilds struct {
+		ContentIDs []uuid.UUID `json:"content_ids"`
+	}
+	err = h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/comments/rebuilds", &rebuilds)
+	if err != nil {
+		h.Err(w, err, "Cannot get comment rebuilds from API", http.StatusInternalServerError)
+		return
+	}
+
+	data := CommentList{
+		Comments: response.Comments,
+		Status:   status,
+		Rebuilds: len(rebuilds.ContentIDs),
+	}
+	page := hm.NewPage(r, data)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-comments")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+// ModerateComment sets the status of a comment and goes back to the queue it
+// was moderated from.
+func (h *WebHandler) ModerateComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Moderate comment")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	id := r.Form.Get("id")
+	if id == "" {
+		h.Err(w, nil, "Missing comment ID", http.StatusBadRequest)
+		return
+	}
+
+	status := r.Form.Get("status")
+	if !feat.ValidCommentStatus(status) {
+		h.Err(w, nil, "Invalid comment status", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/comments/%s/status", url.PathEscape(id))
+	req := feat.ModerateCommentForm{Status: status}
+	err := h.apiClient.Put(h.addSiteSlugHeader(r), path, req, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to moderate comment via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, fmt.Sprintf("Comment marked as %s", status))
+	h.Redir(w, r, commentQueuePath(r.Form.Get("from")), http.StatusSeeOther)
+}
+
+func (h *WebHandler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete comment")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	id := r.Form.Get("id")
+	if id == "" {
+		h.Err(w, nil, "Missing comment ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/comments/%s", url.PathEscape(id))
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete comment via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Comment deleted successfully")
+	h.Redir(w, r, commentQueuePath(r.Form.Get("from")), http.StatusSeeOther)
+}
+
+// GenerateCommentPages rebuilds only the pages whose comments changed since
+// the last build.
+func (h *WebHandler) GenerateCommentPages(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Generate comment pages")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+	redir := commentQueuePath(r.Form.Get("from"))
+
+	var response struct {
+		Pages int `json:"pages"`
+	}
+	err := h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/generate-html/comments", nil, &response)
+	if err != nil {
+		h.FlashError(w, r, fmt.Sprintf("Failed to rebuild pages: %v", err))
+		h.Redir(w, r, redir, http.StatusSeeOther)
+		return
+	}
+
+	h.FlashSuccess(w, r, fmt.Sprintf("%d pages rebuilt with their comments", response.Pages))
+	h.Redir(w, r, redir, http.StatusSeeOther)
+}
+
+// commentQueuePath returns the path of the moderation queue for status.
+func commentQueuePath(status string) string {
+	if !feat.ValidCommentStatus(status) {
+		return listCommentsPath
+	}
+	return listCommentsPath + "?status=" + status
+}
@@ -0,0 +1,161 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerModerateComment(t *testing.T) {
+	id := uuid.New().String()
+
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+		wantLocation   string
+	}{
+		{
+			name:           "approves comment and goes back to its queue",
+			formData:       url.Values{"id": {id}, "status": {"approved"}, "from": {"spam"}},
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "/ssg/list-comments?status=spam",
+		},
+		{
+			name:           "goes back to the default queue with unknown origin",
+			formData:       url.Values{"id": {id}, "status": {"spam"}, "from": {"other"}},
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "/ssg/list-comments",
+		},
+		{
+			name:           "fails with missing id",
+			formData:       url.Values{"status": {"approved"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails with invalid status",
+			formData:       url.Values{"id": {id}, "status": {"deleted"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": {id}, "status": {"approved"}},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/moderate-comment", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.ModerateComment(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("ModerateComment() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantLocation != "" && w.Header().Get("Location") != tt.wantLocation {
+				t.Errorf("ModerateComment() location = %q, want %q", w.Header().Get("Location"), tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteComment(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes comment successfully",
+			formData:       url.Values{"id": {uuid.New().String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing id",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": {uuid.New().String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-comment", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteComment(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteComment() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerGenerateCommentPages(t *testing.T) {
+	tests := []struct {
+		name    string
+		postErr error
+	}{
+		{
+			name: "rebuilds pages successfully",
+		},
+		{
+			name:    "redirects back when API returns error",
+			postErr: fmt.Errorf("api error"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(url.Values{"from": {"approved"}}.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/generate-comment-pages", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.GenerateCommentPages(w, req)
+
+			if w.Code != http.StatusSeeOther {
+				t.Errorf("GenerateCommentPages() status = %d, want %d", w.Code, http.StatusSeeOther)
+			}
+			if got := w.Header().Get("Location"); got != "/ssg/list-comments?status=approved" {
+				t.Errorf("GenerateCommentPages() location = %q", got)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/hermesgen/clio/internal/feat/auth"
 	feat "github.com/hermesgen/clio/internal/feat/ssg"
 	"github.com/hermesgen/hm"
@@ -15,7 +17,34 @@ import (
 func (h *WebHandler) NewContent(w http.ResponseWriter, r *http.Request) {
 	h.Log().Info("New content form")
 	form := NewContentForm(r)
-	h.renderContentForm(w, r, form, NewContent("", ""), "", http.StatusOK)
+	content := NewContent("", "")
+
+	// Start from the archetype asked for, if any
+	if name := r.URL.Query().Get("template"); name != "" {
+		archetypes, err := h.getArchetypes(r)
+		if err != nil {
+			h.Err(w, err, "Cannot get archetypes from API", http.StatusInternalServerError)
+			return
+		}
+		archetype, ok := feat.FindArchetype(archetypes, name)
+		if !ok {
+			h.Err(w, nil, "Unknown archetype", http.StatusNotFound)
+			return
+		}
+
+		var featContent feat.Content
+		archetype.Apply(&featContent)
+		form = ToContentForm(r, featContent)
+		form.ID = ""
+		form.UserID = ""
+		if featContent.SectionID == uuid.Nil {
+			form.SectionID = ""
+		}
+		form.Archetype = archetype.Name
+		content = ToWebContent(featContent)
+	}
+
+	h.renderContentForm(w, r, form, content, "", http.StatusOK)
 }
 
 func (h *WebHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
@@ -30,6 +59,12 @@ func (h *WebHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
 
 	h.Log().Infof("Form parsed - Heading: %s, Body: %s, SectionID: %s, UserID: %s", form.Heading, form.Body, form.SectionID, form.UserID)
 
+	form.ContentTypes, err = h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+
 	form.Validate()
 	if form.HasErrors() {
 		h.Log().Infof("Form validation failed - Body empty: %v", form.Body == "")
@@ -47,6 +82,10 @@ func (h *WebHandler) CreateContent(w http.ResponseWriter, r *http.Request) {
 	}
 	h.Log().Info("Calling API to create content...")
 	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/contents", content, &response)
+	if isConflict(err) {
+		h.renderConflict(w, r, form, content, err)
+		return
+	}
 	if err != nil {
 		h.Err(w, err, "Failed to create content via API", http.StatusInternalServerError)
 		return
@@ -98,6 +137,12 @@ func (h *WebHandler) UpdateContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	form.ContentTypes, err = h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+
 	form.Validate()
 	if form.HasErrors() {
 		content := ToFeatContent(form)
@@ -110,6 +155,10 @@ func (h *WebHandler) UpdateContent(w http.ResponseWriter, r *http.Request) {
 
 	path := fmt.Sprintf("/ssg/contents/%s", content.GetID())
 	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, content, nil)
+	if isConflict(err) {
+		h.renderConflict(w, r, form, content, err)
+		return
+	}
 	if err != nil {
 		h.Err(w, err, "Failed to update content via API", http.StatusInternalServerError)
 		return
@@ -125,6 +174,28 @@ func (h *WebHandler) UpdateContent(w http.ResponseWriter, r *http.Request) {
 	h.Redir(w, r, hm.EditPath(&Content{}, content.GetID()), http.StatusSeeOther)
 }
 
+// renderConflict renders the content form back with the field flagged that
+// clashes with another content of the site: the slug, or the locale when the
+// translation group already has content in it.
+func (h *WebHandler) renderConflict(w http.ResponseWriter, r *http.Request, form ContentForm, content feat.Content, err error) {
+	validation := form.Validation()
+	if strings.Contains(err.Error(), feat.ErrTranslationTaken.Error()) {
+		validation.AddFieldError("locale", form.Locale, "The translation group already has content in this locale")
+		form.SetValidation(validation)
+		h.renderContentForm(w, r, form, ToWebContent(content), "Translation already exists", http.StatusConflict)
+		return
+	}
+
+	validation.AddFieldError("slug", form.Slug, "Slug is already used by another content")
+	form.SetValidation(validation)
+	h.renderContentForm(w, r, form, ToWebContent(content), "Slug already in use", http.StatusConflict)
+}
+
+// isConflict reports whether err is an API response with 409 Conflict status.
+func isConflict(err error) bool {
+	return apiErrorStatus(err) == http.StatusConflict
+}
+
 func (h *WebHandler) ListContent(w http.ResponseWriter, r *http.Request) {
 	h.Log().Info("List content")
 
@@ -244,6 +315,19 @@ func (h *WebHandler) ShowContent(w http.ResponseWriter, r *http.Request) {
 	page := hm.NewPage(r, content)
 	page.Name = "Show Content"
 
+	siteSlug, _ := feat.GetSiteSlugFromContext(r.Context())
+
+	// The backlinks are loaded from the API by the browser.
+	pageData := struct {
+		*hm.Page
+		SiteSlug   string
+		APIBaseURL string
+	}{
+		Page:       page,
+		SiteSlug:   siteSlug,
+		APIBaseURL: h.browserAPIURL(),
+	}
+
 	tmpl, err := h.Tmpl().Get(ssgFeat, "show-content")
 	if err != nil {
 		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
@@ -251,7 +335,7 @@ func (h *WebHandler) ShowContent(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, page); err != nil {
+	if err := tmpl.Execute(&buf, pageData); err != nil {
 		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
 		return
 	}
@@ -460,6 +544,56 @@ func (h *WebHandler) renderContentForm(w http.ResponseWriter, r *http.Request, f
 	tags := tagsResponse.Tags
 	h.Log().Debugf("Tags received: %+v", tags)
 
+	var seriesResponse struct {
+		Series []Series `json:"series"`
+	}
+	if siteMode != "blog" {
+		h.Log().Debug("Calling API to get series")
+		err = h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/series", &seriesResponse)
+		if err != nil {
+			h.Log().Errorf("Cannot get series from API: %v", err)
+			h.Err(w, err, "Cannot get series from API", http.StatusInternalServerError)
+			return
+		}
+	}
+	series := seriesResponse.Series
+
+	var authorsResponse struct {
+		Authors []Author `json:"authors"`
+	}
+	h.Log().Debug("Calling API to get authors")
+	err = h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/authors", &authorsResponse)
+	if err != nil {
+		h.Log().Errorf("Cannot get authors from API: %v", err)
+		h.Err(w, err, "Cannot get authors from API", http.StatusInternalServerError)
+		return
+	}
+	authors := authorsResponse.Authors
+
+	contentTypes, err := h.getContentTypes(r)
+	if err != nil {
+		h.Log().Errorf("Cannot get content types from API: %v", err)
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+	form.ContentTypes = contentTypes
+
+	var archetypes []Archetype
+	if content.IsZero() {
+		featArchetypes, err := h.getArchetypes(r)
+		if err != nil {
+			h.Log().Errorf("Cannot get archetypes from API: %v", err)
+			h.Err(w, err, "Cannot get archetypes from API", http.StatusInternalServerError)
+			return
+		}
+		// In blog mode, only archetypes of blog content can be used
+		for _, a := range ToWebArchetypes(featArchetypes) {
+			if siteMode != "blog" || a.Kind == "blog" {
+				archetypes = append(archetypes, a)
+			}
+		}
+	}
+
 	// In blog mode, only "blog" content type is allowed
 	var kinds []hm.SelectOpt
 	if siteMode == "blog" {
@@ -467,12 +601,7 @@ func (h *WebHandler) renderContentForm(w http.ResponseWriter, r *http.Request, f
 			{Value: "blog", Label: "Blog"},
 		}
 	} else {
-		kinds = []hm.SelectOpt{
-			{Value: "article", Label: "Article"},
-			{Value: "page", Label: "Page"},
-			{Value: "blog", Label: "Blog"},
-			{Value: "series", Label: "Series"},
-		}
+		kinds = kindOptions(contentTypes)
 	}
 
 	page := hm.NewPage(r, content)
@@ -480,7 +609,10 @@ func (h *WebHandler) renderContentForm(w http.ResponseWriter, r *http.Request, f
 	page.AddSelect("sections", hm.ToSelectOpt(hm.ToPtrSlice(sections)))
 	page.AddSelect("users", hm.ToSelectOpt(hm.ToPtrSlice(users)))
 	page.AddSelect("tags", hm.ToSelectOpt(hm.ToPtrSlice(tags)))
+	page.AddSelect("series", hm.ToSelectOpt(hm.ToPtrSlice(series)))
+	page.AddSelect("authors", hm.ToSelectOpt(hm.ToPtrSlice(authors)))
 	page.AddSelect("kinds", kinds)
+	page.AddSelect("archetypes", hm.ToSelectOpt(hm.ToPtrSlice(archetypes)))
 
 	if content.IsZero() {
 		page.Name = "New Content"
@@ -502,8 +634,21 @@ func (h *WebHandler) renderContentForm(w http.ResponseWriter, r *http.Request, f
 
 	page.SetFlash(h.GetFlash(r))
 
+	siteSlug, _ := feat.GetSiteSlugFromContext(r.Context())
+
+	// The form scripts call the API from the browser.
+	pageData := struct {
+		*hm.Page
+		SiteSlug   string
+		APIBaseURL string
+	}{
+		Page:       page,
+		SiteSlug:   siteSlug,
+		APIBaseURL: h.browserAPIURL(),
+	}
+
 	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, page)
+	err = tmpl.Execute(&buf, pageData)
 	if err != nil {
 		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
 		return
@@ -533,3 +678,20 @@ func (h *WebHandler) GenerateHTML(w http.ResponseWriter, r *http.Request) {
 	h.FlashSuccess(w, r, fmt.Sprintf("HTML generated successfully! Preview available at: %s", previewURL))
 	h.Redir(w, r, "/ssg/list-content", http.StatusSeeOther)
 }
+
+// kindOptions returns the kinds content can have: the built-in ones followed
+// by those the content types of the site add.
+func kindOptions(contentTypes []feat.ContentType) []hm.SelectOpt {
+	kinds := []hm.SelectOpt{
+		{Value: "article", Label: "Article"},
+		{Value: "page", Label: "Page"},
+		{Value: "blog", Label: "Blog"},
+		{Value: "series", Label: "Series"},
+	}
+	for _, ct := range ToWebContentTypes(contentTypes) {
+		if !feat.IsBuiltinKind(ct.Name) {
+			kinds = append(kinds, hm.SelectOpt{Value: ct.OptValue(), Label: ct.OptLabel()})
+		}
+	}
+	return kinds
+}
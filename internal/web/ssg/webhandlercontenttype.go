@@ -0,0 +1,216 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+func (h *WebHandler) NewContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New content type form")
+	form := NewContentTypeForm(r)
+	h.renderContentTypeForm(w, r, form, NewContentType(""), "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create content type")
+	form, err := ContentTypeFormFromRequest(r)
+	if err != nil {
+		h.renderContentTypeForm(w, r, form, NewContentType(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		contentType := ToFeatContentType(form)
+		h.renderContentTypeForm(w, r, form, ToWebContentType(contentType), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featContentType := ToFeatContentType(form)
+	var response struct {
+		ContentType feat.ContentType `json:"content_type"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/content-types", featContentType, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create content type via API", http.StatusInternalServerError)
+		return
+	}
+
+	createdContentType := ToWebContentType(response.ContentType)
+	h.FlashInfo(w, r, "Content type created")
+	h.Redir(w, r, hm.EditPath(&ContentType{}, createdContentType.GetID()), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit content type")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing content type ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		ContentType feat.ContentType `json:"content_type"`
+	}
+	path := fmt.Sprintf("/ssg/content-types/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get content type from API", http.StatusInternalServerError)
+		return
+	}
+
+	contentType := response.ContentType
+	form := ToContentTypeForm(r, contentType)
+	h.renderContentTypeForm(w, r, form, ToWebContentType(contentType), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update content type")
+	form, err := ContentTypeFormFromRequest(r)
+	if err != nil {
+		h.renderContentTypeForm(w, r, form, NewContentType(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		contentType := ToFeatContentType(form)
+		h.renderContentTypeForm(w, r, form, ToWebContentType(contentType), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featContentType := ToFeatContentType(form)
+	path := fmt.Sprintf("/ssg/content-types/%s", featContentType.GetID())
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featContentType, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update content type via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Content type updated successfully")
+	h.Redir(w, r, hm.ListPath(&ContentType{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListContentTypes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List content types")
+	contentTypes, err := h.getContentTypes(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get content types from API", http.StatusInternalServerError)
+		return
+	}
+
+	page := hm.NewPage(r, ToWebContentTypes(contentTypes))
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-content-types")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+func (h *WebHandler) DeleteContentType(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete content type")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing content type ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/content-types/%s", idStr)
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete content type via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Content type deleted successfully")
+	h.Redir(w, r, hm.ListPath(&ContentType{}), http.StatusSeeOther)
+}
+
+// getContentTypes returns the content types of the site with their field
+// schemas.
+func (h *WebHandler) getContentTypes(r *http.Request) ([]feat.ContentType, error) {
+	var response struct {
+		ContentTypes []feat.ContentType `json:"content_types"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/content-types", &response)
+	return response.ContentTypes, err
+}
+
+func (h *WebHandler) renderContentTypeForm(w http.ResponseWriter, r *http.Request, form ContentTypeForm, contentType ContentType, errorMessage string, statusCode int) {
+	var layoutsResponse struct {
+		Layouts []Layout `json:"layouts"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/layouts", &layoutsResponse)
+	if err != nil {
+		h.Err(w, err, "Cannot get layouts from API", http.StatusInternalServerError)
+		return
+	}
+
+	var sectionsResponse struct {
+		Sections []Section `json:"sections"`
+	}
+	err = h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/sections", &sectionsResponse)
+	if err != nil {
+		h.Err(w, err, "Cannot get sections from API", http.StatusInternalServerError)
+		return
+	}
+
+	fieldTypes := make([]hm.SelectOpt, len(feat.FieldTypes))
+	for i, t := range feat.FieldTypes {
+		fieldTypes[i] = hm.SelectOpt{Value: t, Label: t}
+	}
+
+	page := hm.NewPage(r, contentType)
+	page.SetForm(&form)
+	page.AddSelect("layouts", hm.ToSelectOpt(hm.ToPtrSlice(layoutsResponse.Layouts)))
+	page.AddSelect("sections", hm.ToSelectOpt(hm.ToPtrSlice(sectionsResponse.Sections)))
+	page.AddSelect("field_types", fieldTypes)
+
+	if contentType.IsZero() {
+		page.Name = "New Content Type"
+		page.IsNew = true
+		page.Form.SetAction(hm.CreatePath(&ContentType{}))
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Content Type"
+		page.IsNew = false
+		page.Form.SetAction(hm.UpdatePath(&ContentType{}))
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-content-type")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
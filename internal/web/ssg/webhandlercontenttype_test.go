@@ -0,0 +1,158 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateContentType(t *testing.T) {
+	createdID := uuid.New()
+
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postErr        error
+		wantStatusCode int
+		wantLocation   string
+	}{
+		{
+			name: "creates content type successfully",
+			formData: url.Values{
+				"name":        []string{"recipe"},
+				"field_name":  []string{"servings", ""},
+				"field_label": []string{"Servings", ""},
+				"field_type":  []string{"number", "string"},
+			},
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "/ssg/edit-content-type?id=" + createdID.String(),
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"name": []string{"recipe"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postResp := map[string]interface{}{
+				"content_type": feat.ContentType{ID: createdID, Name: "recipe"},
+			}
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-content-type", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateContentType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateContentType() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantLocation != "" && w.Header().Get("Location") != tt.wantLocation {
+				t.Errorf("CreateContentType() location = %q, want %q", w.Header().Get("Location"), tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestWebHandlerUpdateContentType(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+	}{
+		{
+			name:           "updates content type successfully",
+			formData:       url.Values{"id": []string{uuid.New().String()}, "name": []string{"recipe"}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{uuid.New().String()}, "name": []string{"recipe"}},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/update-content-type", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.UpdateContentType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("UpdateContentType() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteContentType(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes content type successfully",
+			formData:       url.Values{"id": []string{uuid.New().String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{uuid.New().String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-content-type", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteContentType(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteContentType() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const (
+	listDataFilesPath  = ssgPath + "/list-data-files"
+	createDataFilePath = ssgPath + "/create-data-file"
+	updateDataFilePath = ssgPath + "/update-data-file"
+)
+
+func (h *WebHandler) NewDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New data file form")
+	form := NewDataFileForm(r)
+	h.renderDataFileForm(w, r, form, true, "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create data file")
+	form, err := DataFileFormFromRequest(r)
+	if err != nil {
+		h.renderDataFileForm(w, r, form, true, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		h.renderDataFileForm(w, r, form, true, "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		DataFile feat.DataFile `json:"data_file"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/data-files", ToFeatDataFile(form), &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create data file via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Data file created")
+	h.Redir(w, r, editDataFilePath(response.DataFile.Name), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit data file")
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		h.Err(w, nil, "Missing data file name", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		DataFile feat.DataFile `json:"data_file"`
+	}
+	path := fmt.Sprintf("/ssg/data-files/%s", url.PathEscape(name))
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get data file from API", http.StatusInternalServerError)
+		return
+	}
+
+	form := ToDataFileForm(r, response.DataFile)
+	h.renderDataFileForm(w, r, form, false, "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update data file")
+	form, err := DataFileFormFromRequest(r)
+	if err != nil {
+		h.renderDataFileForm(w, r, form, false, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		h.renderDataFileForm(w, r, form, false, "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/data-files/%s", url.PathEscape(form.Name))
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, ToFeatDataFile(form), nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update data file via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Data file updated successfully")
+	h.Redir(w, r, listDataFilesPath, http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListDataFiles(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List data files")
+	var response struct {
+		DataFiles []feat.DataFile `json:"data_files"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/data-files", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get data files from API", http.StatusInternalServerError)
+		return
+	}
+
+	page := hm.NewPage(r, response.DataFiles)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-data-files")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+func (h *WebHandler) DeleteDataFile(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete data file")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	name := r.Form.Get("name")
+	if name == "" {
+		h.Err(w, nil, "Missing data file name", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/data-files/%s", url.PathEscape(name))
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete data file via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Data file deleted successfully")
+	h.Redir(w, r, listDataFilesPath, http.StatusSeeOther)
+}
+
+// renderDataFileForm renders the data file form. The name of existing files
+// cannot be changed.
+func (h *WebHandler) renderDataFileForm(w http.ResponseWriter, r *http.Request, form DataFileForm, isNew bool, errorMessage string, statusCode int) {
+	page := hm.NewPage(r, ToFeatDataFile(form))
+	page.SetForm(&form)
+
+	if isNew {
+		page.Name = "New Data File"
+		page.IsNew = true
+		page.Form.SetAction(createDataFilePath)
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Data File"
+		page.IsNew = false
+		page.Form.SetAction(updateDataFilePath)
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-data-file")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
+
+func editDataFilePath(name string) string {
+	return ssgPath + "/edit-data-file?name=" + url.QueryEscape(name)
+}
@@ -0,0 +1,149 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postErr        error
+		wantStatusCode int
+		wantLocation   string
+	}{
+		{
+			name:           "creates data file successfully",
+			formData:       url.Values{"name": []string{"team.yaml"}, "content": []string{"- name: Ada\n"}},
+			wantStatusCode: http.StatusSeeOther,
+			wantLocation:   "/ssg/edit-data-file?name=team.yaml",
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"name": []string{"team.yaml"}, "content": []string{"- name: Ada\n"}},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			postResp := map[string]interface{}{
+				"data_file": feat.DataFile{Name: "team.yaml", Format: feat.DataFormatYAML},
+			}
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-data-file", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateDataFile() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+			if tt.wantLocation != "" && w.Header().Get("Location") != tt.wantLocation {
+				t.Errorf("CreateDataFile() location = %q, want %q", w.Header().Get("Location"), tt.wantLocation)
+			}
+		})
+	}
+}
+
+func TestWebHandlerUpdateDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+	}{
+		{
+			name:           "updates data file successfully",
+			formData:       url.Values{"name": []string{"team.toml"}, "content": []string{"[[members]]\nname = \"Ada\"\n"}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"name": []string{"team.toml"}, "content": []string{"[[members]]\nname = \"Ada\"\n"}},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/update-data-file", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.UpdateDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("UpdateDataFile() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteDataFile(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes data file successfully",
+			formData:       url.Values{"name": []string{"team.yaml"}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing name",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"name": []string{"team.yaml"}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-data-file", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteDataFile(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteDataFile() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -2,11 +2,8 @@ package ssg
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
-	"image"
-	_ "image/gif"
-	_ "image/jpeg"
-	_ "image/png"
 	"io"
 	"net/http"
 	"os"
@@ -34,9 +31,7 @@ func (h *WebHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	form := NewImageForm(r) // Pass r
-	form.Name = r.FormValue("name")
-	form.Description = r.FormValue("description")
-	form.AltText = r.FormValue("altText")
+	readImageFormValues(r, &form)
 
 	file, header, err := r.FormFile("file")
 	if err != nil {
@@ -54,53 +49,57 @@ func (h *WebHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Determine upload directory
-	uploadDir, _ := h.Cfg().StrVal(feat.SSGKey.ImagesPath)
-	if err = os.MkdirAll(uploadDir, os.ModePerm); err != nil {
-		h.Err(w, err, "Cannot create upload directory", http.StatusInternalServerError)
+	data, err := io.ReadAll(file)
+	if err != nil {
+		h.Err(w, err, "Cannot read uploaded file", http.StatusBadRequest)
 		return
 	}
 
-	// Generate unique filename
-	filename := fmt.Sprintf("%s%s", uuid.New().String(), filepath.Ext(header.Filename))
-	destPath := filepath.Join(uploadDir, filename)
-
-	// Save the file
-	dst, err := os.Create(destPath)
+	keepMetadata := h.paramManager.Get(r.Context(), feat.SSGKey.ImagesKeepMetadata, "false") == "true"
+	processed, err := feat.ProcessImage(data, header.Header.Get("Content-Type"), keepMetadata)
 	if err != nil {
-		h.Err(w, err, "Cannot create file on disk", http.StatusInternalServerError)
+		msg := "File is not a valid image"
+		if errors.Is(err, feat.ErrImageTooLarge) {
+			msg = "Image is too large"
+		}
+		form.BaseForm.Validation().AddFieldError("file", "", msg)
+		h.renderImageForm(w, r, form, NewImage("", "", "", "", "", "", 0, 0, 0), "Validation failed", http.StatusBadRequest)
 		return
 	}
-	defer dst.Close()
 
-	if _, err = io.Copy(dst, file); err != nil {
-		h.Err(w, err, "Cannot save file to disk", http.StatusInternalServerError)
+	// Determine upload directory
+	uploadDir, _ := h.Cfg().StrVal(feat.SSGKey.ImagesPath)
+	if err = os.MkdirAll(uploadDir, os.ModePerm); err != nil {
+		h.Err(w, err, "Cannot create upload directory", http.StatusInternalServerError)
 		return
 	}
 
-	// Get image dimensions and mime type
-	imgFile, err := os.Open(destPath)
-	if err != nil {
-		h.Err(w, err, "Cannot open saved image file", http.StatusInternalServerError)
-		return
-	}
-	defer imgFile.Close()
+	// Generate unique filename using the decoded format extension
+	filename := fmt.Sprintf("%s%s", uuid.New().String(), processed.Extension)
+	destPath := filepath.Join(uploadDir, filename)
 
-	imgConfig, format, err := image.DecodeConfig(imgFile)
-	if err != nil {
-		h.Err(w, err, "Cannot decode image config", http.StatusInternalServerError)
+	// Save the sanitized file
+	if err = os.WriteFile(destPath, processed.Data, 0644); err != nil {
+		h.Err(w, err, "Cannot save file to disk", http.StatusInternalServerError)
 		return
 	}
 
-	// Reset file pointer for subsequent reads if needed, though not strictly necessary here
-	imgFile.Seek(0, io.SeekStart)
-
 	// Construct feat.Image
 	featImage := ToFeatImage(form)
 	featImage.FileName = header.Filename
 	featImage.FilePath = filename
-	featImage.Width = imgConfig.Width
-	featImage.Height = imgConfig.Height
+	featImage.Width = processed.Width
+	featImage.Height = processed.Height
+	featImage.FilesizeByte = int64(len(processed.Data))
+	featImage.Mime = processed.Mime
+
+	placeholder, err := feat.GeneratePlaceholder(processed.Data, processed.Orientation)
+	if err != nil {
+		h.Log().Errorf("Cannot generate image placeholder: %v", err)
+	}
+	if placeholder != nil {
+		featImage.DominantColor = placeholder.DominantColor
+	}
 
 	var response struct {
 		Image feat.Image `json:"image"`
@@ -117,10 +116,10 @@ func (h *WebHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 	featImageVariant.ImageID = createdImage.ID
 	featImageVariant.Kind = "original"
 	featImageVariant.BlobRef = "/static/images/" + filename // Use BlobRef
-	featImageVariant.Mime = "image/" + format
-	featImageVariant.FilesizeByte = header.Size
-	featImageVariant.Width = imgConfig.Width
-	featImageVariant.Height = imgConfig.Height
+	featImageVariant.Mime = processed.Mime
+	featImageVariant.FilesizeByte = int64(len(processed.Data))
+	featImageVariant.Width = processed.Width
+	featImageVariant.Height = processed.Height
 
 	var variantResponse struct {
 		ImageVariant feat.ImageVariant `json:"imageVariant"`
@@ -131,6 +130,14 @@ func (h *WebHandler) CreateImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if placeholder != nil {
+		placeholderVariant := placeholder.Variant(createdImage.ID)
+		err = h.apiClient.Post(h.addSiteSlugHeader(r), fmt.Sprintf("/ssg/images/%s/variants", createdImage.ID), placeholderVariant, nil)
+		if err != nil {
+			h.Log().Errorf("Failed to create placeholder image variant via API: %v", err)
+		}
+	}
+
 	h.FlashInfo(w, r, "Image created successfully")
 	h.Redir(w, r, hm.EditPath(&Image{}, createdImage.GetID()), http.StatusSeeOther)
 }
@@ -170,29 +177,36 @@ func (h *WebHandler) UpdateImage(w http.ResponseWriter, r *http.Request) {
 
 	form := NewImageForm(r) // Pass r
 	form.ID = r.FormValue("id")
-	form.Name = r.FormValue("name")
-	form.Description = r.FormValue("description")
-	form.AltText = r.FormValue("altText")
+	readImageFormValues(r, &form)
 	// Note: File upload is not handled in update for simplicity, assuming image content is immutable after creation
 
+	// Fetch the current image, the form only carries its editable metadata
+	id, _ := uuid.Parse(form.ID)
+	var currentImageResponse struct {
+		Image feat.Image `json:"image"`
+	}
+	err = h.apiClient.Get(h.addSiteSlugHeader(r), fmt.Sprintf("/ssg/images/%s", id), &currentImageResponse)
+	if err != nil {
+		h.Err(w, err, "Cannot get current image from API", http.StatusInternalServerError)
+		return
+	}
+
 	form.Validate()
 	if form.HasErrors() {
-		// Re-fetch image to pass to render function, as form doesn't contain full image data
-		id, _ := uuid.Parse(form.ID)
-		var currentImageResponse struct {
-			Image feat.Image `json:"image"`
-		}
-		err = h.apiClient.Get(h.addSiteSlugHeader(r), fmt.Sprintf("/ssg/images/%s", id), &currentImageResponse) // Declare err
-		if err != nil {
-			h.Err(w, err, "Cannot get image from API for validation", http.StatusInternalServerError)
-			return
-		}
 		h.renderImageForm(w, r, form, ToWebImage(currentImageResponse.Image), "Validation failed", http.StatusBadRequest)
 		return
 	}
 
+	// File information, Mime, FilesizeByte, Width and Height are kept from the current image
 	featImage := ToFeatImage(form)
-	// Mime, FilesizeByte, Width, Height are not updated here, as file upload is not handled
+	featImage.SiteID = currentImageResponse.Image.SiteID
+	featImage.FileName = currentImageResponse.Image.FileName
+	featImage.FilePath = currentImageResponse.Image.FilePath
+	featImage.Width = currentImageResponse.Image.Width
+	featImage.Height = currentImageResponse.Image.Height
+	featImage.FilesizeByte = currentImageResponse.Image.FilesizeByte
+	featImage.Mime = currentImageResponse.Image.Mime
+	featImage.DominantColor = currentImageResponse.Image.DominantColor
 
 	path := fmt.Sprintf("/ssg/images/%s", featImage.GetID())
 	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featImage, nil)
@@ -0,0 +1,512 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+func (h *WebHandler) NewMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New menu form")
+	form := NewMenuForm(r)
+	h.renderMenuForm(w, r, form, NewMenu(""), "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create menu")
+	form, err := MenuFormFromRequest(r)
+	if err != nil {
+		h.renderMenuForm(w, r, form, NewMenu(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		menu := ToFeatMenu(form)
+		h.renderMenuForm(w, r, form, ToWebMenu(menu), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featMenu := ToFeatMenu(form)
+	var response struct {
+		Menu feat.Menu `json:"menu"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/menus", featMenu, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create menu via API", http.StatusInternalServerError)
+		return
+	}
+
+	createdMenu := ToWebMenu(response.Menu)
+	h.FlashInfo(w, r, "Menu created")
+	h.Redir(w, r, hm.ShowPath(&Menu{}, createdMenu.GetID()), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit menu")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing menu ID", http.StatusBadRequest)
+		return
+	}
+
+	menu, err := h.menu(r, idStr)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu from API", http.StatusInternalServerError)
+		return
+	}
+
+	form := ToMenuForm(r, menu)
+	h.renderMenuForm(w, r, form, ToWebMenu(menu), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update menu")
+	form, err := MenuFormFromRequest(r)
+	if err != nil {
+		h.renderMenuForm(w, r, form, NewMenu(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		menu := ToFeatMenu(form)
+		h.renderMenuForm(w, r, form, ToWebMenu(menu), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featMenu := ToFeatMenu(form)
+	path := fmt.Sprintf("/ssg/menus/%s", featMenu.GetID())
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featMenu, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update menu via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu updated successfully")
+	h.Redir(w, r, hm.ListPath(&Menu{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListMenus(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List menus")
+	var response struct {
+		Menus []feat.Menu `json:"menus"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/menus", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get menus from API", http.StatusInternalServerError)
+		return
+	}
+
+	menus := ToWebMenus(response.Menus)
+	page := hm.NewPage(r, menus)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-menus")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+// MenuPage is the data of the menu page: the menu with its items in display
+// order, and the top level items new items can be nested below.
+type MenuPage struct {
+	Menu    Menu
+	Parents []MenuItem
+}
+
+func (h *WebHandler) ShowMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Show menu")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing menu ID", http.StatusBadRequest)
+		return
+	}
+
+	form := NewMenuItemForm(r)
+	form.MenuID = idStr
+	h.renderMenu(w, r, idStr, form, "", http.StatusOK)
+}
+
+func (h *WebHandler) DeleteMenu(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete menu")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing menu ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/menus/%s", idStr)
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete menu via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu deleted successfully")
+	h.Redir(w, r, hm.ListPath(&Menu{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) CreateMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create menu item")
+	form, err := MenuItemFormFromRequest(r)
+	if err != nil {
+		h.Err(w, err, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		if _, err := uuid.Parse(form.MenuID); err != nil {
+			h.Err(w, err, "Invalid menu ID", http.StatusBadRequest)
+			return
+		}
+		h.renderMenu(w, r, form.MenuID, form, "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	item := ToFeatMenuItem(form)
+	path := fmt.Sprintf("/ssg/menus/%s/items", item.MenuID)
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), path, item, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to create menu item via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu item added")
+	h.Redir(w, r, hm.ShowPath(&Menu{}, item.MenuID), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit menu item")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing menu item ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		MenuItem feat.MenuItem `json:"menu_item"`
+	}
+	path := fmt.Sprintf("/ssg/menu-items/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu item from API", http.StatusInternalServerError)
+		return
+	}
+
+	form := ToMenuItemForm(r, response.MenuItem)
+	h.renderMenuItemForm(w, r, form, ToWebMenuItem(response.MenuItem), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update menu item")
+	form, err := MenuItemFormFromRequest(r)
+	if err != nil {
+		h.Err(w, err, "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	item := ToFeatMenuItem(form)
+	if item.ID == uuid.Nil {
+		h.Err(w, nil, "Invalid menu item ID", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		h.renderMenuItemForm(w, r, form, ToWebMenuItem(item), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/menu-items/%s", item.ID)
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, item, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update menu item via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu item updated successfully")
+	h.Redir(w, r, hm.ShowPath(&Menu{}, item.MenuID), http.StatusSeeOther)
+}
+
+// MoveMenuItem moves a menu item one position up or down among its siblings.
+func (h *WebHandler) MoveMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Move menu item")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	id, err := uuid.Parse(r.Form.Get("id"))
+	if err != nil {
+		h.Err(w, err, "Invalid menu item ID", http.StatusBadRequest)
+		return
+	}
+	menuID, err := uuid.Parse(r.Form.Get("menu_id"))
+	if err != nil {
+		h.Err(w, err, "Invalid menu ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/menu-items/%s/move", id)
+	req := feat.MoveMenuItemRequest{Direction: r.Form.Get("direction")}
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, req, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to move menu item via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu order updated")
+	h.Redir(w, r, hm.ShowPath(&Menu{}, menuID), http.StatusSeeOther)
+}
+
+func (h *WebHandler) DeleteMenuItem(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete menu item")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing menu item ID", http.StatusBadRequest)
+		return
+	}
+	menuID, err := uuid.Parse(r.Form.Get("menu_id"))
+	if err != nil {
+		h.Err(w, err, "Invalid menu ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/menu-items/%s", idStr)
+	err = h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete menu item via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Menu item deleted successfully")
+	h.Redir(w, r, hm.ShowPath(&Menu{}, menuID), http.StatusSeeOther)
+}
+
+func (h *WebHandler) menu(r *http.Request, menuID string) (feat.Menu, error) {
+	var response struct {
+		Menu feat.Menu `json:"menu"`
+	}
+	path := fmt.Sprintf("/ssg/menus/%s", menuID)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		return feat.Menu{}, err
+	}
+	return response.Menu, nil
+}
+
+// menuTargets returns the select options of the sections, contents and tags
+// menu items can point to, and their names by ID.
+func (h *WebHandler) menuTargets(r *http.Request) (map[string][]hm.SelectOpt, map[uuid.UUID]string, error) {
+	var sectionsResponse struct {
+		Sections []Section `json:"sections"`
+	}
+	if err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/sections", &sectionsResponse); err != nil {
+		return nil, nil, fmt.Errorf("cannot get sections: %w", err)
+	}
+
+	var contentsResponse struct {
+		Contents []Content `json:"contents"`
+	}
+	if err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/contents", &contentsResponse); err != nil {
+		return nil, nil, fmt.Errorf("cannot get contents: %w", err)
+	}
+
+	var tagsResponse struct {
+		Tags []Tag `json:"tags"`
+	}
+	if err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/tags", &tagsResponse); err != nil {
+		return nil, nil, fmt.Errorf("cannot get tags: %w", err)
+	}
+
+	names := make(map[uuid.UUID]string)
+	for _, s := range sectionsResponse.Sections {
+		names[s.ID] = s.Name
+	}
+	for _, c := range contentsResponse.Contents {
+		names[c.ID] = c.Heading
+	}
+	for _, t := range tagsResponse.Tags {
+		names[t.ID] = t.Name
+	}
+
+	selects := map[string][]hm.SelectOpt{
+		"sections": hm.ToSelectOpt(hm.ToPtrSlice(sectionsResponse.Sections)),
+		"contents": hm.ToSelectOpt(hm.ToPtrSlice(contentsResponse.Contents)),
+		"tags":     hm.ToSelectOpt(hm.ToPtrSlice(tagsResponse.Tags)),
+		"targets": {
+			{Value: feat.MenuTargetSection, Label: "Section"},
+			{Value: feat.MenuTargetContent, Label: "Content"},
+			{Value: feat.MenuTargetTag, Label: "Tag"},
+			{Value: feat.MenuTargetURL, Label: "URL"},
+		},
+	}
+
+	return selects, names, nil
+}
+
+// menuParents returns the top level items of menu other than skipID, the
+// ones an item can be nested below.
+func menuParents(items []MenuItem, skipID uuid.UUID) []MenuItem {
+	var parents []MenuItem
+	for _, item := range items {
+		if !item.IsChild() && item.ID != skipID {
+			parents = append(parents, item)
+		}
+	}
+	return parents
+}
+
+// renderMenu renders the menu page with form as the add item form.
+func (h *WebHandler) renderMenu(w http.ResponseWriter, r *http.Request, menuID string, form MenuItemForm, errorMessage string, statusCode int) {
+	featMenu, err := h.menu(r, menuID)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu from API", http.StatusInternalServerError)
+		return
+	}
+
+	selects, names, err := h.menuTargets(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu targets from API", http.StatusInternalServerError)
+		return
+	}
+
+	menu := ToWebMenu(featMenu)
+	for i := range menu.Items {
+		menu.Items[i].TargetName = names[menu.Items[i].TargetID]
+	}
+
+	page := hm.NewPage(r, MenuPage{Menu: menu, Parents: menuParents(menu.Items, uuid.Nil)})
+	page.Name = "Show Menu"
+	page.SetForm(&form)
+	page.Form.SetAction(hm.CreatePath(&MenuItem{}))
+	page.Form.SetSubmitButtonText("Add")
+	for name, opts := range selects {
+		page.AddSelect(name, opts)
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "show-menu")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
+
+func (h *WebHandler) renderMenuForm(w http.ResponseWriter, r *http.Request, form MenuForm, menu Menu, errorMessage string, statusCode int) {
+	locations := []hm.SelectOpt{
+		{Value: feat.MenuLocationMain, Label: "Main"},
+		{Value: feat.MenuLocationFooter, Label: "Footer"},
+		{Value: feat.MenuLocationSubmenu, Label: "Submenu"},
+	}
+
+	page := hm.NewPage(r, menu)
+	page.SetForm(&form)
+	page.AddSelect("locations", locations)
+
+	if menu.IsZero() {
+		page.Name = "New Menu"
+		page.IsNew = true
+		page.Form.SetAction(hm.CreatePath(&Menu{}))
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Menu"
+		page.IsNew = false
+		page.Form.SetAction(hm.UpdatePath(&Menu{}))
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-menu")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
+
+func (h *WebHandler) renderMenuItemForm(w http.ResponseWriter, r *http.Request, form MenuItemForm, item MenuItem, errorMessage string, statusCode int) {
+	featMenu, err := h.menu(r, form.MenuID)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu from API", http.StatusInternalServerError)
+		return
+	}
+
+	selects, names, err := h.menuTargets(r)
+	if err != nil {
+		h.Err(w, err, "Cannot get menu targets from API", http.StatusInternalServerError)
+		return
+	}
+
+	menu := ToWebMenu(featMenu)
+	for i := range menu.Items {
+		menu.Items[i].TargetName = names[menu.Items[i].TargetID]
+	}
+	page := hm.NewPage(r, MenuPage{Menu: menu, Parents: menuParents(menu.Items, item.ID)})
+	page.Name = "Edit Menu Item"
+	page.SetForm(&form)
+	page.Form.SetAction(hm.UpdatePath(&MenuItem{}))
+	page.Form.SetSubmitButtonText("Update")
+	for name, opts := range selects {
+		page.AddSelect(name, opts)
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "edit-menu-item")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
@@ -0,0 +1,303 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateMenu(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postResp       interface{}
+		postErr        error
+		wantStatusCode int
+	}{
+		{
+			name: "creates menu successfully",
+			formData: url.Values{
+				"name":     []string{"Main"},
+				"location": []string{"main"},
+			},
+			postResp: map[string]interface{}{
+				"menu": map[string]interface{}{
+					"id":   uuid.New().String(),
+					"name": "Main",
+				},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"name":     []string{"Main"},
+				"location": []string{"main"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, tt.postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-menu", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateMenu(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateMenu() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteMenu(t *testing.T) {
+	menuID := uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes menu successfully",
+			formData:       url.Values{"id": []string{menuID.String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{menuID.String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-menu", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteMenu(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteMenu() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerCreateMenuItem(t *testing.T) {
+	menuID := uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postErr        error
+		wantStatusCode int
+	}{
+		{
+			name: "adds URL item",
+			formData: url.Values{
+				"menu_id":     []string{menuID.String()},
+				"label":       []string{"Docs"},
+				"target_type": []string{"url"},
+				"url":         []string{"https://example.com/docs"},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "adds section item",
+			formData: url.Values{
+				"menu_id":     []string{menuID.String()},
+				"target_type": []string{"section"},
+				"section_id":  []string{uuid.New().String()},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "fails with invalid menu ID",
+			formData: url.Values{
+				"menu_id":     []string{"invalid"},
+				"target_type": []string{"url"},
+				"url":         []string{"/about/"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"menu_id":     []string{menuID.String()},
+				"target_type": []string{"url"},
+				"url":         []string{"/about/"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-menu-item", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateMenuItem(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateMenuItem() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerMoveMenuItem(t *testing.T) {
+	menuID, itemID := uuid.New(), uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+	}{
+		{
+			name: "moves item up",
+			formData: url.Values{
+				"id":        []string{itemID.String()},
+				"menu_id":   []string{menuID.String()},
+				"direction": []string{"up"},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "fails with invalid item ID",
+			formData: url.Values{
+				"id":        []string{"invalid"},
+				"menu_id":   []string{menuID.String()},
+				"direction": []string{"up"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with invalid menu ID",
+			formData: url.Values{
+				"id":        []string{itemID.String()},
+				"direction": []string{"down"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"id":        []string{itemID.String()},
+				"menu_id":   []string{menuID.String()},
+				"direction": []string{"down"},
+			},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/move-menu-item", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.MoveMenuItem(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("MoveMenuItem() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteMenuItem(t *testing.T) {
+	menuID, itemID := uuid.New(), uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name: "deletes item successfully",
+			formData: url.Values{
+				"id":      []string{itemID.String()},
+				"menu_id": []string{menuID.String()},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{"menu_id": []string{menuID.String()}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"id":      []string{itemID.String()},
+				"menu_id": []string{menuID.String()},
+			},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-menu-item", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteMenuItem(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteMenuItem() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"net/http"
+	"sort"
 
 	"github.com/google/uuid"
 	feat "github.com/hermesgen/clio/internal/feat/ssg"
@@ -116,6 +117,8 @@ func (h *WebHandler) ListSections(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	sections := ToWebSections(response.Sections)
+	// Nested sections follow their parent
+	sort.SliceStable(sections, func(i, j int) bool { return sections[i].Path < sections[j].Path })
 
 	page := hm.NewPage(r, sections)
 	page.Form.SetAction(ssgPath)
@@ -208,9 +211,19 @@ func (h *WebHandler) renderSectionForm(w http.ResponseWriter, r *http.Request, f
 	}
 	layouts := response.Layouts
 
+	var sectionsResponse struct {
+		Sections []feat.Section `json:"sections"`
+	}
+	err = h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/sections", &sectionsResponse)
+	if err != nil {
+		h.Err(w, err, "Cannot get sections from API", http.StatusInternalServerError)
+		return
+	}
+
 	page := hm.NewPage(r, section)
 	page.SetForm(&form)
 	page.AddSelect("layouts", hm.ToSelectOpt(hm.ToPtrSlice(layouts)))
+	page.AddSelect("parents", sectionParentOpts(section.ID, sectionsResponse.Sections))
 
 	if section.IsZero() {
 		page.Name = "New Section"
@@ -241,3 +254,26 @@ func (h *WebHandler) renderSectionForm(w http.ResponseWriter, r *http.Request, f
 
 	h.OK(w, r, &buf, statusCode)
 }
+
+// sectionParentOpts returns the sections the section with id can be nested
+// in: all but itself and its descendants, labelled with their path.
+func sectionParentOpts(id uuid.UUID, sections []feat.Section) []hm.SelectOpt {
+	excluded := make(map[uuid.UUID]bool)
+	if id != uuid.Nil {
+		excluded[id] = true
+		for _, s := range feat.SectionDescendants(id, sections) {
+			excluded[s.ID] = true
+		}
+	}
+
+	var opts []hm.SelectOpt
+	for _, s := range sections {
+		if excluded[s.ID] || s.IsRoot() {
+			continue
+		}
+		opts = append(opts, hm.SelectOpt{Value: s.ID.String(), Label: s.Path})
+	}
+	sort.Slice(opts, func(i, j int) bool { return opts[i].Label < opts[j].Label })
+
+	return opts
+}
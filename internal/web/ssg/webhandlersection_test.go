@@ -5,11 +5,13 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/google/uuid"
 	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
 )
 
 func TestWebHandlerListSections(t *testing.T) {
@@ -359,3 +361,26 @@ func TestWebHandlerShowSection(t *testing.T) {
 		})
 	}
 }
+
+func TestSectionParentOpts(t *testing.T) {
+	root := feat.Section{ID: uuid.New(), Name: "root", Path: "/"}
+	guides := feat.Section{ID: uuid.New(), Name: "Guides", Path: "/guides"}
+	golang := feat.Section{ID: uuid.New(), Name: "Go", Path: "/guides/go", ParentID: guides.ID}
+	news := feat.Section{ID: uuid.New(), Name: "News", Path: "/news"}
+	sections := []feat.Section{news, golang, root, guides}
+
+	labels := func(opts []hm.SelectOpt) []string {
+		var l []string
+		for _, o := range opts {
+			l = append(l, o.Label)
+		}
+		return l
+	}
+
+	if got := labels(sectionParentOpts(uuid.Nil, sections)); !reflect.DeepEqual(got, []string{"/guides", "/guides/go", "/news"}) {
+		t.Errorf("sectionParentOpts() for new section = %v", got)
+	}
+	if got := labels(sectionParentOpts(guides.ID, sections)); !reflect.DeepEqual(got, []string{"/news"}) {
+		t.Errorf("sectionParentOpts() should skip the section and its descendants, got %v", got)
+	}
+}
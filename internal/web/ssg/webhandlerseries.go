@@ -0,0 +1,341 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+func (h *WebHandler) NewSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("New series form")
+	form := NewSeriesForm(r)
+	h.renderSeriesForm(w, r, form, NewSeries(""), "", http.StatusOK)
+}
+
+func (h *WebHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Create series")
+	form, err := SeriesFormFromRequest(r)
+	if err != nil {
+		h.renderSeriesForm(w, r, form, NewSeries(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		series := ToFeatSeries(form)
+		h.renderSeriesForm(w, r, form, ToWebSeries(series), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featSeries := ToFeatSeries(form)
+	var response struct {
+		Series feat.Series `json:"series"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/series", featSeries, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to create series via API", http.StatusInternalServerError)
+		return
+	}
+
+	createdSeries := ToWebSeries(response.Series)
+	h.FlashInfo(w, r, "Series created")
+	h.Redir(w, r, hm.EditPath(&Series{}, createdSeries.GetID()), http.StatusSeeOther)
+}
+
+func (h *WebHandler) EditSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Edit series")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing series ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		Series feat.Series `json:"series"`
+	}
+	path := fmt.Sprintf("/ssg/series/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get series from API", http.StatusInternalServerError)
+		return
+	}
+
+	series := response.Series
+	form := ToSeriesForm(r, series)
+	h.renderSeriesForm(w, r, form, ToWebSeries(series), "", http.StatusOK)
+}
+
+func (h *WebHandler) UpdateSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Update series")
+	form, err := SeriesFormFromRequest(r)
+	if err != nil {
+		h.renderSeriesForm(w, r, form, NewSeries(""), "Invalid form data", http.StatusBadRequest)
+		return
+	}
+
+	form.Validate()
+	if form.HasErrors() {
+		series := ToFeatSeries(form)
+		h.renderSeriesForm(w, r, form, ToWebSeries(series), "Validation failed", http.StatusBadRequest)
+		return
+	}
+
+	featSeries := ToFeatSeries(form)
+	path := fmt.Sprintf("/ssg/series/%s", featSeries.GetID())
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, featSeries, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to update series via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Series updated successfully")
+	h.Redir(w, r, hm.ListPath(&Series{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) ListSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List series")
+	var response struct {
+		Series []feat.Series `json:"series"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/series", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get series from API", http.StatusInternalServerError)
+		return
+	}
+
+	series := ToWebSeriesList(response.Series)
+	page := hm.NewPage(r, series)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-series")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+// SeriesParts is the data of the series page: the series and its parts in
+// reading order.
+type SeriesParts struct {
+	Series Series
+	Parts  []feat.Content
+}
+
+func (h *WebHandler) ShowSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Show series")
+	idStr := r.URL.Query().Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing series ID", http.StatusBadRequest)
+		return
+	}
+
+	var response struct {
+		Series feat.Series `json:"series"`
+	}
+	path := fmt.Sprintf("/ssg/series/%s", idStr)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get series from API", http.StatusInternalServerError)
+		return
+	}
+
+	parts, err := h.seriesParts(r, idStr)
+	if err != nil {
+		h.Err(w, err, "Cannot get series parts from API", http.StatusInternalServerError)
+		return
+	}
+
+	page := hm.NewPage(r, SeriesParts{Series: ToWebSeries(response.Series), Parts: parts})
+	page.Name = "Show Series"
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "show-series")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+// ReorderSeries sets the reading order of the parts of a series. It accepts
+// either the full new order as content_ids, as sent by drag and drop, or a
+// single content_id to move one position up or down.
+func (h *WebHandler) ReorderSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Reorder series")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	seriesID, err := uuid.Parse(r.Form.Get("id"))
+	if err != nil {
+		h.Err(w, err, "Invalid series ID", http.StatusBadRequest)
+		return
+	}
+	idStr := seriesID.String()
+
+	var order []uuid.UUID
+	if values := r.Form["content_ids"]; len(values) > 0 {
+		for _, v := range values {
+			id, err := uuid.Parse(v)
+			if err != nil {
+				h.Err(w, err, "Invalid content ID", http.StatusBadRequest)
+				return
+			}
+			order = append(order, id)
+		}
+	} else {
+		parts, err := h.seriesParts(r, idStr)
+		if err != nil {
+			h.Err(w, err, "Cannot get series parts from API", http.StatusInternalServerError)
+			return
+		}
+		order = movePart(parts, r.Form.Get("content_id"), r.Form.Get("direction"))
+	}
+
+	path := fmt.Sprintf("/ssg/series/%s/order", idStr)
+	err = h.apiClient.Put(h.addSiteSlugHeader(r), path, feat.ReorderSeriesRequest{ContentIDs: order}, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to reorder series via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Series order updated")
+	h.Redir(w, r, hm.ShowPath(&Series{}, seriesID), http.StatusSeeOther)
+}
+
+func (h *WebHandler) DeleteSeries(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete series")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	idStr := r.Form.Get("id")
+	if idStr == "" {
+		h.Err(w, nil, "Missing series ID", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/series/%s", idStr)
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete series via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Series deleted successfully")
+	h.Redir(w, r, hm.ListPath(&Series{}), http.StatusSeeOther)
+}
+
+func (h *WebHandler) seriesParts(r *http.Request, seriesID string) ([]feat.Content, error) {
+	var response struct {
+		Contents []feat.Content `json:"contents"`
+	}
+	path := fmt.Sprintf("/ssg/series/%s/contents", seriesID)
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), path, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Contents, nil
+}
+
+// movePart returns the IDs of parts with the one identified by contentID
+// swapped with its previous ("up") or next ("down") sibling. The order is
+// unchanged when the part is unknown or already at that end.
+func movePart(parts []feat.Content, contentID, direction string) []uuid.UUID {
+	order := make([]uuid.UUID, len(parts))
+	pos := -1
+	for i, p := range parts {
+		order[i] = p.ID
+		if p.ID.String() == contentID {
+			pos = i
+		}
+	}
+	if pos < 0 {
+		return order
+	}
+
+	switch direction {
+	case "up":
+		if pos > 0 {
+			order[pos-1], order[pos] = order[pos], order[pos-1]
+		}
+	case "down":
+		if pos < len(order)-1 {
+			order[pos], order[pos+1] = order[pos+1], order[pos]
+		}
+	}
+	return order
+}
+
+func (h *WebHandler) renderSeriesForm(w http.ResponseWriter, r *http.Request, form SeriesForm, series Series, errorMessage string, statusCode int) {
+	var response struct {
+		Sections []Section `json:"sections"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/sections", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get sections from API", http.StatusInternalServerError)
+		return
+	}
+	sections := response.Sections
+
+	statuses := []hm.SelectOpt{
+		{Value: feat.SeriesStatusOngoing, Label: "Ongoing"},
+		{Value: feat.SeriesStatusComplete, Label: "Complete"},
+	}
+
+	page := hm.NewPage(r, series)
+	page.SetForm(&form)
+	page.AddSelect("sections", hm.ToSelectOpt(hm.ToPtrSlice(sections)))
+	page.AddSelect("statuses", statuses)
+
+	if series.IsZero() {
+		page.Name = "New Series"
+		page.IsNew = true
+		page.Form.SetAction(hm.CreatePath(&Series{}))
+		page.Form.SetSubmitButtonText("Create")
+	} else {
+		page.Name = "Edit Series"
+		page.IsNew = false
+		page.Form.SetAction(hm.UpdatePath(&Series{}))
+		page.Form.SetSubmitButtonText("Update")
+	}
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "new-series")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	page.SetFlash(h.GetFlash(r))
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, page)
+	if err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, statusCode)
+}
@@ -0,0 +1,221 @@
+package ssg
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+)
+
+func TestWebHandlerCreateSeries(t *testing.T) {
+	tests := []struct {
+		name           string
+		formData       url.Values
+		postResp       interface{}
+		postErr        error
+		wantStatusCode int
+	}{
+		{
+			name: "creates series successfully",
+			formData: url.Values{
+				"name":   []string{"Learning Go"},
+				"status": []string{"ongoing"},
+			},
+			postResp: map[string]interface{}{
+				"series": map[string]interface{}{
+					"id":   uuid.New().String(),
+					"name": "Learning Go",
+				},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"name":   []string{"Learning Go"},
+				"status": []string{"ongoing"},
+			},
+			postErr:        fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, tt.postResp, tt.postErr, nil, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/create-series", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.CreateSeries(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("CreateSeries() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerDeleteSeries(t *testing.T) {
+	seriesID := uuid.New()
+	tests := []struct {
+		name           string
+		formData       url.Values
+		deleteErr      error
+		wantStatusCode int
+	}{
+		{
+			name:           "deletes series successfully",
+			formData:       url.Values{"id": []string{seriesID.String()}},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with missing ID",
+			formData:       url.Values{},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "fails when API returns error",
+			formData:       url.Values{"id": []string{seriesID.String()}},
+			deleteErr:      fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(nil, nil, nil, nil, nil, tt.deleteErr)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/delete-series", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.DeleteSeries(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("DeleteSeries() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestWebHandlerReorderSeries(t *testing.T) {
+	seriesID := uuid.New()
+	first, second := uuid.New(), uuid.New()
+	parts := map[string]interface{}{
+		"contents": []map[string]interface{}{
+			{"id": first.String(), "heading": "First", "series_order": 1},
+			{"id": second.String(), "heading": "Second", "series_order": 2},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		formData       url.Values
+		putErr         error
+		wantStatusCode int
+	}{
+		{
+			name: "sets dragged order",
+			formData: url.Values{
+				"id":          []string{seriesID.String()},
+				"content_ids": []string{second.String(), first.String()},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name: "moves a part up",
+			formData: url.Values{
+				"id":         []string{seriesID.String()},
+				"content_id": []string{second.String()},
+				"direction":  []string{"up"},
+			},
+			wantStatusCode: http.StatusSeeOther,
+		},
+		{
+			name:           "fails with invalid series ID",
+			formData:       url.Values{"id": []string{"invalid"}},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails with invalid content ID",
+			formData: url.Values{
+				"id":          []string{seriesID.String()},
+				"content_ids": []string{"invalid"},
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "fails when API returns error",
+			formData: url.Values{
+				"id":          []string{seriesID.String()},
+				"content_ids": []string{second.String(), first.String()},
+			},
+			putErr:         fmt.Errorf("api error"),
+			wantStatusCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, server := newTestWebHandlerWithMockAPI(parts, nil, nil, nil, tt.putErr, nil)
+			defer server.Close()
+
+			body := strings.NewReader(tt.formData.Encode())
+			req := httptest.NewRequest(http.MethodPost, "/ssg/reorder-series", body)
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			ctx := feat.NewContextWithSite("test-site", uuid.New())
+			req = req.WithContext(ctx)
+			w := httptest.NewRecorder()
+
+			handler.ReorderSeries(w, req)
+
+			if w.Code != tt.wantStatusCode {
+				t.Errorf("ReorderSeries() status = %d, want %d", w.Code, tt.wantStatusCode)
+			}
+		})
+	}
+}
+
+func TestMovePart(t *testing.T) {
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	parts := []feat.Content{{ID: a}, {ID: b}, {ID: c}}
+
+	tests := []struct {
+		name      string
+		contentID string
+		direction string
+		want      []uuid.UUID
+	}{
+		{name: "moves up", contentID: b.String(), direction: "up", want: []uuid.UUID{b, a, c}},
+		{name: "moves down", contentID: b.String(), direction: "down", want: []uuid.UUID{a, c, b}},
+		{name: "first stays first", contentID: a.String(), direction: "up", want: []uuid.UUID{a, b, c}},
+		{name: "last stays last", contentID: c.String(), direction: "down", want: []uuid.UUID{a, b, c}},
+		{name: "unknown part", contentID: uuid.New().String(), direction: "up", want: []uuid.UUID{a, b, c}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := movePart(parts, tt.contentID, tt.direction)
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Fatalf("movePart() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
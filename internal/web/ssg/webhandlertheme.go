@@ -0,0 +1,150 @@
+package ssg
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+
+	feat "github.com/hermesgen/clio/internal/feat/ssg"
+	"github.com/hermesgen/hm"
+)
+
+const listThemesPath = ssgPath + "/list-themes"
+
+// ThemeList is the data of the themes page.
+type ThemeList struct {
+	Themes []feat.ThemeManifest
+	Active string
+}
+
+func (h *WebHandler) ListThemes(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("List themes")
+	var response struct {
+		Themes []feat.ThemeManifest `json:"themes"`
+	}
+	err := h.apiClient.Get(h.addSiteSlugHeader(r), "/ssg/themes", &response)
+	if err != nil {
+		h.Err(w, err, "Cannot get themes from API", http.StatusInternalServerError)
+		return
+	}
+
+	data := ThemeList{
+		Themes: response.Themes,
+		Active: h.paramManager.Get(r.Context(), feat.SSGKey.ThemeName, feat.DefaultThemeName),
+	}
+	page := hm.NewPage(r, data)
+	page.Form.SetAction(ssgPath)
+
+	tmpl, err := h.Tmpl().Get(ssgFeat, "list-themes")
+	if err != nil {
+		h.Err(w, err, hm.ErrTemplateNotFound, http.StatusInternalServerError)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		h.Err(w, err, hm.ErrCannotRenderTemplate, http.StatusInternalServerError)
+		return
+	}
+
+	h.OK(w, r, &buf, http.StatusOK)
+}
+
+// InstallTheme installs an uploaded theme zip. The upload is saved to a
+// temporary file the API installs the theme from.
+func (h *WebHandler) InstallTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Install theme")
+
+	err := r.ParseMultipartForm(32 << 20) // 32MB max upload size
+	if err != nil {
+		h.Err(w, err, "Cannot parse multipart form", http.StatusBadRequest)
+		return
+	}
+
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		h.Err(w, err, "Theme file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	tmp, err := os.CreateTemp("", "clio-theme-*.zip")
+	if err != nil {
+		h.Err(w, err, "Cannot save uploaded theme", http.StatusInternalServerError)
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, file)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		h.Err(w, err, "Cannot save uploaded theme", http.StatusInternalServerError)
+		return
+	}
+
+	req := feat.InstallThemeRequest{Source: tmp.Name()}
+	var response struct {
+		Theme feat.ThemeManifest `json:"theme"`
+	}
+	err = h.apiClient.Post(h.addSiteSlugHeader(r), "/ssg/themes", req, &response)
+	if err != nil {
+		h.Err(w, err, "Failed to install theme via API", http.StatusBadRequest)
+		return
+	}
+
+	h.FlashInfo(w, r, fmt.Sprintf("Theme %s installed", response.Theme.Name))
+	h.Redir(w, r, listThemesPath, http.StatusSeeOther)
+}
+
+func (h *WebHandler) SelectTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Select theme")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	name := r.Form.Get("name")
+	if name == "" {
+		h.Err(w, nil, "Missing theme name", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/themes/%s/select", url.PathEscape(name))
+	err := h.apiClient.Put(h.addSiteSlugHeader(r), path, nil, nil)
+	if err != nil {
+		h.Err(w, err, "Failed to select theme via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Theme selected, regenerate the site to apply it")
+	h.Redir(w, r, listThemesPath, http.StatusSeeOther)
+}
+
+func (h *WebHandler) DeleteTheme(w http.ResponseWriter, r *http.Request) {
+	h.Log().Info("Delete theme")
+	if err := r.ParseForm(); err != nil {
+		h.Err(w, err, "Failed to parse form", http.StatusBadRequest)
+		return
+	}
+
+	name := r.Form.Get("name")
+	if name == "" {
+		h.Err(w, nil, "Missing theme name", http.StatusBadRequest)
+		return
+	}
+
+	path := fmt.Sprintf("/ssg/themes/%s", url.PathEscape(name))
+	err := h.apiClient.Delete(h.addSiteSlugHeader(r), path)
+	if err != nil {
+		h.Err(w, err, "Failed to delete theme via API", http.StatusInternalServerError)
+		return
+	}
+
+	h.FlashInfo(w, r, "Theme deleted successfully")
+	h.Redir(w, r, listThemesPath, http.StatusSeeOther)
+}
@@ -42,6 +42,74 @@ func NewWebRouter(handler *WebHandler, mw []hm.Middleware, params hm.XParams) *h
 	core.Get("/show-tag", handler.ShowTag)
 	core.Post("/delete-tag", handler.DeleteTag)
 
+	// Series routes
+	core.Get("/new-series", handler.NewSeries)
+	core.Post("/create-series", handler.CreateSeries)
+	core.Get("/edit-series", handler.EditSeries)
+	core.Post("/update-series", handler.UpdateSeries)
+	core.Get("/list-series", handler.ListSeries)
+	core.Get("/show-series", handler.ShowSeries)
+	core.Post("/reorder-series", handler.ReorderSeries)
+	core.Post("/delete-series", handler.DeleteSeries)
+
+	// Author routes
+	core.Get("/new-author", handler.NewAuthor)
+	core.Post("/create-author", handler.CreateAuthor)
+	core.Get("/edit-author", handler.EditAuthor)
+	core.Post("/update-author", handler.UpdateAuthor)
+	core.Get("/list-authors", handler.ListAuthors)
+	core.Post("/delete-author", handler.DeleteAuthor)
+
+	// Content type routes
+	core.Get("/new-content-type", handler.NewContentType)
+	core.Post("/create-content-type", handler.CreateContentType)
+	core.Get("/edit-content-type", handler.EditContentType)
+	core.Post("/update-content-type", handler.UpdateContentType)
+	core.Get("/list-content-types", handler.ListContentTypes)
+	core.Post("/delete-content-type", handler.DeleteContentType)
+
+	// Archetype routes
+	core.Get("/new-archetype", handler.NewArchetype)
+	core.Post("/create-archetype", handler.CreateArchetype)
+	core.Get("/edit-archetype", handler.EditArchetype)
+	core.Post("/update-archetype", handler.UpdateArchetype)
+	core.Get("/list-archetypes", handler.ListArchetypes)
+	core.Post("/delete-archetype", handler.DeleteArchetype)
+
+	// Data file routes
+	core.Get("/new-data-file", handler.NewDataFile)
+	core.Post("/create-data-file", handler.CreateDataFile)
+	core.Get("/edit-data-file", handler.EditDataFile)
+	core.Post("/update-data-file", handler.UpdateDataFile)
+	core.Get("/list-data-files", handler.ListDataFiles)
+	core.Post("/delete-data-file", handler.DeleteDataFile)
+
+	// Theme routes
+	core.Get("/list-themes", handler.ListThemes)
+	core.Post("/install-theme", handler.InstallTheme)
+	core.Post("/select-theme", handler.SelectTheme)
+	core.Post("/delete-theme", handler.DeleteTheme)
+
+	// Comment routes
+	core.Get("/list-comments", handler.ListComments)
+	core.Post("/moderate-comment", handler.ModerateComment)
+	core.Post("/delete-comment", handler.DeleteComment)
+	core.Post("/generate-comment-pages", handler.GenerateCommentPages)
+
+	// Menu routes
+	core.Get("/new-menu", handler.NewMenu)
+	core.Post("/create-menu", handler.CreateMenu)
+	core.Get("/edit-menu", handler.EditMenu)
+	core.Post("/update-menu", handler.UpdateMenu)
+	core.Get("/list-menus", handler.ListMenus)
+	core.Get("/show-menu", handler.ShowMenu)
+	core.Post("/delete-menu", handler.DeleteMenu)
+	core.Post("/create-menu-item", handler.CreateMenuItem)
+	core.Get("/edit-menu-item", handler.EditMenuItem)
+	core.Post("/update-menu-item", handler.UpdateMenuItem)
+	core.Post("/move-menu-item", handler.MoveMenuItem)
+	core.Post("/delete-menu-item", handler.DeleteMenuItem)
+
 	// Layout routes
 	core.Get("/new-layout", handler.NewLayout)
 	core.Post("/create-layout", handler.CreateLayout)
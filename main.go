@@ -60,10 +60,11 @@ func main() {
 	authSeeder := auth.NewSeeder(assetsFS, engine, clioRepo, xparams)
 	ssgSeeder := ssg.NewSeeder(assetsFS, engine, clioRepo, xparams)
 	paramManager := ssg.NewParamManager(clioRepo, xparams)
-	imageManager := ssg.NewImageManager(xparams)
+	imageManager := ssg.NewImageManager(paramManager, xparams)
 	ssgAPIService := ssg.NewService(assetsFS, clioRepo, ssgGenerator, ssgPublisher, paramManager, imageManager, xparams)
 	ssgAPIHandler := ssg.NewAPIHandler("ssg-api-handler", ssgAPIService, siteManager, xparams)
 	ssgAPIRouter := ssg.NewAPIRouter(ssgAPIHandler, []hm.Middleware{hm.CORSMw, siteContextMw.APIHandler}, xparams)
+	ssgCommentRouter := ssg.NewCommentRouter(ssgAPIHandler, []hm.Middleware{hm.CORSMw, siteContextMw.PublicHandler}, xparams)
 
 	authAPIHandler := auth.NewAPIHandler("auth-api-handler", clioRepo, xparams)
 	authAPIRouter := auth.NewAPIRouter(authAPIHandler, []hm.Middleware{}, xparams)
@@ -89,6 +90,7 @@ func main() {
 	app.Add(authAPIRouter)
 	app.Add(ssgAPIHandler)
 	app.Add(ssgAPIRouter)
+	app.Add(ssgCommentRouter)
 
 	ssgWebHandler := webssg.NewWebHandler(templateManager, fm, paramManager, siteManager, sessionManager, xparams)
 	ssgWebRouter := webssg.NewWebRouter(ssgWebHandler, append(fm.Middlewares(), siteContextMw.WebHandler), xparams)
@@ -108,6 +110,7 @@ func main() {
 	app.Router.HandleFunc("/static/images/*", adminFileServer.Handler())
 	app.MountAPI("/api/v1/auth", authAPIRouter)
 	app.MountAPI("/api/v1/ssg", ssgAPIRouter)
+	app.MountAPI("/api/v1/comments", ssgCommentRouter)
 	app.MountWeb("/ssg", ssgWebRouter)
 	app.MountFileServer("/", fileServer)
 